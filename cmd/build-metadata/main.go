@@ -0,0 +1,111 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/buildpacks"
+	"github.com/google/kf/pkg/kf/describe"
+)
+
+func main() {
+	image := flag.String("image", "", "the built image")
+	runImage := flag.String("run-image", "", "the image used as a stack")
+	builderImage := flag.String("builder-image", "", "the image used as the builder")
+	sourceImage := flag.String("source-image", "", "the image the source was read from")
+	terminationLog := flag.String("termination-log", "/dev/termination-log", "the file to write the metadata to")
+
+	flag.Parse()
+
+	imageRef, img, err := fetch(*image)
+	if err != nil {
+		log.Fatalf("couldn't fetch built image: %s", err)
+	}
+
+	metadata, err := buildpacks.ReadBuildMetadata(imageRef, img)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if metadata.Stack == "" {
+		metadata.Stack = *runImage
+	}
+
+	if metadata.BuilderImageDigest, err = digest(*builderImage); err != nil {
+		log.Fatalf("couldn't fetch builder image: %s", err)
+	}
+
+	if metadata.SourceDigest, err = digest(*sourceImage); err != nil {
+		log.Fatalf("couldn't fetch source image: %s", err)
+	}
+
+	describe.SourceBuildMetadata(os.Stdout, metadata)
+
+	out, err := json.Marshal(v1alpha1.BuildMetadataMessage{BuildMetadata: metadata})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*terminationLog, out, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func fetch(image string) (name.Reference, gcrv1.Image, error) {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	auth, err := authn.DefaultKeychain.Resolve(ref.Context().Registry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	img, err := remote.Image(ref, remote.WithAuth(auth))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ref, img, nil
+}
+
+func digest(image string) (string, error) {
+	if image == "" {
+		return "", nil
+	}
+
+	_, img, err := fetch(image)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := img.Digest()
+	if err != nil {
+		return "", err
+	}
+
+	return hash.String(), nil
+}
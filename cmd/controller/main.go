@@ -16,6 +16,7 @@ package main
 
 import (
 	"github.com/google/kf/pkg/reconciler/app"
+	"github.com/google/kf/pkg/reconciler/organization"
 	"github.com/google/kf/pkg/reconciler/route"
 	"github.com/google/kf/pkg/reconciler/source"
 	"github.com/google/kf/pkg/reconciler/space"
@@ -25,6 +26,7 @@ import (
 func main() {
 	sharedmain.Main("controller",
 		// Append all controllers here
+		organization.NewController,
 		space.NewController,
 		source.NewController,
 		route.NewController,
@@ -0,0 +1,72 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/google/kf/pkg/broker"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func main() {
+	catalogPath := flag.String("catalog", "/etc/minibroker/catalog.yaml", "the YAML file with the catalog to serve")
+	namespace := flag.String("namespace", os.Getenv("POD_NAMESPACE"), "the namespace to store instances in")
+	masterURL := flag.String("master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
+	kubeconfig := flag.String("kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
+
+	flag.Parse()
+
+	if *namespace == "" {
+		log.Fatal("--namespace or POD_NAMESPACE is required")
+	}
+
+	catalog, err := broker.LoadCatalog(*catalogPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	clusterConfig, err := clientcmd.BuildConfigFromFlags(*masterURL, *kubeconfig)
+	if err != nil {
+		log.Fatalf("couldn't get cluster config: %s", err)
+	}
+
+	client, err := kubernetes.NewForConfig(clusterConfig)
+	if err != nil {
+		log.Fatalf("couldn't create Kubernetes client: %s", err)
+	}
+
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+	}
+
+	server := broker.NewServer(
+		catalog,
+		broker.NewSecretStore(client.CoreV1(), *namespace),
+		broker.Options{
+			Username: os.Getenv("BROKER_USERNAME"),
+			Password: os.Getenv("BROKER_PASSWORD"),
+			Logger:   log.New(os.Stderr, "", log.LstdFlags),
+		},
+	)
+
+	log.Printf("serving %d services on port %s", len(catalog.Services), port)
+	log.Fatal(http.ListenAndServe(":"+port, server))
+}
@@ -23,11 +23,18 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/google/kf/pkg/admission"
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kfclientset "github.com/google/kf/pkg/client/clientset/versioned"
+	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned"
+	"github.com/google/kf/pkg/kf/marketplace"
+	"github.com/google/kf/pkg/kf/organizations"
+	"github.com/google/kf/pkg/kf/spaces"
 	"github.com/google/kf/pkg/system"
 	apiconfig "github.com/google/kf/third_party/knative-serving/pkg/apis/config"
 	"github.com/google/kf/third_party/knative-serving/pkg/apis/serving/v1beta1"
 	routecfg "github.com/google/kf/third_party/knative-serving/pkg/reconciler/route/config"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	cv1alpha3 "knative.dev/pkg/client/clientset/versioned/typed/istio/v1alpha3"
@@ -86,6 +93,22 @@ func main() {
 		logger.Fatalw("Failed to get the istio client set", zap.Error(err))
 	}
 
+	kfClient, err := kfclientset.NewForConfig(clusterConfig)
+	if err != nil {
+		logger.Fatalw("Failed to get the kf client set", zap.Error(err))
+	}
+
+	serviceCatalogClient, err := servicecatalogclient.NewForConfig(clusterConfig)
+	if err != nil {
+		logger.Fatalw("Failed to get the service catalog client set", zap.Error(err))
+	}
+
+	planChecker := marketplace.NewServicePlanChecker(serviceCatalogClient, kfClient.KfV1alpha1())
+	spaceAccessChecker := spaces.NewSpaceAccessChecker(kubeClient.AuthorizationV1())
+	organizationLister := organizations.NewOrganizationLister(kfClient.KfV1alpha1())
+	quotaChecker := spaces.NewSpaceQuotaChecker(kfClient.KfV1alpha1(), serviceCatalogClient.ServicecatalogV1beta1())
+	spaceGetter := spaces.NewSpaceGetter(kfClient.KfV1alpha1())
+
 	// Watch the logging config map and dynamically update logging levels.
 	configMapWatcher := configmap.NewInformedWatcher(kubeClient, system.Namespace())
 	configMapWatcher.Watch(logging.ConfigMapName(), logging.UpdateLevelFromConfigMap(logger, atomicLevel, component))
@@ -117,10 +140,22 @@ func main() {
 		Client:  kubeClient,
 		Options: options,
 		Handlers: map[schema.GroupVersionKind]webhook.GenericCRD{
-			v1alpha1.SchemeGroupVersion.WithKind("Space"):      &v1alpha1.Space{},
-			v1alpha1.SchemeGroupVersion.WithKind("App"):        &v1alpha1.App{},
-			v1alpha1.SchemeGroupVersion.WithKind("Route"):      &v1alpha1.Route{},
-			v1alpha1.SchemeGroupVersion.WithKind("RouteClaim"): &v1alpha1.RouteClaim{},
+			v1alpha1.SchemeGroupVersion.WithKind("Organization"):          &v1alpha1.Organization{},
+			v1alpha1.SchemeGroupVersion.WithKind("Space"):                 &v1alpha1.Space{},
+			v1alpha1.SchemeGroupVersion.WithKind("App"):                   &v1alpha1.App{},
+			v1alpha1.SchemeGroupVersion.WithKind("Route"):                 &v1alpha1.Route{},
+			v1alpha1.SchemeGroupVersion.WithKind("RouteClaim"):            &v1alpha1.RouteClaim{},
+			v1alpha1.SchemeGroupVersion.WithKind("BuildpackCatalog"):      &v1alpha1.BuildpackCatalog{},
+			v1alpha1.SchemeGroupVersion.WithKind("ServiceInstanceShare"):  &v1alpha1.ServiceInstanceShare{},
+			v1alpha1.SchemeGroupVersion.WithKind("ServicePlanVisibility"): &v1alpha1.ServicePlanVisibility{},
+			v1alpha1.SchemeGroupVersion.WithKind("QuotaPlan"):             &v1alpha1.QuotaPlan{},
+			v1alpha1.SchemeGroupVersion.WithKind("SecurityGroup"):         &v1alpha1.SecurityGroup{},
+			v1alpha1.SchemeGroupVersion.WithKind("PlacementProfile"):      &v1alpha1.PlacementProfile{},
+			v1alpha1.SchemeGroupVersion.WithKind("SpaceTemplate"):         &v1alpha1.SpaceTemplate{},
+
+			// ServiceInstances are validated so plans can't be used in spaces
+			// they aren't enabled in.
+			servicecatalogv1beta1.SchemeGroupVersion.WithKind("ServiceInstance"): &v1alpha1.ServiceInstanceAdmission{},
 		},
 		Logger:                logger,
 		DisallowUnknownFields: true,
@@ -130,12 +165,41 @@ func main() {
 			// XXX: Route webhook needs to look at what VirtualServices are
 			// deployed.
 			ctx = v1alpha1.SetupIstioClient(ctx, istioClient)
+			ctx = v1alpha1.WithServicePlanChecker(ctx, planChecker)
+			ctx = v1alpha1.WithSpaceAccessChecker(ctx, spaceAccessChecker)
+			ctx = v1alpha1.WithOrganizationLister(ctx, organizationLister)
+			ctx = v1alpha1.WithSpaceQuotaChecker(ctx, quotaChecker)
+			ctx = v1alpha1.WithSpaceGetter(ctx, spaceGetter)
 
 			ctx = routeStore.ToContext(ctx)
 
 			return v1beta1.WithUpgradeViaDefaulting(store.ToContext(ctx))
 		},
 	}
+	// Pods and deletions are handled by a separate server. Pods only come
+	// from Kf's namespaces so pods in the rest of the cluster don't depend on
+	// Kf, and the Knative webhook allows every deletion.
+	admissionServer := &admission.Server{
+		Client: kubeClient,
+		Options: admission.Options{
+			ServiceName:              "admission-webhook",
+			Namespace:                system.Namespace(),
+			Port:                     8444,
+			MutatingConfigurations:   []string{"pod-placement.webhook.kf.dev"},
+			ValidatingConfigurations: []string{"space-deletion.webhook.kf.dev"},
+		},
+		Handlers: map[string]admission.AdmitFunc{
+			"/pods":   admission.AdmitPodPlacement,
+			"/spaces": admission.NewSpaceDeletionAdmitter(spaceGetter),
+		},
+		Logger: logger.Named("admission"),
+	}
+	go func() {
+		if err := admissionServer.Run(stopCh); err != nil {
+			logger.Fatalw("Failed to start the admission server", zap.Error(err))
+		}
+	}()
+
 	if err = controller.Run(stopCh); err != nil {
 		logger.Fatalw("Failed to start the admission controller", zap.Error(err))
 	}
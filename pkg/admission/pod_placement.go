@@ -0,0 +1,86 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/mattbaird/jsonpatch"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// AdmitPodPlacement sets the node selector, tolerations and affinity of pods
+// from the v1alpha1.PodPlacementAnnotation. Knative Serving and Build don't
+// accept all of these fields so Kf annotates the resources that create pods
+// instead. Pods without the annotation are allowed unchanged.
+func AdmitPodPlacement(request *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	patch, err := placementPatch(request.Object.Raw)
+	if err != nil {
+		return deny(err.Error())
+	}
+
+	response := allow()
+	if patch != nil {
+		patchType := admissionv1beta1.PatchTypeJSONPatch
+		response.Patch = patch
+		response.PatchType = &patchType
+	}
+
+	return response
+}
+
+// placementPatch returns a JSON patch that applies the annotated placement
+// to the pod or nil if the pod doesn't need to change.
+func placementPatch(raw []byte) ([]byte, error) {
+	pod := &corev1.Pod{}
+	if err := json.Unmarshal(raw, pod); err != nil {
+		return nil, fmt.Errorf("couldn't decode pod: %v", err)
+	}
+
+	placement, ok, err := v1alpha1.PodPlacementFromAnnotations(pod.Annotations)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %v", v1alpha1.PodPlacementAnnotation, err)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	before, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	placement.ApplyTo(&pod.Spec)
+
+	after, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	operations, err := jsonpatch.CreatePatch(before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(operations) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(operations)
+}
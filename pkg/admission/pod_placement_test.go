@@ -0,0 +1,123 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"encoding/json"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/testutil"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAdmitPodPlacement(t *testing.T) {
+	t.Parallel()
+
+	defaultToleration := corev1.Toleration{
+		Key:      "node.kubernetes.io/not-ready",
+		Operator: corev1.TolerationOpExists,
+		Effect:   corev1.TaintEffectNoExecute,
+	}
+	pciToleration := corev1.Toleration{
+		Key:      "dedicated",
+		Operator: corev1.TolerationOpEqual,
+		Value:    "pci",
+		Effect:   corev1.TaintEffectNoSchedule,
+	}
+
+	placement := v1alpha1.PodPlacement{
+		NodeSelector: map[string]string{"pool": "pci"},
+		Tolerations:  []corev1.Toleration{pciToleration},
+		Affinity:     &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}},
+	}
+	placementAnnotations, err := placement.Annotations()
+	testutil.AssertNil(t, "Annotations err", err)
+
+	newPod := func(annotations map[string]string) *corev1.Pod {
+		pod := &corev1.Pod{}
+		pod.Name = "my-pod"
+		pod.Annotations = annotations
+		pod.Spec.Containers = []corev1.Container{{Name: "user-container"}}
+		pod.Spec.Tolerations = []corev1.Toleration{defaultToleration}
+		return pod
+	}
+
+	wantPod := newPod(placementAnnotations)
+	wantPod.Spec.NodeSelector = placement.NodeSelector
+	wantPod.Spec.Tolerations = []corev1.Toleration{defaultToleration, pciToleration}
+	wantPod.Spec.Affinity = placement.Affinity
+
+	cases := map[string]struct {
+		pod         *corev1.Pod
+		wantAllowed bool
+		wantPatched bool
+		wantPod     *corev1.Pod
+	}{
+		"no placement": {
+			pod:         newPod(nil),
+			wantAllowed: true,
+			wantPod:     newPod(nil),
+		},
+		"placement": {
+			pod:         newPod(placementAnnotations),
+			wantAllowed: true,
+			wantPatched: true,
+			wantPod:     wantPod,
+		},
+		"already placed": {
+			pod:         wantPod,
+			wantAllowed: true,
+			wantPod:     wantPod,
+		},
+		"invalid placement": {
+			pod: newPod(map[string]string{v1alpha1.PodPlacementAnnotation: "not-json"}),
+		},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			raw, err := json.Marshal(tc.pod)
+			testutil.AssertNil(t, "marshal err", err)
+
+			response := AdmitPodPlacement(&admissionv1beta1.AdmissionRequest{
+				Object: runtime.RawExtension{Raw: raw},
+			})
+
+			testutil.AssertEqual(t, "allowed", tc.wantAllowed, response.Allowed)
+			testutil.AssertEqual(t, "patched", tc.wantPatched, response.Patch != nil)
+			if !tc.wantAllowed {
+				return
+			}
+
+			if response.Patch != nil {
+				patch, err := jsonpatch.DecodePatch(response.Patch)
+				testutil.AssertNil(t, "DecodePatch err", err)
+				raw, err = patch.Apply(raw)
+				testutil.AssertNil(t, "Apply err", err)
+			}
+
+			got := &corev1.Pod{}
+			testutil.AssertNil(t, "unmarshal err", json.Unmarshal(raw, got))
+			testutil.AssertEqual(t, "pod", tc.wantPod, got)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/mattbaird/jsonpatch"
+	"go.uber.org/zap"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/webhook"
+)
+
+// AdmitFunc returns the response to an admission request.
+type AdmitFunc func(request *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse
+
+// Options configures the admission server.
+type Options struct {
+	// ServiceName is the name of the Service in front of the server.
+	ServiceName string
+
+	// Namespace is the namespace of the Service.
+	Namespace string
+
+	// Port is the port the server listens on.
+	Port int
+
+	// MutatingConfigurations and ValidatingConfigurations are the names of
+	// the webhook configurations that send requests to the server. The
+	// server sets their CA bundle on startup.
+	MutatingConfigurations   []string
+	ValidatingConfigurations []string
+}
+
+// Server serves admission requests, routing them to the AdmitFunc
+// registered for the path the webhook configuration sends them to.
+type Server struct {
+	Client   kubernetes.Interface
+	Options  Options
+	Handlers map[string]AdmitFunc
+	Logger   *zap.SugaredLogger
+}
+
+// Run registers the webhooks and serves admission requests until stopCh is
+// closed.
+func (s *Server) Run(stopCh <-chan struct{}) error {
+	ctx := logging.WithLogger(context.Background(), s.Logger)
+	serverKey, serverCert, caCert, err := webhook.CreateCerts(ctx, s.Options.ServiceName, s.Options.Namespace)
+	if err != nil {
+		return fmt.Errorf("couldn't create certificates: %v", err)
+	}
+
+	if err := s.register(caCert); err != nil {
+		return fmt.Errorf("couldn't register the webhooks: %v", err)
+	}
+
+	cert, err := tls.X509KeyPair(serverCert, serverKey)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:      fmt.Sprintf(":%d", s.Options.Port),
+		Handler:   s,
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{cert}},
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		if err := server.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case <-stopCh:
+		return server.Close()
+	case err := <-errCh:
+		return err
+	}
+}
+
+// register sets the CA bundle of the webhook configurations so the API
+// server trusts the generated certificate.
+func (s *Server) register(caCert []byte) error {
+	patch, err := json.Marshal([]jsonpatch.JsonPatchOperation{
+		jsonpatch.NewPatch("add", "/webhooks/0/clientConfig/caBundle", caCert),
+	})
+	if err != nil {
+		return err
+	}
+
+	configurations := s.Client.AdmissionregistrationV1beta1()
+	for _, name := range s.Options.MutatingConfigurations {
+		if _, err := configurations.MutatingWebhookConfigurations().Patch(name, types.JSONPatchType, patch); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range s.Options.ValidatingConfigurations {
+		if _, err := configurations.ValidatingWebhookConfigurations().Patch(name, types.JSONPatchType, patch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	admit, ok := s.Handlers[r.URL.Path]
+	if !ok {
+		http.Error(rw, fmt.Sprintf("no webhook at %q", r.URL.Path), http.StatusNotFound)
+		return
+	}
+
+	var review admissionv1beta1.AdmissionReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+		http.Error(rw, fmt.Sprintf("couldn't decode request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if review.Request == nil {
+		http.Error(rw, "missing admission request", http.StatusBadRequest)
+		return
+	}
+
+	response := admit(review.Request)
+	response.UID = review.Request.UID
+	if !response.Allowed {
+		s.Logger.Infow("Rejected request",
+			zap.String("path", r.URL.Path),
+			zap.String("kind", review.Request.Kind.Kind),
+			zap.String("name", review.Request.Name),
+			zap.String("message", response.Result.Message))
+	}
+
+	if err := json.NewEncoder(rw).Encode(admissionv1beta1.AdmissionReview{Response: response}); err != nil {
+		http.Error(rw, fmt.Sprintf("couldn't encode response: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// allow returns a response allowing the request.
+func allow() *admissionv1beta1.AdmissionResponse {
+	return &admissionv1beta1.AdmissionResponse{Allowed: true}
+}
+
+// deny returns a response rejecting the request with the message.
+func deny(message string) *admissionv1beta1.AdmissionResponse {
+	return &admissionv1beta1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: message,
+		},
+	}
+}
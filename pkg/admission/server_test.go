@@ -0,0 +1,83 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+)
+
+func TestServer_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	server := &Server{
+		Handlers: map[string]AdmitFunc{
+			"/allow": func(*admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+				return allow()
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		path       string
+		body       interface{}
+		wantStatus int
+	}{
+		"allowed": {
+			path:       "/allow",
+			body:       admissionv1beta1.AdmissionReview{Request: &admissionv1beta1.AdmissionRequest{UID: "some-uid"}},
+			wantStatus: http.StatusOK,
+		},
+		"unknown path": {
+			path:       "/missing",
+			body:       admissionv1beta1.AdmissionReview{Request: &admissionv1beta1.AdmissionRequest{UID: "some-uid"}},
+			wantStatus: http.StatusNotFound,
+		},
+		"missing request": {
+			path:       "/allow",
+			body:       admissionv1beta1.AdmissionReview{},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := json.Marshal(tc.body)
+			testutil.AssertNil(t, "marshal err", err)
+
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, tc.path, bytes.NewReader(body)))
+
+			testutil.AssertEqual(t, "status", tc.wantStatus, recorder.Code)
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+
+			var review admissionv1beta1.AdmissionReview
+			testutil.AssertNil(t, "decode err", json.NewDecoder(recorder.Body).Decode(&review))
+			testutil.AssertEqual(t, "uid", "some-uid", string(review.Response.UID))
+			testutil.AssertEqual(t, "allowed", true, review.Response.Allowed)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+)
+
+// NewSpaceDeletionAdmitter creates an AdmitFunc that rejects deleting spaces
+// with deletion protection turned on. The space is looked up because the
+// API server doesn't send the object being deleted.
+func NewSpaceDeletionAdmitter(spaces v1alpha1.SpaceGetter) AdmitFunc {
+	return func(request *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+		if request.Operation != admissionv1beta1.Delete {
+			return allow()
+		}
+
+		space, err := spaces.GetSpace(request.Name)
+		if err != nil {
+			return deny(fmt.Sprintf("couldn't get space %q: %v", request.Name, err))
+		}
+
+		if space != nil && space.Spec.DeletionProtection {
+			return deny(fmt.Sprintf(
+				"space %q is protected from deletion, run 'kf configure-space unset-deletion-protection %s' first",
+				request.Name,
+				request.Name,
+			))
+		}
+
+		return allow()
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/testutil"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+)
+
+type fakeSpaceGetter struct {
+	space *v1alpha1.Space
+	err   error
+}
+
+func (f *fakeSpaceGetter) GetSpace(name string) (*v1alpha1.Space, error) {
+	return f.space, f.err
+}
+
+func TestNewSpaceDeletionAdmitter(t *testing.T) {
+	t.Parallel()
+
+	protected := &v1alpha1.Space{}
+	protected.Spec.DeletionProtection = true
+
+	cases := map[string]struct {
+		operation   admissionv1beta1.Operation
+		getter      *fakeSpaceGetter
+		wantAllowed bool
+		wantMessage string
+	}{
+		"unprotected": {
+			operation:   admissionv1beta1.Delete,
+			getter:      &fakeSpaceGetter{space: &v1alpha1.Space{}},
+			wantAllowed: true,
+		},
+		"protected": {
+			operation:   admissionv1beta1.Delete,
+			getter:      &fakeSpaceGetter{space: protected},
+			wantMessage: "space \"my-space\" is protected from deletion, run 'kf configure-space unset-deletion-protection my-space' first",
+		},
+		"missing": {
+			operation:   admissionv1beta1.Delete,
+			getter:      &fakeSpaceGetter{},
+			wantAllowed: true,
+		},
+		"lookup fails": {
+			operation:   admissionv1beta1.Delete,
+			getter:      &fakeSpaceGetter{err: errors.New("some-server-error")},
+			wantMessage: `couldn't get space "my-space": some-server-error`,
+		},
+		"not a delete": {
+			operation:   admissionv1beta1.Update,
+			getter:      &fakeSpaceGetter{space: protected},
+			wantAllowed: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			admit := NewSpaceDeletionAdmitter(tc.getter)
+			response := admit(&admissionv1beta1.AdmissionRequest{
+				Name:      "my-space",
+				Operation: tc.operation,
+			})
+
+			testutil.AssertEqual(t, "allowed", tc.wantAllowed, response.Allowed)
+			if !tc.wantAllowed {
+				testutil.AssertEqual(t, "message", tc.wantMessage, response.Result.Message)
+			}
+		})
+	}
+}
@@ -31,10 +31,14 @@ func AppSpecSourceMask(in SourceSpec) SourceSpec {
 	out.BuildpackBuild.Env = in.BuildpackBuild.Env
 	out.BuildpackBuild.Source = in.BuildpackBuild.Source
 	out.BuildpackBuild.Stack = in.BuildpackBuild.Stack
+	out.BuildpackBuild.RebasedImage = in.BuildpackBuild.RebasedImage
 	out.UpdateRequests = in.UpdateRequests
 	out.ContainerImage.Image = in.ContainerImage.Image
 	out.Dockerfile.Source = in.Dockerfile.Source
 	out.Dockerfile.Path = in.Dockerfile.Path
+	out.Dockerfile.BuildArgs = in.Dockerfile.BuildArgs
+	out.Dockerfile.Target = in.Dockerfile.Target
+	out.Dockerfile.Secrets = in.Dockerfile.Secrets
 
 	// Disallowed fields
 	// This list is unnecessary, but added here for clarity
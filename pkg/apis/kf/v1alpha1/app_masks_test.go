@@ -32,14 +32,18 @@ func TestAppSpecSourceMask(t *testing.T) {
 			Image:            "",
 			Source:           "gcr.io/custom-source:mysource",
 			Stack:            "cflinuxfs3",
+			RebasedImage:     "gcr.io/custom-image@sha256:abc",
 		},
 		ContainerImage: SourceSpecContainerImage{
 			Image: "mysql/mysql",
 		},
 		Dockerfile: SourceSpecDockerfile{
-			Image:  "",
-			Path:   "path/to/Dockerfile",
-			Source: "gcr.io/custom-source:dockerfilesource",
+			Image:     "",
+			Path:      "path/to/Dockerfile",
+			Source:    "gcr.io/custom-source:dockerfilesource",
+			BuildArgs: []corev1.EnvVar{{Name: "arg-key", Value: "arg-value"}},
+			Target:    "release",
+			Secrets:   []string{"npmrc"},
 		},
 	}
 
@@ -53,14 +57,18 @@ func TestAppSpecSourceMask(t *testing.T) {
 			Image:            "gcr.io/custom-image:label",
 			Source:           "gcr.io/custom-source:mysource",
 			Stack:            "cflinuxfs3",
+			RebasedImage:     "gcr.io/custom-image@sha256:abc",
 		},
 		ContainerImage: SourceSpecContainerImage{
 			Image: "mysql/mysql",
 		},
 		Dockerfile: SourceSpecDockerfile{
-			Image:  "gcr.io/custom-image:label",
-			Path:   "path/to/Dockerfile",
-			Source: "gcr.io/custom-source:dockerfilesource",
+			Image:     "gcr.io/custom-image:label",
+			Path:      "path/to/Dockerfile",
+			Source:    "gcr.io/custom-source:dockerfilesource",
+			BuildArgs: []corev1.EnvVar{{Name: "arg-key", Value: "arg-value"}},
+			Target:    "release",
+			Secrets:   []string{"npmrc"},
 		},
 	}
 
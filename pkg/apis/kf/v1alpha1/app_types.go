@@ -130,6 +130,12 @@ type AppSpecServiceBinding struct {
 	// Instance is the service the app will bind to.
 	Instance string `json:"instance"`
 
+	// InstanceSpace is the space of the service if it was shared with the
+	// App's space from another space.
+	// If unspecified the service is in the App's space.
+	// +optional
+	InstanceSpace string `json:"instanceSpace,omitempty"`
+
 	// Parameters is an arbitrary JSON to be injected into VCAP_SERVICES.
 	// +optional
 	Parameters json.RawMessage `json:"parameters,omitempty"`
@@ -138,6 +144,12 @@ type AppSpecServiceBinding struct {
 	// If unspecified it will default to the service name
 	// +optional
 	BindingName string `json:"bindingName,omitempty"`
+
+	// MountFiles mounts the credentials of the binding as files in
+	// $SERVICE_BINDING_ROOT/<BindingName>/ following the servicebinding.io
+	// spec in addition to VCAP_SERVICES.
+	// +optional
+	MountFiles bool `json:"mountFiles,omitempty"`
 }
 
 // MinAnnotationValue returns the value autoscaling.knative.dev/minScale should
@@ -186,6 +198,24 @@ func (instances *AppSpecInstances) ScalingAnnotations() map[string]string {
 	return out
 }
 
+// QuotaCount returns the number of instances counted against a space's app
+// instance quota. Autoscaled apps count as their maximum so the quota can't
+// be exceeded by scaling up.
+func (instances *AppSpecInstances) QuotaCount() int64 {
+	switch {
+	case instances.Stopped:
+		return 0
+	case instances.Exactly != nil:
+		return int64(*instances.Exactly)
+	case instances.Max != nil:
+		return int64(*instances.Max)
+	case instances.Min != nil:
+		return int64(*instances.Min)
+	default:
+		return 1
+	}
+}
+
 // AppStatus is the current configuration and running state for an App.
 type AppStatus struct {
 	// Pull in the fields from Knative's duckv1beta1 status field.
@@ -30,6 +30,8 @@ func (app *App) Validate(ctx context.Context) (errs *apis.FieldError) {
 	// of a spec issue.
 	if !apis.IsInStatusUpdate(ctx) {
 		errs = errs.Also(app.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+		errs = errs.Also(app.validateKfQuota(ctx))
+		errs = errs.Also(app.validateSpacePolicy(ctx))
 	}
 
 	return errs
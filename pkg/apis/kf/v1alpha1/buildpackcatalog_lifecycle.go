@@ -0,0 +1,156 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (r *BuildpackCatalog) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("BuildpackCatalog")
+}
+
+// BuildpackStacks is a list of stacks available to apps.
+type BuildpackStacks []BuildpackStack
+
+// ResolveBuildpackStacks merges the stacks defined on a space with those of
+// the catalog it uses. Space stacks replace catalog stacks with the same name
+// and a default stack on the space takes precedence over the catalog's.
+// The catalog may be nil.
+func ResolveBuildpackStacks(catalog *BuildpackCatalog, spaceStacks []BuildpackStack) BuildpackStacks {
+	var out BuildpackStacks
+	if catalog != nil {
+		for _, stack := range catalog.Spec.Stacks {
+			out = append(out, *stack.DeepCopy())
+		}
+	}
+
+	spaceDefault := false
+	for _, stack := range spaceStacks {
+		spaceDefault = spaceDefault || stack.Default
+	}
+
+	if spaceDefault {
+		for i := range out {
+			out[i].Default = false
+		}
+	}
+
+	for _, stack := range spaceStacks {
+		if idx := out.index(stack.Name); idx >= 0 {
+			out[idx] = *stack.DeepCopy()
+		} else {
+			out = append(out, *stack.DeepCopy())
+		}
+	}
+
+	return out
+}
+
+func (stacks BuildpackStacks) index(name string) int {
+	for i, stack := range stacks {
+		if stack.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Names returns the names of the stacks.
+func (stacks BuildpackStacks) Names() []string {
+	var out []string
+	for _, stack := range stacks {
+		out = append(out, stack.Name)
+	}
+
+	return out
+}
+
+// Find gets the stack with the given name. If name is blank, the default
+// stack is returned.
+func (stacks BuildpackStacks) Find(name string) (*BuildpackStack, error) {
+	if len(stacks) == 0 {
+		return nil, fmt.Errorf("no stacks are available")
+	}
+
+	if name == "" {
+		for i := range stacks {
+			if stacks[i].Default {
+				return &stacks[i], nil
+			}
+		}
+
+		return &stacks[0], nil
+	}
+
+	if idx := stacks.index(name); idx >= 0 {
+		return &stacks[idx], nil
+	}
+
+	return nil, fmt.Errorf(
+		"stack %q isn't available, choose one of: %s",
+		name,
+		strings.Join(stacks.Names(), ", "),
+	)
+}
+
+// EnabledBuildpacks returns the buildpacks on the stack that haven't been
+// disabled in the order they're detected.
+func (stack *BuildpackStack) EnabledBuildpacks() []BuildpackCatalogEntry {
+	var out []BuildpackCatalogEntry
+	for _, bp := range stack.Buildpacks {
+		if !bp.Disabled {
+			out = append(out, bp)
+		}
+	}
+
+	return out
+}
+
+// ValidateBuildpack checks that the buildpack can be selected on the stack.
+// Stacks that don't list buildpacks allow any buildpack on the builder.
+func (stack *BuildpackStack) ValidateBuildpack(buildpack string) error {
+	if buildpack == "" || len(stack.Buildpacks) == 0 {
+		return nil
+	}
+
+	for _, bp := range stack.EnabledBuildpacks() {
+		if bp.ID == buildpack {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("buildpack %q isn't enabled on stack %q", buildpack, stack.Name)
+}
+
+// BuildpackOrder returns the enabled buildpacks on the stack formatted as
+// ID@VERSION, or just ID if the version isn't pinned.
+func (stack *BuildpackStack) BuildpackOrder() []string {
+	var out []string
+	for _, bp := range stack.EnabledBuildpacks() {
+		if bp.Version == "" {
+			out = append(out, bp.ID)
+		} else {
+			out = append(out, fmt.Sprintf("%s@%s", bp.ID, bp.Version))
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,160 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestResolveBuildpackStacks(t *testing.T) {
+	t.Parallel()
+
+	catalog := &BuildpackCatalog{
+		Spec: BuildpackCatalogSpec{
+			Stacks: []BuildpackStack{
+				{Name: "cflinuxfs3", BuilderImage: "catalog-builder", Default: true},
+				{Name: "distroless", BuilderImage: "distroless-builder"},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		catalog     *BuildpackCatalog
+		spaceStacks []BuildpackStack
+		want        BuildpackStacks
+	}{
+		"no catalog or stacks": {},
+		"catalog only": {
+			catalog: catalog,
+			want:    BuildpackStacks(catalog.Spec.Stacks),
+		},
+		"space only": {
+			spaceStacks: []BuildpackStack{
+				{Name: "custom", BuilderImage: "custom-builder"},
+			},
+			want: BuildpackStacks{
+				{Name: "custom", BuilderImage: "custom-builder"},
+			},
+		},
+		"space overrides catalog": {
+			catalog: catalog,
+			spaceStacks: []BuildpackStack{
+				{Name: "cflinuxfs3", BuilderImage: "space-builder", Default: true},
+				{Name: "custom", BuilderImage: "custom-builder"},
+			},
+			want: BuildpackStacks{
+				{Name: "cflinuxfs3", BuilderImage: "space-builder", Default: true},
+				{Name: "distroless", BuilderImage: "distroless-builder"},
+				{Name: "custom", BuilderImage: "custom-builder"},
+			},
+		},
+		"space default replaces catalog default": {
+			catalog: catalog,
+			spaceStacks: []BuildpackStack{
+				{Name: "custom", BuilderImage: "custom-builder", Default: true},
+			},
+			want: BuildpackStacks{
+				{Name: "cflinuxfs3", BuilderImage: "catalog-builder"},
+				{Name: "distroless", BuilderImage: "distroless-builder"},
+				{Name: "custom", BuilderImage: "custom-builder", Default: true},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := ResolveBuildpackStacks(tc.catalog, tc.spaceStacks)
+
+			testutil.AssertEqual(t, "stacks", tc.want, got)
+		})
+	}
+
+	testutil.AssertEqual(t, "catalog default unchanged", true, catalog.Spec.Stacks[0].Default)
+}
+
+func TestBuildpackStacks_Find(t *testing.T) {
+	t.Parallel()
+
+	stacks := BuildpackStacks{
+		{Name: "cflinuxfs3"},
+		{Name: "distroless", Default: true},
+	}
+
+	cases := map[string]struct {
+		stacks  BuildpackStacks
+		name    string
+		want    string
+		wantErr error
+	}{
+		"named": {
+			stacks: stacks,
+			name:   "cflinuxfs3",
+			want:   "cflinuxfs3",
+		},
+		"default": {
+			stacks: stacks,
+			want:   "distroless",
+		},
+		"first if no default": {
+			stacks: BuildpackStacks{{Name: "a"}, {Name: "b"}},
+			want:   "a",
+		},
+		"missing": {
+			stacks:  stacks,
+			name:    "windows",
+			wantErr: errors.New(`stack "windows" isn't available, choose one of: cflinuxfs3, distroless`),
+		},
+		"empty": {
+			wantErr: errors.New("no stacks are available"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got, err := tc.stacks.Find(tc.name)
+			if tc.wantErr != nil || err != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, err)
+				return
+			}
+
+			testutil.AssertEqual(t, "stack", tc.want, got.Name)
+		})
+	}
+}
+
+func TestBuildpackStack_buildpacks(t *testing.T) {
+	t.Parallel()
+
+	stack := &BuildpackStack{
+		Name: "cflinuxfs3",
+		Buildpacks: []BuildpackCatalogEntry{
+			{ID: "java", Version: "1.0"},
+			{ID: "php", Disabled: true},
+			{ID: "go"},
+		},
+	}
+
+	testutil.AssertEqual(t, "order", []string{"java@1.0", "go"}, stack.BuildpackOrder())
+	testutil.AssertNil(t, "enabled buildpack", stack.ValidateBuildpack("go"))
+	testutil.AssertNil(t, "no buildpack", stack.ValidateBuildpack(""))
+	testutil.AssertErrorsEqual(t,
+		errors.New(`buildpack "php" isn't enabled on stack "cflinuxfs3"`),
+		stack.ValidateBuildpack("php"),
+	)
+	testutil.AssertNil(t, "unrestricted stack", (&BuildpackStack{}).ValidateBuildpack("php"))
+}
@@ -0,0 +1,101 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BuildpackCatalog is a cluster wide list of the stacks, builders and
+// buildpacks operators allow apps to be built with. Spaces opt into a catalog
+// by name and may extend or override its stacks.
+type BuildpackCatalog struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec BuildpackCatalogSpec `json:"spec,omitempty"`
+}
+
+// BuildpackCatalogSpec contains the specification for a BuildpackCatalog.
+type BuildpackCatalogSpec struct {
+	// Stacks holds the stacks apps may be built on.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Stacks []BuildpackStack `json:"stacks,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+}
+
+// BuildpackStack is a stack apps can be built on along with the builder that
+// is used to build them and the buildpacks that builder may run.
+type BuildpackStack struct {
+	// Name is the name apps use to select the stack e.g. cflinuxfs3.
+	Name string `json:"name"`
+
+	// Description is a human readable description of the stack.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// BuilderImage is the buildpacks.io builder image used to build apps on
+	// the stack.
+	BuilderImage string `json:"builderImage"`
+
+	// RunImage is the image built apps are run on. If blank, the run image of
+	// the builder is used.
+	// +optional
+	RunImage string `json:"runImage,omitempty"`
+
+	// Default marks the stack that is used when apps don't specify one.
+	// If no stack is marked as default, the first is used.
+	// +optional
+	Default bool `json:"default,omitempty"`
+
+	// Buildpacks holds the buildpacks in the order they're detected. If
+	// blank, all the buildpacks on the builder are used in the order the
+	// builder defines.
+	// +optional
+	Buildpacks []BuildpackCatalogEntry `json:"buildpacks,omitempty"`
+}
+
+// BuildpackCatalogEntry is a buildpack in a BuildpackStack.
+type BuildpackCatalogEntry struct {
+	// ID is the ID of the buildpack e.g. org.cloudfoundry.buildpacks.java
+	ID string `json:"id"`
+
+	// Version pins the buildpack to a specific version, if blank the latest
+	// version on the builder is used.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Disabled prevents the buildpack from being detected or selected by apps.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BuildpackCatalogList is a list of BuildpackCatalog resources
+type BuildpackCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []BuildpackCatalog `json:"items"`
+}
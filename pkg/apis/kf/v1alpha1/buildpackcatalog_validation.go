@@ -0,0 +1,97 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate makes sure that BuildpackCatalog is properly configured.
+func (catalog *BuildpackCatalog) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if catalog.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+
+	errs = errs.Also(catalog.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+
+	return errs
+}
+
+// Validate makes sure that BuildpackCatalogSpec is properly configured.
+func (spec *BuildpackCatalogSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	return validateBuildpackStacks(ctx, spec.Stacks).ViaField("stacks")
+}
+
+// validateBuildpackStacks checks a list of stacks for missing fields,
+// duplicate names and multiple defaults.
+func validateBuildpackStacks(ctx context.Context, stacks []BuildpackStack) (errs *apis.FieldError) {
+	names := make(map[string]bool)
+	hasDefault := false
+
+	for i, stack := range stacks {
+		errs = errs.Also(stack.Validate(ctx).ViaIndex(i))
+
+		if names[stack.Name] {
+			errs = errs.Also((&apis.FieldError{
+				Message: fmt.Sprintf("duplicate stack %q", stack.Name),
+				Paths:   []string{"name"},
+			}).ViaIndex(i))
+		}
+		names[stack.Name] = true
+
+		if stack.Default && hasDefault {
+			errs = errs.Also((&apis.FieldError{
+				Message: "multiple defaults",
+				Details: "at most one stack may be set to default",
+				Paths:   []string{"default"},
+			}).ViaIndex(i))
+		}
+		hasDefault = hasDefault || stack.Default
+	}
+
+	return errs
+}
+
+// Validate makes sure that BuildpackStack is properly configured.
+func (stack *BuildpackStack) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if stack.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+
+	if stack.BuilderImage == "" {
+		errs = errs.Also(apis.ErrMissingField("builderImage"))
+	}
+
+	ids := make(map[string]bool)
+	for i, bp := range stack.Buildpacks {
+		if bp.ID == "" {
+			errs = errs.Also(apis.ErrMissingField("id").ViaFieldIndex("buildpacks", i))
+			continue
+		}
+
+		if ids[bp.ID] {
+			errs = errs.Also((&apis.FieldError{
+				Message: fmt.Sprintf("duplicate buildpack %q", bp.ID),
+				Paths:   []string{"id"},
+			}).ViaFieldIndex("buildpacks", i))
+		}
+		ids[bp.ID] = true
+	}
+
+	return errs
+}
@@ -0,0 +1,120 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestBuildpackCatalogValidation(t *testing.T) {
+	goodStack := BuildpackStack{
+		Name:         "cflinuxfs3",
+		BuilderImage: "some-builder",
+		Buildpacks: []BuildpackCatalogEntry{
+			{ID: "java", Version: "1.0"},
+			{ID: "go", Disabled: true},
+		},
+	}
+
+	cases := map[string]struct {
+		catalog *BuildpackCatalog
+		want    *apis.FieldError
+	}{
+		"good": {
+			catalog: &BuildpackCatalog{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: BuildpackCatalogSpec{
+					Stacks: []BuildpackStack{goodStack},
+				},
+			},
+		},
+		"missing name": {
+			catalog: &BuildpackCatalog{},
+			want:    apis.ErrMissingField("name"),
+		},
+		"missing stack fields": {
+			catalog: &BuildpackCatalog{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: BuildpackCatalogSpec{
+					Stacks: []BuildpackStack{{}},
+				},
+			},
+			want: apis.ErrMissingField("spec.stacks[0].builderImage", "spec.stacks[0].name"),
+		},
+		"duplicate stacks": {
+			catalog: &BuildpackCatalog{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: BuildpackCatalogSpec{
+					Stacks: []BuildpackStack{goodStack, goodStack},
+				},
+			},
+			want: &apis.FieldError{
+				Message: `duplicate stack "cflinuxfs3"`,
+				Paths:   []string{"spec.stacks[1].name"},
+			},
+		},
+		"multiple defaults": {
+			catalog: &BuildpackCatalog{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: BuildpackCatalogSpec{
+					Stacks: []BuildpackStack{
+						{Name: "a", BuilderImage: "some-builder", Default: true},
+						{Name: "b", BuilderImage: "some-builder", Default: true},
+					},
+				},
+			},
+			want: &apis.FieldError{
+				Message: "multiple defaults",
+				Details: "at most one stack may be set to default",
+				Paths:   []string{"spec.stacks[1].default"},
+			},
+		},
+		"bad buildpacks": {
+			catalog: &BuildpackCatalog{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: BuildpackCatalogSpec{
+					Stacks: []BuildpackStack{
+						{
+							Name:         "a",
+							BuilderImage: "some-builder",
+							Buildpacks: []BuildpackCatalogEntry{
+								{ID: "java"},
+								{},
+								{ID: "java"},
+							},
+						},
+					},
+				},
+			},
+			want: apis.ErrMissingField("spec.stacks[0].buildpacks[1].id").Also(&apis.FieldError{
+				Message: `duplicate buildpack "java"`,
+				Paths:   []string{"spec.stacks[0].buildpacks[2].id"},
+			}),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := tc.catalog.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
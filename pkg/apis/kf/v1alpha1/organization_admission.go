@@ -0,0 +1,257 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+// OrganizationLister looks up Organizations and their Spaces so Spaces can
+// inherit defaults and be checked against quotas when they're admitted.
+type OrganizationLister interface {
+	// GetOrganization gets the Organization with the given name.
+	GetOrganization(name string) (*Organization, error)
+
+	// ListOrganizationSpaces lists the Spaces in the Organization.
+	ListOrganizationSpaces(name string) ([]Space, error)
+}
+
+type organizationListerKey struct{}
+
+// WithOrganizationLister adds an OrganizationLister to the context.
+func WithOrganizationLister(ctx context.Context, lister OrganizationLister) context.Context {
+	return context.WithValue(ctx, organizationListerKey{}, lister)
+}
+
+// OrganizationListerFromContext gets the OrganizationLister from the context
+// or nil if none was set.
+func OrganizationListerFromContext(ctx context.Context) OrganizationLister {
+	lister, _ := ctx.Value(organizationListerKey{}).(OrganizationLister)
+	return lister
+}
+
+// inheritOrganization copies the defaults of the Space's Organization into
+// fields the Space leaves empty and makes the Organization an owner of the
+// Space. Spaces moving out of an Organization drop the values they inherited
+// from it. Spaces without an Organization, or whose Organization can't be
+// found, are otherwise left alone; validation reports the missing
+// Organization.
+func (k *Space) inheritOrganization(ctx context.Context) {
+	lister := OrganizationListerFromContext(ctx)
+	if lister == nil {
+		return
+	}
+
+	if base, ok := apis.GetBaseline(ctx).(*Space); ok && base != nil {
+		if previous := base.Spec.Organization; previous != "" && previous != k.Spec.Organization {
+			if org, err := lister.GetOrganization(previous); err == nil && org != nil {
+				k.Spec.RemoveOrganizationDefaults(org.Spec.Defaults)
+			}
+
+			k.removeOrganizationOwner(previous)
+		}
+	}
+
+	if k.Spec.Organization == "" {
+		return
+	}
+
+	org, err := lister.GetOrganization(k.Spec.Organization)
+	if err != nil || org == nil {
+		return
+	}
+
+	k.Spec.InheritOrganizationDefaults(org.Spec.Defaults)
+
+	for _, ref := range k.OwnerReferences {
+		if ref.UID == org.UID {
+			return
+		}
+	}
+
+	gvk := org.GetGroupVersionKind()
+	k.OwnerReferences = append(k.OwnerReferences, metav1.OwnerReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       org.Name,
+		UID:        org.UID,
+	})
+}
+
+// removeOrganizationOwner removes the owner reference to the named
+// Organization.
+func (k *Space) removeOrganizationOwner(name string) {
+	kind := (&Organization{}).GetGroupVersionKind()
+
+	var refs []metav1.OwnerReference
+	for _, ref := range k.OwnerReferences {
+		if ref.Kind == kind.Kind && ref.APIVersion == kind.GroupVersion().String() && ref.Name == name {
+			continue
+		}
+
+		refs = append(refs, ref)
+	}
+
+	k.OwnerReferences = refs
+}
+
+// InheritOrganizationDefaults copies the Organization defaults into the
+// empty fields of the SpaceSpec. Environment variables the Space doesn't set
+// are prepended so the Space's own variables keep their order.
+func (k *SpaceSpec) InheritOrganizationDefaults(defaults OrganizationSpecDefaults) {
+	if k.BuildpackBuild.BuilderImage == "" {
+		k.BuildpackBuild.BuilderImage = defaults.BuilderImage
+	}
+
+	if k.BuildpackBuild.ContainerRegistry == "" {
+		k.BuildpackBuild.ContainerRegistry = defaults.ContainerRegistry
+	}
+
+	if len(k.Execution.Domains) == 0 && len(defaults.Domains) > 0 {
+		k.Execution.Domains = append([]SpaceDomain(nil), defaults.Domains...)
+	}
+
+	overridden := make(map[string]bool)
+	for _, env := range k.Execution.Env {
+		overridden[env.Name] = true
+	}
+
+	var inherited []v1.EnvVar
+	for _, env := range defaults.Env {
+		if !overridden[env.Name] {
+			inherited = append(inherited, *env.DeepCopy())
+		}
+	}
+
+	if len(inherited) > 0 {
+		k.Execution.Env = append(inherited, k.Execution.Env...)
+	}
+}
+
+// RemoveOrganizationDefaults clears the fields of the SpaceSpec that hold
+// the given Organization defaults so different defaults can be inherited.
+// Fields the Space set to the same value as the Organization are cleared
+// too because the two can't be told apart.
+func (k *SpaceSpec) RemoveOrganizationDefaults(defaults OrganizationSpecDefaults) {
+	if defaults.BuilderImage != "" && k.BuildpackBuild.BuilderImage == defaults.BuilderImage {
+		k.BuildpackBuild.BuilderImage = ""
+	}
+
+	if defaults.ContainerRegistry != "" && k.BuildpackBuild.ContainerRegistry == defaults.ContainerRegistry {
+		k.BuildpackBuild.ContainerRegistry = ""
+	}
+
+	if len(defaults.Domains) > 0 && equality.Semantic.DeepEqual(k.Execution.Domains, defaults.Domains) {
+		k.Execution.Domains = nil
+	}
+
+	var env []v1.EnvVar
+	for _, e := range k.Execution.Env {
+		inherited := false
+		for _, d := range defaults.Env {
+			if equality.Semantic.DeepEqual(e, d) {
+				inherited = true
+				break
+			}
+		}
+
+		if !inherited {
+			env = append(env, e)
+		}
+	}
+	k.Execution.Env = env
+}
+
+// validateOrganization makes sure the Space's Organization exists and that
+// adding the Space doesn't exceed the Organization's quota.
+func (space *Space) validateOrganization(ctx context.Context) (errs *apis.FieldError) {
+	lister := OrganizationListerFromContext(ctx)
+	if lister == nil || space.Spec.Organization == "" {
+		return nil
+	}
+
+	org, err := lister.GetOrganization(space.Spec.Organization)
+	if err != nil || org == nil {
+		return &apis.FieldError{
+			Message: fmt.Sprintf("couldn't find organization %q: %v", space.Spec.Organization, err),
+			Paths:   []string{"spec.organization"},
+		}
+	}
+
+	if len(org.Spec.Quota) == 0 {
+		return nil
+	}
+
+	members, err := lister.ListOrganizationSpaces(org.Name)
+	if err != nil {
+		return &apis.FieldError{
+			Message: fmt.Sprintf("couldn't list the spaces of organization %q: %v", org.Name, err),
+			Paths:   []string{"spec.organization"},
+		}
+	}
+
+	total := v1.ResourceList{}
+	for _, member := range members {
+		if member.Name != space.Name {
+			addResources(total, member.Spec.ResourceLimits.SpaceQuota)
+		}
+	}
+
+	quota := space.Spec.ResourceLimits.SpaceQuota
+	addResources(total, quota)
+
+	for _, name := range sortedResourceNames(org.Spec.Quota) {
+		limit := org.Spec.Quota[name]
+
+		if _, ok := quota[name]; !ok {
+			errs = errs.Also(&apis.FieldError{
+				Message: fmt.Sprintf("organization %q requires spaces to set a quota for %s", org.Name, name),
+				Paths:   []string{"spec.resourceLimits.spaceQuota"},
+			})
+			continue
+		}
+
+		if sum := total[name]; sum.Cmp(limit) > 0 {
+			errs = errs.Also(&apis.FieldError{
+				Message: fmt.Sprintf("the spaces in organization %q would have a %s quota of %s, exceeding the organization's %s", org.Name, name, sum.String(), limit.String()),
+				Paths:   []string{"spec.resourceLimits.spaceQuota"},
+			})
+		}
+	}
+
+	return errs
+}
+
+// sortedResourceNames returns the names in the ResourceList in a stable
+// order.
+func sortedResourceNames(list v1.ResourceList) []v1.ResourceName {
+	var names []v1.ResourceName
+	for name := range list {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+
+	return names
+}
@@ -0,0 +1,289 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+type fakeOrganizationLister struct {
+	orgs   map[string]*Organization
+	spaces []Space
+	err    error
+}
+
+func (f *fakeOrganizationLister) GetOrganization(name string) (*Organization, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	org, ok := f.orgs[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return org, nil
+}
+
+func (f *fakeOrganizationLister) ListOrganizationSpaces(name string) ([]Space, error) {
+	return f.spaces, f.err
+}
+
+func ExampleSpace_SetDefaults_organization() {
+	org := &Organization{}
+	org.Name = "my-org"
+	org.UID = "org-uid"
+	org.Spec.Defaults = OrganizationSpecDefaults{
+		ContainerRegistry: "gcr.io/my-org",
+		Domains:           []SpaceDomain{{Domain: "my-org.example.com", Default: true}},
+		Env: []corev1.EnvVar{
+			{Name: "REGION", Value: "us"},
+			{Name: "LOG_LEVEL", Value: "info"},
+		},
+	}
+
+	ctx := WithOrganizationLister(context.Background(), &fakeOrganizationLister{
+		orgs: map[string]*Organization{"my-org": org},
+	})
+
+	space := Space{}
+	space.Name = "my-space"
+	space.Spec.Organization = "my-org"
+	space.Spec.Execution.Env = []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}}
+	space.SetDefaults(ctx)
+
+	fmt.Println("Builder:", space.Spec.BuildpackBuild.BuilderImage)
+	fmt.Println("Registry:", space.Spec.BuildpackBuild.ContainerRegistry)
+	fmt.Println("Domain:", space.Spec.Execution.Domains[0].Domain)
+	for _, env := range space.Spec.Execution.Env {
+		fmt.Printf("Env: %s=%s\n", env.Name, env.Value)
+	}
+	fmt.Println("Owner:", space.OwnerReferences[0].Kind, space.OwnerReferences[0].Name)
+
+	// Output: Builder: gcr.io/kf-releases/buildpack-builder:latest
+	// Registry: gcr.io/my-org
+	// Domain: my-org.example.com
+	// Env: REGION=us
+	// Env: LOG_LEVEL=debug
+	// Owner: Organization my-org
+}
+
+func ExampleSpace_SetDefaults_moveOrganization() {
+	oldOrg := &Organization{}
+	oldOrg.Name = "old-org"
+	oldOrg.UID = "old-org-uid"
+	oldOrg.Spec.Defaults = OrganizationSpecDefaults{
+		ContainerRegistry: "gcr.io/old-org",
+		Env:               []corev1.EnvVar{{Name: "REGION", Value: "us"}},
+	}
+
+	newOrg := &Organization{}
+	newOrg.Name = "new-org"
+	newOrg.UID = "new-org-uid"
+	newOrg.Spec.Defaults = OrganizationSpecDefaults{
+		ContainerRegistry: "gcr.io/new-org",
+		Env:               []corev1.EnvVar{{Name: "REGION", Value: "eu"}},
+	}
+
+	ctx := WithOrganizationLister(context.Background(), &fakeOrganizationLister{
+		orgs: map[string]*Organization{"old-org": oldOrg, "new-org": newOrg},
+	})
+
+	original := Space{}
+	original.Name = "my-space"
+	original.Spec.Organization = "old-org"
+	original.Spec.Execution.Env = []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}}
+	original.SetDefaults(ctx)
+
+	space := original.DeepCopy()
+	space.Spec.Organization = "new-org"
+	space.SetDefaults(apis.WithinUpdate(ctx, &original))
+
+	fmt.Println("Registry:", space.Spec.BuildpackBuild.ContainerRegistry)
+	for _, env := range space.Spec.Execution.Env {
+		fmt.Printf("Env: %s=%s\n", env.Name, env.Value)
+	}
+	for _, ref := range space.OwnerReferences {
+		fmt.Println("Owner:", ref.Kind, ref.Name)
+	}
+
+	// Output: Registry: gcr.io/new-org
+	// Env: REGION=eu
+	// Env: LOG_LEVEL=debug
+	// Owner: Organization new-org
+}
+
+func TestSpaceSpec_RemoveOrganizationDefaults(t *testing.T) {
+	defaults := OrganizationSpecDefaults{
+		BuilderImage:      "gcr.io/org/builder",
+		ContainerRegistry: "gcr.io/org",
+		Domains:           []SpaceDomain{{Domain: "org.example.com", Default: true}},
+		Env:               []corev1.EnvVar{{Name: "REGION", Value: "us"}},
+	}
+
+	cases := map[string]struct {
+		defaults OrganizationSpecDefaults
+		spec     SpaceSpec
+		want     SpaceSpec
+	}{
+		"inherited values": {
+			defaults: defaults,
+			spec: func() SpaceSpec {
+				spec := SpaceSpec{}
+				spec.InheritOrganizationDefaults(defaults)
+				return spec
+			}(),
+			want: SpaceSpec{},
+		},
+		"overridden values": {
+			defaults: defaults,
+			spec: SpaceSpec{
+				BuildpackBuild: SpaceSpecBuildpackBuild{
+					BuilderImage:      "gcr.io/space/builder",
+					ContainerRegistry: "gcr.io/space",
+				},
+				Execution: SpaceSpecExecution{
+					Domains: []SpaceDomain{{Domain: "space.example.com", Default: true}},
+					Env:     []corev1.EnvVar{{Name: "REGION", Value: "eu"}},
+				},
+			},
+			want: SpaceSpec{
+				BuildpackBuild: SpaceSpecBuildpackBuild{
+					BuilderImage:      "gcr.io/space/builder",
+					ContainerRegistry: "gcr.io/space",
+				},
+				Execution: SpaceSpecExecution{
+					Domains: []SpaceDomain{{Domain: "space.example.com", Default: true}},
+					Env:     []corev1.EnvVar{{Name: "REGION", Value: "eu"}},
+				},
+			},
+		},
+		"empty defaults": {
+			spec: SpaceSpec{
+				BuildpackBuild: SpaceSpecBuildpackBuild{ContainerRegistry: "gcr.io/space"},
+			},
+			want: SpaceSpec{
+				BuildpackBuild: SpaceSpecBuildpackBuild{ContainerRegistry: "gcr.io/space"},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			tc.spec.RemoveOrganizationDefaults(tc.defaults)
+
+			testutil.AssertEqual(t, "spec", tc.want, tc.spec)
+		})
+	}
+}
+
+func TestSpace_validateOrganization(t *testing.T) {
+	newSpace := func(name, memory string) Space {
+		space := Space{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: SpaceSpec{
+				Organization: "my-org",
+				BuildpackBuild: SpaceSpecBuildpackBuild{
+					BuilderImage:      DefaultBuilderImage,
+					ContainerRegistry: "gcr.io/test",
+				},
+				Execution: SpaceSpecExecution{
+					Domains: []SpaceDomain{{Domain: "example.com", Default: true}},
+				},
+			},
+		}
+
+		if memory != "" {
+			space.Spec.ResourceLimits.SpaceQuota = corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse(memory),
+			}
+		}
+
+		return space
+	}
+
+	org := &Organization{ObjectMeta: metav1.ObjectMeta{Name: "my-org"}}
+	org.Spec.Quota = corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("10Gi"),
+	}
+
+	cases := map[string]struct {
+		space  Space
+		lister OrganizationLister
+		want   *apis.FieldError
+	}{
+		"no lister": {
+			space: newSpace("my-space", ""),
+		},
+		"within quota": {
+			space: newSpace("my-space", "4Gi"),
+			lister: &fakeOrganizationLister{
+				orgs:   map[string]*Organization{"my-org": org},
+				spaces: []Space{newSpace("other", "6Gi"), newSpace("my-space", "8Gi")},
+			},
+		},
+		"exceeds quota": {
+			space: newSpace("my-space", "5Gi"),
+			lister: &fakeOrganizationLister{
+				orgs:   map[string]*Organization{"my-org": org},
+				spaces: []Space{newSpace("other", "6Gi")},
+			},
+			want: &apis.FieldError{
+				Message: `the spaces in organization "my-org" would have a memory quota of 11Gi, exceeding the organization's 10Gi`,
+				Paths:   []string{"spec.resourceLimits.spaceQuota"},
+			},
+		},
+		"missing space quota": {
+			space: newSpace("my-space", ""),
+			lister: &fakeOrganizationLister{
+				orgs: map[string]*Organization{"my-org": org},
+			},
+			want: &apis.FieldError{
+				Message: `organization "my-org" requires spaces to set a quota for memory`,
+				Paths:   []string{"spec.resourceLimits.spaceQuota"},
+			},
+		},
+		"missing organization": {
+			space:  newSpace("my-space", "1Gi"),
+			lister: &fakeOrganizationLister{},
+			want: &apis.FieldError{
+				Message: `couldn't find organization "my-org": not found`,
+				Paths:   []string{"spec.organization"},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.lister != nil {
+				ctx = WithOrganizationLister(ctx, tc.lister)
+			}
+
+			got := tc.space.Validate(ctx)
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
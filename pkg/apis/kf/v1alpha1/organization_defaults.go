@@ -0,0 +1,37 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/google/kf/pkg/kf/algorithms"
+)
+
+// SetDefaults implements apis.Defaultable
+func (k *Organization) SetDefaults(ctx context.Context) {
+	k.Spec.Defaults.SetDefaults(ctx)
+}
+
+// SetDefaults implements apis.Defaultable
+func (k *OrganizationSpecDefaults) SetDefaults(ctx context.Context) {
+	if len(k.Domains) == 0 {
+		return
+	}
+
+	k.Domains = []SpaceDomain(algorithms.Dedupe(
+		SpaceDomains(k.Domains),
+	).(SpaceDomains))
+}
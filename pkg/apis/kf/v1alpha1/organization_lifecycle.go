@@ -0,0 +1,119 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (r *Organization) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("Organization")
+}
+
+// ConditionType represents an Organization condition value
+const (
+	// OrganizationConditionReady is set when the organization is configured
+	// and its spaces are within its quota.
+	OrganizationConditionReady = apis.ConditionReady
+	// OrganizationConditionQuotaReady is set when the quotas of the spaces in
+	// the organization don't exceed its quota.
+	OrganizationConditionQuotaReady apis.ConditionType = "QuotaReady"
+)
+
+func (status *OrganizationStatus) manage() apis.ConditionManager {
+	return apis.NewLivingConditionSet(
+		OrganizationConditionQuotaReady,
+	).Manage(status)
+}
+
+// IsReady returns if the organization is ready to be used.
+func (status *OrganizationStatus) IsReady() bool {
+	return status.manage().IsHappy()
+}
+
+// GetCondition returns the condition by name.
+func (status *OrganizationStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return status.manage().GetCondition(t)
+}
+
+// InitializeConditions sets the initial values to the conditions.
+func (status *OrganizationStatus) InitializeConditions() {
+	status.manage().InitializeConditions()
+}
+
+// PropagateSpaces records the spaces in the organization and sums their
+// quotas and usage. The quota is ready if the quotas of the spaces don't
+// exceed the organization's quota.
+func (status *OrganizationStatus) PropagateSpaces(quota v1.ResourceList, spaces []*Space) {
+	status.Spaces = nil
+	status.Quota = v1.ResourceQuotaStatus{
+		Hard: quota.DeepCopy(),
+	}
+
+	allocated := v1.ResourceList{}
+	used := v1.ResourceList{}
+	for _, space := range spaces {
+		status.Spaces = append(status.Spaces, space.Name)
+		addResources(allocated, space.Spec.ResourceLimits.SpaceQuota)
+		addResources(used, space.Status.Quota.Used)
+	}
+	sort.Strings(status.Spaces)
+
+	if len(used) > 0 {
+		status.Quota.Used = used
+	}
+
+	var exceeded []string
+	for name, limit := range quota {
+		if total, ok := allocated[name]; ok && total.Cmp(limit) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("%s (%s of %s)", name, total.String(), limit.String()))
+		}
+	}
+
+	if len(exceeded) > 0 {
+		sort.Strings(exceeded)
+		status.MarkQuotaExceeded(strings.Join(exceeded, ", "))
+		return
+	}
+
+	status.manage().MarkTrue(OrganizationConditionQuotaReady)
+}
+
+// MarkQuotaExceeded marks the organization's quota as exceeded by the quotas
+// of its spaces.
+func (status *OrganizationStatus) MarkQuotaExceeded(resources string) {
+	status.manage().MarkFalse(OrganizationConditionQuotaReady, "QuotaExceeded",
+		fmt.Sprintf("The quotas of the spaces exceed the organization quota for: %s", resources))
+}
+
+// addResources adds the quantities in delta to total.
+func addResources(total, delta v1.ResourceList) {
+	for name, quantity := range delta {
+		sum, ok := total[name]
+		if !ok {
+			sum = resource.Quantity{}
+		}
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestOrganizationStatus_PropagateSpaces(t *testing.T) {
+	newSpace := func(name, quota, used string) *Space {
+		space := &Space{}
+		space.Name = name
+		space.Spec.ResourceLimits.SpaceQuota = corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse(quota),
+		}
+		space.Status.Quota.Used = corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse(used),
+		}
+		return space
+	}
+
+	quota := corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("10Gi"),
+	}
+
+	cases := map[string]struct {
+		spaces    []*Space
+		wantReady bool
+		wantUsed  string
+	}{
+		"within quota": {
+			spaces:    []*Space{newSpace("b", "4Gi", "1Gi"), newSpace("a", "6Gi", "2Gi")},
+			wantReady: true,
+			wantUsed:  "3Gi",
+		},
+		"exceeds quota": {
+			spaces:    []*Space{newSpace("b", "4Gi", "1Gi"), newSpace("a", "8Gi", "1Gi")},
+			wantReady: false,
+			wantUsed:  "2Gi",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			status := &OrganizationStatus{}
+			status.InitializeConditions()
+			status.PropagateSpaces(quota, tc.spaces)
+
+			used := status.Quota.Used[corev1.ResourceMemory]
+			testutil.AssertEqual(t, "ready", tc.wantReady, status.IsReady())
+			testutil.AssertEqual(t, "spaces", []string{"a", "b"}, status.Spaces)
+			testutil.AssertEqual(t, "used", tc.wantUsed, used.String())
+		})
+	}
+}
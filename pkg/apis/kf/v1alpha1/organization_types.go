@@ -0,0 +1,112 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1beta1 "knative.dev/pkg/apis/duck/v1beta1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Organization groups Spaces and holds configuration they inherit.
+type Organization struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec OrganizationSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+// OrganizationSpec contains the specification for an Organization.
+type OrganizationSpec struct {
+	// Defaults contains configuration Spaces in the Organization inherit
+	// unless they override it.
+	// +optional
+	Defaults OrganizationSpecDefaults `json:"defaults,omitempty"`
+
+	// Quota limits the total resources of the Spaces in the Organization.
+	// Every Space in the Organization must set a quota for each resource
+	// listed here and the sum of the Space quotas can't exceed it.
+	// +optional
+	Quota corev1.ResourceList `json:"quota,omitempty"`
+}
+
+// OrganizationSpecDefaults contains the configuration Spaces in the
+// Organization inherit. Defaults are copied into the empty fields of a Space
+// when it's created or updated, and replaced in every Space of the
+// Organization when they change.
+type OrganizationSpecDefaults struct {
+	// BuilderImage is the buildpacks.io builder image of Spaces that don't
+	// set one.
+	// +optional
+	BuilderImage string `json:"builderImage,omitempty"`
+
+	// ContainerRegistry is the container registry of Spaces that don't set
+	// one.
+	// +optional
+	ContainerRegistry string `json:"containerRegistry,omitempty"`
+
+	// Domains are the domains of Spaces that don't set any.
+	// +optional
+	// +patchMergeKey=domain
+	// +patchStrategy=merge
+	Domains []SpaceDomain `json:"domains,omitempty" patchStrategy:"merge" patchMergeKey:"domain"`
+
+	// Env sets environment variables on apps in the Organization's Spaces.
+	// Variables with the same name in a Space override these.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Env []corev1.EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+}
+
+// OrganizationStatus represents information about the status of an
+// Organization.
+type OrganizationStatus struct {
+	// Pull in the fields from Knative's duckv1beta1 status field.
+	duckv1beta1.Status `json:",inline"`
+
+	// Spaces holds the names of the Spaces in the Organization.
+	// +optional
+	Spaces []string `json:"spaces,omitempty"`
+
+	// Quota holds the Organization's quota and the sum of the resources
+	// used by its Spaces.
+	// +optional
+	Quota corev1.ResourceQuotaStatus `json:"quota,omitempty"`
+
+	// AppliedDefaults holds the defaults last copied into the Organization's
+	// Spaces. They're replaced in the Spaces when the defaults change.
+	// +optional
+	AppliedDefaults OrganizationSpecDefaults `json:"appliedDefaults,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OrganizationList is a list of Organization resources.
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Organization `json:"items"`
+}
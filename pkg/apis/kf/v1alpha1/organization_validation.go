@@ -0,0 +1,80 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate makes sure that Organization is properly configured.
+func (org *Organization) Validate(ctx context.Context) (errs *apis.FieldError) {
+
+	// If we're specifically updating status, don't reject the change because
+	// of a spec issue.
+	if apis.IsInStatusUpdate(ctx) {
+		return
+	}
+
+	if org.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+
+	errs = errs.Also(org.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+
+	return errs
+}
+
+// Validate makes sure that OrganizationSpec is properly configured.
+func (s *OrganizationSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	errs = errs.Also(s.Defaults.Validate(ctx).ViaField("defaults"))
+
+	for name, quantity := range s.Quota {
+		if quantity.Sign() < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(quantity.String(), string(name)).ViaField("quota"))
+		}
+	}
+
+	return errs
+}
+
+// Validate makes sure that OrganizationSpecDefaults is properly configured.
+func (s *OrganizationSpecDefaults) Validate(ctx context.Context) (errs *apis.FieldError) {
+	// Unlike Spaces, Organizations don't need domains but if they set them
+	// exactly one must be the default so Spaces inherit a usable list.
+	if len(s.Domains) == 0 {
+		return errs
+	}
+
+	defaults := 0
+	for _, d := range s.Domains {
+		if d.Default {
+			defaults++
+		}
+	}
+
+	if defaults != 1 {
+		errs = errs.Also(
+			&apis.FieldError{
+				Paths:   []string{"domains"},
+				Message: "multiple defaults",
+				Details: "one domain must be set to default",
+			},
+		)
+	}
+
+	return errs
+}
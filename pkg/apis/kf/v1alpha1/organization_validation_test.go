@@ -0,0 +1,81 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestOrganization_Validate(t *testing.T) {
+	cases := map[string]struct {
+		org  Organization
+		want *apis.FieldError
+	}{
+		"valid": {
+			org: Organization{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-org"},
+				Spec: OrganizationSpec{
+					Defaults: OrganizationSpecDefaults{
+						Domains: []SpaceDomain{{Domain: "example.com", Default: true}},
+					},
+					Quota: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			},
+		},
+		"missing name": {
+			org:  Organization{},
+			want: apis.ErrMissingField("name"),
+		},
+		"no default domain": {
+			org: Organization{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-org"},
+				Spec: OrganizationSpec{
+					Defaults: OrganizationSpecDefaults{
+						Domains: []SpaceDomain{{Domain: "example.com"}},
+					},
+				},
+			},
+			want: &apis.FieldError{
+				Paths:   []string{"spec.defaults.domains"},
+				Message: "multiple defaults",
+				Details: "one domain must be set to default",
+			},
+		},
+		"negative quota": {
+			org: Organization{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-org"},
+				Spec: OrganizationSpec{
+					Quota: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Gi")},
+				},
+			},
+			want: apis.ErrInvalidValue("-1Gi", "spec.quota.memory"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := tc.org.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PodPlacementAnnotation holds the JSON encoded PodPlacement of the pods a
+// resource creates. Knative Serving and Build don't accept every placement
+// field so Kf's pod webhook sets them on the pods from this annotation.
+const PodPlacementAnnotation = "kf.dev/pod-placement"
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (r *PlacementProfile) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("PlacementProfile")
+}
+
+// ResolvePodPlacement merges the placement set on a space with the one from
+// its PlacementProfile. Node selector keys and affinity set on the space
+// replace the profile's, tolerations from both are kept.
+func ResolvePodPlacement(profile, space PodPlacement) PodPlacement {
+	var out PodPlacement
+
+	if len(profile.NodeSelector) > 0 || len(space.NodeSelector) > 0 {
+		out.NodeSelector = UnionMaps(profile.NodeSelector, space.NodeSelector)
+	}
+
+	for _, tolerations := range [][]corev1.Toleration{profile.Tolerations, space.Tolerations} {
+		for _, toleration := range tolerations {
+			duplicate := false
+			for _, existing := range out.Tolerations {
+				duplicate = duplicate || equality.Semantic.DeepEqual(existing, toleration)
+			}
+
+			if !duplicate {
+				out.Tolerations = append(out.Tolerations, *toleration.DeepCopy())
+			}
+		}
+	}
+
+	switch {
+	case space.Affinity != nil:
+		out.Affinity = space.Affinity.DeepCopy()
+	case profile.Affinity != nil:
+		out.Affinity = profile.Affinity.DeepCopy()
+	}
+
+	return out
+}
+
+// IsEmpty returns true if the placement doesn't constrain where pods run.
+func (p *PodPlacement) IsEmpty() bool {
+	return len(p.NodeSelector) == 0 && len(p.Tolerations) == 0 && p.Affinity == nil
+}
+
+// Annotations returns the annotations that place pods created from a
+// resource, or nil if the placement is empty.
+func (p *PodPlacement) Annotations() (map[string]string, error) {
+	if p.IsEmpty() {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{PodPlacementAnnotation: string(encoded)}, nil
+}
+
+// PodPlacementFromAnnotations reads the placement from annotations created by
+// Annotations. The returned bool is false if there's no placement.
+func PodPlacementFromAnnotations(annotations map[string]string) (PodPlacement, bool, error) {
+	var placement PodPlacement
+
+	encoded, ok := annotations[PodPlacementAnnotation]
+	if !ok {
+		return placement, false, nil
+	}
+
+	if err := json.Unmarshal([]byte(encoded), &placement); err != nil {
+		return placement, false, err
+	}
+
+	return placement, true, nil
+}
+
+// ApplyTo adds the placement to the PodSpec. Node selector keys and affinity
+// from the placement replace the pod's, tolerations from both are kept so
+// ones added by the API server aren't lost.
+func (p *PodPlacement) ApplyTo(podSpec *corev1.PodSpec) {
+	placement := ResolvePodPlacement(PodPlacement{
+		NodeSelector: podSpec.NodeSelector,
+		Tolerations:  podSpec.Tolerations,
+		Affinity:     podSpec.Affinity,
+	}, *p)
+
+	podSpec.NodeSelector = placement.NodeSelector
+	podSpec.Tolerations = placement.Tolerations
+	podSpec.Affinity = placement.Affinity
+}
@@ -0,0 +1,127 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestResolvePodPlacement(t *testing.T) {
+	pci := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "pci", Effect: corev1.TaintEffectNoSchedule}
+	gpu := corev1.Toleration{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}
+	profileAffinity := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}}
+	spaceAffinity := &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{}}
+
+	cases := map[string]struct {
+		profile PodPlacement
+		space   PodPlacement
+		want    PodPlacement
+	}{
+		"empty": {},
+		"profile only": {
+			profile: PodPlacement{
+				NodeSelector: map[string]string{"pool": "pci"},
+				Tolerations:  []corev1.Toleration{pci},
+				Affinity:     profileAffinity,
+			},
+			want: PodPlacement{
+				NodeSelector: map[string]string{"pool": "pci"},
+				Tolerations:  []corev1.Toleration{pci},
+				Affinity:     profileAffinity,
+			},
+		},
+		"space overrides profile": {
+			profile: PodPlacement{
+				NodeSelector: map[string]string{"pool": "pci", "zone": "a"},
+				Tolerations:  []corev1.Toleration{pci},
+				Affinity:     profileAffinity,
+			},
+			space: PodPlacement{
+				NodeSelector: map[string]string{"pool": "high-mem"},
+				Tolerations:  []corev1.Toleration{pci, gpu},
+				Affinity:     spaceAffinity,
+			},
+			want: PodPlacement{
+				NodeSelector: map[string]string{"pool": "high-mem", "zone": "a"},
+				Tolerations:  []corev1.Toleration{pci, gpu},
+				Affinity:     spaceAffinity,
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := ResolvePodPlacement(tc.profile, tc.space)
+
+			testutil.AssertEqual(t, "placement", tc.want, got)
+		})
+	}
+}
+
+func TestPodPlacement_Annotations(t *testing.T) {
+	cases := map[string]struct {
+		placement PodPlacement
+		wantOk    bool
+	}{
+		"empty": {},
+		"node selector": {
+			placement: PodPlacement{NodeSelector: map[string]string{"pool": "pci"}},
+			wantOk:    true,
+		},
+		"tolerations and affinity": {
+			placement: PodPlacement{
+				Tolerations: []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+				Affinity:    &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}},
+			},
+			wantOk: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			annotations, err := tc.placement.Annotations()
+			testutil.AssertNil(t, "Annotations err", err)
+
+			got, ok, err := PodPlacementFromAnnotations(annotations)
+			testutil.AssertNil(t, "PodPlacementFromAnnotations err", err)
+			testutil.AssertEqual(t, "ok", tc.wantOk, ok)
+			testutil.AssertEqual(t, "placement", tc.placement, got)
+		})
+	}
+}
+
+func ExamplePodPlacement_ApplyTo() {
+	placement := PodPlacement{
+		NodeSelector: map[string]string{"pool": "pci"},
+		Tolerations: []corev1.Toleration{
+			{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "pci", Effect: corev1.TaintEffectNoSchedule},
+		},
+	}
+
+	podSpec := &corev1.PodSpec{}
+	placement.ApplyTo(podSpec)
+
+	fmt.Println("Node Selector:", podSpec.NodeSelector)
+	fmt.Println("Toleration:", podSpec.Tolerations[0].Key, podSpec.Tolerations[0].Value, podSpec.Tolerations[0].Effect)
+	fmt.Println("Affinity:", podSpec.Affinity)
+
+	// Output: Node Selector: map[pool:pci]
+	// Toleration: dedicated pci NoSchedule
+	// Affinity: <nil>
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PlacementProfile is a named set of node placement constraints operators
+// define for the apps and builds of the spaces that reference it, for
+// example to run those spaces on a dedicated node pool.
+type PlacementProfile struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec PlacementProfileSpec `json:"spec,omitempty"`
+}
+
+// PlacementProfileSpec contains the specification for a PlacementProfile.
+type PlacementProfileSpec struct {
+	// Execution is the placement of app instances.
+	// +optional
+	Execution PodPlacement `json:"execution,omitempty"`
+
+	// Build is the placement of builds. Knative Build doesn't support
+	// tolerations so they can't be set here.
+	// +optional
+	Build PodPlacement `json:"build,omitempty"`
+}
+
+// PodPlacement holds the fields of a Pod that control which nodes it can be
+// scheduled on.
+type PodPlacement struct {
+	// NodeSelector is a selector which must match a node's labels for the
+	// pod to be scheduled on that node.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allow the pod to be scheduled on nodes with matching
+	// taints.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity holds the pod's node and pod affinity scheduling rules.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PlacementProfileList is a list of PlacementProfile resources
+type PlacementProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []PlacementProfile `json:"items"`
+}
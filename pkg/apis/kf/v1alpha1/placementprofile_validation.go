@@ -0,0 +1,116 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (k *PlacementProfile) Validate(ctx context.Context) (errs *apis.FieldError) {
+	return errs.Also(k.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+}
+
+// Validate implements apis.Validatable
+func (k *PlacementProfileSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	errs = errs.Also(validatePodPlacement(k.Execution).ViaField("execution"))
+	errs = errs.Also(validateBuildPlacement(k.Build).ViaField("build"))
+
+	return errs
+}
+
+// validateBuildPlacement validates the placement of builds, which can't
+// have tolerations because Knative Build doesn't pass them to the build pod.
+func validateBuildPlacement(placement PodPlacement) (errs *apis.FieldError) {
+	if len(placement.Tolerations) > 0 {
+		errs = errs.Also(&apis.FieldError{
+			Message: "tolerations can't be set on builds",
+			Paths:   []string{"tolerations"},
+			Details: "Knative Build doesn't support tolerations, use a node selector or affinity instead",
+		})
+	}
+
+	return errs.Also(validatePodPlacement(placement))
+}
+
+func validatePodPlacement(placement PodPlacement) (errs *apis.FieldError) {
+	for key, value := range placement.NodeSelector {
+		if len(validation.IsQualifiedName(key)) > 0 {
+			errs = errs.Also(&apis.FieldError{
+				Message: "invalid key name " + key,
+				Paths:   []string{"nodeSelector"},
+				Details: "node selector keys must be valid label keys",
+			})
+		}
+
+		if len(validation.IsValidLabelValue(value)) > 0 {
+			errs = errs.Also(&apis.FieldError{
+				Message: "invalid value: " + value,
+				Paths:   []string{"nodeSelector." + key},
+				Details: "node selector values must be valid label values",
+			})
+		}
+	}
+
+	for i, toleration := range placement.Tolerations {
+		errs = errs.Also(validateToleration(toleration).ViaFieldIndex("tolerations", i))
+	}
+
+	return errs
+}
+
+func validateToleration(toleration corev1.Toleration) (errs *apis.FieldError) {
+	switch toleration.Operator {
+	case corev1.TolerationOpEqual, "":
+		if toleration.Key == "" {
+			errs = errs.Also(&apis.FieldError{
+				Message: "key is required unless operator is Exists",
+				Paths:   []string{"key"},
+			})
+		}
+
+	case corev1.TolerationOpExists:
+		if toleration.Value != "" {
+			errs = errs.Also(&apis.FieldError{
+				Message: "value must be empty when operator is Exists",
+				Paths:   []string{"value"},
+			})
+		}
+
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(toleration.Operator, "operator"))
+	}
+
+	switch toleration.Effect {
+	case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, "":
+		if toleration.TolerationSeconds != nil {
+			errs = errs.Also(&apis.FieldError{
+				Message: "tolerationSeconds can only be set when effect is NoExecute",
+				Paths:   []string{"tolerationSeconds"},
+			})
+		}
+
+	case corev1.TaintEffectNoExecute:
+
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(toleration.Effect, "effect"))
+	}
+
+	return errs
+}
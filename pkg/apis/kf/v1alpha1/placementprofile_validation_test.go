@@ -0,0 +1,113 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestPlacementProfileValidation(t *testing.T) {
+	cases := map[string]struct {
+		profile *PlacementProfile
+		want    *apis.FieldError
+	}{
+		"good": {
+			profile: &PlacementProfile{
+				Spec: PlacementProfileSpec{
+					Execution: PodPlacement{
+						NodeSelector: map[string]string{"cloud.google.com/gke-nodepool": "pci"},
+						Tolerations: []corev1.Toleration{
+							{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "pci", Effect: corev1.TaintEffectNoSchedule},
+							{Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.Int64(30)},
+						},
+					},
+					Build: PodPlacement{
+						NodeSelector: map[string]string{"cloud.google.com/gke-nodepool": "builds"},
+					},
+				},
+			},
+		},
+		"empty": {
+			profile: &PlacementProfile{},
+		},
+		"bad node selector": {
+			profile: &PlacementProfile{
+				Spec: PlacementProfileSpec{
+					Execution: PodPlacement{
+						NodeSelector: map[string]string{"pool": "not valid"},
+					},
+				},
+			},
+			want: &apis.FieldError{
+				Message: "invalid value: not valid",
+				Paths:   []string{"spec.execution.nodeSelector.pool"},
+				Details: "node selector values must be valid label values",
+			},
+		},
+		"bad tolerations": {
+			profile: &PlacementProfile{
+				Spec: PlacementProfileSpec{
+					Execution: PodPlacement{
+						Tolerations: []corev1.Toleration{
+							{Value: "pci"},
+							{Key: "dedicated", Operator: corev1.TolerationOpExists, Value: "pci"},
+							{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule, TolerationSeconds: ptr.Int64(30)},
+						},
+					},
+				},
+			},
+			want: (&apis.FieldError{
+				Message: "key is required unless operator is Exists",
+				Paths:   []string{"spec.execution.tolerations[0].key"},
+			}).Also(&apis.FieldError{
+				Message: "value must be empty when operator is Exists",
+				Paths:   []string{"spec.execution.tolerations[1].value"},
+			}).Also(&apis.FieldError{
+				Message: "tolerationSeconds can only be set when effect is NoExecute",
+				Paths:   []string{"spec.execution.tolerations[2].tolerationSeconds"},
+			}),
+		},
+		"build tolerations": {
+			profile: &PlacementProfile{
+				Spec: PlacementProfileSpec{
+					Build: PodPlacement{
+						Tolerations: []corev1.Toleration{
+							{Key: "dedicated", Operator: corev1.TolerationOpExists},
+						},
+					},
+				},
+			},
+			want: &apis.FieldError{
+				Message: "tolerations can't be set on builds",
+				Paths:   []string{"spec.build.tolerations"},
+				Details: "Knative Build doesn't support tolerations, use a node selector or affinity instead",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := tc.profile.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ResourceList converts the space-wide limits of the plan into the hard
+// limits of a ResourceQuota. The limits on kf resources are enforced by the
+// webhook instead, see SpaceKfQuota.
+func (s *QuotaPlanSpec) ResourceList() corev1.ResourceList {
+	out := corev1.ResourceList{}
+
+	if s.Memory != nil {
+		out[corev1.ResourceMemory] = s.Memory.DeepCopy()
+	}
+
+	if s.CPU != nil {
+		out[corev1.ResourceCPU] = s.CPU.DeepCopy()
+	}
+
+	return out
+}
+
+// LimitRangeItems converts the per-app limits of the plan into the limits of
+// a LimitRange.
+func (s *QuotaPlanSpec) LimitRangeItems() []corev1.LimitRangeItem {
+	if s.AppMemory == nil {
+		return nil
+	}
+
+	return []corev1.LimitRangeItem{
+		{
+			Type: corev1.LimitTypeContainer,
+			Max: corev1.ResourceList{
+				corev1.ResourceMemory: s.AppMemory.DeepCopy(),
+			},
+		},
+	}
+}
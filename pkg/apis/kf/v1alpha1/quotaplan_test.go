@@ -0,0 +1,51 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func ExampleQuotaPlanSpec_ResourceList() {
+	memory := resource.MustParse("10Gi")
+	routes := int64(20)
+
+	plan := QuotaPlanSpec{
+		Memory: &memory,
+	}
+	plan.Routes = &routes
+	list := plan.ResourceList()
+
+	fmt.Println("Memory:", list.Memory())
+	fmt.Println("Limits:", len(list))
+
+	// Output: Memory: 10Gi
+	// Limits: 1
+}
+
+func ExampleQuotaPlanSpec_LimitRangeItems() {
+	appMemory := resource.MustParse("1Gi")
+
+	plan := QuotaPlanSpec{AppMemory: &appMemory}
+	for _, item := range plan.LimitRangeItems() {
+		fmt.Println("Type:", item.Type)
+		fmt.Println("Max memory:", item.Max.Memory())
+	}
+
+	// Output: Type: Container
+	// Max memory: 1Gi
+}
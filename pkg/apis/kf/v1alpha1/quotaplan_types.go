@@ -0,0 +1,67 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuotaPlan is a named set of resource limits that Spaces reference rather
+// than each setting their own. Changes to a QuotaPlan are applied to every
+// Space that references it.
+type QuotaPlan struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec QuotaPlanSpec `json:"spec,omitempty"`
+}
+
+// QuotaPlanSpec contains the limits of a QuotaPlan. Limits that aren't set
+// are unlimited.
+type QuotaPlanSpec struct {
+	// Memory is the total amount of memory apps in the space can request.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+
+	// CPU is the total amount of CPU apps in the space can request.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+
+	// SpaceKfQuota contains the limits on kf resources in the space.
+	SpaceKfQuota `json:",inline"`
+
+	// AppMemory is the maximum amount of memory a single app instance can
+	// have.
+	// +optional
+	AppMemory *resource.Quantity `json:"appMemory,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuotaPlanList is a list of QuotaPlan resources.
+type QuotaPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []QuotaPlan `json:"items"`
+}
@@ -0,0 +1,48 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (k *QuotaPlan) Validate(ctx context.Context) (errs *apis.FieldError) {
+	return errs.Also(k.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+}
+
+// Validate implements apis.Validatable
+func (k *QuotaPlanSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	quantities := []struct {
+		field string
+		value *resource.Quantity
+	}{
+		{"memory", k.Memory},
+		{"cpu", k.CPU},
+		{"appMemory", k.AppMemory},
+	}
+	for _, q := range quantities {
+		if q.value != nil && q.value.Sign() < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(q.value.String(), q.field))
+		}
+	}
+
+	errs = errs.Also(k.SpaceKfQuota.Validate(ctx))
+
+	return errs
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"knative.dev/pkg/apis"
+)
+
+func TestQuotaPlan_Validate(t *testing.T) {
+	memory := resource.MustParse("10Gi")
+	negativeMemory := resource.MustParse("-1Gi")
+	routes := int64(10)
+	negativeRoutes := int64(-1)
+
+	cases := map[string]struct {
+		spec QuotaPlanSpec
+		want *apis.FieldError
+	}{
+		"unlimited": {
+			spec: QuotaPlanSpec{},
+		},
+		"valid": {
+			spec: QuotaPlanSpec{Memory: &memory, SpaceKfQuota: SpaceKfQuota{Routes: &routes}},
+		},
+		"negative quantity": {
+			spec: QuotaPlanSpec{AppMemory: &negativeMemory},
+			want: apis.ErrInvalidValue("-1Gi", "spec.appMemory"),
+		},
+		"negative count": {
+			spec: QuotaPlanSpec{SpaceKfQuota: SpaceKfQuota{Routes: &negativeRoutes}},
+			want: apis.ErrInvalidValue("-1", "spec.routes"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			plan := &QuotaPlan{Spec: tc.spec}
+
+			got := plan.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
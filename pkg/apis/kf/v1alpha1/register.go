@@ -56,6 +56,22 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&RouteList{},
 		&RouteClaim{},
 		&RouteClaimList{},
+		&BuildpackCatalog{},
+		&BuildpackCatalogList{},
+		&ServiceInstanceShare{},
+		&ServiceInstanceShareList{},
+		&ServicePlanVisibility{},
+		&ServicePlanVisibilityList{},
+		&Organization{},
+		&OrganizationList{},
+		&QuotaPlan{},
+		&QuotaPlanList{},
+		&SecurityGroup{},
+		&SecurityGroupList{},
+		&PlacementProfile{},
+		&PlacementProfileList{},
+		&SpaceTemplate{},
+		&SpaceTemplateList{},
 		&metav1.Status{},
 	)
 
@@ -124,6 +124,8 @@ func (r *RouteClaim) Validate(ctx context.Context) (errs *apis.FieldError) {
 		return errs
 	}
 
+	errs = errs.Also(r.validateKfQuota(ctx))
+
 	return checkVirtualServiceCollision(ctx, r.Spec.Hostname, r.Spec.Domain, r.GetNamespace(), errs)
 }
 
@@ -0,0 +1,173 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// MaxSecurityGroupRulePorts is the maximum number of ports a single
+// SecurityGroupRule can list. NetworkPolicies can't express port ranges so
+// each port becomes its own entry.
+const MaxSecurityGroupRulePorts = 256
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (r *SecurityGroup) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("SecurityGroup")
+}
+
+// DestinationCIDRs returns the CIDR blocks that cover the rule's
+// destination.
+func (r *SecurityGroupRule) DestinationCIDRs() ([]string, error) {
+	dest := strings.TrimSpace(r.Destination)
+
+	if strings.Contains(dest, "/") {
+		_, ipNet, err := net.ParseCIDR(dest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q", dest)
+		}
+		return []string{ipNet.String()}, nil
+	}
+
+	if parts := strings.SplitN(dest, "-", 2); len(parts) == 2 {
+		start := net.ParseIP(strings.TrimSpace(parts[0])).To4()
+		end := net.ParseIP(strings.TrimSpace(parts[1])).To4()
+		if start == nil || end == nil {
+			return nil, fmt.Errorf("invalid IPv4 range %q", dest)
+		}
+
+		first, last := binary.BigEndian.Uint32(start), binary.BigEndian.Uint32(end)
+		if first > last {
+			return nil, fmt.Errorf("range %q ends before it starts", dest)
+		}
+
+		return ipv4RangeToCIDRs(first, last), nil
+	}
+
+	ip := net.ParseIP(dest)
+	switch {
+	case ip == nil:
+		return nil, fmt.Errorf("invalid IP address %q", dest)
+	case ip.To4() != nil:
+		return []string{ip.String() + "/32"}, nil
+	default:
+		return []string{ip.String() + "/128"}, nil
+	}
+}
+
+// ipv4RangeToCIDRs returns the smallest list of CIDR blocks that cover the
+// addresses from first to last inclusive.
+func ipv4RangeToCIDRs(first, last uint32) []string {
+	var out []string
+	for start, end := uint64(first), uint64(last); start <= end; {
+		// Grow the block while it stays aligned and inside the range.
+		bits := uint(0)
+		for bits < 32 {
+			size := uint64(1) << (bits + 1)
+			if start%size != 0 || start+size-1 > end {
+				break
+			}
+			bits++
+		}
+
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, uint32(start))
+		out = append(out, fmt.Sprintf("%s/%d", ip, 32-bits))
+
+		start += uint64(1) << bits
+	}
+
+	return out
+}
+
+// PortList returns the ports the rule allows in ascending order. A nil list
+// means every port is allowed.
+func (r *SecurityGroupRule) PortList() ([]int32, error) {
+	if strings.TrimSpace(r.Ports) == "" {
+		return nil, nil
+	}
+
+	seen := make(map[int32]bool)
+	for _, part := range strings.Split(r.Ports, ",") {
+		part = strings.TrimSpace(part)
+
+		low, high := part, part
+		if idx := strings.Index(part, "-"); idx >= 0 {
+			low, high = strings.TrimSpace(part[:idx]), strings.TrimSpace(part[idx+1:])
+		}
+
+		first, err := parsePort(low)
+		if err != nil {
+			return nil, err
+		}
+		last, err := parsePort(high)
+		if err != nil {
+			return nil, err
+		}
+		if first > last {
+			return nil, fmt.Errorf("port range %q ends before it starts", part)
+		}
+
+		// A range covering every port is the same as no restriction.
+		if first == 1 && last == 65535 {
+			return nil, nil
+		}
+
+		if int(last-first)+1+len(seen) > MaxSecurityGroupRulePorts {
+			return nil, fmt.Errorf("rules can list at most %d ports", MaxSecurityGroupRulePorts)
+		}
+
+		for port := first; port <= last; port++ {
+			seen[port] = true
+		}
+	}
+
+	var ports []int32
+	for port := range seen {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+
+	return ports, nil
+}
+
+func parsePort(s string) (int32, error) {
+	port, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+
+	return int32(port), nil
+}
+
+// SecurityGroupsFor returns the names of the SecurityGroups bound to the
+// space for the lifecycle.
+func (s *SpaceSpecSecurity) SecurityGroupsFor(lifecycle SecurityGroupLifecycle) []string {
+	var names []string
+	for _, binding := range s.SecurityGroups {
+		if binding.Lifecycle == lifecycle {
+			names = append(names, binding.Name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,137 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestSecurityGroupRule_DestinationCIDRs(t *testing.T) {
+	cases := map[string]struct {
+		destination string
+		want        []string
+		wantErr     error
+	}{
+		"cidr": {
+			destination: "10.0.0.0/8",
+			want:        []string{"10.0.0.0/8"},
+		},
+		"unaligned cidr": {
+			destination: "10.1.2.3/16",
+			want:        []string{"10.1.0.0/16"},
+		},
+		"ipv4 address": {
+			destination: "8.8.8.8",
+			want:        []string{"8.8.8.8/32"},
+		},
+		"ipv6 address": {
+			destination: "2001:db8::1",
+			want:        []string{"2001:db8::1/128"},
+		},
+		"aligned range": {
+			destination: "192.168.0.0-192.168.0.255",
+			want:        []string{"192.168.0.0/24"},
+		},
+		"unaligned range": {
+			destination: "10.0.0.1-10.0.0.6",
+			want:        []string{"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6/32"},
+		},
+		"every address": {
+			destination: "0.0.0.0-255.255.255.255",
+			want:        []string{"0.0.0.0/0"},
+		},
+		"backwards range": {
+			destination: "10.0.0.9-10.0.0.1",
+			wantErr:     errors.New(`range "10.0.0.9-10.0.0.1" ends before it starts`),
+		},
+		"bad address": {
+			destination: "example.com",
+			wantErr:     errors.New(`invalid IP address "example.com"`),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			rule := SecurityGroupRule{Destination: tc.destination}
+			got, err := rule.DestinationCIDRs()
+
+			testutil.AssertErrorsEqual(t, tc.wantErr, err)
+			testutil.AssertEqual(t, "cidrs", tc.want, got)
+		})
+	}
+}
+
+func TestSecurityGroupRule_PortList(t *testing.T) {
+	cases := map[string]struct {
+		ports   string
+		want    []int32
+		wantErr error
+	}{
+		"blank": {
+			ports: "",
+			want:  nil,
+		},
+		"single": {
+			ports: "443",
+			want:  []int32{443},
+		},
+		"list and range": {
+			ports: "8080,80,8081-8083",
+			want:  []int32{80, 8080, 8081, 8082, 8083},
+		},
+		"every port": {
+			ports: "1-65535",
+			want:  nil,
+		},
+		"out of range": {
+			ports:   "0",
+			wantErr: errors.New(`invalid port "0"`),
+		},
+		"backwards range": {
+			ports:   "90-80",
+			wantErr: errors.New(`port range "90-80" ends before it starts`),
+		},
+		"too many": {
+			ports:   "1000-2000",
+			wantErr: errors.New("rules can list at most 256 ports"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			rule := SecurityGroupRule{Ports: tc.ports}
+			got, err := rule.PortList()
+
+			testutil.AssertErrorsEqual(t, tc.wantErr, err)
+			testutil.AssertEqual(t, "ports", tc.want, got)
+		})
+	}
+}
+
+func TestSpaceSpecSecurity_SecurityGroupsFor(t *testing.T) {
+	security := SpaceSpecSecurity{
+		SecurityGroups: []SpaceSecurityGroup{
+			{Name: "dns", Lifecycle: SecurityGroupLifecycleRunning},
+			{Name: "mirrors", Lifecycle: SecurityGroupLifecycleStaging},
+			{Name: "public", Lifecycle: SecurityGroupLifecycleRunning},
+		},
+	}
+
+	testutil.AssertEqual(t, "running", []string{"dns", "public"}, security.SecurityGroupsFor(SecurityGroupLifecycleRunning))
+	testutil.AssertEqual(t, "staging", []string{"mirrors"}, security.SecurityGroupsFor(SecurityGroupLifecycleStaging))
+}
@@ -0,0 +1,103 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecurityGroup is a list of egress rules that can be bound to spaces. Pods
+// in a space can only reach destinations outside the cluster that a
+// SecurityGroup bound to the space allows.
+type SecurityGroup struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec SecurityGroupSpec `json:"spec,omitempty"`
+}
+
+// SecurityGroupSpec contains the specification for a SecurityGroup.
+type SecurityGroupSpec struct {
+	// Rules are the egress rules of the group.
+	// +optional
+	Rules []SecurityGroupRule `json:"rules,omitempty"`
+}
+
+// SecurityGroupProtocol is a protocol a SecurityGroupRule allows.
+type SecurityGroupProtocol string
+
+const (
+	// SecurityGroupProtocolTCP allows TCP traffic.
+	SecurityGroupProtocolTCP SecurityGroupProtocol = "tcp"
+	// SecurityGroupProtocolUDP allows UDP traffic.
+	SecurityGroupProtocolUDP SecurityGroupProtocol = "udp"
+	// SecurityGroupProtocolAll allows TCP and UDP traffic.
+	SecurityGroupProtocolAll SecurityGroupProtocol = "all"
+)
+
+// SecurityGroupRule allows egress traffic to a destination.
+type SecurityGroupRule struct {
+	// Protocol is the protocol the rule allows, one of tcp, udp or all.
+	Protocol SecurityGroupProtocol `json:"protocol"`
+
+	// Destination is an IP address, a CIDR block or a range of IPv4
+	// addresses in the form 10.0.0.1-10.0.0.255.
+	Destination string `json:"destination"`
+
+	// Ports is a comma separated list of ports and port ranges, for example
+	// 443 or 80,8000-8080. If blank, every port is allowed. Ports can't be
+	// set when Protocol is all.
+	// +optional
+	Ports string `json:"ports,omitempty"`
+
+	// Description is a human readable description of the rule.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// SecurityGroupLifecycle is the phase of an app's lifecycle a
+// SecurityGroup applies to.
+type SecurityGroupLifecycle string
+
+const (
+	// SecurityGroupLifecycleStaging applies a SecurityGroup to builds.
+	SecurityGroupLifecycleStaging SecurityGroupLifecycle = "staging"
+	// SecurityGroupLifecycleRunning applies a SecurityGroup to app
+	// instances.
+	SecurityGroupLifecycleRunning SecurityGroupLifecycle = "running"
+)
+
+// SecurityGroupLifecycles contains every SecurityGroupLifecycle.
+var SecurityGroupLifecycles = []SecurityGroupLifecycle{
+	SecurityGroupLifecycleRunning,
+	SecurityGroupLifecycleStaging,
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecurityGroupList is a list of SecurityGroup resources
+type SecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []SecurityGroup `json:"items"`
+}
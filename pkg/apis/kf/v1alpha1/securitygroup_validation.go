@@ -0,0 +1,79 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (k *SecurityGroup) Validate(ctx context.Context) (errs *apis.FieldError) {
+	return errs.Also(k.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+}
+
+// Validate implements apis.Validatable
+func (k *SecurityGroupSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	for i, rule := range k.Rules {
+		errs = errs.Also(rule.Validate(ctx).ViaFieldIndex("rules", i))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (k *SecurityGroupRule) Validate(ctx context.Context) (errs *apis.FieldError) {
+	switch k.Protocol {
+	case SecurityGroupProtocolTCP, SecurityGroupProtocolUDP:
+		if _, err := k.PortList(); err != nil {
+			errs = errs.Also(&apis.FieldError{
+				Message: "invalid value: " + k.Ports,
+				Paths:   []string{"ports"},
+				Details: err.Error(),
+			})
+		}
+
+	case SecurityGroupProtocolAll:
+		if k.Ports != "" {
+			errs = errs.Also(&apis.FieldError{
+				Message: "ports can't be set when protocol is all",
+				Paths:   []string{"ports"},
+			})
+		}
+
+	case "":
+		errs = errs.Also(apis.ErrMissingField("protocol"))
+
+	default:
+		errs = errs.Also(&apis.FieldError{
+			Message: "invalid value: " + string(k.Protocol),
+			Paths:   []string{"protocol"},
+			Details: "protocol must be one of tcp, udp or all",
+		})
+	}
+
+	if k.Destination == "" {
+		errs = errs.Also(apis.ErrMissingField("destination"))
+	} else if _, err := k.DestinationCIDRs(); err != nil {
+		errs = errs.Also(&apis.FieldError{
+			Message: "invalid value: " + k.Destination,
+			Paths:   []string{"destination"},
+			Details: err.Error(),
+		})
+	}
+
+	return errs
+}
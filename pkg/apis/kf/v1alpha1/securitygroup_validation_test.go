@@ -0,0 +1,106 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	"knative.dev/pkg/apis"
+)
+
+func TestSecurityGroupValidation(t *testing.T) {
+	cases := map[string]struct {
+		group *SecurityGroup
+		want  *apis.FieldError
+	}{
+		"good": {
+			group: &SecurityGroup{
+				Spec: SecurityGroupSpec{
+					Rules: []SecurityGroupRule{
+						{Protocol: SecurityGroupProtocolTCP, Destination: "10.0.0.0/8", Ports: "443,8000-8080"},
+						{Protocol: SecurityGroupProtocolUDP, Destination: "8.8.8.8", Ports: "53"},
+						{Protocol: SecurityGroupProtocolAll, Destination: "192.168.0.1-192.168.0.9"},
+					},
+				},
+			},
+		},
+		"no rules": {
+			group: &SecurityGroup{},
+		},
+		"missing fields": {
+			group: &SecurityGroup{
+				Spec: SecurityGroupSpec{
+					Rules: []SecurityGroupRule{{}},
+				},
+			},
+			want: apis.ErrMissingField("spec.rules[0].protocol", "spec.rules[0].destination"),
+		},
+		"icmp": {
+			group: &SecurityGroup{
+				Spec: SecurityGroupSpec{
+					Rules: []SecurityGroupRule{
+						{Protocol: "icmp", Destination: "0.0.0.0/0"},
+					},
+				},
+			},
+			want: &apis.FieldError{
+				Message: "invalid value: icmp",
+				Paths:   []string{"spec.rules[0].protocol"},
+				Details: "protocol must be one of tcp, udp or all",
+			},
+		},
+		"ports with all": {
+			group: &SecurityGroup{
+				Spec: SecurityGroupSpec{
+					Rules: []SecurityGroupRule{
+						{Protocol: SecurityGroupProtocolAll, Destination: "0.0.0.0/0", Ports: "443"},
+					},
+				},
+			},
+			want: &apis.FieldError{
+				Message: "ports can't be set when protocol is all",
+				Paths:   []string{"spec.rules[0].ports"},
+			},
+		},
+		"bad ports and destination": {
+			group: &SecurityGroup{
+				Spec: SecurityGroupSpec{
+					Rules: []SecurityGroupRule{
+						{Protocol: SecurityGroupProtocolTCP, Destination: "10.0.0.9-10.0.0.1", Ports: "70000"},
+					},
+				},
+			},
+			want: (&apis.FieldError{
+				Message: "invalid value: 70000",
+				Paths:   []string{"spec.rules[0].ports"},
+				Details: `invalid port "70000"`,
+			}).Also(&apis.FieldError{
+				Message: "invalid value: 10.0.0.9-10.0.0.1",
+				Paths:   []string{"spec.rules[0].destination"},
+				Details: `range "10.0.0.9-10.0.0.1" ends before it starts`,
+			}),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := tc.group.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+)
+
+// ServicePlanChecker determines if a service plan can be used in a space.
+type ServicePlanChecker interface {
+	// IsPlanVisible returns true if the plan referenced by a ServiceInstance
+	// can be used in the space.
+	IsPlanVisible(ref servicecatalogv1beta1.PlanReference, space string) (bool, error)
+}
+
+type servicePlanCheckerKey struct{}
+
+// WithServicePlanChecker adds a ServicePlanChecker to the context.
+func WithServicePlanChecker(ctx context.Context, checker ServicePlanChecker) context.Context {
+	return context.WithValue(ctx, servicePlanCheckerKey{}, checker)
+}
+
+// ServicePlanCheckerFromContext gets the ServicePlanChecker from the context
+// or nil if none was set.
+func ServicePlanCheckerFromContext(ctx context.Context) ServicePlanChecker {
+	checker, _ := ctx.Value(servicePlanCheckerKey{}).(ServicePlanChecker)
+	return checker
+}
+
+// +k8s:deepcopy-gen=false
+
+// ServiceInstanceAdmission wraps a service catalog ServiceInstance so the
+// webhook can reject instances of plans that aren't enabled in their space.
+type ServiceInstanceAdmission struct {
+	servicecatalogv1beta1.ServiceInstance `json:",inline"`
+}
+
+var _ apis.Validatable = (*ServiceInstanceAdmission)(nil)
+var _ apis.Defaultable = (*ServiceInstanceAdmission)(nil)
+
+// DeepCopyObject implements runtime.Object.
+func (si *ServiceInstanceAdmission) DeepCopyObject() runtime.Object {
+	if si == nil {
+		return nil
+	}
+
+	return &ServiceInstanceAdmission{
+		ServiceInstance: *si.ServiceInstance.DeepCopy(),
+	}
+}
+
+// SetDefaults implements apis.Defaultable. ServiceInstances are defaulted by
+// the service catalog so this is a no-op.
+func (si *ServiceInstanceAdmission) SetDefaults(ctx context.Context) {
+}
+
+// Validate implements apis.Validatable
+func (si *ServiceInstanceAdmission) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	ref := si.Spec.PlanReference
+	base, _ := apis.GetBaseline(ctx).(*ServiceInstanceAdmission)
+
+	// Only new instances count against the space's quota.
+	if base == nil {
+		if err := validateServiceInstanceQuota(ctx, si.Namespace); err != nil {
+			return err
+		}
+	}
+
+	// Only check plans when they're chosen so existing instances keep working
+	// if their plan gets disabled.
+	if base != nil && base.Spec.PlanReference == ref {
+		return nil
+	}
+
+	checker := ServicePlanCheckerFromContext(ctx)
+	if checker == nil {
+		return nil
+	}
+
+	visible, err := checker.IsPlanVisible(ref, si.Namespace)
+	if err != nil {
+		return &apis.FieldError{
+			Message: fmt.Sprintf("couldn't check plan visibility: %v", err),
+			Paths:   []string{"spec"},
+		}
+	}
+
+	if !visible {
+		plan, class, path := planReferenceNames(ref)
+		return &apis.FieldError{
+			Message: fmt.Sprintf(
+				"plan %q of service %q isn't enabled in space %q",
+				plan,
+				class,
+				si.Namespace,
+			),
+			Paths: []string{path},
+		}
+	}
+
+	return nil
+}
+
+// planReferenceNames returns the plan and class a cluster PlanReference uses
+// along with the path of the plan field.
+func planReferenceNames(ref servicecatalogv1beta1.PlanReference) (plan, class, path string) {
+	switch {
+	case ref.ClusterServicePlanName != "":
+		return ref.ClusterServicePlanName, ref.ClusterServiceClassName, "spec.clusterServicePlanName"
+	case ref.ClusterServicePlanExternalID != "":
+		return ref.ClusterServicePlanExternalID, ref.ClusterServiceClassExternalID, "spec.clusterServicePlanExternalID"
+	default:
+		return ref.ClusterServicePlanExternalName, ref.ClusterServiceClassExternalName, "spec.clusterServicePlanExternalName"
+	}
+}
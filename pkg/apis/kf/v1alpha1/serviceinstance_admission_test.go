@@ -0,0 +1,154 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+type fakePlanChecker struct {
+	visible bool
+	err     error
+}
+
+func (f *fakePlanChecker) IsPlanVisible(ref servicecatalogv1beta1.PlanReference, space string) (bool, error) {
+	return f.visible, f.err
+}
+
+func TestServiceInstanceAdmission_Validate(t *testing.T) {
+	newInstance := func(plan string) *ServiceInstanceAdmission {
+		return &ServiceInstanceAdmission{
+			ServiceInstance: servicecatalogv1beta1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "some-space"},
+				Spec: servicecatalogv1beta1.ServiceInstanceSpec{
+					PlanReference: servicecatalogv1beta1.PlanReference{
+						ClusterServiceClassExternalName: "mydb",
+						ClusterServicePlanExternalName:  plan,
+					},
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		instance *ServiceInstanceAdmission
+		setup    func(context.Context) context.Context
+		want     *apis.FieldError
+	}{
+		"no checker": {
+			instance: newInstance("small"),
+		},
+		"plan visible": {
+			instance: newInstance("small"),
+			setup: func(ctx context.Context) context.Context {
+				return WithServicePlanChecker(ctx, &fakePlanChecker{visible: true})
+			},
+		},
+		"plan hidden": {
+			instance: newInstance("small"),
+			setup: func(ctx context.Context) context.Context {
+				return WithServicePlanChecker(ctx, &fakePlanChecker{visible: false})
+			},
+			want: &apis.FieldError{
+				Message: `plan "small" of service "mydb" isn't enabled in space "some-space"`,
+				Paths:   []string{"spec.clusterServicePlanExternalName"},
+			},
+		},
+		"plan hidden by name": {
+			instance: &ServiceInstanceAdmission{
+				ServiceInstance: servicecatalogv1beta1.ServiceInstance{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "some-space"},
+					Spec: servicecatalogv1beta1.ServiceInstanceSpec{
+						PlanReference: servicecatalogv1beta1.PlanReference{
+							ClusterServiceClassName: "mydb-id",
+							ClusterServicePlanName:  "small-id",
+						},
+					},
+				},
+			},
+			setup: func(ctx context.Context) context.Context {
+				return WithServicePlanChecker(ctx, &fakePlanChecker{visible: false})
+			},
+			want: &apis.FieldError{
+				Message: `plan "small-id" of service "mydb-id" isn't enabled in space "some-space"`,
+				Paths:   []string{"spec.clusterServicePlanName"},
+			},
+		},
+		"checker error": {
+			instance: newInstance("small"),
+			setup: func(ctx context.Context) context.Context {
+				return WithServicePlanChecker(ctx, &fakePlanChecker{err: errors.New("some-error")})
+			},
+			want: &apis.FieldError{
+				Message: "couldn't check plan visibility: some-error",
+				Paths:   []string{"spec"},
+			},
+		},
+		"plan unchanged on update": {
+			instance: newInstance("small"),
+			setup: func(ctx context.Context) context.Context {
+				ctx = WithServicePlanChecker(ctx, &fakePlanChecker{visible: false})
+				return apis.WithinUpdate(ctx, newInstance("small"))
+			},
+		},
+		"plan changed on update": {
+			instance: newInstance("large"),
+			setup: func(ctx context.Context) context.Context {
+				ctx = WithServicePlanChecker(ctx, &fakePlanChecker{visible: false})
+				return apis.WithinUpdate(ctx, newInstance("small"))
+			},
+			want: &apis.FieldError{
+				Message: `plan "large" of service "mydb" isn't enabled in space "some-space"`,
+				Paths:   []string{"spec.clusterServicePlanExternalName"},
+			},
+		},
+		"status update": {
+			instance: newInstance("small"),
+			setup: func(ctx context.Context) context.Context {
+				ctx = WithServicePlanChecker(ctx, &fakePlanChecker{visible: false})
+				return apis.WithinSubResourceUpdate(ctx, nil, "status")
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.setup != nil {
+				ctx = tc.setup(ctx)
+			}
+
+			got := tc.instance.Validate(ctx)
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
+
+func TestServiceInstanceAdmission_DeepCopyObject(t *testing.T) {
+	instance := &ServiceInstanceAdmission{}
+	instance.Name = "mydb"
+
+	copied, ok := instance.DeepCopyObject().(*ServiceInstanceAdmission)
+	testutil.AssertEqual(t, "type", true, ok)
+	testutil.AssertEqual(t, "name", "mydb", copied.Name)
+}
@@ -0,0 +1,58 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Service instances are shared with other spaces by creating a
+// ServiceInstanceShare in the space of the instance. Apps in the other space
+// reference the instance with AppSpecServiceBinding.InstanceSpace.
+const (
+	// ServiceInstanceShareInstanceLabel holds the name of the shared service
+	// instance on ServiceInstanceShares.
+	ServiceInstanceShareInstanceLabel = "kf.dev/shared-instance"
+
+	// ServiceInstanceShareSpaceLabel holds the name of the space a service
+	// instance is shared with on ServiceInstanceShares.
+	ServiceInstanceShareSpaceLabel = "kf.dev/shared-to-space"
+
+	// AppSpaceLabel holds the space of the App on ServiceBindings that are
+	// created in the space of a shared service instance.
+	AppSpaceLabel = "kf.dev/app-space"
+)
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (r *ServiceInstanceShare) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ServiceInstanceShare")
+}
+
+// ServiceInstanceShareName gets the name of the ServiceInstanceShare that
+// shares the instance with the given space.
+func ServiceInstanceShareName(instanceName, space string) string {
+	return fmt.Sprintf("kf-share-%s-%s", instanceName, space)
+}
+
+// ServiceInstanceShareLabels gets the labels for a ServiceInstanceShare.
+func ServiceInstanceShareLabels(instanceName, space string) map[string]string {
+	return map[string]string{
+		ManagedByLabel:                    "kf",
+		ServiceInstanceShareInstanceLabel: instanceName,
+		ServiceInstanceShareSpaceLabel:    space,
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceInstanceShare grants another space access to a service instance.
+// Shares live in the space of the instance and are owned by it, Apps in the
+// space the instance is shared with can bind to it as if it were their own.
+// Deleting the share removes the bindings Apps in the other space made.
+type ServiceInstanceShare struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec ServiceInstanceShareSpec `json:"spec,omitempty"`
+}
+
+// ServiceInstanceShareSpec contains the specification for a
+// ServiceInstanceShare.
+type ServiceInstanceShareSpec struct {
+	// InstanceName is the name of the service instance being shared, it must
+	// be in the same space as the share.
+	InstanceName string `json:"instanceName"`
+
+	// Space is the name of the space the instance is shared with.
+	Space string `json:"space"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceInstanceShareList is a list of ServiceInstanceShare resources
+type ServiceInstanceShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ServiceInstanceShare `json:"items"`
+}
@@ -0,0 +1,48 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (k *ServiceInstanceShare) Validate(ctx context.Context) (errs *apis.FieldError) {
+	errs = errs.Also(k.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+
+	if k.Spec.Space != "" && k.Spec.Space == k.Namespace {
+		errs = errs.Also(&apis.FieldError{
+			Message: "service instances can't be shared with their own space",
+			Paths:   []string{"spec.space"},
+		})
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (k *ServiceInstanceShareSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if k.InstanceName == "" {
+		errs = errs.Also(apis.ErrMissingField("instanceName"))
+	}
+
+	if k.Space == "" {
+		errs = errs.Also(apis.ErrMissingField("space"))
+	}
+
+	return errs
+}
@@ -0,0 +1,68 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestServiceInstanceShareValidation(t *testing.T) {
+	cases := map[string]struct {
+		share *ServiceInstanceShare
+		want  *apis.FieldError
+	}{
+		"good": {
+			share: &ServiceInstanceShare{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "source"},
+				Spec: ServiceInstanceShareSpec{
+					InstanceName: "mydb",
+					Space:        "target",
+				},
+			},
+		},
+		"missing fields": {
+			share: &ServiceInstanceShare{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "source"},
+			},
+			want: apis.ErrMissingField("spec.instanceName", "spec.space"),
+		},
+		"shared with own space": {
+			share: &ServiceInstanceShare{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "source"},
+				Spec: ServiceInstanceShareSpec{
+					InstanceName: "mydb",
+					Space:        "source",
+				},
+			},
+			want: &apis.FieldError{
+				Message: "service instances can't be shared with their own space",
+				Paths:   []string{"spec.space"},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := tc.share.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
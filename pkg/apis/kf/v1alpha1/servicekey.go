@@ -0,0 +1,49 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Service keys are Service Catalog ServiceBindings that aren't owned by an
+// App. They let operators get credentials from a broker without pushing an
+// App.
+const (
+	// ServiceKeyLabel holds the name of the service key on ServiceBindings
+	// that are service keys.
+	ServiceKeyLabel = "kf.dev/service-key"
+)
+
+// ServiceKeyBindingName gets the name of the ServiceBinding that holds the
+// service key for the given service instance. Instance and key names can
+// contain dashes so the name ends with a hash of both, separated by a slash
+// neither can contain, to keep keys like "a-b"/"c" and "a"/"b-c" apart.
+func ServiceKeyBindingName(instanceName, keyName string) string {
+	h := fnv.New32a()
+	h.Write([]byte(instanceName + "/" + keyName))
+	return fmt.Sprintf("kf-key-%s-%s-%08x", instanceName, keyName, h.Sum32())
+}
+
+// ServiceKeyLabels creates the labels of the ServiceBinding that holds the
+// service key with the given name.
+func ServiceKeyLabels(keyName string) map[string]string {
+	return map[string]string{
+		ManagedByLabel:  "kf",
+		ComponentLabel:  keyName,
+		ServiceKeyLabel: keyName,
+	}
+}
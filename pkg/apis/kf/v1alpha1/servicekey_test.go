@@ -0,0 +1,34 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestServiceKeyBindingName(t *testing.T) {
+	name := ServiceKeyBindingName("mydb", "mykey")
+	testutil.AssertEqual(t, "prefix", true, strings.HasPrefix(name, "kf-key-mydb-mykey-"))
+	testutil.AssertEqual(t, "stable", name, ServiceKeyBindingName("mydb", "mykey"))
+
+	// Dashes in instance and key names must not make different keys share a
+	// ServiceBinding.
+	if ServiceKeyBindingName("a-b", "c") == ServiceKeyBindingName("a", "b-c") {
+		t.Fatal("expected keys a-b/c and a/b-c to have different names")
+	}
+}
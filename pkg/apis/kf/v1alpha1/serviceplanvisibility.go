@@ -0,0 +1,75 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (r *ServicePlanVisibility) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("ServicePlanVisibility")
+}
+
+// ServicePlanVisibilityName gets the name of the ServicePlanVisibility for
+// the broker, service and plan. The broker and plan may be blank.
+func ServicePlanVisibilityName(brokerName, serviceName, planName string) string {
+	return GenerateName("plan-visibility", brokerName, serviceName, planName)
+}
+
+// Matches returns true if the ServicePlanVisibility covers the plan.
+func (spec *ServicePlanVisibilitySpec) Matches(brokerName, serviceName, planName string) bool {
+	switch {
+	case spec.BrokerName != "" && spec.BrokerName != brokerName:
+		return false
+	case spec.ServiceName != serviceName:
+		return false
+	case spec.PlanName != "" && spec.PlanName != planName:
+		return false
+	default:
+		return true
+	}
+}
+
+// EnabledIn returns true if the plans are enabled in the space.
+func (spec *ServicePlanVisibilitySpec) EnabledIn(space string) bool {
+	for _, s := range spec.Spaces {
+		if s == space {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsServicePlanVisible returns true if a cluster service plan can be used in
+// the space. A plan is visible if no ServicePlanVisibility matches it or any
+// of the matching ones enables the space.
+func IsServicePlanVisible(visibilities []ServicePlanVisibility, brokerName, serviceName, planName, space string) bool {
+	restricted := false
+	for _, v := range visibilities {
+		if !v.Spec.Matches(brokerName, serviceName, planName) {
+			continue
+		}
+
+		if v.Spec.EnabledIn(space) {
+			return true
+		}
+
+		restricted = true
+	}
+
+	return !restricted
+}
@@ -0,0 +1,64 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestIsServicePlanVisible(t *testing.T) {
+	visibilities := []ServicePlanVisibility{
+		{Spec: ServicePlanVisibilitySpec{ServiceName: "db", PlanName: "large", Spaces: []string{"prod"}}},
+		{Spec: ServicePlanVisibilitySpec{ServiceName: "db", PlanName: "large", Spaces: []string{"staging"}}},
+		{Spec: ServicePlanVisibilitySpec{BrokerName: "gcp", ServiceName: "cache"}},
+	}
+
+	cases := map[string]struct {
+		broker  string
+		service string
+		plan    string
+		space   string
+		want    bool
+	}{
+		"unrestricted plan": {
+			broker: "gcp", service: "db", plan: "small", space: "dev", want: true,
+		},
+		"restricted plan in enabled space": {
+			broker: "gcp", service: "db", plan: "large", space: "prod", want: true,
+		},
+		"restricted plan in space enabled by another visibility": {
+			broker: "gcp", service: "db", plan: "large", space: "staging", want: true,
+		},
+		"restricted plan in other space": {
+			broker: "gcp", service: "db", plan: "large", space: "dev", want: false,
+		},
+		"service disabled everywhere": {
+			broker: "gcp", service: "cache", plan: "small", space: "prod", want: false,
+		},
+		"same service from another broker": {
+			broker: "aws", service: "cache", plan: "small", space: "prod", want: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := IsServicePlanVisible(visibilities, tc.broker, tc.service, tc.plan, tc.space)
+
+			testutil.AssertEqual(t, "visible", tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServicePlanVisibility restricts cluster service plans to a set of spaces.
+// Plans that no ServicePlanVisibility matches are visible in every space, a
+// plan that's matched is only visible in the spaces the matching
+// ServicePlanVisibilities list.
+type ServicePlanVisibility struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec ServicePlanVisibilitySpec `json:"spec,omitempty"`
+}
+
+// ServicePlanVisibilitySpec contains the specification for a
+// ServicePlanVisibility.
+type ServicePlanVisibilitySpec struct {
+	// BrokerName is the name of the cluster service broker that offers the
+	// service. If blank, the service of every broker is matched.
+	// +optional
+	BrokerName string `json:"brokerName,omitempty"`
+
+	// ServiceName is the name of the service offering in the marketplace.
+	ServiceName string `json:"serviceName"`
+
+	// PlanName is the name of the plan in the marketplace. If blank, every
+	// plan of the service is matched.
+	// +optional
+	PlanName string `json:"planName,omitempty"`
+
+	// Spaces are the spaces the plans are enabled in.
+	// +optional
+	Spaces []string `json:"spaces,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServicePlanVisibilityList is a list of ServicePlanVisibility resources
+type ServicePlanVisibilityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ServicePlanVisibility `json:"items"`
+}
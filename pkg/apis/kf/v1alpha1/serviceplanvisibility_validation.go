@@ -0,0 +1,41 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (k *ServicePlanVisibility) Validate(ctx context.Context) (errs *apis.FieldError) {
+	return errs.Also(k.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+}
+
+// Validate implements apis.Validatable
+func (k *ServicePlanVisibilitySpec) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if k.ServiceName == "" {
+		errs = errs.Also(apis.ErrMissingField("serviceName"))
+	}
+
+	for i, space := range k.Spaces {
+		if space == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(space, "spaces", i))
+		}
+	}
+
+	return errs
+}
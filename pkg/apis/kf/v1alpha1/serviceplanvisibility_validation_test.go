@@ -0,0 +1,68 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	"knative.dev/pkg/apis"
+)
+
+func TestServicePlanVisibilityValidation(t *testing.T) {
+	cases := map[string]struct {
+		visibility *ServicePlanVisibility
+		want       *apis.FieldError
+	}{
+		"good": {
+			visibility: &ServicePlanVisibility{
+				Spec: ServicePlanVisibilitySpec{
+					ServiceName: "db",
+					PlanName:    "large",
+					Spaces:      []string{"prod"},
+				},
+			},
+		},
+		"no spaces": {
+			visibility: &ServicePlanVisibility{
+				Spec: ServicePlanVisibilitySpec{
+					ServiceName: "db",
+				},
+			},
+		},
+		"missing service": {
+			visibility: &ServicePlanVisibility{},
+			want:       apis.ErrMissingField("spec.serviceName"),
+		},
+		"blank space": {
+			visibility: &ServicePlanVisibility{
+				Spec: ServicePlanVisibilitySpec{
+					ServiceName: "db",
+					Spaces:      []string{"prod", ""},
+				},
+			},
+			want: apis.ErrInvalidArrayValue("", "spec.spaces", 1),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := tc.visibility.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
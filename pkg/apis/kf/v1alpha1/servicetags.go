@@ -0,0 +1,58 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+)
+
+// ServiceTagsAnnotation holds the JSON encoded tags users gave a service
+// instance. For brokered instances they're added to the tags of the service
+// offering in VCAP_SERVICES.
+const ServiceTagsAnnotation = "kf.dev/tags"
+
+// ServiceTags gets the tags stored in the annotations of a service instance.
+func ServiceTags(annotations map[string]string) ([]string, error) {
+	raw, ok := annotations[ServiceTagsAnnotation]
+	if !ok || raw == "" {
+		return nil, nil
+	}
+
+	var tags []string
+	if err := json.Unmarshal([]byte(raw), &tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// SetServiceTags stores tags in the annotations of a service instance,
+// removing the annotation if there are no tags. The updated annotations are
+// returned.
+func SetServiceTags(annotations map[string]string, tags []string) map[string]string {
+	if len(tags) == 0 {
+		delete(annotations, ServiceTagsAnnotation)
+		return annotations
+	}
+
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	// Marshaling a list of strings can't fail.
+	encoded, _ := json.Marshal(tags)
+	annotations[ServiceTagsAnnotation] = string(encoded)
+	return annotations
+}
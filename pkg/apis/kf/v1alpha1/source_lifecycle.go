@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"fmt"
 
 	build "github.com/google/kf/third_party/knative-build/pkg/apis/build/v1alpha1"
@@ -36,9 +37,13 @@ const (
 
 	BuildArgImage             = "IMAGE"
 	BuildArgBuildpack         = "BUILDPACK"
+	BuildArgBuildpackOrder    = "BUILDPACK_ORDER"
 	BuildArgBuildpackBuilder  = "BUILDER_IMAGE"
 	BuildArgBuildpackRunImage = "RUN_IMAGE"
+	BuildArgBuildpackSource   = "SOURCE_IMAGE"
 	BuildArgDockerfile        = "DOCKERFILE"
+	BuildArgDockerfileTarget  = "TARGET"
+	BuildArgDockerfileArgs    = "BUILD_ARGS"
 )
 
 func (status *SourceStatus) manage() apis.ConditionManager {
@@ -80,9 +85,37 @@ func (status *SourceStatus) PropagateBuildStatus(build *build.Build) {
 	cond := build.Status.GetCondition(apis.ConditionSucceeded)
 	if PropagateCondition(status.manage(), SourceConditionBuildSucceeded, cond) {
 		status.Image = GetBuildArg(build, BuildArgImage)
+		status.BuildMetadata = GetBuildMetadata(build)
 	}
 }
 
+// BuildMetadataMessage is written to the termination log of a build step to
+// report the provenance of the built image.
+type BuildMetadataMessage struct {
+	BuildMetadata *SourceBuildMetadata `json:"buildMetadata"`
+}
+
+// GetBuildMetadata returns the metadata reported by the steps of the Build
+// or nil if none was reported.
+func GetBuildMetadata(b *build.Build) *SourceBuildMetadata {
+	for _, state := range b.Status.StepStates {
+		if state.Terminated == nil || state.Terminated.Message == "" {
+			continue
+		}
+
+		var msg BuildMetadataMessage
+		if err := json.Unmarshal([]byte(state.Terminated.Message), &msg); err != nil {
+			continue
+		}
+
+		if msg.BuildMetadata != nil {
+			return msg.BuildMetadata
+		}
+	}
+
+	return nil
+}
+
 func GetBuildArg(b *build.Build, key string) string {
 	for _, arg := range b.Spec.Template.Arguments {
 		if arg.Name == key {
@@ -243,6 +243,50 @@ func TestSourceHappyPath(t *testing.T) {
 	testutil.AssertEqual(t, "Image", "some-container-image", status.Image)
 }
 
+func TestGetBuildMetadata(t *testing.T) {
+	cases := map[string]struct {
+		stepStates []corev1.ContainerState
+		want       *SourceBuildMetadata
+	}{
+		"no steps": {},
+		"no messages": {
+			stepStates: []corev1.ContainerState{
+				{Terminated: &corev1.ContainerStateTerminated{}},
+				{Running: &corev1.ContainerStateRunning{}},
+			},
+		},
+		"unrelated messages": {
+			stepStates: []corev1.ContainerState{
+				{Terminated: &corev1.ContainerStateTerminated{Message: "not json"}},
+				{Terminated: &corev1.ContainerStateTerminated{Message: `{"other":true}`}},
+			},
+		},
+		"metadata reported": {
+			stepStates: []corev1.ContainerState{
+				{Terminated: &corev1.ContainerStateTerminated{Message: "not json"}},
+				{Terminated: &corev1.ContainerStateTerminated{
+					Message: `{"buildMetadata":{"imageDigest":"sha256:123","buildpacks":[{"id":"java","version":"1.0"}]}}`,
+				}},
+			},
+			want: &SourceBuildMetadata{
+				ImageDigest: "sha256:123",
+				Buildpacks: []SourceBuildpackMetadata{
+					{ID: "java", Version: "1.0"},
+				},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			b := happyBuild()
+			b.Status.StepStates = tc.stepStates
+
+			testutil.AssertEqual(t, "metadata", tc.want, GetBuildMetadata(b))
+		})
+	}
+}
+
 func TestSourceStatus_lifecycle(t *testing.T) {
 	cases := map[string]struct {
 		Init func(*SourceStatus)
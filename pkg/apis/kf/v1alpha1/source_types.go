@@ -22,6 +22,9 @@ import (
 	duckv1beta1 "knative.dev/pkg/apis/duck/v1beta1"
 )
 
+// BuildPodLabel is set by Knative Build on the pods that run builds.
+const BuildPodLabel = "build.knative.dev/buildName"
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -64,6 +67,11 @@ type SourceSpec struct {
 	// Dockerfile defines Dockerfile information for source.
 	// +optional
 	Dockerfile SourceSpecDockerfile `json:"dockerfile,omitempty"`
+	// Placement controls which nodes the build runs on. It's copied from
+	// the space's resolved build placement. Only the node selector and
+	// affinity are used because Knative Build doesn't support tolerations.
+	// +optional
+	Placement PodPlacement `json:"placement,omitempty"`
 }
 
 // NeedsUpdateRequestsIncrement returns true if UpdateRequests needs to be
@@ -104,6 +112,11 @@ type SourceSpecBuildpackBuild struct {
 	// +optional
 	Buildpack string `json:"buildpack,omitempty"`
 
+	// BuildpackOrder is the list of buildpacks to detect in order, each as
+	// ID or ID@VERSION. If blank the order defined by the builder is used.
+	// +optional
+	BuildpackOrder []string `json:"buildpackOrder,omitempty"`
+
 	// BuildpackBuilder is the container image which builds the App.
 	BuildpackBuilder string `json:"buildpackBuilder"`
 
@@ -112,6 +125,12 @@ type SourceSpecBuildpackBuild struct {
 
 	// Env represents the environment variables to apply when building the App.
 	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// RebasedImage is a previously built image that has been rebased onto a
+	// newer run image. If set, detect and build are skipped and the image is
+	// recorded as the result of the build.
+	// +optional
+	RebasedImage string `json:"rebasedImage,omitempty"`
 }
 
 // SourceSpecDockerfile defines building an App using a Dockerfile.
@@ -126,8 +145,30 @@ type SourceSpecDockerfile struct {
 
 	// Image is the location to store the built image.
 	Image string `json:"image"`
+
+	// BuildArgs are passed to the build as --build-arg values.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	BuildArgs []corev1.EnvVar `json:"buildArgs,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// Target is the stage of a multi-stage Dockerfile to build. If blank, the
+	// final stage is built.
+	// +optional
+	Target string `json:"target,omitempty"`
+
+	// Secrets holds the names of Secrets in the Source's namespace. The keys
+	// of each Secret are mounted as files in DockerfileBuildSecretsPath while
+	// the Dockerfile is built so RUN instructions can read them. They aren't
+	// written to the built image.
+	// +optional
+	Secrets []string `json:"secrets,omitempty"`
 }
 
+// DockerfileBuildSecretsPath is the directory Secrets listed in
+// SourceSpecDockerfile are mounted into during a build.
+const DockerfileBuildSecretsPath = "/var/run/kf/build-secrets"
+
 // SourceStatus is the current configuration and running state for an App's Source.
 type SourceStatus struct {
 	// Pull in the fields from Knative's duckv1beta1 status field.
@@ -147,6 +188,50 @@ type SourceStatusFields struct {
 	// BuildName is the name of the build that produced the image.
 	// +optional
 	BuildName string `json:"buildName,omitempty"`
+
+	// BuildMetadata describes what went into the latest successfully built
+	// image. It's only recorded for buildpack builds.
+	// +optional
+	BuildMetadata *SourceBuildMetadata `json:"buildMetadata,omitempty"`
+}
+
+// SourceBuildMetadata holds the provenance of a built image.
+type SourceBuildMetadata struct {
+	// ImageDigest is the digest of the built image.
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// Stack is a digest reference to the run image the app was built on.
+	// +optional
+	Stack string `json:"stack,omitempty"`
+
+	// SourceDigest is the digest of the image the source code was read from.
+	// +optional
+	SourceDigest string `json:"sourceDigest,omitempty"`
+
+	// BuilderImageDigest is the digest of the builder image that ran the
+	// build.
+	// +optional
+	BuilderImageDigest string `json:"builderImageDigest,omitempty"`
+
+	// Buildpacks are the buildpacks that contributed to the image.
+	// +optional
+	Buildpacks []SourceBuildpackMetadata `json:"buildpacks,omitempty"`
+
+	// SBOM is a digest reference to the image whose config holds the
+	// software bill of materials in the io.buildpacks.build.metadata label.
+	// +optional
+	SBOM string `json:"sbom,omitempty"`
+}
+
+// SourceBuildpackMetadata identifies a buildpack used in a build.
+type SourceBuildpackMetadata struct {
+	// ID is the ID of the buildpack.
+	ID string `json:"id"`
+
+	// Version is the version of the buildpack.
+	// +optional
+	Version string `json:"version,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -169,6 +254,12 @@ func (spec *SourceSpec) IsBuildpackBuild() bool {
 	return spec.BuildpackBuild.Source != ""
 }
 
+// IsRebasedBuild returns true if the build is for a buildpack and the
+// resulting image was produced by a rebase rather than a full build.
+func (spec *SourceSpec) IsRebasedBuild() bool {
+	return spec.IsBuildpackBuild() && spec.BuildpackBuild.RebasedImage != ""
+}
+
 // IsDockerfileBuild returns true if the build is for a dockerfile
 func (spec *SourceSpec) IsDockerfileBuild() bool {
 	return spec.Dockerfile.Source != ""
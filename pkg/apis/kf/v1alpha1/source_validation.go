@@ -16,6 +16,8 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"knative.dev/pkg/apis"
 )
@@ -112,5 +114,37 @@ func (dockerfile *SourceSpecDockerfile) Validate(ctx context.Context) (errs *api
 		errs = errs.Also(apis.ErrMissingField("source"))
 	}
 
+	for i, arg := range dockerfile.BuildArgs {
+		switch {
+		case arg.Name == "":
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("buildArgs", i))
+		case strings.ContainsAny(arg.Name, "=\n"):
+			errs = errs.Also(apis.ErrInvalidValue(arg.Name, "name").ViaFieldIndex("buildArgs", i))
+		}
+
+		if strings.Contains(arg.Value, "\n") {
+			errs = errs.Also(apis.ErrInvalidValue(arg.Value, "value").ViaFieldIndex("buildArgs", i))
+		}
+
+		if arg.ValueFrom != nil {
+			errs = errs.Also(apis.ErrDisallowedFields("valueFrom").ViaFieldIndex("buildArgs", i))
+		}
+	}
+
+	seenSecrets := make(map[string]bool)
+	for i, secret := range dockerfile.Secrets {
+		switch {
+		case secret == "":
+			errs = errs.Also(apis.ErrInvalidArrayValue(secret, "secrets", i))
+		case seenSecrets[secret]:
+			errs = errs.Also(&apis.FieldError{
+				Message: fmt.Sprintf("duplicate secret %q", secret),
+				Paths:   []string{apis.CurrentField},
+			}).ViaFieldIndex("secrets", i)
+		}
+
+		seenSecrets[secret] = true
+	}
+
 	return errs
 }
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 )
@@ -202,6 +203,67 @@ func TestSourceSpecDockerfile_Valdiate(t *testing.T) {
 			},
 			want: apis.ErrMissingField("image"),
 		},
+		"valid build args, target and secrets": {
+			spec: SourceSpecDockerfile{
+				Image:     "some-image",
+				Path:      "some-path",
+				Source:    "some-source",
+				BuildArgs: []corev1.EnvVar{{Name: "VERSION", Value: "1.2.3"}},
+				Target:    "release",
+				Secrets:   []string{"npmrc", "netrc"},
+			},
+		},
+		"build arg missing name": {
+			spec: SourceSpecDockerfile{
+				Image:     "some-image",
+				Path:      "some-path",
+				Source:    "some-source",
+				BuildArgs: []corev1.EnvVar{{Value: "1.2.3"}},
+			},
+			want: apis.ErrMissingField("buildArgs[0].name"),
+		},
+		"build arg with newline": {
+			spec: SourceSpecDockerfile{
+				Image:     "some-image",
+				Path:      "some-path",
+				Source:    "some-source",
+				BuildArgs: []corev1.EnvVar{{Name: "VERSION", Value: "1\n2"}},
+			},
+			want: apis.ErrInvalidValue("1\n2", "buildArgs[0].value"),
+		},
+		"build arg with valueFrom": {
+			spec: SourceSpecDockerfile{
+				Image:  "some-image",
+				Path:   "some-path",
+				Source: "some-source",
+				BuildArgs: []corev1.EnvVar{{
+					Name:      "VERSION",
+					ValueFrom: &corev1.EnvVarSource{},
+				}},
+			},
+			want: apis.ErrDisallowedFields("buildArgs[0].valueFrom"),
+		},
+		"blank secret": {
+			spec: SourceSpecDockerfile{
+				Image:   "some-image",
+				Path:    "some-path",
+				Source:  "some-source",
+				Secrets: []string{""},
+			},
+			want: apis.ErrInvalidArrayValue("", "secrets", 0),
+		},
+		"duplicate secret": {
+			spec: SourceSpecDockerfile{
+				Image:   "some-image",
+				Path:    "some-path",
+				Source:  "some-source",
+				Secrets: []string{"npmrc", "npmrc"},
+			},
+			want: &apis.FieldError{
+				Message: `duplicate secret "npmrc"`,
+				Paths:   []string{"secrets[1]"},
+			},
+		},
 	}
 
 	for tn, tc := range cases {
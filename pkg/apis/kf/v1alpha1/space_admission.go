@@ -0,0 +1,143 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"knative.dev/pkg/apis"
+)
+
+// SpaceAccessChecker determines which changes a user may make to a Space.
+type SpaceAccessChecker interface {
+	// CanConfigure returns true if the user can change the configuration of
+	// the space rather than just its role assignments.
+	CanConfigure(user authenticationv1.UserInfo, space *Space) (bool, error)
+
+	// CanAssignRole returns true if the user can assign users and groups to
+	// the role in the space.
+	CanAssignRole(user authenticationv1.UserInfo, space *Space, role SpaceRoleName) (bool, error)
+}
+
+type spaceAccessCheckerKey struct{}
+
+// WithSpaceAccessChecker adds a SpaceAccessChecker to the context.
+func WithSpaceAccessChecker(ctx context.Context, checker SpaceAccessChecker) context.Context {
+	return context.WithValue(ctx, spaceAccessCheckerKey{}, checker)
+}
+
+// SpaceAccessCheckerFromContext gets the SpaceAccessChecker from the context
+// or nil if none was set.
+func SpaceAccessCheckerFromContext(ctx context.Context) SpaceAccessChecker {
+	checker, _ := ctx.Value(spaceAccessCheckerKey{}).(SpaceAccessChecker)
+	return checker
+}
+
+// validateAccess makes sure the user updating the Space is allowed to make
+// the change. Users that can configure the Space may change anything, other
+// users (i.e. space managers) may only change the assignments of roles they
+// can bind.
+func (space *Space) validateAccess(ctx context.Context) (errs *apis.FieldError) {
+	checker := SpaceAccessCheckerFromContext(ctx)
+	user := apis.GetUserInfo(ctx)
+	original, ok := apis.GetBaseline(ctx).(*Space)
+	if checker == nil || user == nil || !ok || original == nil {
+		return nil
+	}
+
+	if !sameSpaceConfiguration(original, space) {
+		allowed, err := checker.CanConfigure(*user, space)
+		if err != nil {
+			return &apis.FieldError{
+				Message: fmt.Sprintf("couldn't check access to space: %v", err),
+				Paths:   []string{"spec"},
+			}
+		}
+
+		if !allowed {
+			return &apis.FieldError{
+				Message: fmt.Sprintf("user %q can only change the roles of space %q", user.Username, space.Name),
+				Paths:   []string{"spec"},
+			}
+		}
+	}
+
+	for _, role := range ChangedSpaceRoles(original.Spec.Security.Roles, space.Spec.Security.Roles) {
+		allowed, err := checker.CanAssignRole(*user, space, role)
+		if err != nil {
+			return &apis.FieldError{
+				Message: fmt.Sprintf("couldn't check access to role %s: %v", role, err),
+				Paths:   []string{"spec.security.roles"},
+			}
+		}
+
+		if !allowed {
+			errs = errs.Also(&apis.FieldError{
+				Message: fmt.Sprintf("user %q can't assign role %s in space %q", user.Username, role, space.Name),
+				Paths:   []string{"spec.security.roles"},
+			})
+		}
+	}
+
+	return errs
+}
+
+// sameSpaceConfiguration returns true if the Spaces only differ in their
+// role assignments or in metadata that the API server sets.
+func sameSpaceConfiguration(a, b *Space) bool {
+	return equality.Semantic.DeepEqual(configurableSpace(a), configurableSpace(b))
+}
+
+// configurableSpace returns a copy of the Space's metadata and spec without
+// the fields managers are allowed to change.
+func configurableSpace(space *Space) *Space {
+	out := &Space{
+		ObjectMeta: *space.ObjectMeta.DeepCopy(),
+		Spec:       *space.Spec.DeepCopy(),
+	}
+
+	out.ResourceVersion = ""
+	out.Generation = 0
+	out.Spec.Security.Roles = nil
+
+	return out
+}
+
+// ChangedSpaceRoles returns the roles that have different users or groups
+// assigned to them in the two lists.
+func ChangedSpaceRoles(original, updated []SpaceRole) []SpaceRoleName {
+	count := make(map[SpaceRole]int)
+	for _, r := range original {
+		count[r]++
+	}
+	for _, r := range updated {
+		count[r]--
+	}
+
+	var out []SpaceRoleName
+	for _, name := range SpaceRoleNames {
+		for r, c := range count {
+			if r.Role == name && c != 0 {
+				out = append(out, name)
+				break
+			}
+		}
+	}
+
+	return out
+}
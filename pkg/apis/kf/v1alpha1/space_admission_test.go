@@ -0,0 +1,197 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+type fakeSpaceAccessChecker struct {
+	configure bool
+	roles     map[SpaceRoleName]bool
+	err       error
+}
+
+func (f *fakeSpaceAccessChecker) CanConfigure(user authenticationv1.UserInfo, space *Space) (bool, error) {
+	return f.configure, f.err
+}
+
+func (f *fakeSpaceAccessChecker) CanAssignRole(user authenticationv1.UserInfo, space *Space, role SpaceRoleName) (bool, error) {
+	return f.roles[role], f.err
+}
+
+func TestSpace_validateAccess(t *testing.T) {
+	newSpace := func(registry string, roles ...SpaceRole) *Space {
+		return &Space{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-space"},
+			Spec: SpaceSpec{
+				Security: SpaceSpecSecurity{Roles: roles},
+				BuildpackBuild: SpaceSpecBuildpackBuild{
+					BuilderImage:      DefaultBuilderImage,
+					ContainerRegistry: registry,
+				},
+				Execution: SpaceSpecExecution{
+					Domains: []SpaceDomain{{Domain: "example.com", Default: true}},
+				},
+			},
+		}
+	}
+
+	manager := SpaceRole{Role: SpaceManager, Kind: "User", Name: "alice"}
+	developer := SpaceRole{Role: SpaceDeveloper, Kind: "User", Name: "bob"}
+
+	managerChecker := &fakeSpaceAccessChecker{
+		roles: map[SpaceRoleName]bool{SpaceDeveloper: true, SpaceAuditor: true},
+	}
+
+	withUpdate := func(checker SpaceAccessChecker, original *Space) func(context.Context) context.Context {
+		return func(ctx context.Context) context.Context {
+			ctx = WithSpaceAccessChecker(ctx, checker)
+			ctx = apis.WithUserInfo(ctx, &authenticationv1.UserInfo{Username: "alice"})
+			return apis.WithinUpdate(ctx, original)
+		}
+	}
+
+	cases := map[string]struct {
+		space *Space
+		setup func(context.Context) context.Context
+		want  *apis.FieldError
+	}{
+		"no checker": {
+			space: newSpace("gcr.io/other", manager),
+		},
+		"create": {
+			space: newSpace("gcr.io/test", manager),
+			setup: func(ctx context.Context) context.Context {
+				ctx = WithSpaceAccessChecker(ctx, managerChecker)
+				return apis.WithUserInfo(ctx, &authenticationv1.UserInfo{Username: "alice"})
+			},
+		},
+		"admin changes everything": {
+			space: newSpace("gcr.io/other", manager, developer),
+			setup: withUpdate(&fakeSpaceAccessChecker{
+				configure: true,
+				roles:     map[SpaceRoleName]bool{SpaceManager: true, SpaceDeveloper: true},
+			}, newSpace("gcr.io/test")),
+		},
+		"manager assigns developer": {
+			space: newSpace("gcr.io/test", manager, developer),
+			setup: withUpdate(managerChecker, newSpace("gcr.io/test", manager)),
+		},
+		"manager assigns manager": {
+			space: newSpace("gcr.io/test", manager, SpaceRole{Role: SpaceManager, Kind: "User", Name: "carol"}),
+			setup: withUpdate(managerChecker, newSpace("gcr.io/test", manager)),
+			want: &apis.FieldError{
+				Message: `user "alice" can't assign role SpaceManager in space "my-space"`,
+				Paths:   []string{"spec.security.roles"},
+			},
+		},
+		"manager removes manager": {
+			space: newSpace("gcr.io/test"),
+			setup: withUpdate(managerChecker, newSpace("gcr.io/test", manager)),
+			want: &apis.FieldError{
+				Message: `user "alice" can't assign role SpaceManager in space "my-space"`,
+				Paths:   []string{"spec.security.roles"},
+			},
+		},
+		"manager reorders roles": {
+			space: newSpace("gcr.io/test", developer, manager),
+			setup: withUpdate(managerChecker, newSpace("gcr.io/test", manager, developer)),
+		},
+		"manager changes configuration": {
+			space: newSpace("gcr.io/other", manager),
+			setup: withUpdate(managerChecker, newSpace("gcr.io/test", manager)),
+			want: &apis.FieldError{
+				Message: `user "alice" can only change the roles of space "my-space"`,
+				Paths:   []string{"spec"},
+			},
+		},
+		"manager changes annotations": {
+			space: func() *Space {
+				s := newSpace("gcr.io/test", manager)
+				s.Annotations = map[string]string{"some-annotation": "some-value"}
+				return s
+			}(),
+			setup: withUpdate(managerChecker, newSpace("gcr.io/test", manager)),
+			want: &apis.FieldError{
+				Message: `user "alice" can only change the roles of space "my-space"`,
+				Paths:   []string{"spec"},
+			},
+		},
+		"manager removes finalizers": {
+			space: newSpace("gcr.io/test", manager),
+			setup: withUpdate(managerChecker, func() *Space {
+				s := newSpace("gcr.io/test", manager)
+				s.Finalizers = []string{"spaces.kf.dev"}
+				return s
+			}()),
+			want: &apis.FieldError{
+				Message: `user "alice" can only change the roles of space "my-space"`,
+				Paths:   []string{"spec"},
+			},
+		},
+		"server updates metadata": {
+			space: func() *Space {
+				s := newSpace("gcr.io/test", manager, developer)
+				s.ResourceVersion = "2"
+				s.Generation = 2
+				return s
+			}(),
+			setup: withUpdate(managerChecker, func() *Space {
+				s := newSpace("gcr.io/test", manager)
+				s.ResourceVersion = "1"
+				s.Generation = 1
+				return s
+			}()),
+		},
+		"checker error": {
+			space: newSpace("gcr.io/test", manager, developer),
+			setup: withUpdate(&fakeSpaceAccessChecker{err: errors.New("some-error")}, newSpace("gcr.io/test", manager)),
+			want: &apis.FieldError{
+				Message: "couldn't check access to role SpaceDeveloper: some-error",
+				Paths:   []string{"spec.security.roles"},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.setup != nil {
+				ctx = tc.setup(ctx)
+			}
+
+			got := tc.space.Validate(ctx)
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
+
+func TestChangedSpaceRoles(t *testing.T) {
+	alice := SpaceRole{Role: SpaceDeveloper, Kind: "User", Name: "alice"}
+	bob := SpaceRole{Role: SpaceAuditor, Kind: "User", Name: "bob"}
+	carol := SpaceRole{Role: SpaceManager, Kind: "Group", Name: "carol"}
+
+	got := ChangedSpaceRoles([]SpaceRole{alice, carol}, []SpaceRole{bob, alice})
+	testutil.AssertEqual(t, "changed roles", []SpaceRoleName{SpaceManager, SpaceAuditor}, got)
+}
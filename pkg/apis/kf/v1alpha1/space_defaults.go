@@ -34,6 +34,9 @@ const (
 
 // SetDefaults implements apis.Defaultable
 func (k *Space) SetDefaults(ctx context.Context) {
+	// Inherit from the organization first so its defaults take precedence
+	// over the global ones.
+	k.inheritOrganization(ctx)
 	k.Spec.SetDefaults(ctx, k.Name)
 }
 
@@ -16,8 +16,10 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/apis"
@@ -29,6 +31,17 @@ func (r *Space) GetGroupVersionKind() schema.GroupVersionKind {
 	return SchemeGroupVersion.WithKind("Space")
 }
 
+// HasFinalizer returns true if the SpaceFinalizer is set on the Space.
+func (r *Space) HasFinalizer() bool {
+	for _, finalizer := range r.Finalizers {
+		if finalizer == SpaceFinalizer {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ConditionType represents a Service condition value
 const (
 	// SpaceConditionReady is set when the space is configured
@@ -42,6 +55,12 @@ const (
 	// SpaceConditionAuditorRoleReady is set when the auditor RBAC role is
 	// ready.
 	SpaceConditionAuditorRoleReady apis.ConditionType = "AuditorRoleReady"
+	// SpaceConditionManagerRoleReady is set when the manager RBAC role is
+	// ready.
+	SpaceConditionManagerRoleReady apis.ConditionType = "ManagerRoleReady"
+	// SpaceConditionRoleBindingsReady is set when the users and groups
+	// assigned to the space's roles are bound to them.
+	SpaceConditionRoleBindingsReady apis.ConditionType = "RoleBindingsReady"
 	// SpaceConditionResourceQuotaReady is set when the resource quota is
 	// ready.
 	SpaceConditionResourceQuotaReady apis.ConditionType = "ResourceQuotaReady"
@@ -51,6 +70,15 @@ const (
 	// SpaceConditionBuildServiceAccountReady is set when the
 	// BuildServiceAccount is ready.
 	SpaceConditionBuildServiceAccountReady apis.ConditionType = "BuildServiceAccountReady"
+	// SpaceConditionBuildpackCatalogReady is set when the stacks available to
+	// the space have been resolved.
+	SpaceConditionBuildpackCatalogReady apis.ConditionType = "BuildpackCatalogReady"
+	// SpaceConditionNetworkPoliciesReady is set when the egress
+	// NetworkPolicies compiled from the space's SecurityGroups are ready.
+	SpaceConditionNetworkPoliciesReady apis.ConditionType = "NetworkPoliciesReady"
+	// SpaceConditionPlacementReady is set when the placement of apps and
+	// builds in the space has been resolved.
+	SpaceConditionPlacementReady apis.ConditionType = "PlacementReady"
 )
 
 func (status *SpaceStatus) manage() apis.ConditionManager {
@@ -58,9 +86,14 @@ func (status *SpaceStatus) manage() apis.ConditionManager {
 		SpaceConditionNamespaceReady,
 		SpaceConditionDeveloperRoleReady,
 		SpaceConditionAuditorRoleReady,
+		SpaceConditionManagerRoleReady,
+		SpaceConditionRoleBindingsReady,
 		SpaceConditionResourceQuotaReady,
 		SpaceConditionLimitRangeReady,
 		SpaceConditionBuildServiceAccountReady,
+		SpaceConditionBuildpackCatalogReady,
+		SpaceConditionNetworkPoliciesReady,
+		SpaceConditionPlacementReady,
 	).Manage(status)
 }
 
@@ -97,12 +130,31 @@ func (status *SpaceStatus) MarkAuditorRoleNotOwned(name string) {
 		fmt.Sprintf("There is an existing auditor role %q that we do not own.", name))
 }
 
+// MarkManagerRoleNotOwned marks the manager role as not being owned by the Space.
+func (status *SpaceStatus) MarkManagerRoleNotOwned(name string) {
+	status.manage().MarkFalse(SpaceConditionManagerRoleReady, "NotOwned",
+		fmt.Sprintf("There is an existing manager role %q that we do not own.", name))
+}
+
+// MarkRoleBindingNotOwned marks the role bindings as not being owned by the
+// Space.
+func (status *SpaceStatus) MarkRoleBindingNotOwned(name string) {
+	status.manage().MarkFalse(SpaceConditionRoleBindingsReady, "NotOwned",
+		fmt.Sprintf("There is an existing role binding %q that we do not own.", name))
+}
+
 // MarkResourceQuotaNotOwned marks the ResourceQuota as not being owned by the Space.
 func (status *SpaceStatus) MarkResourceQuotaNotOwned(name string) {
 	status.manage().MarkFalse(SpaceConditionResourceQuotaReady, "NotOwned",
 		fmt.Sprintf("There is an existing resourcequota %q that we do not own.", name))
 }
 
+// MarkQuotaPlanNotFound marks the QuotaPlan the Space uses as missing.
+func (status *SpaceStatus) MarkQuotaPlanNotFound(name string) {
+	status.manage().MarkFalse(SpaceConditionResourceQuotaReady, "QuotaPlanNotFound",
+		fmt.Sprintf("The QuotaPlan %q doesn't exist.", name))
+}
+
 // MarkLimitRangeNotOwned marks the LimitRange as not being owned by the Space.
 func (status *SpaceStatus) MarkLimitRangeNotOwned(name string) {
 	status.manage().MarkFalse(SpaceConditionLimitRangeReady, "NotOwned",
@@ -116,6 +168,55 @@ func (status *SpaceStatus) MarkBuildServiceAccountNotOwned(name string) {
 		fmt.Sprintf("There is an existing build serviceaccount %q that we do not own.", name))
 }
 
+// MarkBuildpackCatalogNotFound marks the BuildpackCatalog the Space uses as
+// missing.
+func (status *SpaceStatus) MarkBuildpackCatalogNotFound(name string) {
+	status.manage().MarkFalse(SpaceConditionBuildpackCatalogReady, "NotFound",
+		fmt.Sprintf("The BuildpackCatalog %q doesn't exist.", name))
+}
+
+// MarkNetworkPolicyNotOwned marks the NetworkPolicy as not being owned by the
+// Space.
+func (status *SpaceStatus) MarkNetworkPolicyNotOwned(name string) {
+	status.manage().MarkFalse(SpaceConditionNetworkPoliciesReady, "NotOwned",
+		fmt.Sprintf("There is an existing networkpolicy %q that we do not own.", name))
+}
+
+// MarkSecurityGroupNotFound marks a SecurityGroup bound to the Space as
+// missing.
+func (status *SpaceStatus) MarkSecurityGroupNotFound(name string) {
+	status.manage().MarkFalse(SpaceConditionNetworkPoliciesReady, "SecurityGroupNotFound",
+		fmt.Sprintf("The SecurityGroup %q doesn't exist.", name))
+}
+
+// MarkPlacementProfileNotFound marks the PlacementProfile the Space uses as
+// missing.
+func (status *SpaceStatus) MarkPlacementProfileNotFound(name string) {
+	status.manage().MarkFalse(SpaceConditionPlacementReady, "NotFound",
+		fmt.Sprintf("The PlacementProfile %q doesn't exist.", name))
+}
+
+// MarkDeletionProtected marks a deleted Space as waiting for its deletion
+// protection to be turned off before anything in it is removed.
+func (status *SpaceStatus) MarkDeletionProtected() {
+	status.manage().MarkFalse(SpaceConditionReady, "DeletionProtected",
+		"The space is protected from deletion, set spec.deletionProtection to false to finish deleting it.")
+}
+
+// MarkServiceInstancesShared marks a deleted Space as waiting for its
+// service instances to be unshared from other spaces.
+func (status *SpaceStatus) MarkServiceInstancesShared(shares []string) {
+	status.manage().MarkFalse(SpaceConditionReady, "ServiceInstancesShared",
+		"Unshare service instances with 'kf unshare-service' to finish deleting the space: %s", strings.Join(shares, ", "))
+}
+
+// MarkDeprovisioning marks a deleted Space as waiting for the service
+// instances in it to be deprovisioned by their brokers.
+func (status *SpaceStatus) MarkDeprovisioning(remaining int) {
+	status.manage().MarkUnknown(SpaceConditionReady, "Deprovisioning",
+		"Waiting for %d service instance(s) to be deprovisioned.", remaining)
+}
+
 // PropagateNamespaceStatus copies fields from the Namespace status to Space
 // and updates the readiness based on the current phase.
 func (status *SpaceStatus) PropagateNamespaceStatus(ns *v1.Namespace) {
@@ -145,6 +246,21 @@ func (status *SpaceStatus) PropagateAuditorRoleStatus(*rv1.Role) {
 	status.manage().MarkTrue(SpaceConditionAuditorRoleReady)
 }
 
+// PropagateManagerRoleStatus copies fields from the Role to Space
+// and updates the readiness based on the current phase.
+func (status *SpaceStatus) PropagateManagerRoleStatus(*rv1.Role) {
+	// Roles don't have a status field so they just need to exist to be ready.
+	status.manage().MarkTrue(SpaceConditionManagerRoleReady)
+}
+
+// PropagateRoleBindingsStatus updates the readiness of the space based on if
+// the RoleBindings for its roles exist.
+func (status *SpaceStatus) PropagateRoleBindingsStatus([]*rv1.RoleBinding) {
+	// RoleBindings don't have a status field so they just need to exist to
+	// be ready.
+	status.manage().MarkTrue(SpaceConditionRoleBindingsReady)
+}
+
 // PropagateResourceQuotaStatus copies the ResourceQuota Used and Hard amounts
 // to the Space and updates the readiness based on if a quota exists.
 func (status *SpaceStatus) PropagateResourceQuotaStatus(quota *v1.ResourceQuota) {
@@ -164,6 +280,30 @@ func (status *SpaceStatus) PropagateBuildServiceAccountStatus(sa *v1.ServiceAcco
 	status.manage().MarkTrue(SpaceConditionBuildServiceAccountReady)
 }
 
+// PropagateBuildpackStacks records the stacks resolved from the space and its
+// BuildpackCatalog and updates the readiness of the catalog.
+func (status *SpaceStatus) PropagateBuildpackStacks(stacks []BuildpackStack) {
+	status.BuildpackStacks = stacks
+	status.manage().MarkTrue(SpaceConditionBuildpackCatalogReady)
+}
+
+// PropagateNetworkPoliciesStatus updates the readiness of the space based on
+// if the NetworkPolicies for its SecurityGroups exist.
+func (status *SpaceStatus) PropagateNetworkPoliciesStatus([]*networkingv1.NetworkPolicy) {
+	// NetworkPolicies don't have a status field so they just need to exist
+	// to be ready.
+	status.manage().MarkTrue(SpaceConditionNetworkPoliciesReady)
+}
+
+// PropagatePlacement records the placement of apps and builds resolved from
+// the space and its PlacementProfile and updates the readiness of the
+// placement.
+func (status *SpaceStatus) PropagatePlacement(execution, build PodPlacement) {
+	status.ExecutionPlacement = execution
+	status.BuildPlacement = build
+	status.manage().MarkTrue(SpaceConditionPlacementReady)
+}
+
 func (status *SpaceStatus) duck() *duckv1beta1.Status {
 	return &status.Status
 }
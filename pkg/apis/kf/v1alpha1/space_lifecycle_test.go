@@ -171,6 +171,9 @@ func initTestStatus(t *testing.T) *SpaceStatus {
 	apitesting.CheckConditionOngoing(status.duck(), SpaceConditionResourceQuotaReady, t)
 	apitesting.CheckConditionOngoing(status.duck(), SpaceConditionLimitRangeReady, t)
 	apitesting.CheckConditionOngoing(status.duck(), SpaceConditionBuildServiceAccountReady, t)
+	apitesting.CheckConditionOngoing(status.duck(), SpaceConditionBuildpackCatalogReady, t)
+	apitesting.CheckConditionOngoing(status.duck(), SpaceConditionNetworkPoliciesReady, t)
+	apitesting.CheckConditionOngoing(status.duck(), SpaceConditionPlacementReady, t)
 
 	return status
 }
@@ -180,20 +183,30 @@ func TestSpaceHappyPath(t *testing.T) {
 	status := initTestStatus(t)
 	status.PropagateDeveloperRoleStatus(nil)
 	status.PropagateAuditorRoleStatus(nil)
+	status.PropagateManagerRoleStatus(nil)
+	status.PropagateRoleBindingsStatus(nil)
 	status.PropagateNamespaceStatus(&corev1.Namespace{Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive}})
 	status.PropagateResourceQuotaStatus(&corev1.ResourceQuota{
 		Status: corev1.ResourceQuotaStatus{},
 	})
 	status.PropagateLimitRangeStatus(nil)
 	status.PropagateBuildServiceAccountStatus(nil)
+	status.PropagateBuildpackStacks(nil)
+	status.PropagateNetworkPoliciesStatus(nil)
+	status.PropagatePlacement(PodPlacement{}, PodPlacement{})
 
 	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionReady, t)
 	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionNamespaceReady, t)
 	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionAuditorRoleReady, t)
 	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionDeveloperRoleReady, t)
+	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionManagerRoleReady, t)
+	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionRoleBindingsReady, t)
 	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionResourceQuotaReady, t)
 	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionLimitRangeReady, t)
 	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionBuildServiceAccountReady, t)
+	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionBuildpackCatalogReady, t)
+	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionNetworkPoliciesReady, t)
+	apitesting.CheckConditionSucceeded(status.duck(), SpaceConditionPlacementReady, t)
 }
 
 func TestPropagateNamespaceStatus_terminating(t *testing.T) {
@@ -246,21 +259,31 @@ func TestSpaceStatus_lifecycle(t *testing.T) {
 			Init: func(status *SpaceStatus) {
 				status.PropagateDeveloperRoleStatus(nil)
 				status.PropagateAuditorRoleStatus(nil)
+				status.PropagateManagerRoleStatus(nil)
+				status.PropagateRoleBindingsStatus(nil)
 				status.PropagateNamespaceStatus(&corev1.Namespace{Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive}})
 				status.PropagateResourceQuotaStatus(&corev1.ResourceQuota{
 					Status: corev1.ResourceQuotaStatus{},
 				})
 				status.PropagateLimitRangeStatus(nil)
 				status.PropagateBuildServiceAccountStatus(nil)
+				status.PropagateBuildpackStacks(nil)
+				status.PropagateNetworkPoliciesStatus(nil)
+				status.PropagatePlacement(PodPlacement{}, PodPlacement{})
 			},
 			ExpectSucceeded: []apis.ConditionType{
 				SpaceConditionReady,
 				SpaceConditionNamespaceReady,
 				SpaceConditionAuditorRoleReady,
 				SpaceConditionDeveloperRoleReady,
+				SpaceConditionManagerRoleReady,
+				SpaceConditionRoleBindingsReady,
 				SpaceConditionResourceQuotaReady,
 				SpaceConditionLimitRangeReady,
 				SpaceConditionBuildServiceAccountReady,
+				SpaceConditionBuildpackCatalogReady,
+				SpaceConditionNetworkPoliciesReady,
+				SpaceConditionPlacementReady,
 			},
 		},
 		"terminating namespace": {
@@ -326,6 +349,32 @@ func TestSpaceStatus_lifecycle(t *testing.T) {
 				SpaceConditionAuditorRoleReady,
 			},
 		},
+		"manager role not owned": {
+			Init: func(status *SpaceStatus) {
+				status.MarkManagerRoleNotOwned("my-managerrole")
+			},
+			ExpectOngoing: []apis.ConditionType{
+				SpaceConditionDeveloperRoleReady,
+				SpaceConditionNamespaceReady,
+			},
+			ExpectFailed: []apis.ConditionType{
+				SpaceConditionReady,
+				SpaceConditionManagerRoleReady,
+			},
+		},
+		"role binding not owned": {
+			Init: func(status *SpaceStatus) {
+				status.MarkRoleBindingNotOwned("space-developer")
+			},
+			ExpectOngoing: []apis.ConditionType{
+				SpaceConditionManagerRoleReady,
+				SpaceConditionNamespaceReady,
+			},
+			ExpectFailed: []apis.ConditionType{
+				SpaceConditionReady,
+				SpaceConditionRoleBindingsReady,
+			},
+		},
 		"resource quota not owned": {
 			Init: func(status *SpaceStatus) {
 				status.MarkResourceQuotaNotOwned("space-quota")
@@ -362,6 +411,85 @@ func TestSpaceStatus_lifecycle(t *testing.T) {
 				SpaceConditionBuildServiceAccountReady,
 			},
 		},
+		"BuildpackCatalog not found": {
+			Init: func(status *SpaceStatus) {
+				status.MarkBuildpackCatalogNotFound("my-catalog")
+			},
+			ExpectOngoing: []apis.ConditionType{
+				SpaceConditionNamespaceReady,
+			},
+			ExpectFailed: []apis.ConditionType{
+				SpaceConditionReady,
+				SpaceConditionBuildpackCatalogReady,
+			},
+		},
+		"NetworkPolicy not owned": {
+			Init: func(status *SpaceStatus) {
+				status.MarkNetworkPolicyNotOwned("running-security-groups")
+			},
+			ExpectOngoing: []apis.ConditionType{
+				SpaceConditionNamespaceReady,
+			},
+			ExpectFailed: []apis.ConditionType{
+				SpaceConditionReady,
+				SpaceConditionNetworkPoliciesReady,
+			},
+		},
+		"SecurityGroup not found": {
+			Init: func(status *SpaceStatus) {
+				status.MarkSecurityGroupNotFound("public-networks")
+			},
+			ExpectOngoing: []apis.ConditionType{
+				SpaceConditionNamespaceReady,
+			},
+			ExpectFailed: []apis.ConditionType{
+				SpaceConditionReady,
+				SpaceConditionNetworkPoliciesReady,
+			},
+		},
+		"PlacementProfile not found": {
+			Init: func(status *SpaceStatus) {
+				status.MarkPlacementProfileNotFound("pci")
+			},
+			ExpectOngoing: []apis.ConditionType{
+				SpaceConditionNamespaceReady,
+			},
+			ExpectFailed: []apis.ConditionType{
+				SpaceConditionReady,
+				SpaceConditionPlacementReady,
+			},
+		},
+		"deletion protected": {
+			Init: func(status *SpaceStatus) {
+				status.MarkDeletionProtected()
+			},
+			ExpectOngoing: []apis.ConditionType{
+				SpaceConditionNamespaceReady,
+			},
+			ExpectFailed: []apis.ConditionType{
+				SpaceConditionReady,
+			},
+		},
+		"service instances shared": {
+			Init: func(status *SpaceStatus) {
+				status.MarkServiceInstancesShared([]string{"my-db (other-space)"})
+			},
+			ExpectOngoing: []apis.ConditionType{
+				SpaceConditionNamespaceReady,
+			},
+			ExpectFailed: []apis.ConditionType{
+				SpaceConditionReady,
+			},
+		},
+		"deprovisioning": {
+			Init: func(status *SpaceStatus) {
+				status.MarkDeprovisioning(2)
+			},
+			ExpectOngoing: []apis.ConditionType{
+				SpaceConditionReady,
+				SpaceConditionNamespaceReady,
+			},
+		},
 	}
 
 	// XXX: if we start copying state from subresources back to the parent,
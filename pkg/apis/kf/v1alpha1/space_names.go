@@ -0,0 +1,48 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// NamespaceName gets the name of a namespace given the space.
+func NamespaceName(space *Space) string {
+	return space.Name
+}
+
+// DeveloperRoleName gets the name of the developer role given the space.
+func DeveloperRoleName(space *Space) string {
+	return "space-developer"
+}
+
+// AuditorRoleName gets the name of the auditor role given the space.
+func AuditorRoleName(space *Space) string {
+	return "space-auditor"
+}
+
+// ManagerRoleName gets the name of the manager role given the space.
+func ManagerRoleName(space *Space) string {
+	return "space-manager"
+}
+
+// RoleNameForSpaceRole gets the name of the Role backing the given
+// SpaceRoleName.
+func RoleNameForSpaceRole(space *Space, role SpaceRoleName) string {
+	switch role {
+	case SpaceManager:
+		return ManagerRoleName(space)
+	case SpaceDeveloper:
+		return DeveloperRoleName(space)
+	default:
+		return AuditorRoleName(space)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+)
+
+func ExampleNamespaceName() {
+	space := &Space{}
+	space.Name = "my-space"
+
+	fmt.Println(NamespaceName(space))
+
+	// Output: my-space
+}
+
+func ExampleAuditorRoleName() {
+	space := &Space{}
+	space.Name = "my-space"
+
+	fmt.Println(AuditorRoleName(space))
+
+	// Output: space-auditor
+}
+
+func ExampleDeveloperRoleName() {
+	space := &Space{}
+	space.Name = "my-space"
+
+	fmt.Println(DeveloperRoleName(space))
+
+	// Output: space-developer
+}
+
+func ExampleManagerRoleName() {
+	space := &Space{}
+	space.Name = "my-space"
+
+	fmt.Println(ManagerRoleName(space))
+
+	// Output: space-manager
+}
+
+func ExampleRoleNameForSpaceRole() {
+	space := &Space{}
+	space.Name = "my-space"
+
+	for _, role := range SpaceRoleNames {
+		fmt.Println(role, "=>", RoleNameForSpaceRole(space, role))
+	}
+
+	// Output: SpaceManager => space-manager
+	// SpaceDeveloper => space-developer
+	// SpaceAuditor => space-auditor
+}
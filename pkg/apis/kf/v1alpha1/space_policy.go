@@ -0,0 +1,230 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"knative.dev/pkg/apis"
+)
+
+// SpaceGetter looks up Spaces so Apps can be checked against the policy of
+// their Space at admission.
+type SpaceGetter interface {
+	// GetSpace gets the Space with the given name or nil if it doesn't
+	// exist.
+	GetSpace(name string) (*Space, error)
+}
+
+type spaceGetterKey struct{}
+
+// WithSpaceGetter adds a SpaceGetter to the context.
+func WithSpaceGetter(ctx context.Context, getter SpaceGetter) context.Context {
+	return context.WithValue(ctx, spaceGetterKey{}, getter)
+}
+
+// SpaceGetterFromContext gets the SpaceGetter from the context or nil if none
+// was set.
+func SpaceGetterFromContext(ctx context.Context) SpaceGetter {
+	getter, _ := ctx.Value(spaceGetterKey{}).(SpaceGetter)
+	return getter
+}
+
+// Validate makes sure that SpacePolicy is properly configured.
+func (p *SpacePolicy) Validate(ctx context.Context) (errs *apis.FieldError) {
+	for i, stack := range p.AllowedStacks {
+		if stack == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(stack, "allowedStacks", i))
+		}
+	}
+
+	for i, registry := range p.AllowedRegistries {
+		if registry == "" || strings.Contains(registry, "://") {
+			errs = errs.Also(apis.ErrInvalidArrayValue(registry, "allowedRegistries", i))
+		}
+	}
+
+	if p.MaxAppInstances != nil && *p.MaxAppInstances < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprint(*p.MaxAppInstances), "maxAppInstances"))
+	}
+
+	switch p.RequiredHealthCheckType {
+	case "", HealthCheckTypeHTTP, HealthCheckTypePort:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(p.RequiredHealthCheckType, "requiredHealthCheckType"))
+	}
+
+	return errs
+}
+
+// AllowsStack returns true if apps can be built on the stack.
+func (p *SpacePolicy) AllowsStack(stack string) bool {
+	if len(p.AllowedStacks) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedStacks {
+		if allowed == stack {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsImage returns true if the container image can be pulled from one of
+// the allowed registries.
+func (p *SpacePolicy) AllowsImage(image string) bool {
+	if len(p.AllowedRegistries) == 0 {
+		return true
+	}
+
+	image = qualifiedImageName(image)
+	for _, registry := range p.AllowedRegistries {
+		registry = strings.TrimSuffix(registry, "/")
+		if strings.HasPrefix(image, registry+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// qualifiedImageName adds the docker.io registry to images that don't name
+// a registry the same way the container runtime does.
+func qualifiedImageName(image string) string {
+	parts := strings.SplitN(image, "/", 2)
+	switch {
+	case len(parts) == 1:
+		return "docker.io/library/" + image
+	case !strings.ContainsAny(parts[0], ".:") && parts[0] != "localhost":
+		return "docker.io/" + image
+	default:
+		return image
+	}
+}
+
+// HealthCheckType returns the type of health check the App's readiness probe
+// runs or an empty string if it doesn't run one Kf supports.
+func (spec *AppSpec) HealthCheckType() HealthCheckType {
+	if len(spec.Template.Spec.Containers) == 0 {
+		return ""
+	}
+
+	probe := spec.Template.Spec.Containers[0].ReadinessProbe
+	switch {
+	case probe == nil:
+		return ""
+	case probe.HTTPGet != nil:
+		return HealthCheckTypeHTTP
+	case probe.TCPSocket != nil:
+		return HealthCheckTypePort
+	default:
+		return ""
+	}
+}
+
+// validateSpacePolicy makes sure the App follows the policy of its Space.
+// Stacks, images and health checks are checked when the App is created or
+// its source or health check changes, and instances when they increase, so
+// existing Apps can still be changed after a policy is tightened.
+func (app *App) validateSpacePolicy(ctx context.Context) (errs *apis.FieldError) {
+	getter := SpaceGetterFromContext(ctx)
+	if getter == nil {
+		return nil
+	}
+
+	space, err := getter.GetSpace(app.Namespace)
+	if err != nil {
+		return &apis.FieldError{
+			Message: fmt.Sprintf("couldn't get the policy of space %q: %v", app.Namespace, err),
+			Paths:   []string{apis.CurrentField},
+		}
+	}
+
+	if space == nil {
+		return nil
+	}
+
+	policy := space.Spec.Policy
+	base, _ := apis.GetBaseline(ctx).(*App)
+	if base == nil {
+		base = &App{}
+	}
+
+	source := app.Spec.Source
+	sourceChanged := !equality.Semantic.DeepEqual(source, base.Spec.Source)
+
+	if sourceChanged {
+		switch {
+		case source.IsContainerBuild() && policy.DisableContainerImages:
+			errs = errs.Also(&apis.FieldError{
+				Message: fmt.Sprintf("space %q doesn't allow pushing container images, push the app from source instead", space.Name),
+				Paths:   []string{"spec.source.containerImage.image"},
+			})
+
+		case source.IsContainerBuild() && !policy.AllowsImage(source.ContainerImage.Image):
+			errs = errs.Also(&apis.FieldError{
+				Message: fmt.Sprintf("space %q doesn't allow container images from %q", space.Name, source.ContainerImage.Image),
+				Paths:   []string{"spec.source.containerImage.image"},
+				Details: fmt.Sprintf("allowed registries are: %s", strings.Join(policy.AllowedRegistries, ", ")),
+			})
+
+		case source.IsBuildpackBuild():
+			stack := source.BuildpackBuild.Stack
+			if stack == "" {
+				if defaultStack, err := BuildpackStacks(space.Status.BuildpackStacks).Find(""); err == nil {
+					stack = defaultStack.Name
+				}
+			}
+
+			if stack != "" && !policy.AllowsStack(stack) {
+				errs = errs.Also(&apis.FieldError{
+					Message: fmt.Sprintf("space %q doesn't allow the stack %q", space.Name, stack),
+					Paths:   []string{"spec.source.buildpackBuild.stack"},
+					Details: fmt.Sprintf("allowed stacks are: %s", strings.Join(policy.AllowedStacks, ", ")),
+				})
+			}
+		}
+	}
+
+	if required := policy.RequiredHealthCheckType; required != "" {
+		healthCheck := app.Spec.HealthCheckType()
+		changed := sourceChanged || healthCheck != base.Spec.HealthCheckType()
+
+		if changed && healthCheck != required {
+			errs = errs.Also(&apis.FieldError{
+				Message: fmt.Sprintf("space %q requires apps to use the %s health check", space.Name, required),
+				Paths:   []string{"spec.template.spec.containers[0].readinessProbe"},
+				Details: fmt.Sprintf("push the app with --health-check-type %s", required),
+			})
+		}
+	}
+
+	if limit := policy.MaxAppInstances; limit != nil {
+		instances := app.Spec.Instances.QuotaCount()
+		if instances > *limit && instances > base.Spec.Instances.QuotaCount() {
+			errs = errs.Also(&apis.FieldError{
+				Message: fmt.Sprintf("space %q allows at most %d instances per app, this app would have %d", space.Name, *limit, instances),
+				Paths:   []string{"spec.instances"},
+			})
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,230 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+type fakeSpaceGetter struct {
+	space *Space
+	err   error
+}
+
+func (f *fakeSpaceGetter) GetSpace(name string) (*Space, error) {
+	return f.space, f.err
+}
+
+func TestSpacePolicy_Validate(t *testing.T) {
+	cases := map[string]struct {
+		policy SpacePolicy
+		want   *apis.FieldError
+	}{
+		"empty": {},
+		"valid": {
+			policy: SpacePolicy{
+				AllowedStacks:           []string{"cflinuxfs3"},
+				AllowedRegistries:       []string{"gcr.io/my-project"},
+				MaxAppInstances:         int64Ptr(10),
+				RequiredHealthCheckType: HealthCheckTypeHTTP,
+			},
+		},
+		"blank stack": {
+			policy: SpacePolicy{AllowedStacks: []string{"cflinuxfs3", ""}},
+			want:   apis.ErrInvalidArrayValue("", "allowedStacks", 1),
+		},
+		"registry with scheme": {
+			policy: SpacePolicy{AllowedRegistries: []string{"https://gcr.io"}},
+			want:   apis.ErrInvalidArrayValue("https://gcr.io", "allowedRegistries", 0),
+		},
+		"negative instances": {
+			policy: SpacePolicy{MaxAppInstances: int64Ptr(-1)},
+			want:   apis.ErrInvalidValue("-1", "maxAppInstances"),
+		},
+		"unknown health check": {
+			policy: SpacePolicy{RequiredHealthCheckType: "process"},
+			want:   apis.ErrInvalidValue("process", "requiredHealthCheckType"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := tc.policy.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
+
+func ExampleSpacePolicy_AllowsImage() {
+	policy := SpacePolicy{AllowedRegistries: []string{"gcr.io/my-project", "docker.io/library"}}
+
+	fmt.Println("gcr.io/my-project/app:v1", policy.AllowsImage("gcr.io/my-project/app:v1"))
+	fmt.Println("gcr.io/my-project-2/app", policy.AllowsImage("gcr.io/my-project-2/app"))
+	fmt.Println("nginx", policy.AllowsImage("nginx"))
+	fmt.Println("bitnami/nginx", policy.AllowsImage("bitnami/nginx"))
+
+	// Output: gcr.io/my-project/app:v1 true
+	// gcr.io/my-project-2/app false
+	// nginx true
+	// bitnami/nginx false
+}
+
+func TestApp_validateSpacePolicy(t *testing.T) {
+	two, five, ten := 2, 5, 10
+	newApp := func(modify func(app *App)) *App {
+		app := &App{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-space"}}
+		app.Spec.Source.BuildpackBuild.Source = "gcr.io/my-project/src"
+		app.Spec.Template.Spec.Containers = []corev1.Container{{
+			ReadinessProbe: &corev1.Probe{Handler: corev1.Handler{TCPSocket: &corev1.TCPSocketAction{}}},
+		}}
+		if modify != nil {
+			modify(app)
+		}
+		return app
+	}
+	withImage := func(image string) func(app *App) {
+		return func(app *App) {
+			app.Spec.Source.BuildpackBuild.Source = ""
+			app.Spec.Source.ContainerImage.Image = image
+		}
+	}
+	newSpace := func(policy SpacePolicy) *Space {
+		space := &Space{ObjectMeta: metav1.ObjectMeta{Name: "my-space"}}
+		space.Spec.Policy = policy
+		space.Status.BuildpackStacks = []BuildpackStack{{Name: "cflinuxfs2"}, {Name: "cflinuxfs3", Default: true}}
+		return space
+	}
+
+	cases := map[string]struct {
+		app    *App
+		base   *App
+		getter *fakeSpaceGetter
+		want   *apis.FieldError
+	}{
+		"no space": {
+			app:    newApp(nil),
+			getter: &fakeSpaceGetter{},
+		},
+		"space error": {
+			app:    newApp(nil),
+			getter: &fakeSpaceGetter{err: errors.New("some-error")},
+			want: &apis.FieldError{
+				Message: `couldn't get the policy of space "my-space": some-error`,
+				Paths:   []string{apis.CurrentField},
+			},
+		},
+		"no policy": {
+			app:    newApp(withImage("nginx")),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{})},
+		},
+		"container images disabled": {
+			app:    newApp(withImage("nginx")),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{DisableContainerImages: true})},
+			want: &apis.FieldError{
+				Message: `space "my-space" doesn't allow pushing container images, push the app from source instead`,
+				Paths:   []string{"spec.source.containerImage.image"},
+			},
+		},
+		"registry not allowed": {
+			app:    newApp(withImage("nginx")),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{AllowedRegistries: []string{"gcr.io/my-project"}})},
+			want: &apis.FieldError{
+				Message: `space "my-space" doesn't allow container images from "nginx"`,
+				Paths:   []string{"spec.source.containerImage.image"},
+				Details: "allowed registries are: gcr.io/my-project",
+			},
+		},
+		"registry allowed": {
+			app:    newApp(withImage("gcr.io/my-project/app")),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{AllowedRegistries: []string{"gcr.io/my-project"}})},
+		},
+		"unchanged image": {
+			app:    newApp(withImage("nginx")),
+			base:   newApp(withImage("nginx")),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{DisableContainerImages: true})},
+		},
+		"stack not allowed": {
+			app:    newApp(func(app *App) { app.Spec.Source.BuildpackBuild.Stack = "cflinuxfs2" }),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{AllowedStacks: []string{"cflinuxfs3"}})},
+			want: &apis.FieldError{
+				Message: `space "my-space" doesn't allow the stack "cflinuxfs2"`,
+				Paths:   []string{"spec.source.buildpackBuild.stack"},
+				Details: "allowed stacks are: cflinuxfs3",
+			},
+		},
+		"default stack not allowed": {
+			app:    newApp(nil),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{AllowedStacks: []string{"cflinuxfs2"}})},
+			want: &apis.FieldError{
+				Message: `space "my-space" doesn't allow the stack "cflinuxfs3"`,
+				Paths:   []string{"spec.source.buildpackBuild.stack"},
+				Details: "allowed stacks are: cflinuxfs2",
+			},
+		},
+		"default stack allowed": {
+			app:    newApp(nil),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{AllowedStacks: []string{"cflinuxfs3"}})},
+		},
+		"wrong health check": {
+			app:    newApp(nil),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{RequiredHealthCheckType: HealthCheckTypeHTTP})},
+			want: &apis.FieldError{
+				Message: `space "my-space" requires apps to use the http health check`,
+				Paths:   []string{"spec.template.spec.containers[0].readinessProbe"},
+				Details: "push the app with --health-check-type http",
+			},
+		},
+		"unchanged health check": {
+			app:    newApp(func(app *App) { app.Spec.Instances.Exactly = &two }),
+			base:   newApp(nil),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{RequiredHealthCheckType: HealthCheckTypeHTTP})},
+		},
+		"too many instances": {
+			app:    newApp(func(app *App) { app.Spec.Instances.Exactly = &five }),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{MaxAppInstances: int64Ptr(4)})},
+			want: &apis.FieldError{
+				Message: `space "my-space" allows at most 4 instances per app, this app would have 5`,
+				Paths:   []string{"spec.instances"},
+			},
+		},
+		"scaling down over the limit": {
+			app:    newApp(func(app *App) { app.Spec.Instances.Exactly = &five }),
+			base:   newApp(func(app *App) { app.Spec.Instances.Max = &ten }),
+			getter: &fakeSpaceGetter{space: newSpace(SpacePolicy{MaxAppInstances: int64Ptr(4)})},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx := WithSpaceGetter(context.Background(), tc.getter)
+			if tc.base != nil {
+				ctx = apis.WithinUpdate(ctx, tc.base)
+			}
+
+			got := tc.app.validateSpacePolicy(ctx)
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+)
+
+// SpaceQuotaUsage holds the kf resources in a space that count against its
+// SpaceKfQuota.
+type SpaceQuotaUsage struct {
+	// Routes holds the distinct routes claimed in the space or used by its
+	// apps.
+	Routes []RouteSpecFields
+
+	// ServiceInstances is the number of service instances in the space.
+	ServiceInstances int64
+
+	// ServiceBindings holds the number of service bindings of each app.
+	ServiceBindings map[string]int64
+
+	// AppInstances holds the number of instances of each app.
+	AppInstances map[string]int64
+}
+
+// SpaceQuotaChecker looks up the kf quota of spaces and the resources they
+// use so quotas Kubernetes can't count are enforced at admission.
+type SpaceQuotaChecker interface {
+	// GetKfQuota returns the kf quota of the space with the limits of its
+	// QuotaPlan filled in.
+	GetKfQuota(space string) (*SpaceKfQuota, error)
+
+	// GetUsage counts the kf resources in the space.
+	GetUsage(space string) (*SpaceQuotaUsage, error)
+}
+
+type spaceQuotaCheckerKey struct{}
+
+// WithSpaceQuotaChecker adds a SpaceQuotaChecker to the context.
+func WithSpaceQuotaChecker(ctx context.Context, checker SpaceQuotaChecker) context.Context {
+	return context.WithValue(ctx, spaceQuotaCheckerKey{}, checker)
+}
+
+// SpaceQuotaCheckerFromContext gets the SpaceQuotaChecker from the context or
+// nil if none was set.
+func SpaceQuotaCheckerFromContext(ctx context.Context) SpaceQuotaChecker {
+	checker, _ := ctx.Value(spaceQuotaCheckerKey{}).(SpaceQuotaChecker)
+	return checker
+}
+
+// Validate makes sure that SpaceKfQuota is properly configured.
+func (q *SpaceKfQuota) Validate(ctx context.Context) (errs *apis.FieldError) {
+	counts := []struct {
+		field string
+		value *int64
+	}{
+		{"routes", q.Routes},
+		{"serviceInstances", q.ServiceInstances},
+		{"serviceBindings", q.ServiceBindings},
+		{"appInstances", q.AppInstances},
+	}
+	for _, c := range counts {
+		if c.value != nil && *c.value < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprint(*c.value), c.field))
+		}
+	}
+
+	return errs
+}
+
+// IsUnlimited returns true if none of the limits are set.
+func (q *SpaceKfQuota) IsUnlimited() bool {
+	return q.Routes == nil &&
+		q.ServiceInstances == nil &&
+		q.ServiceBindings == nil &&
+		q.AppInstances == nil
+}
+
+// WithDefaults returns a copy of the quota with the limits it doesn't set
+// taken from defaults.
+func (q *SpaceKfQuota) WithDefaults(defaults SpaceKfQuota) SpaceKfQuota {
+	out := *q.DeepCopy()
+
+	if out.Routes == nil {
+		out.Routes = defaults.Routes
+	}
+
+	if out.ServiceInstances == nil {
+		out.ServiceInstances = defaults.ServiceInstances
+	}
+
+	if out.ServiceBindings == nil {
+		out.ServiceBindings = defaults.ServiceBindings
+	}
+
+	if out.AppInstances == nil {
+		out.AppInstances = defaults.AppInstances
+	}
+
+	return out
+}
+
+// spaceQuotaUsage gets the kf quota of a space and its usage. The quota is
+// nil if there's no SpaceQuotaChecker or the space is unlimited.
+func spaceQuotaUsage(ctx context.Context, space string) (*SpaceKfQuota, *SpaceQuotaUsage, *apis.FieldError) {
+	checker := SpaceQuotaCheckerFromContext(ctx)
+	if checker == nil {
+		return nil, nil, nil
+	}
+
+	quota, err := checker.GetKfQuota(space)
+	if err != nil {
+		return nil, nil, &apis.FieldError{
+			Message: fmt.Sprintf("couldn't get the quota of space %q: %v", space, err),
+			Paths:   []string{apis.CurrentField},
+		}
+	}
+
+	if quota == nil || quota.IsUnlimited() {
+		return nil, nil, nil
+	}
+
+	usage, err := checker.GetUsage(space)
+	if err != nil {
+		return nil, nil, &apis.FieldError{
+			Message: fmt.Sprintf("couldn't get the quota usage of space %q: %v", space, err),
+			Paths:   []string{apis.CurrentField},
+		}
+	}
+
+	return quota, usage, nil
+}
+
+// quotaExceeded creates an error in the style of Cloud Foundry's quota
+// errors.
+func quotaExceeded(message, field, space, resource string, limit, total int64) *apis.FieldError {
+	return &apis.FieldError{
+		Message: message,
+		Paths:   []string{field},
+		Details: fmt.Sprintf("space %q allows %d %s, this change would use %d", space, limit, resource, total),
+	}
+}
+
+// validateKfQuota makes sure that increasing the instances, service bindings
+// or routes of the App doesn't exceed its space's quota. Changes that don't
+// use more of the quota are always allowed so apps in a space over its quota
+// can still be updated.
+func (app *App) validateKfQuota(ctx context.Context) (errs *apis.FieldError) {
+	quota, usage, ferr := spaceQuotaUsage(ctx, app.Namespace)
+	if ferr != nil || quota == nil {
+		return ferr
+	}
+
+	base, _ := apis.GetBaseline(ctx).(*App)
+	if base == nil {
+		base = &App{}
+	}
+
+	if quota.AppInstances != nil {
+		instances := app.Spec.Instances.QuotaCount()
+		if instances > base.Spec.Instances.QuotaCount() {
+			total := sumExcept(usage.AppInstances, app.Name) + instances
+			if total > *quota.AppInstances {
+				errs = errs.Also(quotaExceeded(
+					"You have exceeded the instance limit for your space's quota.",
+					"spec.instances", app.Namespace, "app instances", *quota.AppInstances, total))
+			}
+		}
+	}
+
+	if quota.ServiceBindings != nil {
+		bindings := int64(len(app.Spec.ServiceBindings))
+		if bindings > int64(len(base.Spec.ServiceBindings)) {
+			total := sumExcept(usage.ServiceBindings, app.Name) + bindings
+			if total > *quota.ServiceBindings {
+				errs = errs.Also(quotaExceeded(
+					"You have exceeded the total service bindings for your space's quota.",
+					"spec.serviceBindings", app.Namespace, "service bindings", *quota.ServiceBindings, total))
+			}
+		}
+	}
+
+	if quota.Routes != nil {
+		existing := make(map[string]bool)
+		for _, route := range usage.Routes {
+			existing[route.String()] = true
+		}
+		for _, route := range base.Spec.Routes {
+			existing[route.String()] = true
+		}
+
+		total := int64(len(usage.Routes))
+		added := false
+		for _, route := range app.Spec.Routes {
+			if !existing[route.String()] {
+				existing[route.String()] = true
+				total++
+				added = true
+			}
+		}
+
+		if added && total > *quota.Routes {
+			errs = errs.Also(quotaExceeded(
+				"You have exceeded the total routes for your space's quota.",
+				"spec.routes", app.Namespace, "routes", *quota.Routes, total))
+		}
+	}
+
+	return errs
+}
+
+// validateKfQuota makes sure that claiming a new route doesn't exceed the
+// space's quota.
+func (r *RouteClaim) validateKfQuota(ctx context.Context) *apis.FieldError {
+	if base, _ := apis.GetBaseline(ctx).(*RouteClaim); base != nil {
+		return nil
+	}
+
+	quota, usage, ferr := spaceQuotaUsage(ctx, r.Namespace)
+	if ferr != nil || quota == nil || quota.Routes == nil {
+		return ferr
+	}
+
+	for _, route := range usage.Routes {
+		if route.String() == r.Spec.RouteSpecFields.String() {
+			return nil
+		}
+	}
+
+	if total := int64(len(usage.Routes)) + 1; total > *quota.Routes {
+		return quotaExceeded(
+			"You have exceeded the total routes for your space's quota.",
+			"spec", r.Namespace, "routes", *quota.Routes, total)
+	}
+
+	return nil
+}
+
+// validateServiceInstanceQuota makes sure that creating a service instance
+// doesn't exceed the space's quota.
+func validateServiceInstanceQuota(ctx context.Context, space string) *apis.FieldError {
+	quota, usage, ferr := spaceQuotaUsage(ctx, space)
+	if ferr != nil || quota == nil || quota.ServiceInstances == nil {
+		return ferr
+	}
+
+	if total := usage.ServiceInstances + 1; total > *quota.ServiceInstances {
+		return quotaExceeded(
+			"You have exceeded your space's services limit.",
+			"spec", space, "service instances", *quota.ServiceInstances, total)
+	}
+
+	return nil
+}
+
+func sumExcept(counts map[string]int64, skip string) (total int64) {
+	for name, count := range counts {
+		if name != skip {
+			total += count
+		}
+	}
+
+	return total
+}
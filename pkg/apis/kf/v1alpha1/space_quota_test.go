@@ -0,0 +1,284 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+type fakeQuotaChecker struct {
+	quota    *SpaceKfQuota
+	usage    *SpaceQuotaUsage
+	quotaErr error
+	usageErr error
+}
+
+func (f *fakeQuotaChecker) GetKfQuota(space string) (*SpaceKfQuota, error) {
+	return f.quota, f.quotaErr
+}
+
+func (f *fakeQuotaChecker) GetUsage(space string) (*SpaceQuotaUsage, error) {
+	return f.usage, f.usageErr
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestSpaceKfQuota_Validate(t *testing.T) {
+	cases := map[string]struct {
+		quota SpaceKfQuota
+		want  *apis.FieldError
+	}{
+		"unlimited": {},
+		"valid": {
+			quota: SpaceKfQuota{Routes: int64Ptr(10), AppInstances: int64Ptr(0)},
+		},
+		"negative": {
+			quota: SpaceKfQuota{ServiceBindings: int64Ptr(-1)},
+			want:  apis.ErrInvalidValue("-1", "serviceBindings"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := tc.quota.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
+
+func ExampleSpaceKfQuota_WithDefaults() {
+	quota := SpaceKfQuota{Routes: int64Ptr(5)}
+	plan := SpaceKfQuota{Routes: int64Ptr(20), AppInstances: int64Ptr(10)}
+
+	merged := quota.WithDefaults(plan)
+	fmt.Println("Routes:", *merged.Routes)
+	fmt.Println("App instances:", *merged.AppInstances)
+	fmt.Println("Service instances set:", merged.ServiceInstances != nil)
+
+	// Output: Routes: 5
+	// App instances: 10
+	// Service instances set: false
+}
+
+func ExampleAppSpecInstances_QuotaCount() {
+	one, three, five := 1, 3, 5
+
+	fmt.Println("Default:", (&AppSpecInstances{}).QuotaCount())
+	fmt.Println("Stopped:", (&AppSpecInstances{Stopped: true, Exactly: &three}).QuotaCount())
+	fmt.Println("Exactly:", (&AppSpecInstances{Exactly: &three}).QuotaCount())
+	fmt.Println("Autoscaled:", (&AppSpecInstances{Min: &one, Max: &five}).QuotaCount())
+
+	// Output: Default: 1
+	// Stopped: 0
+	// Exactly: 3
+	// Autoscaled: 5
+}
+
+func TestApp_validateKfQuota(t *testing.T) {
+	two, four := 2, 4
+	route := func(hostname string) RouteSpecFields {
+		return RouteSpecFields{Hostname: hostname, Domain: "example.com"}
+	}
+	newApp := func(instances *int, routes []RouteSpecFields, bindings ...string) *App {
+		app := &App{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-space"}}
+		app.Spec.Instances.Exactly = instances
+		app.Spec.Routes = routes
+		for _, b := range bindings {
+			app.Spec.ServiceBindings = append(app.Spec.ServiceBindings, AppSpecServiceBinding{Instance: b})
+		}
+		return app
+	}
+	usage := &SpaceQuotaUsage{
+		Routes:           []RouteSpecFields{route("a"), route("b")},
+		ServiceBindings:  map[string]int64{"my-app": 1, "other-app": 2},
+		AppInstances:     map[string]int64{"my-app": 2, "other-app": 3},
+		ServiceInstances: 3,
+	}
+
+	cases := map[string]struct {
+		app     *App
+		base    *App
+		checker *fakeQuotaChecker
+		want    *apis.FieldError
+	}{
+		"no quota": {
+			app:     newApp(&four, nil),
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{}},
+		},
+		"quota error": {
+			app:     newApp(&four, nil),
+			checker: &fakeQuotaChecker{quotaErr: errors.New("some-error")},
+			want: &apis.FieldError{
+				Message: `couldn't get the quota of space "my-space": some-error`,
+				Paths:   []string{apis.CurrentField},
+			},
+		},
+		"usage error": {
+			app:     newApp(&four, nil),
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{AppInstances: int64Ptr(5)}, usageErr: errors.New("some-error")},
+			want: &apis.FieldError{
+				Message: `couldn't get the quota usage of space "my-space": some-error`,
+				Paths:   []string{apis.CurrentField},
+			},
+		},
+		"instances within quota": {
+			app:     newApp(&two, nil),
+			base:    newApp(&two, nil),
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{AppInstances: int64Ptr(5)}, usage: usage},
+		},
+		"instances exceed quota": {
+			app:     newApp(&four, nil),
+			base:    newApp(&two, nil),
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{AppInstances: int64Ptr(5)}, usage: usage},
+			want: &apis.FieldError{
+				Message: "You have exceeded the instance limit for your space's quota.",
+				Paths:   []string{"spec.instances"},
+				Details: `space "my-space" allows 5 app instances, this change would use 7`,
+			},
+		},
+		"scaling down over quota": {
+			app:     newApp(&two, nil),
+			base:    newApp(&four, nil),
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{AppInstances: int64Ptr(1)}, usage: usage},
+		},
+		"bindings exceed quota": {
+			app:     newApp(&two, nil, "db", "cache"),
+			base:    newApp(&two, nil, "db"),
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{ServiceBindings: int64Ptr(3)}, usage: usage},
+			want: &apis.FieldError{
+				Message: "You have exceeded the total service bindings for your space's quota.",
+				Paths:   []string{"spec.serviceBindings"},
+				Details: `space "my-space" allows 3 service bindings, this change would use 4`,
+			},
+		},
+		"existing routes": {
+			app:     newApp(&two, []RouteSpecFields{route("a"), route("b")}),
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{Routes: int64Ptr(2)}, usage: usage},
+		},
+		"routes exceed quota": {
+			app:     newApp(&two, []RouteSpecFields{route("a"), route("c")}),
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{Routes: int64Ptr(2)}, usage: usage},
+			want: &apis.FieldError{
+				Message: "You have exceeded the total routes for your space's quota.",
+				Paths:   []string{"spec.routes"},
+				Details: `space "my-space" allows 2 routes, this change would use 3`,
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx := WithSpaceQuotaChecker(context.Background(), tc.checker)
+			if tc.base != nil {
+				ctx = apis.WithinUpdate(ctx, tc.base)
+			}
+
+			got := tc.app.validateKfQuota(ctx)
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
+
+func TestRouteClaim_validateKfQuota(t *testing.T) {
+	usage := &SpaceQuotaUsage{
+		Routes: []RouteSpecFields{{Hostname: "a", Domain: "example.com"}},
+	}
+	newClaim := func(hostname string) *RouteClaim {
+		claim := &RouteClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "my-space"}}
+		claim.Spec.Hostname = hostname
+		claim.Spec.Domain = "example.com"
+		return claim
+	}
+
+	cases := map[string]struct {
+		claim *RouteClaim
+		quota *SpaceKfQuota
+		want  *apis.FieldError
+	}{
+		"unlimited": {
+			claim: newClaim("b"),
+			quota: &SpaceKfQuota{AppInstances: int64Ptr(1)},
+		},
+		"existing route": {
+			claim: newClaim("a"),
+			quota: &SpaceKfQuota{Routes: int64Ptr(1)},
+		},
+		"new route exceeds quota": {
+			claim: newClaim("b"),
+			quota: &SpaceKfQuota{Routes: int64Ptr(1)},
+			want: &apis.FieldError{
+				Message: "You have exceeded the total routes for your space's quota.",
+				Paths:   []string{"spec"},
+				Details: `space "my-space" allows 1 routes, this change would use 2`,
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx := WithSpaceQuotaChecker(context.Background(), &fakeQuotaChecker{quota: tc.quota, usage: usage})
+
+			got := tc.claim.validateKfQuota(ctx)
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
+
+func TestValidateServiceInstanceQuota(t *testing.T) {
+	usage := &SpaceQuotaUsage{ServiceInstances: 2}
+
+	cases := map[string]struct {
+		checker SpaceQuotaChecker
+		want    *apis.FieldError
+	}{
+		"no checker": {},
+		"within quota": {
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{ServiceInstances: int64Ptr(3)}, usage: usage},
+		},
+		"exceeds quota": {
+			checker: &fakeQuotaChecker{quota: &SpaceKfQuota{ServiceInstances: int64Ptr(2)}, usage: usage},
+			want: &apis.FieldError{
+				Message: "You have exceeded your space's services limit.",
+				Paths:   []string{"spec"},
+				Details: `space "my-space" allows 2 service instances, this change would use 3`,
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.checker != nil {
+				ctx = WithSpaceQuotaChecker(ctx, tc.checker)
+			}
+
+			got := validateServiceInstanceQuota(ctx, "my-space")
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
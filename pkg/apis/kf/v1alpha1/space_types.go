@@ -36,8 +36,18 @@ type Space struct {
 	Status SpaceStatus `json:"status,omitempty"`
 }
 
+// SpaceFinalizer is set on Spaces so service instances in them are
+// deprovisioned by their brokers before the backing namespace is removed.
+const SpaceFinalizer = "spaces.kf.dev"
+
 // SpaceSpec contains the specification for a space.
 type SpaceSpec struct {
+	// Organization is the name of the Organization the space belongs to.
+	// Spaces inherit the Organization's defaults for fields they leave
+	// empty.
+	// +optional
+	Organization string `json:"organization,omitempty"`
+
 	// Security contains config for RBAC roles that will be created for the
 	// space.
 	// +optional
@@ -57,6 +67,64 @@ type SpaceSpec struct {
 	// SpaceSpecResourceLimits contains definitions for resource usage limits.
 	// +optional
 	ResourceLimits SpaceSpecResourceLimits `json:"resourceLimits,omitempty"`
+
+	// PlacementProfile is the name of the cluster PlacementProfile that
+	// controls which nodes apps and builds in the space run on. Placement
+	// set in Execution and BuildpackBuild is merged on top of it.
+	// +optional
+	PlacementProfile string `json:"placementProfile,omitempty"`
+
+	// DeletionProtection keeps the space and everything in it from being
+	// deleted. A protected space that gets deleted stays terminating without
+	// removing anything until protection is turned off.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+
+	// Policy restricts the apps that can be pushed to the space. It's
+	// enforced by the webhook when apps are created or their source changes.
+	// +optional
+	Policy SpacePolicy `json:"policy,omitempty"`
+}
+
+// HealthCheckType is the type of health check apps run.
+type HealthCheckType string
+
+const (
+	// HealthCheckTypeHTTP checks apps with HTTP GET requests.
+	HealthCheckTypeHTTP HealthCheckType = "http"
+
+	// HealthCheckTypePort checks apps by opening a TCP connection.
+	HealthCheckTypePort HealthCheckType = "port"
+)
+
+// SpacePolicy holds the feature flags and limits platform teams set on the
+// apps in a space. The zero value doesn't restrict anything.
+type SpacePolicy struct {
+	// AllowedStacks lists the stacks buildpack apps can be built on. Any
+	// stack is allowed if empty.
+	// +optional
+	AllowedStacks []string `json:"allowedStacks,omitempty"`
+
+	// DisableContainerImages rejects apps that run prebuilt container images
+	// rather than building from source.
+	// +optional
+	DisableContainerImages bool `json:"disableContainerImages,omitempty"`
+
+	// AllowedRegistries lists the registries, optionally followed by a path,
+	// that prebuilt container images can be pulled from. Any registry is
+	// allowed if empty. Images without a registry are on docker.io.
+	// +optional
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+
+	// MaxAppInstances is the most instances a single app can have.
+	// Autoscaled apps are counted as their maximum.
+	// +optional
+	MaxAppInstances *int64 `json:"maxAppInstances,omitempty"`
+
+	// RequiredHealthCheckType is the type of health check every app must
+	// use, either http or port.
+	// +optional
+	RequiredHealthCheckType HealthCheckType `json:"requiredHealthCheckType,omitempty"`
 }
 
 // SpaceSpecSecurity holds fields for creating RBAC in the space.
@@ -71,6 +139,62 @@ type SpaceSpecSecurity struct {
 	// all builds.
 	// +optional
 	BuildServiceAccount string `json:"buildServiceAccount,omitempty"`
+
+	// Roles assigns users and groups to the space's roles. Each role is
+	// reconciled into a RoleBinding in the space's namespace.
+	// +optional
+	// +patchStrategy=merge
+	Roles []SpaceRole `json:"roles,omitempty"`
+
+	// SecurityGroups binds SecurityGroups to the space. Each lifecycle's
+	// groups are reconciled into an egress NetworkPolicy in the space's
+	// namespace. Lifecycles with no groups aren't restricted.
+	// +optional
+	SecurityGroups []SpaceSecurityGroup `json:"securityGroups,omitempty"`
+}
+
+// SpaceSecurityGroup binds a SecurityGroup to a space.
+type SpaceSecurityGroup struct {
+	// Name is the name of the SecurityGroup.
+	Name string `json:"name"`
+
+	// Lifecycle is the phase the group applies to, either staging or
+	// running.
+	Lifecycle SecurityGroupLifecycle `json:"lifecycle"`
+}
+
+// SpaceRoleName is the name of a role users can be assigned in a space.
+type SpaceRoleName string
+
+const (
+	// SpaceManager can assign the developer and auditor roles in the space.
+	SpaceManager SpaceRoleName = "SpaceManager"
+	// SpaceDeveloper can push and manage apps and services in the space.
+	SpaceDeveloper SpaceRoleName = "SpaceDeveloper"
+	// SpaceAuditor has read-only access to the space.
+	SpaceAuditor SpaceRoleName = "SpaceAuditor"
+)
+
+// SpaceRoleNames contains every SpaceRoleName in order of decreasing
+// privilege.
+var SpaceRoleNames = []SpaceRoleName{
+	SpaceManager,
+	SpaceDeveloper,
+	SpaceAuditor,
+}
+
+// SpaceRole assigns a role in the space to a user or group.
+type SpaceRole struct {
+	// Role is the role being assigned.
+	Role SpaceRoleName `json:"role"`
+
+	// Kind is the kind of subject the role is assigned to, either User or
+	// Group. Service accounts are users with names in the form
+	// system:serviceaccount:<namespace>:<name>.
+	Kind string `json:"kind"`
+
+	// Name is the name of the user or group.
+	Name string `json:"name"`
 }
 
 // SpaceSpecBuildpackBuild holds fields for managing building via buildpacks.
@@ -90,6 +214,24 @@ type SpaceSpecBuildpackBuild struct {
 	// +patchMergeKey=name
 	// +patchStrategy=merge
 	Env []corev1.EnvVar `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// Catalog is the name of the cluster BuildpackCatalog that lists the
+	// stacks apps in the space can be built on. If blank, and no Stacks are
+	// set, apps are built with BuilderImage.
+	// +optional
+	Catalog string `json:"catalog,omitempty"`
+
+	// Stacks adds stacks to those in the Catalog for this space. Stacks with
+	// the same name as one in the Catalog replace it.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Stacks []BuildpackStack `json:"stacks,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// PodPlacement controls which nodes builds run on. Knative Build doesn't
+	// support tolerations so they can't be set.
+	// +optional
+	PodPlacement `json:",inline"`
 }
 
 // SpaceSpecExecution contains settings for the execution environment.
@@ -106,10 +248,24 @@ type SpaceSpecExecution struct {
 	// +patchMergeKey=name
 	// +patchStrategy=merge
 	Domains []SpaceDomain `json:"domains,omitempty" patchStrategy:"merge" patchMergeKey:"domain"`
+
+	// ServiceBindingFiles mounts the credentials of all service bindings in
+	// the space as files like AppSpecServiceBinding.MountFiles.
+	// +optional
+	ServiceBindingFiles bool `json:"serviceBindingFiles,omitempty"`
+
+	// PodPlacement controls which nodes app instances run on.
+	// +optional
+	PodPlacement `json:",inline"`
 }
 
 // SpaceSpecResourceLimits contains definitions for resource usage limits.
 type SpaceSpecResourceLimits struct {
+	// QuotaPlan is the name of the QuotaPlan whose limits apply to the
+	// space. Resources also listed in SpaceQuota use the SpaceQuota value.
+	// +optional
+	QuotaPlan string `json:"quotaPlan,omitempty"`
+
 	// SpaceQuota holds the k8s ResourceQuota created for the whole space.
 	// For now, only one ResourceQuota per space is supported.
 	// Consider allowing multiple ResourceQuotas when more quota scopes are enabled in k8s
@@ -120,6 +276,33 @@ type SpaceSpecResourceLimits struct {
 	// which sets default request/limit for resources per pod or container.
 	// +optional
 	ResourceDefaults []corev1.LimitRangeItem `json:"resourceDefaults,omitempty"`
+
+	// KfQuota holds limits on kf resources that Kubernetes ResourceQuotas
+	// can't count. They're enforced when the resources are admitted.
+	// +optional
+	KfQuota SpaceKfQuota `json:"kfQuota,omitempty"`
+}
+
+// SpaceKfQuota contains limits on the kf resources in a space. Limits that
+// aren't set are unlimited.
+type SpaceKfQuota struct {
+	// Routes is the maximum number of routes in the space.
+	// +optional
+	Routes *int64 `json:"routes,omitempty"`
+
+	// ServiceInstances is the maximum number of service instances in the
+	// space.
+	// +optional
+	ServiceInstances *int64 `json:"serviceInstances,omitempty"`
+
+	// ServiceBindings is the maximum number of service bindings of all apps
+	// in the space.
+	// +optional
+	ServiceBindings *int64 `json:"serviceBindings,omitempty"`
+
+	// AppInstances is the maximum number of app instances in the space.
+	// +optional
+	AppInstances *int64 `json:"appInstances,omitempty"`
 }
 
 // SpaceDomain stores information about a domain available in a space.
@@ -140,6 +323,21 @@ type SpaceStatus struct {
 	duckv1beta1.Status `json:",inline"`
 
 	Quota corev1.ResourceQuotaStatus `json:"quota,omitempty"`
+
+	// BuildpackStacks holds the stacks available to apps in the space after
+	// merging the space's stacks with its catalog.
+	// +optional
+	BuildpackStacks []BuildpackStack `json:"buildpackStacks,omitempty"`
+
+	// ExecutionPlacement holds the placement of app instances after merging
+	// the space's execution placement with its PlacementProfile.
+	// +optional
+	ExecutionPlacement PodPlacement `json:"executionPlacement,omitempty"`
+
+	// BuildPlacement holds the placement of builds after merging the space's
+	// build placement with its PlacementProfile.
+	// +optional
+	BuildPlacement PodPlacement `json:"buildPlacement,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -16,7 +16,9 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 
+	rbacv1 "k8s.io/api/rbac/v1"
 	"knative.dev/pkg/apis"
 )
 
@@ -38,23 +40,116 @@ func (space *Space) Validate(ctx context.Context) (errs *apis.FieldError) {
 	}
 
 	errs = errs.Also(space.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec"))
+	errs = errs.Also(space.validateAccess(ctx))
+	errs = errs.Also(space.validateOrganization(ctx))
+	errs = errs.Also(space.validateFinalizers(ctx))
 
 	return errs
 }
 
+// validateFinalizers keeps the SpaceFinalizer from being removed from a
+// protected space, which would let its namespace be deleted and skip
+// deprovisioning its service instances.
+func (space *Space) validateFinalizers(ctx context.Context) *apis.FieldError {
+	original, ok := apis.GetBaseline(ctx).(*Space)
+	if !ok || original == nil || !space.Spec.DeletionProtection {
+		return nil
+	}
+
+	if original.HasFinalizer() && !space.HasFinalizer() {
+		return &apis.FieldError{
+			Message: fmt.Sprintf("can't remove finalizer %q from a protected space", SpaceFinalizer),
+			Paths:   []string{"metadata.finalizers"},
+			Details: "set spec.deletionProtection to false first",
+		}
+	}
+
+	return nil
+}
+
 // Validate makes sure that SpaceSpec is properly configured.
 func (s *SpaceSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 	errs = errs.Also(s.Security.Validate(ctx).ViaField("security"))
 	errs = errs.Also(s.BuildpackBuild.Validate(ctx).ViaField("buildpackBuild"))
 	errs = errs.Also(s.Execution.Validate(ctx).ViaField("execution"))
 	errs = errs.Also(s.ResourceLimits.Validate(ctx).ViaField("resourceLimits"))
+	errs = errs.Also(s.Policy.Validate(ctx).ViaField("policy"))
 
 	return errs
 }
 
 // Validate makes sure that SpaceSpecSecurity is properly configured.
 func (s *SpaceSpecSecurity) Validate(ctx context.Context) (errs *apis.FieldError) {
-	// XXX: no validation
+	seen := make(map[SpaceRole]bool)
+	for i, role := range s.Roles {
+		errs = errs.Also(role.Validate(ctx).ViaFieldIndex("roles", i))
+
+		if seen[role] {
+			dup := &apis.FieldError{
+				Message: fmt.Sprintf("%s %q is assigned %s more than once", role.Kind, role.Name, role.Role),
+				Paths:   []string{apis.CurrentField},
+			}
+			errs = errs.Also(dup.ViaFieldIndex("roles", i))
+		}
+		seen[role] = true
+	}
+
+	boundGroups := make(map[SpaceSecurityGroup]bool)
+	for i, group := range s.SecurityGroups {
+		errs = errs.Also(group.Validate(ctx).ViaFieldIndex("securityGroups", i))
+
+		if boundGroups[group] {
+			dup := &apis.FieldError{
+				Message: fmt.Sprintf("security group %q is bound to %s more than once", group.Name, group.Lifecycle),
+				Paths:   []string{apis.CurrentField},
+			}
+			errs = errs.Also(dup.ViaFieldIndex("securityGroups", i))
+		}
+		boundGroups[group] = true
+	}
+
+	return errs
+}
+
+// Validate makes sure that SpaceSecurityGroup is properly configured.
+func (g *SpaceSecurityGroup) Validate(ctx context.Context) (errs *apis.FieldError) {
+	if g.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+
+	switch g.Lifecycle {
+	case SecurityGroupLifecycleStaging, SecurityGroupLifecycleRunning:
+	case "":
+		errs = errs.Also(apis.ErrMissingField("lifecycle"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(g.Lifecycle, "lifecycle"))
+	}
+
+	return errs
+}
+
+// Validate makes sure that SpaceRole is properly configured.
+func (r *SpaceRole) Validate(ctx context.Context) (errs *apis.FieldError) {
+	switch r.Role {
+	case SpaceManager, SpaceDeveloper, SpaceAuditor:
+	case "":
+		errs = errs.Also(apis.ErrMissingField("role"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(r.Role, "role"))
+	}
+
+	switch r.Kind {
+	case rbacv1.UserKind, rbacv1.GroupKind:
+	case "":
+		errs = errs.Also(apis.ErrMissingField("kind"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(r.Kind, "kind"))
+	}
+
+	if r.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+
 	return errs
 }
 
@@ -68,11 +163,16 @@ func (s *SpaceSpecBuildpackBuild) Validate(ctx context.Context) (errs *apis.Fiel
 		errs = errs.Also(apis.ErrMissingField("containerRegistry"))
 	}
 
+	errs = errs.Also(validateBuildpackStacks(ctx, s.Stacks).ViaField("stacks"))
+	errs = errs.Also(validateBuildPlacement(s.PodPlacement))
+
 	return errs
 }
 
 // Validate makes sure that SpaceSpecExecution is properly configured.
 func (s *SpaceSpecExecution) Validate(ctx context.Context) (errs *apis.FieldError) {
+	errs = errs.Also(validatePodPlacement(s.PodPlacement))
+
 	if len(s.Domains) == 0 {
 		return errs.Also(apis.ErrMissingField("domains"))
 	}
@@ -110,6 +210,5 @@ func (s *SpaceSpecExecution) Validate(ctx context.Context) (errs *apis.FieldErro
 
 // Validate makes sure that SpaceSpecResourceLimits is properly configured.
 func (s *SpaceSpecResourceLimits) Validate(ctx context.Context) (errs *apis.FieldError) {
-	// XXX: no validation
-	return errs
+	return errs.Also(s.KfQuota.Validate(ctx).ViaField("kfQuota"))
 }
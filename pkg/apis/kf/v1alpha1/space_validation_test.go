@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 )
@@ -92,6 +93,20 @@ func TestSpaceValidation(t *testing.T) {
 			},
 			want: apis.ErrMissingField("spec.buildpackBuild.builderImage"),
 		},
+		"invalid stacks": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: SpaceSpec{
+					Execution: goodExecuton,
+					BuildpackBuild: SpaceSpecBuildpackBuild{
+						BuilderImage:      DefaultBuilderImage,
+						ContainerRegistry: "gcr.io/test",
+						Stacks:            []BuildpackStack{{Name: "cflinuxfs3"}},
+					},
+				},
+			},
+			want: apis.ErrMissingField("spec.buildpackBuild.stacks[0].builderImage"),
+		},
 		"no domains": {
 			space: &Space{
 				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
@@ -147,6 +162,145 @@ func TestSpaceValidation(t *testing.T) {
 				Details: "one domain must be set to default",
 			},
 		},
+		"valid roles": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: SpaceSpec{
+					Security: SpaceSpecSecurity{
+						Roles: []SpaceRole{
+							{Role: SpaceManager, Kind: "User", Name: "alice@example.com"},
+							{Role: SpaceDeveloper, Kind: "Group", Name: "devs"},
+						},
+					},
+					BuildpackBuild: goodBuildpackBuild,
+					Execution:      goodExecuton,
+				},
+			},
+		},
+		"invalid roles": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: SpaceSpec{
+					Security: SpaceSpecSecurity{
+						Roles: []SpaceRole{
+							{Role: "OrgManager", Kind: "User", Name: "alice@example.com"},
+							{Role: SpaceAuditor, Kind: "ServiceAccount", Name: "bot"},
+							{Role: SpaceAuditor},
+						},
+					},
+					BuildpackBuild: goodBuildpackBuild,
+					Execution:      goodExecuton,
+				},
+			},
+			want: apis.ErrInvalidValue("OrgManager", "spec.security.roles[0].role").Also(
+				apis.ErrInvalidValue("ServiceAccount", "spec.security.roles[1].kind"),
+				apis.ErrMissingField("spec.security.roles[2].kind", "spec.security.roles[2].name"),
+			),
+		},
+		"duplicate roles": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: SpaceSpec{
+					Security: SpaceSpecSecurity{
+						Roles: []SpaceRole{
+							{Role: SpaceDeveloper, Kind: "User", Name: "alice@example.com"},
+							{Role: SpaceDeveloper, Kind: "User", Name: "alice@example.com"},
+						},
+					},
+					BuildpackBuild: goodBuildpackBuild,
+					Execution:      goodExecuton,
+				},
+			},
+			want: &apis.FieldError{
+				Message: `User "alice@example.com" is assigned SpaceDeveloper more than once`,
+				Paths:   []string{"spec.security.roles[1]"},
+			},
+		},
+		"valid security groups": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: SpaceSpec{
+					Security: SpaceSpecSecurity{
+						SecurityGroups: []SpaceSecurityGroup{
+							{Name: "public-networks", Lifecycle: SecurityGroupLifecycleRunning},
+							{Name: "public-networks", Lifecycle: SecurityGroupLifecycleStaging},
+						},
+					},
+					BuildpackBuild: goodBuildpackBuild,
+					Execution:      goodExecuton,
+				},
+			},
+		},
+		"invalid security groups": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: SpaceSpec{
+					Security: SpaceSpecSecurity{
+						SecurityGroups: []SpaceSecurityGroup{
+							{Name: "dns", Lifecycle: "building"},
+							{},
+							{Name: "dns", Lifecycle: "building"},
+						},
+					},
+					BuildpackBuild: goodBuildpackBuild,
+					Execution:      goodExecuton,
+				},
+			},
+			want: apis.ErrInvalidValue("building", "spec.security.securityGroups[0].lifecycle").Also(
+				apis.ErrMissingField("spec.security.securityGroups[1].name", "spec.security.securityGroups[1].lifecycle"),
+				apis.ErrInvalidValue("building", "spec.security.securityGroups[2].lifecycle"),
+				&apis.FieldError{
+					Message: `security group "dns" is bound to building more than once`,
+					Paths:   []string{"spec.security.securityGroups[2]"},
+				},
+			),
+		},
+		"placement": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: SpaceSpec{
+					PlacementProfile: "pci",
+					BuildpackBuild: SpaceSpecBuildpackBuild{
+						BuilderImage:      DefaultBuilderImage,
+						ContainerRegistry: "gcr.io/test",
+						PodPlacement: PodPlacement{
+							NodeSelector: map[string]string{"pool": "builds"},
+						},
+					},
+					Execution: SpaceSpecExecution{
+						Domains: []SpaceDomain{{Domain: "example.com", Default: true}},
+						PodPlacement: PodPlacement{
+							NodeSelector: map[string]string{"pool": "pci"},
+							Tolerations: []corev1.Toleration{
+								{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "pci", Effect: corev1.TaintEffectNoSchedule},
+							},
+						},
+					},
+				},
+			},
+		},
+		"build tolerations": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec: SpaceSpec{
+					BuildpackBuild: SpaceSpecBuildpackBuild{
+						BuilderImage:      DefaultBuilderImage,
+						ContainerRegistry: "gcr.io/test",
+						PodPlacement: PodPlacement{
+							Tolerations: []corev1.Toleration{
+								{Key: "dedicated", Operator: corev1.TolerationOpExists},
+							},
+						},
+					},
+					Execution: goodExecuton,
+				},
+			},
+			want: &apis.FieldError{
+				Message: "tolerations can't be set on builds",
+				Paths:   []string{"spec.buildpackBuild.tolerations"},
+				Details: "Knative Build doesn't support tolerations, use a node selector or affinity instead",
+			},
+		},
 	}
 
 	for tn, tc := range cases {
@@ -157,3 +311,58 @@ func TestSpaceValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestSpaceValidation_finalizers(t *testing.T) {
+	spec := SpaceSpec{
+		BuildpackBuild: SpaceSpecBuildpackBuild{
+			BuilderImage:      DefaultBuilderImage,
+			ContainerRegistry: "gcr.io/test",
+		},
+		Execution: SpaceSpecExecution{
+			Domains: []SpaceDomain{{Domain: "example.com", Default: true}},
+		},
+	}
+
+	protectedSpec := spec
+	protectedSpec.DeletionProtection = true
+
+	withFinalizer := &Space{
+		ObjectMeta: metav1.ObjectMeta{Name: "valid", Finalizers: []string{SpaceFinalizer}},
+		Spec:       protectedSpec,
+	}
+
+	cases := map[string]struct {
+		space *Space
+		want  *apis.FieldError
+	}{
+		"finalizer kept": {
+			space: withFinalizer.DeepCopy(),
+		},
+		"finalizer removed from protected space": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec:       protectedSpec,
+			},
+			want: &apis.FieldError{
+				Message: `can't remove finalizer "spaces.kf.dev" from a protected space`,
+				Paths:   []string{"metadata.finalizers"},
+				Details: "set spec.deletionProtection to false first",
+			},
+		},
+		"finalizer removed while turning protection off": {
+			space: &Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+				Spec:       spec,
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx := apis.WithinUpdate(context.Background(), withFinalizer)
+			got := tc.space.Validate(ctx)
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (k *SpaceTemplate) Validate(ctx context.Context) (errs *apis.FieldError) {
+	return errs.Also(k.validateSpec(apis.WithinSpec(ctx)).ViaField("spec"))
+}
+
+// validateSpec validates the spec like a Space's, except that fields required
+// on Spaces can be left blank.
+func (k *SpaceTemplate) validateSpec(ctx context.Context) (errs *apis.FieldError) {
+	spec := k.Spec
+
+	errs = errs.Also(spec.Security.Validate(ctx).ViaField("security"))
+
+	errs = errs.Also(validateBuildpackStacks(ctx, spec.BuildpackBuild.Stacks).ViaField("stacks").ViaField("buildpackBuild"))
+	errs = errs.Also(validateBuildPlacement(spec.BuildpackBuild.PodPlacement).ViaField("buildpackBuild"))
+
+	if len(spec.Execution.Domains) > 0 {
+		errs = errs.Also(spec.Execution.Validate(ctx).ViaField("execution"))
+	} else {
+		errs = errs.Also(validatePodPlacement(spec.Execution.PodPlacement).ViaField("execution"))
+	}
+
+	errs = errs.Also(spec.ResourceLimits.Validate(ctx).ViaField("resourceLimits"))
+
+	return errs
+}
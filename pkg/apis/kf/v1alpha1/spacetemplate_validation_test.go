@@ -0,0 +1,88 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	"knative.dev/pkg/apis"
+)
+
+func TestSpaceTemplateValidation(t *testing.T) {
+	cases := map[string]struct {
+		template *SpaceTemplate
+		want     *apis.FieldError
+	}{
+		"empty": {
+			template: &SpaceTemplate{},
+		},
+		"partial spec": {
+			template: &SpaceTemplate{
+				Spec: SpaceSpec{
+					BuildpackBuild: SpaceSpecBuildpackBuild{
+						ContainerRegistry: "gcr.io/test",
+					},
+					ResourceLimits: SpaceSpecResourceLimits{
+						QuotaPlan: "small",
+					},
+				},
+			},
+		},
+		"invalid stacks": {
+			template: &SpaceTemplate{
+				Spec: SpaceSpec{
+					BuildpackBuild: SpaceSpecBuildpackBuild{
+						Stacks: []BuildpackStack{{Name: "cflinuxfs3"}},
+					},
+				},
+			},
+			want: apis.ErrMissingField("spec.buildpackBuild.stacks[0].builderImage"),
+		},
+		"invalid domains": {
+			template: &SpaceTemplate{
+				Spec: SpaceSpec{
+					Execution: SpaceSpecExecution{
+						Domains: []SpaceDomain{{Domain: "example.com"}},
+					},
+				},
+			},
+			want: &apis.FieldError{
+				Paths:   []string{"spec.execution.domains"},
+				Message: "multiple defaults",
+				Details: "one domain must be set to default",
+			},
+		},
+		"invalid roles": {
+			template: &SpaceTemplate{
+				Spec: SpaceSpec{
+					Security: SpaceSpecSecurity{
+						Roles: []SpaceRole{{Role: "OrgManager", Kind: "User", Name: "alice@example.com"}},
+					},
+				},
+			},
+			want: apis.ErrInvalidValue("OrgManager", "spec.security.roles[0].role"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got := tc.template.Validate(context.Background())
+
+			testutil.AssertEqual(t, "validation errors", tc.want.Error(), got.Error())
+		})
+	}
+}
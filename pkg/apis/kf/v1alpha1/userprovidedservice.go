@@ -0,0 +1,75 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// User-provided service instances are stored as Secrets in the space. The
+// Secret data holds the credentials and the other properties CF allows on
+// user-provided services are stored as annotations.
+const (
+	// UserProvidedServiceLabel is set to "true" on Secrets that hold
+	// user-provided service instances.
+	UserProvidedServiceLabel = "kf.dev/user-provided-service"
+
+	// UserProvidedServiceClass is the service label user-provided instances
+	// are listed under in VCAP_SERVICES.
+	UserProvidedServiceClass = "user-provided"
+
+	// UserProvidedServiceTagsAnnotation holds the JSON encoded tags of a
+	// user-provided service.
+	UserProvidedServiceTagsAnnotation = ServiceTagsAnnotation
+
+	// UserProvidedServiceSyslogDrainURLAnnotation holds the URL logs of bound
+	// apps are drained to.
+	UserProvidedServiceSyslogDrainURLAnnotation = "kf.dev/syslog-drain-url"
+
+	// UserProvidedServiceRouteServiceURLAnnotation holds the URL of a route
+	// service.
+	UserProvidedServiceRouteServiceURLAnnotation = "kf.dev/route-service-url"
+)
+
+// UserProvidedServiceSecretName gets the name of the Secret that holds the
+// user-provided service instance with the given name.
+func UserProvidedServiceSecretName(instanceName string) string {
+	return fmt.Sprintf("kf-user-provided-%s", instanceName)
+}
+
+// IsUserProvidedService returns true if the Secret holds a user-provided
+// service instance.
+func IsUserProvidedService(secret *corev1.Secret) bool {
+	return secret != nil && secret.Labels[UserProvidedServiceLabel] == "true"
+}
+
+// UserProvidedServiceInstanceName gets the name of the user-provided service
+// instance stored in the Secret.
+func UserProvidedServiceInstanceName(secret *corev1.Secret) string {
+	return secret.Labels[NameLabel]
+}
+
+// UserProvidedServiceTags gets the tags of the user-provided service stored in
+// the Secret.
+func UserProvidedServiceTags(secret *corev1.Secret) ([]string, error) {
+	tags, err := ServiceTags(secret.Annotations)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read tags of user-provided service %q: %v", UserProvidedServiceInstanceName(secret), err)
+	}
+
+	return tags, nil
+}
@@ -191,7 +191,7 @@ func (in *AppSpecTemplate) DeepCopy() *AppSpecTemplate {
 func (in *AppStatus) DeepCopyInto(out *AppStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.SourceStatusFields = in.SourceStatusFields
+	in.SourceStatusFields.DeepCopyInto(&out.SourceStatusFields)
 	out.ConfigurationStatusFields = in.ConfigurationStatusFields
 	in.RouteStatusFields.DeepCopyInto(&out.RouteStatusFields)
 	if in.ServiceBindingNames != nil {
@@ -219,6 +219,148 @@ func (in *AppStatus) DeepCopy() *AppStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildpackCatalog) DeepCopyInto(out *BuildpackCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackCatalog.
+func (in *BuildpackCatalog) DeepCopy() *BuildpackCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildpackCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BuildpackCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildpackCatalogEntry) DeepCopyInto(out *BuildpackCatalogEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackCatalogEntry.
+func (in *BuildpackCatalogEntry) DeepCopy() *BuildpackCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildpackCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildpackCatalogList) DeepCopyInto(out *BuildpackCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BuildpackCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackCatalogList.
+func (in *BuildpackCatalogList) DeepCopy() *BuildpackCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildpackCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BuildpackCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildpackCatalogSpec) DeepCopyInto(out *BuildpackCatalogSpec) {
+	*out = *in
+	if in.Stacks != nil {
+		in, out := &in.Stacks, &out.Stacks
+		*out = make([]BuildpackStack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackCatalogSpec.
+func (in *BuildpackCatalogSpec) DeepCopy() *BuildpackCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildpackCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildpackStack) DeepCopyInto(out *BuildpackStack) {
+	*out = *in
+	if in.Buildpacks != nil {
+		in, out := &in.Buildpacks, &out.Buildpacks
+		*out = make([]BuildpackCatalogEntry, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackStack.
+func (in *BuildpackStack) DeepCopy() *BuildpackStack {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildpackStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in BuildpackStacks) DeepCopyInto(out *BuildpackStacks) {
+	{
+		in := &in
+		*out = make(BuildpackStacks, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackStacks.
+func (in BuildpackStacks) DeepCopy() BuildpackStacks {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildpackStacks)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in HTTPRoutes) DeepCopyInto(out *HTTPRoutes) {
 	{
@@ -264,26 +406,27 @@ func (in OwnerReferences) DeepCopy() OwnerReferences {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route) DeepCopyInto(out *Route) {
+func (in *Organization) DeepCopyInto(out *Organization) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
-func (in *Route) DeepCopy() *Route {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
+func (in *Organization) DeepCopy() *Organization {
 	if in == nil {
 		return nil
 	}
-	out := new(Route)
+	out := new(Organization)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Route) DeepCopyObject() runtime.Object {
+func (in *Organization) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -291,26 +434,135 @@ func (in *Route) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteClaim) DeepCopyInto(out *RouteClaim) {
+func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Organization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
+func (in *OrganizationList) DeepCopy() *OrganizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
+	*out = *in
+	in.Defaults.DeepCopyInto(&out.Defaults)
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
+func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSpecDefaults) DeepCopyInto(out *OrganizationSpecDefaults) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]SpaceDomain, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpecDefaults.
+func (in *OrganizationSpecDefaults) DeepCopy() *OrganizationSpecDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSpecDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Spaces != nil {
+		in, out := &in.Spaces, &out.Spaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Quota.DeepCopyInto(&out.Quota)
+	in.AppliedDefaults.DeepCopyInto(&out.AppliedDefaults)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
+func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementProfile) DeepCopyInto(out *PlacementProfile) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteClaim.
-func (in *RouteClaim) DeepCopy() *RouteClaim {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementProfile.
+func (in *PlacementProfile) DeepCopy() *PlacementProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteClaim)
+	out := new(PlacementProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteClaim) DeepCopyObject() runtime.Object {
+func (in *PlacementProfile) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -318,13 +570,13 @@ func (in *RouteClaim) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteClaimList) DeepCopyInto(out *RouteClaimList) {
+func (in *PlacementProfileList) DeepCopyInto(out *PlacementProfileList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RouteClaim, len(*in))
+		*out = make([]PlacementProfile, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -332,18 +584,18 @@ func (in *RouteClaimList) DeepCopyInto(out *RouteClaimList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteClaimList.
-func (in *RouteClaimList) DeepCopy() *RouteClaimList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementProfileList.
+func (in *PlacementProfileList) DeepCopy() *PlacementProfileList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteClaimList)
+	out := new(PlacementProfileList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteClaimList) DeepCopyObject() runtime.Object {
+func (in *PlacementProfileList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -351,30 +603,516 @@ func (in *RouteClaimList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteClaimSpec) DeepCopyInto(out *RouteClaimSpec) {
+func (in *PlacementProfileSpec) DeepCopyInto(out *PlacementProfileSpec) {
 	*out = *in
-	out.RouteSpecFields = in.RouteSpecFields
+	in.Execution.DeepCopyInto(&out.Execution)
+	in.Build.DeepCopyInto(&out.Build)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementProfileSpec.
+func (in *PlacementProfileSpec) DeepCopy() *PlacementProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPlacement) DeepCopyInto(out *PodPlacement) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPlacement.
+func (in *PodPlacement) DeepCopy() *PodPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaPlan) DeepCopyInto(out *QuotaPlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaPlan.
+func (in *QuotaPlan) DeepCopy() *QuotaPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuotaPlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaPlanList) DeepCopyInto(out *QuotaPlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuotaPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaPlanList.
+func (in *QuotaPlanList) DeepCopy() *QuotaPlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaPlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuotaPlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaPlanSpec) DeepCopyInto(out *QuotaPlanSpec) {
+	*out = *in
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.SpaceKfQuota.DeepCopyInto(&out.SpaceKfQuota)
+	if in.AppMemory != nil {
+		in, out := &in.AppMemory, &out.AppMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaPlanSpec.
+func (in *QuotaPlanSpec) DeepCopy() *QuotaPlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaPlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteClaim) DeepCopyInto(out *RouteClaim) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteClaim.
+func (in *RouteClaim) DeepCopy() *RouteClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteClaim) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteClaimList) DeepCopyInto(out *RouteClaimList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouteClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteClaimList.
+func (in *RouteClaimList) DeepCopy() *RouteClaimList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteClaimList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteClaimList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteClaimSpec) DeepCopyInto(out *RouteClaimSpec) {
+	*out = *in
+	out.RouteSpecFields = in.RouteSpecFields
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteClaimSpec.
+func (in *RouteClaimSpec) DeepCopy() *RouteClaimSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteClaimSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteList) DeepCopyInto(out *RouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
+func (in *RouteList) DeepCopy() *RouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+	*out = *in
+	out.RouteSpecFields = in.RouteSpecFields
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpecFields) DeepCopyInto(out *RouteSpecFields) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpecFields.
+func (in *RouteSpecFields) DeepCopy() *RouteSpecFields {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpecFields)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in RouteSpecFieldsSlice) DeepCopyInto(out *RouteSpecFieldsSlice) {
+	{
+		in := &in
+		*out = make(RouteSpecFieldsSlice, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpecFieldsSlice.
+func (in RouteSpecFieldsSlice) DeepCopy() RouteSpecFieldsSlice {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpecFieldsSlice)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Routes) DeepCopyInto(out *Routes) {
+	{
+		in := &in
+		*out = make(Routes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Routes.
+func (in Routes) DeepCopy() Routes {
+	if in == nil {
+		return nil
+	}
+	out := new(Routes)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ServiceBindings) DeepCopyInto(out *ServiceBindings) {
+	{
+		in := &in
+		*out = make(ServiceBindings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindings.
+func (in ServiceBindings) DeepCopy() ServiceBindings {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindings)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
+func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupList) DeepCopyInto(out *SecurityGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupList.
+func (in *SecurityGroupList) DeepCopy() *SecurityGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupSpec) DeepCopyInto(out *SecurityGroupSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]SecurityGroupRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupSpec.
+func (in *SecurityGroupSpec) DeepCopy() *SecurityGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceInstanceShare) DeepCopyInto(out *ServiceInstanceShare) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteClaimSpec.
-func (in *RouteClaimSpec) DeepCopy() *RouteClaimSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceShare.
+func (in *ServiceInstanceShare) DeepCopy() *ServiceInstanceShare {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteClaimSpec)
+	out := new(ServiceInstanceShare)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceInstanceShare) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteList) DeepCopyInto(out *RouteList) {
+func (in *ServiceInstanceShareList) DeepCopyInto(out *ServiceInstanceShareList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Route, len(*in))
+		*out = make([]ServiceInstanceShare, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -382,18 +1120,18 @@ func (in *RouteList) DeepCopyInto(out *RouteList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
-func (in *RouteList) DeepCopy() *RouteList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceShareList.
+func (in *ServiceInstanceShareList) DeepCopy() *ServiceInstanceShareList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteList)
+	out := new(ServiceInstanceShareList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteList) DeepCopyObject() runtime.Object {
+func (in *ServiceInstanceShareList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -401,100 +1139,100 @@ func (in *RouteList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+func (in *ServiceInstanceShareSpec) DeepCopyInto(out *ServiceInstanceShareSpec) {
 	*out = *in
-	out.RouteSpecFields = in.RouteSpecFields
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
-func (in *RouteSpec) DeepCopy() *RouteSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceShareSpec.
+func (in *ServiceInstanceShareSpec) DeepCopy() *ServiceInstanceShareSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteSpec)
+	out := new(ServiceInstanceShareSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteSpecFields) DeepCopyInto(out *RouteSpecFields) {
+func (in *ServicePlanVisibility) DeepCopyInto(out *ServicePlanVisibility) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpecFields.
-func (in *RouteSpecFields) DeepCopy() *RouteSpecFields {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanVisibility.
+func (in *ServicePlanVisibility) DeepCopy() *ServicePlanVisibility {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteSpecFields)
+	out := new(ServicePlanVisibility)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in RouteSpecFieldsSlice) DeepCopyInto(out *RouteSpecFieldsSlice) {
-	{
-		in := &in
-		*out = make(RouteSpecFieldsSlice, len(*in))
-		copy(*out, *in)
-		return
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpecFieldsSlice.
-func (in RouteSpecFieldsSlice) DeepCopy() RouteSpecFieldsSlice {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServicePlanVisibility) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(RouteSpecFieldsSlice)
-	in.DeepCopyInto(out)
-	return *out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in Routes) DeepCopyInto(out *Routes) {
-	{
-		in := &in
-		*out = make(Routes, len(*in))
+func (in *ServicePlanVisibilityList) DeepCopyInto(out *ServicePlanVisibilityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServicePlanVisibility, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
-		return
 	}
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Routes.
-func (in Routes) DeepCopy() Routes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanVisibilityList.
+func (in *ServicePlanVisibilityList) DeepCopy() *ServicePlanVisibilityList {
 	if in == nil {
 		return nil
 	}
-	out := new(Routes)
+	out := new(ServicePlanVisibilityList)
 	in.DeepCopyInto(out)
-	return *out
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServicePlanVisibilityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ServiceBindings) DeepCopyInto(out *ServiceBindings) {
-	{
-		in := &in
-		*out = make(ServiceBindings, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-		return
+func (in *ServicePlanVisibilitySpec) DeepCopyInto(out *ServicePlanVisibilitySpec) {
+	*out = *in
+	if in.Spaces != nil {
+		in, out := &in.Spaces, &out.Spaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindings.
-func (in ServiceBindings) DeepCopy() ServiceBindings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanVisibilitySpec.
+func (in *ServicePlanVisibilitySpec) DeepCopy() *ServicePlanVisibilitySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceBindings)
+	out := new(ServicePlanVisibilitySpec)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -525,6 +1263,43 @@ func (in *Source) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceBuildMetadata) DeepCopyInto(out *SourceBuildMetadata) {
+	*out = *in
+	if in.Buildpacks != nil {
+		in, out := &in.Buildpacks, &out.Buildpacks
+		*out = make([]SourceBuildpackMetadata, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceBuildMetadata.
+func (in *SourceBuildMetadata) DeepCopy() *SourceBuildMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceBuildMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceBuildpackMetadata) DeepCopyInto(out *SourceBuildpackMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceBuildpackMetadata.
+func (in *SourceBuildpackMetadata) DeepCopy() *SourceBuildpackMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceBuildpackMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceList) DeepCopyInto(out *SourceList) {
 	*out = *in
@@ -563,7 +1338,8 @@ func (in *SourceSpec) DeepCopyInto(out *SourceSpec) {
 	*out = *in
 	out.ContainerImage = in.ContainerImage
 	in.BuildpackBuild.DeepCopyInto(&out.BuildpackBuild)
-	out.Dockerfile = in.Dockerfile
+	in.Dockerfile.DeepCopyInto(&out.Dockerfile)
+	in.Placement.DeepCopyInto(&out.Placement)
 	return
 }
 
@@ -580,6 +1356,11 @@ func (in *SourceSpec) DeepCopy() *SourceSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceSpecBuildpackBuild) DeepCopyInto(out *SourceSpecBuildpackBuild) {
 	*out = *in
+	if in.BuildpackOrder != nil {
+		in, out := &in.BuildpackOrder, &out.BuildpackOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]v1.EnvVar, len(*in))
@@ -619,6 +1400,18 @@ func (in *SourceSpecContainerImage) DeepCopy() *SourceSpecContainerImage {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceSpecDockerfile) DeepCopyInto(out *SourceSpecDockerfile) {
 	*out = *in
+	if in.BuildArgs != nil {
+		in, out := &in.BuildArgs, &out.BuildArgs
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -636,7 +1429,7 @@ func (in *SourceSpecDockerfile) DeepCopy() *SourceSpecDockerfile {
 func (in *SourceStatus) DeepCopyInto(out *SourceStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
-	out.SourceStatusFields = in.SourceStatusFields
+	in.SourceStatusFields.DeepCopyInto(&out.SourceStatusFields)
 	return
 }
 
@@ -653,6 +1446,11 @@ func (in *SourceStatus) DeepCopy() *SourceStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceStatusFields) DeepCopyInto(out *SourceStatusFields) {
 	*out = *in
+	if in.BuildMetadata != nil {
+		in, out := &in.BuildMetadata, &out.BuildMetadata
+		*out = new(SourceBuildMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -730,6 +1528,42 @@ func (in SpaceDomains) DeepCopy() SpaceDomains {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceKfQuota) DeepCopyInto(out *SpaceKfQuota) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ServiceInstances != nil {
+		in, out := &in.ServiceInstances, &out.ServiceInstances
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ServiceBindings != nil {
+		in, out := &in.ServiceBindings, &out.ServiceBindings
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AppInstances != nil {
+		in, out := &in.AppInstances, &out.AppInstances
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceKfQuota.
+func (in *SpaceKfQuota) DeepCopy() *SpaceKfQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceKfQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SpaceList) DeepCopyInto(out *SpaceList) {
 	*out = *in
@@ -763,13 +1597,77 @@ func (in *SpaceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpacePolicy) DeepCopyInto(out *SpacePolicy) {
+	*out = *in
+	if in.AllowedStacks != nil {
+		in, out := &in.AllowedStacks, &out.AllowedStacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedRegistries != nil {
+		in, out := &in.AllowedRegistries, &out.AllowedRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxAppInstances != nil {
+		in, out := &in.MaxAppInstances, &out.MaxAppInstances
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpacePolicy.
+func (in *SpacePolicy) DeepCopy() *SpacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SpacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceRole) DeepCopyInto(out *SpaceRole) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRole.
+func (in *SpaceRole) DeepCopy() *SpaceRole {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceSecurityGroup) DeepCopyInto(out *SpaceSecurityGroup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceSecurityGroup.
+func (in *SpaceSecurityGroup) DeepCopy() *SpaceSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SpaceSpec) DeepCopyInto(out *SpaceSpec) {
 	*out = *in
-	out.Security = in.Security
+	in.Security.DeepCopyInto(&out.Security)
 	in.BuildpackBuild.DeepCopyInto(&out.BuildpackBuild)
 	in.Execution.DeepCopyInto(&out.Execution)
 	in.ResourceLimits.DeepCopyInto(&out.ResourceLimits)
+	in.Policy.DeepCopyInto(&out.Policy)
 	return
 }
 
@@ -793,6 +1691,14 @@ func (in *SpaceSpecBuildpackBuild) DeepCopyInto(out *SpaceSpecBuildpackBuild) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Stacks != nil {
+		in, out := &in.Stacks, &out.Stacks
+		*out = make([]BuildpackStack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.PodPlacement.DeepCopyInto(&out.PodPlacement)
 	return
 }
 
@@ -821,6 +1727,7 @@ func (in *SpaceSpecExecution) DeepCopyInto(out *SpaceSpecExecution) {
 		*out = make([]SpaceDomain, len(*in))
 		copy(*out, *in)
 	}
+	in.PodPlacement.DeepCopyInto(&out.PodPlacement)
 	return
 }
 
@@ -851,6 +1758,7 @@ func (in *SpaceSpecResourceLimits) DeepCopyInto(out *SpaceSpecResourceLimits) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.KfQuota.DeepCopyInto(&out.KfQuota)
 	return
 }
 
@@ -867,6 +1775,16 @@ func (in *SpaceSpecResourceLimits) DeepCopy() *SpaceSpecResourceLimits {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SpaceSpecSecurity) DeepCopyInto(out *SpaceSpecSecurity) {
 	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]SpaceRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]SpaceSecurityGroup, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -885,6 +1803,15 @@ func (in *SpaceStatus) DeepCopyInto(out *SpaceStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
 	in.Quota.DeepCopyInto(&out.Quota)
+	if in.BuildpackStacks != nil {
+		in, out := &in.BuildpackStacks, &out.BuildpackStacks
+		*out = make([]BuildpackStack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.ExecutionPlacement.DeepCopyInto(&out.ExecutionPlacement)
+	in.BuildPlacement.DeepCopyInto(&out.BuildPlacement)
 	return
 }
 
@@ -897,3 +1824,63 @@ func (in *SpaceStatus) DeepCopy() *SpaceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceTemplate) DeepCopyInto(out *SpaceTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceTemplate.
+func (in *SpaceTemplate) DeepCopy() *SpaceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpaceTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceTemplateList) DeepCopyInto(out *SpaceTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SpaceTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceTemplateList.
+func (in *SpaceTemplateList) DeepCopy() *SpaceTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpaceTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
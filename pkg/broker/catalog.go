@@ -0,0 +1,170 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Catalog is the set of services the broker offers. It's read from YAML and
+// uses the field names of the Open Service Broker API catalog.
+type Catalog struct {
+	Services []Service `json:"services"`
+}
+
+// Service is a service offering in the catalog.
+type Service struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	Bindable       bool     `json:"bindable"`
+	PlanUpdateable bool     `json:"plan_updateable,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	Plans          []Plan   `json:"plans"`
+
+	// Credentials are added to the generated credentials of every instance
+	// of the service. They aren't part of the catalog the broker serves.
+	Credentials map[string]interface{} `json:"credentials,omitempty"`
+}
+
+// Plan is a plan of a service offering.
+type Plan struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Free        *bool  `json:"free,omitempty"`
+
+	// Credentials are added to the generated credentials of every instance
+	// of the plan, they take precedence over the credentials of the service.
+	// They aren't part of the catalog the broker serves.
+	Credentials map[string]interface{} `json:"credentials,omitempty"`
+}
+
+// LoadCatalog reads and validates a catalog from a YAML file.
+func LoadCatalog(path string) (*Catalog, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read catalog: %v", err)
+	}
+
+	return ParseCatalog(contents)
+}
+
+// ParseCatalog reads and validates a catalog from YAML.
+func ParseCatalog(contents []byte) (*Catalog, error) {
+	catalog := &Catalog{}
+	if err := yaml.UnmarshalStrict(contents, catalog); err != nil {
+		return nil, fmt.Errorf("couldn't parse catalog: %v", err)
+	}
+
+	if err := catalog.Validate(); err != nil {
+		return nil, err
+	}
+
+	return catalog, nil
+}
+
+// Validate checks that the catalog has at least one service, every service
+// has a plan and that IDs and names are set and unique.
+func (c *Catalog) Validate() error {
+	if len(c.Services) == 0 {
+		return fmt.Errorf("catalog must have at least one service")
+	}
+
+	ids := make(map[string]bool)
+	names := make(map[string]bool)
+	for i, service := range c.Services {
+		switch {
+		case service.ID == "":
+			return fmt.Errorf("services[%d] is missing an id", i)
+		case service.Name == "":
+			return fmt.Errorf("services[%d] is missing a name", i)
+		case ids[service.ID]:
+			return fmt.Errorf("duplicate id %q", service.ID)
+		case names[service.Name]:
+			return fmt.Errorf("duplicate service %q", service.Name)
+		case len(service.Plans) == 0:
+			return fmt.Errorf("service %q must have at least one plan", service.Name)
+		}
+		ids[service.ID] = true
+		names[service.Name] = true
+
+		planNames := make(map[string]bool)
+		for j, plan := range service.Plans {
+			switch {
+			case plan.ID == "":
+				return fmt.Errorf("service %q plans[%d] is missing an id", service.Name, j)
+			case plan.Name == "":
+				return fmt.Errorf("service %q plans[%d] is missing a name", service.Name, j)
+			case ids[plan.ID]:
+				return fmt.Errorf("duplicate id %q", plan.ID)
+			case planNames[plan.Name]:
+				return fmt.Errorf("service %q has duplicate plan %q", service.Name, plan.Name)
+			}
+			ids[plan.ID] = true
+			planNames[plan.Name] = true
+		}
+	}
+
+	return nil
+}
+
+// Find gets the service and plan with the given IDs. If planID is blank only
+// the service is looked up and the plan is nil.
+func (c *Catalog) Find(serviceID, planID string) (*Service, *Plan, error) {
+	for i := range c.Services {
+		service := &c.Services[i]
+		if service.ID != serviceID {
+			continue
+		}
+
+		if planID == "" {
+			return service, nil, nil
+		}
+
+		for j := range service.Plans {
+			if service.Plans[j].ID == planID {
+				return service, &service.Plans[j], nil
+			}
+		}
+
+		return nil, nil, fmt.Errorf("plan %q doesn't exist on service %q", planID, service.Name)
+	}
+
+	return nil, nil, fmt.Errorf("service %q doesn't exist", serviceID)
+}
+
+// public returns a copy of the catalog without the fields that aren't part of
+// the Open Service Broker API.
+func (c *Catalog) public() *Catalog {
+	out := &Catalog{}
+	for _, service := range c.Services {
+		service.Credentials = nil
+
+		var plans []Plan
+		for _, plan := range service.Plans {
+			plan.Credentials = nil
+			plans = append(plans, plan)
+		}
+		service.Plans = plans
+
+		out.Services = append(out.Services, service)
+	}
+
+	return out
+}
@@ -0,0 +1,165 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+const testCatalog = `
+services:
+- id: db-id
+  name: db
+  description: A database
+  bindable: true
+  plan_updateable: true
+  tags: [sql]
+  credentials:
+    uri: db://localhost
+  plans:
+  - id: small-id
+    name: small
+    description: A small database
+  - id: large-id
+    name: large
+    description: A large database
+    credentials:
+      uri: db://large
+`
+
+func TestParseCatalog(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		contents string
+		wantErr  error
+	}{
+		"valid": {
+			contents: testCatalog,
+		},
+		"no services": {
+			contents: `services: []`,
+			wantErr:  errors.New("catalog must have at least one service"),
+		},
+		"missing service id": {
+			contents: `services: [{name: a, plans: [{id: b, name: b}]}]`,
+			wantErr:  errors.New("services[0] is missing an id"),
+		},
+		"missing service name": {
+			contents: `services: [{id: a, plans: [{id: b, name: b}]}]`,
+			wantErr:  errors.New("services[0] is missing a name"),
+		},
+		"no plans": {
+			contents: `services: [{id: a, name: a}]`,
+			wantErr:  errors.New(`service "a" must have at least one plan`),
+		},
+		"duplicate service": {
+			contents: `services: [{id: a, name: a, plans: [{id: b, name: b}]}, {id: c, name: a, plans: [{id: d, name: d}]}]`,
+			wantErr:  errors.New(`duplicate service "a"`),
+		},
+		"duplicate id": {
+			contents: `services: [{id: a, name: a, plans: [{id: a, name: b}]}]`,
+			wantErr:  errors.New(`duplicate id "a"`),
+		},
+		"missing plan name": {
+			contents: `services: [{id: a, name: a, plans: [{id: b}]}]`,
+			wantErr:  errors.New(`service "a" plans[0] is missing a name`),
+		},
+		"duplicate plan": {
+			contents: `services: [{id: a, name: a, plans: [{id: b, name: b}, {id: c, name: b}]}]`,
+			wantErr:  errors.New(`service "a" has duplicate plan "b"`),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			_, err := ParseCatalog([]byte(tc.contents))
+			testutil.AssertErrorsEqual(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestParseCatalog_strict(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseCatalog([]byte(`services: [{id: a, name: a, plans: [{id: b, name: b}], color: red}]`))
+	testutil.AssertErrorContainsAll(t, err, []string{"couldn't parse catalog", `unknown field "color"`})
+}
+
+func TestCatalog_Find(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := ParseCatalog([]byte(testCatalog))
+	testutil.AssertNil(t, "err", err)
+
+	cases := map[string]struct {
+		serviceID string
+		planID    string
+		wantPlan  string
+		wantErr   error
+	}{
+		"service and plan": {
+			serviceID: "db-id",
+			planID:    "large-id",
+			wantPlan:  "large",
+		},
+		"service only": {
+			serviceID: "db-id",
+		},
+		"missing plan": {
+			serviceID: "db-id",
+			planID:    "huge-id",
+			wantErr:   errors.New(`plan "huge-id" doesn't exist on service "db"`),
+		},
+		"missing service": {
+			serviceID: "cache-id",
+			wantErr:   errors.New(`service "cache-id" doesn't exist`),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			service, plan, err := catalog.Find(tc.serviceID, tc.planID)
+			testutil.AssertErrorsEqual(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			testutil.AssertEqual(t, "service", "db", service.Name)
+			if tc.wantPlan == "" {
+				testutil.AssertNil(t, "plan", plan)
+			} else {
+				testutil.AssertEqual(t, "plan", tc.wantPlan, plan.Name)
+			}
+		})
+	}
+}
+
+func TestCatalog_public(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := ParseCatalog([]byte(testCatalog))
+	testutil.AssertNil(t, "err", err)
+
+	public := catalog.public()
+	testutil.AssertEqual(t, "service credentials", map[string]interface{}(nil), public.Services[0].Credentials)
+	testutil.AssertEqual(t, "plan credentials", map[string]interface{}(nil), public.Services[0].Plans[1].Credentials)
+
+	// The original catalog must be left intact.
+	testutil.AssertEqual(t, "original credentials", "db://large", catalog.Services[0].Plans[1].Credentials["uri"])
+}
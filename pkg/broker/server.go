@@ -0,0 +1,314 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+
+	"github.com/gorilla/mux"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// APIVersionHeader is the header Open Service Broker API clients send the
+// version of the API they use in.
+const APIVersionHeader = "X-Broker-API-Version"
+
+// Options configures the broker server.
+type Options struct {
+	// Username and Password, if set, are required as basic auth credentials
+	// on every request.
+	Username string
+	Password string
+
+	// Logger receives a line for every request, it may be nil.
+	Logger *log.Logger
+}
+
+// Server is an http.Handler that implements the Open Service Broker API.
+// Provisioning and binding are synchronous.
+type Server struct {
+	catalog *Catalog
+	store   Store
+	options Options
+	router  *mux.Router
+}
+
+var _ http.Handler = (*Server)(nil)
+
+// NewServer creates a broker that serves the catalog and keeps instances in
+// the store.
+func NewServer(catalog *Catalog, store Store, options Options) *Server {
+	s := &Server{
+		catalog: catalog,
+		store:   store,
+		options: options,
+		router:  mux.NewRouter(),
+	}
+
+	s.router.HandleFunc("/v2/catalog", s.getCatalog).Methods(http.MethodGet)
+	s.router.HandleFunc("/v2/service_instances/{instance_id}", s.provision).Methods(http.MethodPut)
+	s.router.HandleFunc("/v2/service_instances/{instance_id}", s.update).Methods(http.MethodPatch)
+	s.router.HandleFunc("/v2/service_instances/{instance_id}", s.deprovision).Methods(http.MethodDelete)
+	s.router.HandleFunc("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", s.bind).Methods(http.MethodPut)
+	s.router.HandleFunc("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", s.unbind).Methods(http.MethodDelete)
+
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.options.Logger != nil {
+		s.options.Logger.Println(r.Method, r.URL)
+	}
+
+	if s.options.Username != "" || s.options.Password != "" {
+		username, password, ok := r.BasicAuth()
+		if !ok || !secureEqual(username, s.options.Username) || !secureEqual(password, s.options.Password) {
+			w.Header().Set("WWW-Authenticate", `Basic realm="kf"`)
+			writeError(w, http.StatusUnauthorized, "invalid credentials")
+			return
+		}
+	}
+
+	if r.Header.Get(APIVersionHeader) == "" {
+		writeError(w, http.StatusPreconditionFailed, fmt.Sprintf("the %s header is required", APIVersionHeader))
+		return
+	}
+
+	s.router.ServeHTTP(w, r)
+}
+
+type provisionRequest struct {
+	ServiceID string `json:"service_id"`
+	PlanID    string `json:"plan_id"`
+}
+
+func (s *Server) getCatalog(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.catalog.public())
+}
+
+func (s *Server) provision(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["instance_id"]
+
+	var req provisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("couldn't parse request: %v", err))
+		return
+	}
+
+	if req.PlanID == "" {
+		writeError(w, http.StatusBadRequest, "plan_id is required")
+		return
+	}
+
+	service, plan, err := s.catalog.Find(req.ServiceID, req.PlanID)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	credentials, err := generateCredentials(service, plan)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	desired := &Instance{
+		ID:          id,
+		ServiceID:   service.ID,
+		PlanID:      plan.ID,
+		Credentials: credentials,
+	}
+
+	existing, err := s.store.Get(id)
+	switch {
+	case apierrors.IsNotFound(err):
+		if err := s.store.Create(desired); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, struct{}{})
+
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+
+	case existing.ServiceID == desired.ServiceID && existing.PlanID == desired.PlanID:
+		writeJSON(w, http.StatusOK, struct{}{})
+
+	default:
+		writeError(w, http.StatusConflict, fmt.Sprintf("instance %q already exists with a different service or plan", id))
+	}
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["instance_id"]
+
+	var req provisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("couldn't parse request: %v", err))
+		return
+	}
+
+	existing, err := s.store.Get(id)
+	switch {
+	case apierrors.IsNotFound(err):
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("instance %q doesn't exist", id))
+		return
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if req.PlanID == "" || req.PlanID == existing.PlanID {
+		writeJSON(w, http.StatusOK, struct{}{})
+		return
+	}
+
+	service, plan, err := s.catalog.Find(existing.ServiceID, req.PlanID)
+	switch {
+	case err != nil:
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	case !service.PlanUpdateable:
+		writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("service %q doesn't allow plan changes", service.Name))
+		return
+	}
+
+	existing.PlanID = plan.ID
+	if err := s.store.Update(existing); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (s *Server) deprovision(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["instance_id"]
+
+	switch err := s.store.Delete(id); {
+	case apierrors.IsNotFound(err):
+		writeJSON(w, http.StatusGone, struct{}{})
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	default:
+		writeJSON(w, http.StatusOK, struct{}{})
+	}
+}
+
+type bindResponse struct {
+	Credentials map[string]interface{} `json:"credentials"`
+}
+
+func (s *Server) bind(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["instance_id"]
+
+	instance, err := s.store.Get(id)
+	switch {
+	case apierrors.IsNotFound(err):
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("instance %q doesn't exist", id))
+		return
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if service, _, err := s.catalog.Find(instance.ServiceID, ""); err == nil && !service.Bindable {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("service %q isn't bindable", service.Name))
+		return
+	}
+
+	// Every binding gets the credentials of the instance, so binding is
+	// idempotent without storing bindings.
+	writeJSON(w, http.StatusCreated, bindResponse{Credentials: instance.Credentials})
+}
+
+func (s *Server) unbind(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["instance_id"]
+
+	switch _, err := s.store.Get(id); {
+	case apierrors.IsNotFound(err):
+		writeJSON(w, http.StatusGone, struct{}{})
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	default:
+		writeJSON(w, http.StatusOK, struct{}{})
+	}
+}
+
+// generateCredentials creates a random username and password for a new
+// instance and adds the static credentials of the service and plan.
+func generateCredentials(service *Service, plan *Plan) (map[string]interface{}, error) {
+	username, err := randomHex(4)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := randomHex(16)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := map[string]interface{}{
+		"username": "user-" + username,
+		"password": password,
+	}
+
+	for _, static := range []map[string]interface{}{service.Credentials, plan.Credentials} {
+		for k, v := range static {
+			credentials[k] = v
+		}
+	}
+
+	return credentials, nil
+}
+
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("couldn't generate credentials: %v", err)
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+func secureEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+type errorResponse struct {
+	Description string `json:"description"`
+}
+
+func writeError(w http.ResponseWriter, status int, description string) {
+	writeJSON(w, status, errorResponse{Description: description})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("couldn't write %s response: %v", reflect.TypeOf(body), err)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestServer(t *testing.T, options Options) http.Handler {
+	t.Helper()
+
+	catalog, err := ParseCatalog([]byte(testCatalog))
+	testutil.AssertNil(t, "err", err)
+
+	store := NewSecretStore(fake.NewSimpleClientset().CoreV1(), "broker")
+	return NewServer(catalog, store, options)
+}
+
+func doRequest(t *testing.T, h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(APIVersionHeader, "2.14")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestServer_headers(t *testing.T) {
+	t.Parallel()
+
+	h := newTestServer(t, Options{Username: "admin", Password: "secret"})
+
+	cases := map[string]struct {
+		setup      func(r *http.Request)
+		wantStatus int
+	}{
+		"missing auth": {
+			setup: func(r *http.Request) {
+				r.Header.Set(APIVersionHeader, "2.14")
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"bad password": {
+			setup: func(r *http.Request) {
+				r.Header.Set(APIVersionHeader, "2.14")
+				r.SetBasicAuth("admin", "guess")
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"missing version": {
+			setup: func(r *http.Request) {
+				r.SetBasicAuth("admin", "secret")
+			},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		"valid": {
+			setup: func(r *http.Request) {
+				r.Header.Set(APIVersionHeader, "2.14")
+				r.SetBasicAuth("admin", "secret")
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v2/catalog", nil)
+			tc.setup(req)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			testutil.AssertEqual(t, "status", tc.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestServer_catalog(t *testing.T) {
+	t.Parallel()
+
+	h := newTestServer(t, Options{})
+	w := doRequest(t, h, http.MethodGet, "/v2/catalog", "")
+
+	testutil.AssertEqual(t, "status", http.StatusOK, w.Code)
+	testutil.AssertContainsAll(t, w.Body.String(), []string{`"id":"db-id"`, `"plan_updateable":true`, `"name":"large"`})
+	if strings.Contains(w.Body.String(), "credentials") {
+		t.Errorf("catalog contains credentials: %s", w.Body.String())
+	}
+}
+
+func TestServer_lifecycle(t *testing.T) {
+	t.Parallel()
+
+	h := newTestServer(t, Options{})
+	const instance = "/v2/service_instances/inst-1"
+	const binding = instance + "/service_bindings/bind-1"
+
+	steps := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{"bind before provision", http.MethodPut, binding, `{}`, http.StatusBadRequest},
+		{"bad plan", http.MethodPut, instance, `{"service_id":"db-id","plan_id":"huge-id"}`, http.StatusBadRequest},
+		{"provision", http.MethodPut, instance, `{"service_id":"db-id","plan_id":"small-id"}`, http.StatusCreated},
+		{"provision again", http.MethodPut, instance, `{"service_id":"db-id","plan_id":"small-id"}`, http.StatusOK},
+		{"provision conflict", http.MethodPut, instance, `{"service_id":"db-id","plan_id":"large-id"}`, http.StatusConflict},
+		{"update plan", http.MethodPatch, instance, `{"service_id":"db-id","plan_id":"large-id"}`, http.StatusOK},
+		{"update bad plan", http.MethodPatch, instance, `{"service_id":"db-id","plan_id":"huge-id"}`, http.StatusBadRequest},
+		{"bind", http.MethodPut, binding, `{}`, http.StatusCreated},
+		{"unbind", http.MethodDelete, binding, "", http.StatusOK},
+		{"deprovision", http.MethodDelete, instance, "", http.StatusOK},
+		{"deprovision again", http.MethodDelete, instance, "", http.StatusGone},
+		{"unbind after deprovision", http.MethodDelete, binding, "", http.StatusGone},
+	}
+
+	for _, step := range steps {
+		w := doRequest(t, h, step.method, step.path, step.body)
+		testutil.AssertEqual(t, step.name+" status", step.wantStatus, w.Code)
+	}
+}
+
+func TestServer_bindCredentials(t *testing.T) {
+	t.Parallel()
+
+	h := newTestServer(t, Options{})
+	const instance = "/v2/service_instances/inst-1"
+
+	w := doRequest(t, h, http.MethodPut, instance, `{"service_id":"db-id","plan_id":"large-id"}`)
+	testutil.AssertEqual(t, "provision status", http.StatusCreated, w.Code)
+
+	var first, second bindResponse
+	for _, resp := range []*bindResponse{&first, &second} {
+		w := doRequest(t, h, http.MethodPut, instance+"/service_bindings/b", `{}`)
+		testutil.AssertEqual(t, "bind status", http.StatusCreated, w.Code)
+		testutil.AssertNil(t, "decode err", json.NewDecoder(w.Body).Decode(resp))
+	}
+
+	testutil.AssertEqual(t, "plan credentials override service", "db://large", first.Credentials["uri"])
+	testutil.AssertNotBlank(t, "username", first.Credentials["username"].(string))
+	testutil.AssertNotBlank(t, "password", first.Credentials["password"].(string))
+	testutil.AssertEqual(t, "stable credentials", first.Credentials, second.Credentials)
+}
@@ -0,0 +1,168 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	serviceIDKey   = "service_id"
+	planIDKey      = "plan_id"
+	credentialsKey = "credentials"
+)
+
+// Instance is a service instance provisioned by the broker.
+type Instance struct {
+	// ID is the ID Service Catalog assigned to the instance.
+	ID string
+
+	// ServiceID is the ID of the service in the catalog.
+	ServiceID string
+
+	// PlanID is the ID of the plan in the catalog.
+	PlanID string
+
+	// Credentials are given to every binding of the instance.
+	Credentials map[string]interface{}
+}
+
+// Store persists service instances.
+type Store interface {
+	// Get gets the instance with the given ID. A NotFound error is returned if
+	// it doesn't exist.
+	Get(id string) (*Instance, error)
+
+	// Create stores a new instance.
+	Create(instance *Instance) error
+
+	// Update replaces an existing instance.
+	Update(instance *Instance) error
+
+	// Delete removes an instance. A NotFound error is returned if it doesn't
+	// exist.
+	Delete(id string) error
+}
+
+type secretStore struct {
+	secrets   v1.SecretsGetter
+	namespace string
+}
+
+// NewSecretStore creates a Store that keeps each instance in a Secret in the
+// namespace.
+func NewSecretStore(secrets v1.SecretsGetter, namespace string) Store {
+	return &secretStore{
+		secrets:   secrets,
+		namespace: namespace,
+	}
+}
+
+// InstanceSecretName gets the name of the Secret the instance with the given
+// ID is stored in.
+func InstanceSecretName(id string) string {
+	return fmt.Sprintf("kf-broker-instance-%s", id)
+}
+
+// Get implements Store.
+func (s *secretStore) Get(id string) (*Instance, error) {
+	secret, err := s.secrets.
+		Secrets(s.namespace).
+		Get(InstanceSecretName(id), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	instance := &Instance{
+		ID:        id,
+		ServiceID: string(secret.Data[serviceIDKey]),
+		PlanID:    string(secret.Data[planIDKey]),
+	}
+
+	if err := json.Unmarshal(secret.Data[credentialsKey], &instance.Credentials); err != nil {
+		return nil, fmt.Errorf("couldn't read credentials of instance %q: %v", id, err)
+	}
+
+	return instance, nil
+}
+
+// Create implements Store.
+func (s *secretStore) Create(instance *Instance) error {
+	secret, err := s.toSecret(instance)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.secrets.Secrets(s.namespace).Create(secret)
+	return err
+}
+
+// Update implements Store.
+func (s *secretStore) Update(instance *Instance) error {
+	existing, err := s.secrets.
+		Secrets(s.namespace).
+		Get(InstanceSecretName(instance.ID), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	secret, err := s.toSecret(instance)
+	if err != nil {
+		return err
+	}
+
+	// Preserve the rest of the object e.g. the resource version.
+	existing = existing.DeepCopy()
+	existing.Data = secret.Data
+
+	_, err = s.secrets.Secrets(s.namespace).Update(existing)
+	return err
+}
+
+// Delete implements Store.
+func (s *secretStore) Delete(id string) error {
+	return s.secrets.
+		Secrets(s.namespace).
+		Delete(InstanceSecretName(id), &metav1.DeleteOptions{})
+}
+
+func (s *secretStore) toSecret(instance *Instance) (*corev1.Secret, error) {
+	credentials, err := json.Marshal(instance.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't encode credentials of instance %q: %v", instance.ID, err)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      InstanceSecretName(instance.ID),
+			Namespace: s.namespace,
+			Labels: map[string]string{
+				v1alpha1.ManagedByLabel: "kf",
+				v1alpha1.ComponentLabel: "broker-instance",
+			},
+		},
+		Data: map[string][]byte{
+			serviceIDKey:   []byte(instance.ServiceID),
+			planIDKey:      []byte(instance.PlanID),
+			credentialsKey: credentials,
+		},
+	}, nil
+}
@@ -30,6 +30,22 @@ func (c *FakeKfV1alpha1) Apps(namespace string) v1alpha1.AppInterface {
 	return &FakeApps{c, namespace}
 }
 
+func (c *FakeKfV1alpha1) BuildpackCatalogs() v1alpha1.BuildpackCatalogInterface {
+	return &FakeBuildpackCatalogs{c}
+}
+
+func (c *FakeKfV1alpha1) Organizations() v1alpha1.OrganizationInterface {
+	return &FakeOrganizations{c}
+}
+
+func (c *FakeKfV1alpha1) PlacementProfiles() v1alpha1.PlacementProfileInterface {
+	return &FakePlacementProfiles{c}
+}
+
+func (c *FakeKfV1alpha1) QuotaPlans() v1alpha1.QuotaPlanInterface {
+	return &FakeQuotaPlans{c}
+}
+
 func (c *FakeKfV1alpha1) Routes(namespace string) v1alpha1.RouteInterface {
 	return &FakeRoutes{c, namespace}
 }
@@ -38,10 +54,26 @@ func (c *FakeKfV1alpha1) RouteClaims(namespace string) v1alpha1.RouteClaimInterf
 	return &FakeRouteClaims{c, namespace}
 }
 
+func (c *FakeKfV1alpha1) SecurityGroups() v1alpha1.SecurityGroupInterface {
+	return &FakeSecurityGroups{c}
+}
+
+func (c *FakeKfV1alpha1) ServiceInstanceShares(namespace string) v1alpha1.ServiceInstanceShareInterface {
+	return &FakeServiceInstanceShares{c, namespace}
+}
+
+func (c *FakeKfV1alpha1) ServicePlanVisibilities() v1alpha1.ServicePlanVisibilityInterface {
+	return &FakeServicePlanVisibilities{c}
+}
+
 func (c *FakeKfV1alpha1) Sources(namespace string) v1alpha1.SourceInterface {
 	return &FakeSources{c, namespace}
 }
 
+func (c *FakeKfV1alpha1) SpaceTemplates() v1alpha1.SpaceTemplateInterface {
+	return &FakeSpaceTemplates{c}
+}
+
 func (c *FakeKfV1alpha1) Spaces() v1alpha1.SpaceInterface {
 	return &FakeSpaces{c}
 }
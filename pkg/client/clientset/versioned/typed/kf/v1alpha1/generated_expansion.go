@@ -18,10 +18,26 @@ package v1alpha1
 
 type AppExpansion interface{}
 
+type BuildpackCatalogExpansion interface{}
+
+type OrganizationExpansion interface{}
+
+type PlacementProfileExpansion interface{}
+
+type QuotaPlanExpansion interface{}
+
 type RouteExpansion interface{}
 
 type RouteClaimExpansion interface{}
 
+type SecurityGroupExpansion interface{}
+
+type ServiceInstanceShareExpansion interface{}
+
+type ServicePlanVisibilityExpansion interface{}
+
 type SourceExpansion interface{}
 
 type SpaceExpansion interface{}
+
+type SpaceTemplateExpansion interface{}
@@ -26,10 +26,18 @@ import (
 type KfV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AppsGetter
+	BuildpackCatalogsGetter
+	OrganizationsGetter
+	PlacementProfilesGetter
+	QuotaPlansGetter
 	RoutesGetter
 	RouteClaimsGetter
+	SecurityGroupsGetter
+	ServiceInstanceSharesGetter
+	ServicePlanVisibilitiesGetter
 	SourcesGetter
 	SpacesGetter
+	SpaceTemplatesGetter
 }
 
 // KfV1alpha1Client is used to interact with features provided by the kf.dev group.
@@ -41,6 +49,22 @@ func (c *KfV1alpha1Client) Apps(namespace string) AppInterface {
 	return newApps(c, namespace)
 }
 
+func (c *KfV1alpha1Client) BuildpackCatalogs() BuildpackCatalogInterface {
+	return newBuildpackCatalogs(c)
+}
+
+func (c *KfV1alpha1Client) Organizations() OrganizationInterface {
+	return newOrganizations(c)
+}
+
+func (c *KfV1alpha1Client) PlacementProfiles() PlacementProfileInterface {
+	return newPlacementProfiles(c)
+}
+
+func (c *KfV1alpha1Client) QuotaPlans() QuotaPlanInterface {
+	return newQuotaPlans(c)
+}
+
 func (c *KfV1alpha1Client) Routes(namespace string) RouteInterface {
 	return newRoutes(c, namespace)
 }
@@ -49,10 +73,26 @@ func (c *KfV1alpha1Client) RouteClaims(namespace string) RouteClaimInterface {
 	return newRouteClaims(c, namespace)
 }
 
+func (c *KfV1alpha1Client) SecurityGroups() SecurityGroupInterface {
+	return newSecurityGroups(c)
+}
+
+func (c *KfV1alpha1Client) ServiceInstanceShares(namespace string) ServiceInstanceShareInterface {
+	return newServiceInstanceShares(c, namespace)
+}
+
+func (c *KfV1alpha1Client) ServicePlanVisibilities() ServicePlanVisibilityInterface {
+	return newServicePlanVisibilities(c)
+}
+
 func (c *KfV1alpha1Client) Sources(namespace string) SourceInterface {
 	return newSources(c, namespace)
 }
 
+func (c *KfV1alpha1Client) SpaceTemplates() SpaceTemplateInterface {
+	return newSpaceTemplates(c)
+}
+
 func (c *KfV1alpha1Client) Spaces() SpaceInterface {
 	return newSpaces(c)
 }
@@ -53,15 +53,32 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=kf.dev, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("apps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().Apps().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("buildpackcatalogs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().BuildpackCatalogs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("organizations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().Organizations().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("placementprofiles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().PlacementProfiles().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("quotaplans"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().QuotaPlans().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("routes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().Routes().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("routeclaims"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().RouteClaims().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("securitygroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().SecurityGroups().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("serviceinstanceshares"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().ServiceInstanceShares().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("serviceplanvisibilities"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().ServicePlanVisibilities().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("sources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().Sources().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("spaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().Spaces().Informer()}, nil
 
+	case v1alpha1.SchemeGroupVersion.WithResource("spacetemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kf().V1alpha1().SpaceTemplates().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -24,12 +24,28 @@ import (
 type Interface interface {
 	// Apps returns a AppInformer.
 	Apps() AppInformer
+	// BuildpackCatalogs returns a BuildpackCatalogInformer.
+	BuildpackCatalogs() BuildpackCatalogInformer
+	// Organizations returns a OrganizationInformer.
+	Organizations() OrganizationInformer
+	// PlacementProfiles returns a PlacementProfileInformer.
+	PlacementProfiles() PlacementProfileInformer
+	// QuotaPlans returns a QuotaPlanInformer.
+	QuotaPlans() QuotaPlanInformer
 	// Routes returns a RouteInformer.
 	Routes() RouteInformer
 	// RouteClaims returns a RouteClaimInformer.
 	RouteClaims() RouteClaimInformer
+	// SecurityGroups returns a SecurityGroupInformer.
+	SecurityGroups() SecurityGroupInformer
+	// ServiceInstanceShares returns a ServiceInstanceShareInformer.
+	ServiceInstanceShares() ServiceInstanceShareInformer
+	// ServicePlanVisibilities returns a ServicePlanVisibilityInformer.
+	ServicePlanVisibilities() ServicePlanVisibilityInformer
 	// Sources returns a SourceInformer.
 	Sources() SourceInformer
+	// SpaceTemplates returns a SpaceTemplateInformer.
+	SpaceTemplates() SpaceTemplateInformer
 	// Spaces returns a SpaceInformer.
 	Spaces() SpaceInformer
 }
@@ -50,6 +66,26 @@ func (v *version) Apps() AppInformer {
 	return &appInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BuildpackCatalogs returns a BuildpackCatalogInformer.
+func (v *version) BuildpackCatalogs() BuildpackCatalogInformer {
+	return &buildpackCatalogInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Organizations returns a OrganizationInformer.
+func (v *version) Organizations() OrganizationInformer {
+	return &organizationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// PlacementProfiles returns a PlacementProfileInformer.
+func (v *version) PlacementProfiles() PlacementProfileInformer {
+	return &placementProfileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// QuotaPlans returns a QuotaPlanInformer.
+func (v *version) QuotaPlans() QuotaPlanInformer {
+	return &quotaPlanInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Routes returns a RouteInformer.
 func (v *version) Routes() RouteInformer {
 	return &routeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -60,11 +96,31 @@ func (v *version) RouteClaims() RouteClaimInformer {
 	return &routeClaimInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// SecurityGroups returns a SecurityGroupInformer.
+func (v *version) SecurityGroups() SecurityGroupInformer {
+	return &securityGroupInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ServiceInstanceShares returns a ServiceInstanceShareInformer.
+func (v *version) ServiceInstanceShares() ServiceInstanceShareInformer {
+	return &serviceInstanceShareInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ServicePlanVisibilities returns a ServicePlanVisibilityInformer.
+func (v *version) ServicePlanVisibilities() ServicePlanVisibilityInformer {
+	return &servicePlanVisibilityInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Sources returns a SourceInformer.
 func (v *version) Sources() SourceInformer {
 	return &sourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// SpaceTemplates returns a SpaceTemplateInformer.
+func (v *version) SpaceTemplates() SpaceTemplateInformer {
+	return &spaceTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Spaces returns a SpaceInformer.
 func (v *version) Spaces() SpaceInformer {
 	return &spaceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -24,6 +24,22 @@ type AppListerExpansion interface{}
 // AppNamespaceLister.
 type AppNamespaceListerExpansion interface{}
 
+// BuildpackCatalogListerExpansion allows custom methods to be added to
+// BuildpackCatalogLister.
+type BuildpackCatalogListerExpansion interface{}
+
+// OrganizationListerExpansion allows custom methods to be added to
+// OrganizationLister.
+type OrganizationListerExpansion interface{}
+
+// PlacementProfileListerExpansion allows custom methods to be added to
+// PlacementProfileLister.
+type PlacementProfileListerExpansion interface{}
+
+// QuotaPlanListerExpansion allows custom methods to be added to
+// QuotaPlanLister.
+type QuotaPlanListerExpansion interface{}
+
 // RouteListerExpansion allows custom methods to be added to
 // RouteLister.
 type RouteListerExpansion interface{}
@@ -40,6 +56,22 @@ type RouteClaimListerExpansion interface{}
 // RouteClaimNamespaceLister.
 type RouteClaimNamespaceListerExpansion interface{}
 
+// SecurityGroupListerExpansion allows custom methods to be added to
+// SecurityGroupLister.
+type SecurityGroupListerExpansion interface{}
+
+// ServiceInstanceShareListerExpansion allows custom methods to be added to
+// ServiceInstanceShareLister.
+type ServiceInstanceShareListerExpansion interface{}
+
+// ServiceInstanceShareNamespaceListerExpansion allows custom methods to be added to
+// ServiceInstanceShareNamespaceLister.
+type ServiceInstanceShareNamespaceListerExpansion interface{}
+
+// ServicePlanVisibilityListerExpansion allows custom methods to be added to
+// ServicePlanVisibilityLister.
+type ServicePlanVisibilityListerExpansion interface{}
+
 // SourceListerExpansion allows custom methods to be added to
 // SourceLister.
 type SourceListerExpansion interface{}
@@ -51,3 +83,7 @@ type SourceNamespaceListerExpansion interface{}
 // SpaceListerExpansion allows custom methods to be added to
 // SpaceLister.
 type SpaceListerExpansion interface{}
+
+// SpaceTemplateListerExpansion allows custom methods to be added to
+// SpaceTemplateLister.
+type SpaceTemplateListerExpansion interface{}
@@ -15,6 +15,7 @@
 package apps
 
 import (
+	"fmt"
 	"io"
 
 	v1alpha1 "github.com/google/kf/pkg/apis/kf/v1alpha1"
@@ -29,6 +30,7 @@ type ClientExtension interface {
 	DeployLogs(out io.Writer, appName, resourceVersion, namespace string, noStart bool) error
 	Restart(namespace, name string) error
 	Restage(namespace, name string) (*v1alpha1.App, error)
+	Rebase(namespace, name, rebasedImage string) (*v1alpha1.App, error)
 	BindService(namespace, name string, binding *v1alpha1.AppSpecServiceBinding) (*v1alpha1.App, error)
 	UnbindService(namespace, name, bindingName string) (*v1alpha1.App, error)
 }
@@ -83,9 +85,28 @@ func (ac *appsClient) Restage(namespace, name string) (app *v1alpha1.App, err er
 
 	app.Spec.Source.UpdateRequests++
 
+	// A restage always runs the full build, even if the last source was
+	// produced by a rebase.
+	app.Spec.Source.BuildpackBuild.RebasedImage = ""
+
 	return ac.coreClient.Update(namespace, app)
 }
 
+// Rebase records an image that was rebased onto a newer stack as a new
+// Source for the app. The controller will deploy the image without running
+// detect or build.
+func (ac *appsClient) Rebase(namespace, name, rebasedImage string) (*v1alpha1.App, error) {
+	return ac.coreClient.Transform(namespace, name, func(app *v1alpha1.App) error {
+		if !app.Spec.Source.IsBuildpackBuild() {
+			return fmt.Errorf("app %q was not built with buildpacks and can't be rebased", name)
+		}
+
+		app.Spec.Source.UpdateRequests++
+		app.Spec.Source.BuildpackBuild.RebasedImage = rebasedImage
+		return nil
+	})
+}
+
 // BindService adds the given service binding to the app.
 func (ac *appsClient) BindService(namespace, name string, binding *v1alpha1.AppSpecServiceBinding) (app *v1alpha1.App, err error) {
 	return ac.coreClient.Transform(namespace, name, func(app *v1alpha1.App) error {
@@ -174,6 +174,21 @@ func (mr *FakeClientMockRecorder) List(arg0 interface{}, arg1 ...interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*FakeClient)(nil).List), varargs...)
 }
 
+// Rebase mocks base method
+func (m *FakeClient) Rebase(arg0, arg1, arg2 string) (*v1alpha1.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebase", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1alpha1.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rebase indicates an expected call of Rebase
+func (mr *FakeClientMockRecorder) Rebase(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebase", reflect.TypeOf((*FakeClient)(nil).Rebase), arg0, arg1, arg2)
+}
+
 // Restage mocks base method
 func (m *FakeClient) Restage(arg0, arg1 string) (*v1alpha1.App, error) {
 	m.ctrl.T.Helper()
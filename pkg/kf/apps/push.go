@@ -64,6 +64,11 @@ func newApp(appName string, opts ...PushOption) (*v1alpha1.App, error) {
 	case cfg.DockerfilePath != "":
 		src.SetDockerfilePath(cfg.DockerfilePath)
 		src.SetDockerfileSource(cfg.SourceImage)
+		src.SetDockerfileTarget(cfg.DockerfileTarget)
+		src.SetDockerfileSecrets(cfg.DockerfileSecrets)
+		if len(cfg.DockerfileBuildArgs) > 0 {
+			src.SetDockerfileBuildArgs(envutil.MapToEnvVars(cfg.DockerfileBuildArgs))
+		}
 
 	default: // default to buildpack build
 		src.SetBuildpackBuildEnv(envs)
@@ -36,8 +36,14 @@ type pushConfig struct {
 	ContainerImage string
 	// DefaultRouteDomain is Domain for a defaultroute. Only used if a route doesn't already exist
 	DefaultRouteDomain string
+	// DockerfileBuildArgs is build arguments for the Dockerfile
+	DockerfileBuildArgs map[string]string
 	// DockerfilePath is the path to a Dockerfile to build
 	DockerfilePath string
+	// DockerfileSecrets is names of Secrets to mount while building the Dockerfile
+	DockerfileSecrets []string
+	// DockerfileTarget is the stage of a multi-stage Dockerfile to build
+	DockerfileTarget string
 	// EnvironmentVariables is set environment variables
 	EnvironmentVariables map[string]string
 	// Grpc is setup the ports for the container to allow gRPC to work
@@ -124,12 +130,30 @@ func (opts PushOptions) DefaultRouteDomain() string {
 	return opts.toConfig().DefaultRouteDomain
 }
 
+// DockerfileBuildArgs returns the last set value for DockerfileBuildArgs or the empty value
+// if not set.
+func (opts PushOptions) DockerfileBuildArgs() map[string]string {
+	return opts.toConfig().DockerfileBuildArgs
+}
+
 // DockerfilePath returns the last set value for DockerfilePath or the empty value
 // if not set.
 func (opts PushOptions) DockerfilePath() string {
 	return opts.toConfig().DockerfilePath
 }
 
+// DockerfileSecrets returns the last set value for DockerfileSecrets or the empty value
+// if not set.
+func (opts PushOptions) DockerfileSecrets() []string {
+	return opts.toConfig().DockerfileSecrets
+}
+
+// DockerfileTarget returns the last set value for DockerfileTarget or the empty value
+// if not set.
+func (opts PushOptions) DockerfileTarget() string {
+	return opts.toConfig().DockerfileTarget
+}
+
 // EnvironmentVariables returns the last set value for EnvironmentVariables or the empty value
 // if not set.
 func (opts PushOptions) EnvironmentVariables() map[string]string {
@@ -238,6 +262,13 @@ func WithPushDefaultRouteDomain(val string) PushOption {
 	}
 }
 
+// WithPushDockerfileBuildArgs creates an Option that sets build arguments for the Dockerfile
+func WithPushDockerfileBuildArgs(val map[string]string) PushOption {
+	return func(cfg *pushConfig) {
+		cfg.DockerfileBuildArgs = val
+	}
+}
+
 // WithPushDockerfilePath creates an Option that sets the path to a Dockerfile to build
 func WithPushDockerfilePath(val string) PushOption {
 	return func(cfg *pushConfig) {
@@ -245,6 +276,20 @@ func WithPushDockerfilePath(val string) PushOption {
 	}
 }
 
+// WithPushDockerfileSecrets creates an Option that sets names of Secrets to mount while building the Dockerfile
+func WithPushDockerfileSecrets(val []string) PushOption {
+	return func(cfg *pushConfig) {
+		cfg.DockerfileSecrets = val
+	}
+}
+
+// WithPushDockerfileTarget creates an Option that sets the stage of a multi-stage Dockerfile to build
+func WithPushDockerfileTarget(val string) PushOption {
+	return func(cfg *pushConfig) {
+		cfg.DockerfileTarget = val
+	}
+}
+
 // WithPushEnvironmentVariables creates an Option that sets set environment variables
 func WithPushEnvironmentVariables(val map[string]string) PushOption {
 	return func(cfg *pushConfig) {
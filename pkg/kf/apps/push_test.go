@@ -323,6 +323,9 @@ func TestPush(t *testing.T) {
 			opts: apps.PushOptions{
 				apps.WithPushSourceImage("some-image"),
 				apps.WithPushDockerfilePath("path/to/Dockerfile"),
+				apps.WithPushDockerfileBuildArgs(map[string]string{"VERSION": "1.2.3"}),
+				apps.WithPushDockerfileTarget("release"),
+				apps.WithPushDockerfileSecrets([]string{"npmrc"}),
 			},
 			setup: func(t *testing.T, appsClient *appsfake.FakeClient) {
 				appsClient.EXPECT().
@@ -330,6 +333,9 @@ func TestPush(t *testing.T) {
 					Do(func(namespace string, newApp *v1alpha1.App, merge apps.Merger) {
 						testutil.AssertEqual(t, "Dockerfile.Source", "some-image", newApp.Spec.Source.Dockerfile.Source)
 						testutil.AssertEqual(t, "Dockerfile.Path", "path/to/Dockerfile", newApp.Spec.Source.Dockerfile.Path)
+						testutil.AssertEqual(t, "Dockerfile.BuildArgs", []corev1.EnvVar{{Name: "VERSION", Value: "1.2.3"}}, newApp.Spec.Source.Dockerfile.BuildArgs)
+						testutil.AssertEqual(t, "Dockerfile.Target", "release", newApp.Spec.Source.Dockerfile.Target)
+						testutil.AssertEqual(t, "Dockerfile.Secrets", []string{"npmrc"}, newApp.Spec.Source.Dockerfile.Secrets)
 					}).
 					Return(&v1alpha1.App{}, nil)
 			},
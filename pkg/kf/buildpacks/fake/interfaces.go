@@ -17,8 +17,20 @@ package fake
 import "github.com/google/kf/pkg/kf/buildpacks"
 
 //go:generate mockgen --package=fake --copyright_file ../../internal/tools/option-builder/LICENSE_HEADER --destination=fake_client.go --mock_names=Client=FakeClient github.com/google/kf/pkg/kf/buildpacks/fake Client
+//go:generate mockgen --package=fake --copyright_file ../../internal/tools/option-builder/LICENSE_HEADER --destination=fake_rebaser.go --mock_names=Rebaser=FakeRebaser github.com/google/kf/pkg/kf/buildpacks/fake Rebaser
+//go:generate mockgen --package=fake --copyright_file ../../internal/tools/option-builder/LICENSE_HEADER --destination=fake_sbom_fetcher.go --mock_names=SBOMFetcher=FakeSBOMFetcher github.com/google/kf/pkg/kf/buildpacks/fake SBOMFetcher
 
 // Client is implemented by buildpacks.Client.
 type Client interface {
 	buildpacks.Client
 }
+
+// Rebaser is implemented by buildpacks.Rebaser.
+type Rebaser interface {
+	buildpacks.Rebaser
+}
+
+// SBOMFetcher is implemented by buildpacks.SBOMFetcher.
+type SBOMFetcher interface {
+	buildpacks.SBOMFetcher
+}
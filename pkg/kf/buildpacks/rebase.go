@@ -0,0 +1,248 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildpacks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// LifecycleMetadataLabel is the label the buildpack lifecycle writes to
+// exported images to describe how they were built.
+const LifecycleMetadataLabel = "io.buildpacks.lifecycle.metadata"
+
+// StackIDLabel is the label builders and run images use to identify the
+// stack they belong to.
+const StackIDLabel = "io.buildpacks.stack.id"
+
+// ErrUpToDate is returned by Rebase if the image is already based on the
+// latest run image.
+var ErrUpToDate = errors.New("image is already based on the latest run image")
+
+// Rebaser swaps the run image layers of buildpack built images.
+type Rebaser interface {
+	// Rebase replaces the run image layers of image with the layers of
+	// runImage and writes the result to destination. If runImage is blank,
+	// the run image recorded by the lifecycle is used. The digest reference of
+	// the rebased image is returned.
+	Rebase(image, runImage, destination string) (string, error)
+}
+
+// RemoteImageWriter is implemented by
+// github.com/google/go-containerregistry/pkg/v1/remote.Write
+type RemoteImageWriter func(ref name.Reference, img gcrv1.Image, auth authn.Authenticator, t http.RoundTripper) error
+
+type rebaser struct {
+	imageFetcher RemoteImageFetcher
+	imageWriter  RemoteImageWriter
+	keychain     authn.Keychain
+}
+
+// NewRebaser creates a new Rebaser that uses the local docker credentials to
+// read and write images.
+func NewRebaser(
+	imageFetcher RemoteImageFetcher,
+	imageWriter RemoteImageWriter,
+) Rebaser {
+	return &rebaser{
+		imageFetcher: imageFetcher,
+		imageWriter:  imageWriter,
+		keychain:     authn.DefaultKeychain,
+	}
+}
+
+// lifecycleRunImage holds the run image the lifecycle exported an image on
+// top of.
+type lifecycleRunImage struct {
+	TopLayer  string `json:"topLayer"`
+	Reference string `json:"reference"`
+}
+
+// lifecycleStack holds the stack information the lifecycle recorded.
+type lifecycleStack struct {
+	RunImage struct {
+		Image string `json:"image"`
+	} `json:"runImage"`
+}
+
+// Rebase implements Rebaser.
+func (r *rebaser) Rebase(image, runImage, destination string) (string, error) {
+	orig, err := r.fetch(image)
+	if err != nil {
+		return "", err
+	}
+
+	cfg, err := orig.ConfigFile()
+	if err != nil {
+		return "", err
+	}
+
+	// Keep the fields we don't understand so the label survives the rebase
+	// intact.
+	metadata := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(cfg.Config.Labels[LifecycleMetadataLabel]), &metadata); err != nil {
+		return "", fmt.Errorf("image %q wasn't built by buildpacks: %s", image, err)
+	}
+
+	var oldRunImage lifecycleRunImage
+	if err := json.Unmarshal(metadata["runImage"], &oldRunImage); err != nil || oldRunImage.Reference == "" {
+		return "", fmt.Errorf("image %q doesn't record its run image", image)
+	}
+
+	if runImage == "" {
+		var stack lifecycleStack
+		if err := json.Unmarshal(metadata["stack"], &stack); err != nil || stack.RunImage.Image == "" {
+			return "", fmt.Errorf("image %q doesn't record its stack, a run image must be supplied", image)
+		}
+		runImage = stack.RunImage.Image
+	}
+
+	oldBase, err := r.fetch(oldRunImage.Reference)
+	if err != nil {
+		return "", err
+	}
+
+	newBaseRef, err := name.ParseReference(runImage, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	newBase, err := r.fetch(runImage)
+	if err != nil {
+		return "", err
+	}
+
+	newBaseCfg, err := newBase.ConfigFile()
+	if err != nil {
+		return "", err
+	}
+
+	// Buildpack layers are only guaranteed to work on the stack they were
+	// built for.
+	oldStack := cfg.Config.Labels[StackIDLabel]
+	newStack := newBaseCfg.Config.Labels[StackIDLabel]
+	if oldStack != "" && newStack != "" && oldStack != newStack {
+		return "", fmt.Errorf("image %q was built for stack %q but run image %q is for stack %q", image, oldStack, runImage, newStack)
+	}
+
+	oldDigest, err := oldBase.Digest()
+	if err != nil {
+		return "", err
+	}
+
+	newDigest, err := newBase.Digest()
+	if err != nil {
+		return "", err
+	}
+
+	if oldDigest == newDigest {
+		return "", ErrUpToDate
+	}
+
+	rebased, err := mutate.Rebase(orig, oldBase, newBase)
+	if err != nil {
+		return "", err
+	}
+
+	// Record the new run image so future rebases start from the right base.
+	newLayers, err := newBase.Layers()
+	if err != nil {
+		return "", err
+	}
+	if len(newLayers) == 0 {
+		return "", fmt.Errorf("run image %q has no layers", runImage)
+	}
+	topLayer, err := newLayers[len(newLayers)-1].DiffID()
+	if err != nil {
+		return "", err
+	}
+
+	metadata["runImage"], err = json.Marshal(lifecycleRunImage{
+		TopLayer:  topLayer.String(),
+		Reference: fmt.Sprintf("%s@%s", newBaseRef.Context().Name(), newDigest),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	rebasedCfg, err := rebased.ConfigFile()
+	if err != nil {
+		return "", err
+	}
+
+	labelValue, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+
+	labels := make(map[string]string)
+	for k, v := range rebasedCfg.Config.Labels {
+		labels[k] = v
+	}
+	labels[LifecycleMetadataLabel] = string(labelValue)
+	rebasedCfg.Config.Labels = labels
+
+	rebased, err = mutate.Config(rebased, rebasedCfg.Config)
+	if err != nil {
+		return "", err
+	}
+
+	return r.write(rebased, destination)
+}
+
+func (r *rebaser) fetch(image string) (gcrv1.Image, error) {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := r.keychain.Resolve(ref.Context().Registry)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.imageFetcher(ref, remote.WithAuth(auth))
+}
+
+func (r *rebaser) write(image gcrv1.Image, destination string) (string, error) {
+	ref, err := name.ParseReference(destination, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	auth, err := r.keychain.Resolve(ref.Context().Registry)
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.imageWriter(ref, image, auth, http.DefaultTransport); err != nil {
+		return "", err
+	}
+
+	digest, err := image.Digest()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s@%s", ref.Context().Name(), digest), nil
+}
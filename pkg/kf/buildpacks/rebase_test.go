@@ -0,0 +1,254 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildpacks_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/kf/pkg/kf/buildpacks"
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+const (
+	bionicStack = "io.buildpacks.stacks.bionic"
+	oldRunRepo  = "gcr.io/some-project/run-old"
+	newRunRepo  = "gcr.io/some-project/run"
+	appRepo     = "gcr.io/some-project/app"
+)
+
+func TestRebaser_Rebase(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		// labels returns the labels of the app image given the digest
+		// reference of the run image it was built on.
+		labels       func(oldRunRef string) map[string]string
+		newRunStack  string
+		runImage     string
+		wantErr      error
+		assertOutput func(t *testing.T, oldRunRef string, newRun gcrv1.Image, written gcrv1.Image, out string)
+	}{
+		"missing lifecycle label": {
+			labels: func(string) map[string]string {
+				return map[string]string{"some-label": "some-value"}
+			},
+			runImage: newRunRepo,
+			wantErr:  errors.New(`image "gcr.io/some-project/app" wasn't built by buildpacks: unexpected end of JSON input`),
+		},
+		"missing run image metadata": {
+			labels: func(string) map[string]string {
+				return map[string]string{
+					buildpacks.LifecycleMetadataLabel: `{"buildpacks":[]}`,
+				}
+			},
+			runImage: newRunRepo,
+			wantErr:  errors.New(`image "gcr.io/some-project/app" doesn't record its run image`),
+		},
+		"missing stack metadata without run image": {
+			labels: func(oldRunRef string) map[string]string {
+				return map[string]string{
+					buildpacks.LifecycleMetadataLabel: fmt.Sprintf(`{"runImage":{"reference":%q}}`, oldRunRef),
+				}
+			},
+			wantErr: errors.New(`image "gcr.io/some-project/app" doesn't record its stack, a run image must be supplied`),
+		},
+		"stack mismatch": {
+			labels:      lifecycleLabels,
+			newRunStack: "io.buildpacks.stacks.other",
+			runImage:    newRunRepo,
+			wantErr:     errors.New(`image "gcr.io/some-project/app" was built for stack "io.buildpacks.stacks.bionic" but run image "gcr.io/some-project/run" is for stack "io.buildpacks.stacks.other"`),
+		},
+		"already up to date": {
+			labels:   lifecycleLabels,
+			runImage: oldRunRepo,
+			wantErr:  buildpacks.ErrUpToDate,
+		},
+		"rewrites run image metadata": {
+			labels:   lifecycleLabels,
+			runImage: newRunRepo,
+			assertOutput: func(t *testing.T, oldRunRef string, newRun, written gcrv1.Image, out string) {
+				newDigest, err := newRun.Digest()
+				testutil.AssertNil(t, "new run digest err", err)
+				newLayers, err := newRun.Layers()
+				testutil.AssertNil(t, "new run layers err", err)
+				topLayer, err := newLayers[len(newLayers)-1].DiffID()
+				testutil.AssertNil(t, "top layer err", err)
+
+				cfg, err := written.ConfigFile()
+				testutil.AssertNil(t, "config err", err)
+
+				var metadata struct {
+					RunImage struct {
+						TopLayer  string `json:"topLayer"`
+						Reference string `json:"reference"`
+					} `json:"runImage"`
+					Buildpacks []map[string]string `json:"buildpacks"`
+				}
+				err = json.Unmarshal([]byte(cfg.Config.Labels[buildpacks.LifecycleMetadataLabel]), &metadata)
+				testutil.AssertNil(t, "unmarshal err", err)
+
+				testutil.AssertEqual(t, "run image reference", newRunRepo+"@"+newDigest.String(), metadata.RunImage.Reference)
+				testutil.AssertEqual(t, "run image top layer", topLayer.String(), metadata.RunImage.TopLayer)
+				testutil.AssertEqual(t, "unknown fields", []map[string]string{{"key": "some-buildpack"}}, metadata.Buildpacks)
+				testutil.AssertEqual(t, "other labels", "some-value", cfg.Config.Labels["some-label"])
+
+				writtenLayers, err := written.Layers()
+				testutil.AssertNil(t, "written layers err", err)
+				writtenBase, err := writtenLayers[0].Digest()
+				testutil.AssertNil(t, "written base err", err)
+				newBase, err := newLayers[0].Digest()
+				testutil.AssertNil(t, "new base err", err)
+				testutil.AssertEqual(t, "base layer", newBase, writtenBase)
+
+				writtenDigest, err := written.Digest()
+				testutil.AssertNil(t, "written digest err", err)
+				testutil.AssertEqual(t, "output", appRepo+"-rebased@"+writtenDigest.String(), out)
+			},
+		},
+		"defaults to the stack run image": {
+			labels: lifecycleLabels,
+			assertOutput: func(t *testing.T, oldRunRef string, newRun, written gcrv1.Image, out string) {
+				newDigest, err := newRun.Digest()
+				testutil.AssertNil(t, "new run digest err", err)
+
+				cfg, err := written.ConfigFile()
+				testutil.AssertNil(t, "config err", err)
+				testutil.AssertContainsAll(t, cfg.Config.Labels[buildpacks.LifecycleMetadataLabel], []string{
+					newRunRepo + "@" + newDigest.String(),
+				})
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			oldRun := randomImage(t, map[string]string{buildpacks.StackIDLabel: bionicStack})
+			newRunStack := tc.newRunStack
+			if newRunStack == "" {
+				newRunStack = bionicStack
+			}
+			newRun := randomImage(t, map[string]string{buildpacks.StackIDLabel: newRunStack})
+
+			oldRunDigest, err := oldRun.Digest()
+			testutil.AssertNil(t, "old run digest err", err)
+			oldRunRef := oldRunRepo + "@" + oldRunDigest.String()
+
+			app := appImage(t, oldRun, tc.labels(oldRunRef))
+
+			images := map[string]gcrv1.Image{
+				oldRunRepo: oldRun,
+				newRunRepo: newRun,
+				appRepo:    app,
+			}
+
+			fetcher := func(ref name.Reference, options ...remote.ImageOption) (gcrv1.Image, error) {
+				img, ok := images[ref.Context().Name()]
+				if !ok {
+					return nil, fmt.Errorf("unexpected image %q", ref.Name())
+				}
+				return fakeImageFrom(ctrl, img), nil
+			}
+
+			var written gcrv1.Image
+			writer := func(_ name.Reference, img gcrv1.Image, _ authn.Authenticator, _ http.RoundTripper) error {
+				written = img
+				return nil
+			}
+
+			out, err := buildpacks.NewRebaser(fetcher, writer).Rebase(appRepo, tc.runImage, appRepo+"-rebased")
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, err)
+				testutil.AssertEqual(t, "written", true, written == nil)
+				return
+			}
+
+			testutil.AssertNil(t, "error", err)
+			tc.assertOutput(t, oldRunRef, newRun, written, out)
+		})
+	}
+}
+
+// lifecycleLabels returns the labels the lifecycle writes to an image built
+// on the bionic stack.
+func lifecycleLabels(oldRunRef string) map[string]string {
+	return map[string]string{
+		buildpacks.StackIDLabel: bionicStack,
+		buildpacks.LifecycleMetadataLabel: fmt.Sprintf(
+			`{"runImage":{"topLayer":"sha256:old","reference":%q},"stack":{"runImage":{"image":%q}},"buildpacks":[{"key":"some-buildpack"}]}`,
+			oldRunRef,
+			newRunRepo,
+		),
+		"some-label": "some-value",
+	}
+}
+
+// randomImage returns a single layer image with the given labels.
+func randomImage(t *testing.T, labels map[string]string) gcrv1.Image {
+	t.Helper()
+
+	img, err := random.Image(1024, 1)
+	testutil.AssertNil(t, "random image err", err)
+
+	img, err = mutate.Config(img, gcrv1.Config{Labels: labels})
+	testutil.AssertNil(t, "config err", err)
+
+	return img
+}
+
+// appImage returns an image with a random app layer on top of base.
+func appImage(t *testing.T, base gcrv1.Image, labels map[string]string) gcrv1.Image {
+	t.Helper()
+
+	appLayers, err := randomImage(t, nil).Layers()
+	testutil.AssertNil(t, "app layers err", err)
+
+	img, err := mutate.AppendLayers(base, appLayers...)
+	testutil.AssertNil(t, "append err", err)
+
+	img, err = mutate.Config(img, gcrv1.Config{Labels: labels})
+	testutil.AssertNil(t, "config err", err)
+
+	return img
+}
+
+// fakeImageFrom returns a FakeImage that serves the contents of img.
+func fakeImageFrom(ctrl *gomock.Controller, img gcrv1.Image) *FakeImage {
+	fake := NewFakeImage(ctrl)
+	fake.EXPECT().ConfigFile().DoAndReturn(img.ConfigFile).AnyTimes()
+	fake.EXPECT().ConfigName().DoAndReturn(img.ConfigName).AnyTimes()
+	fake.EXPECT().Digest().DoAndReturn(img.Digest).AnyTimes()
+	fake.EXPECT().LayerByDiffID(gomock.Any()).DoAndReturn(img.LayerByDiffID).AnyTimes()
+	fake.EXPECT().LayerByDigest(gomock.Any()).DoAndReturn(img.LayerByDigest).AnyTimes()
+	fake.EXPECT().Layers().DoAndReturn(img.Layers).AnyTimes()
+	fake.EXPECT().Manifest().DoAndReturn(img.Manifest).AnyTimes()
+	fake.EXPECT().MediaType().DoAndReturn(img.MediaType).AnyTimes()
+	fake.EXPECT().RawConfigFile().DoAndReturn(img.RawConfigFile).AnyTimes()
+	fake.EXPECT().RawManifest().DoAndReturn(img.RawManifest).AnyTimes()
+	return fake
+}
@@ -0,0 +1,176 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildpacks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+)
+
+// BuildMetadataLabel is the label the buildpack lifecycle writes to exported
+// images to record the buildpacks that ran and the bill of materials they
+// contributed.
+const BuildMetadataLabel = "io.buildpacks.build.metadata"
+
+// BuildpackRef identifies a buildpack.
+type BuildpackRef struct {
+	ID      string `json:"id"`
+	Version string `json:"version,omitempty"`
+}
+
+// BOMEntry is a single dependency in the bill of materials of an image.
+type BOMEntry struct {
+	Name      string                 `json:"name"`
+	Version   string                 `json:"version,omitempty"`
+	Buildpack BuildpackRef           `json:"buildpack"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// buildMetadata is the content of the BuildMetadataLabel.
+type buildMetadata struct {
+	Buildpacks []BuildpackRef `json:"buildpacks"`
+	BOM        []BOMEntry     `json:"bom"`
+}
+
+// lifecycleBuildpack is a buildpack recorded in the LifecycleMetadataLabel
+// by older versions of the lifecycle.
+type lifecycleBuildpack struct {
+	Key     string `json:"key"`
+	Version string `json:"version"`
+}
+
+// lifecycleMetadata is the subset of the LifecycleMetadataLabel used to
+// describe an image.
+type lifecycleMetadata struct {
+	RunImage   lifecycleRunImage    `json:"runImage"`
+	Buildpacks []lifecycleBuildpack `json:"buildpacks"`
+}
+
+// ReadBuildMetadata reads the provenance of an image built by buildpacks from
+// its labels. The SBOM of the returned metadata points at the image itself.
+func ReadBuildMetadata(ref name.Reference, img gcrv1.Image) (*v1alpha1.SourceBuildMetadata, error) {
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return nil, err
+	}
+
+	var lifecycle lifecycleMetadata
+	if err := json.Unmarshal([]byte(cfg.Config.Labels[LifecycleMetadataLabel]), &lifecycle); err != nil {
+		return nil, fmt.Errorf("image %q wasn't built by buildpacks: %s", ref, err)
+	}
+
+	out := &v1alpha1.SourceBuildMetadata{
+		ImageDigest: digest.String(),
+		Stack:       lifecycle.RunImage.Reference,
+	}
+
+	if raw, ok := cfg.Config.Labels[BuildMetadataLabel]; ok {
+		var build buildMetadata
+		if err := json.Unmarshal([]byte(raw), &build); err != nil {
+			return nil, fmt.Errorf("couldn't read %s label: %s", BuildMetadataLabel, err)
+		}
+
+		for _, bp := range build.Buildpacks {
+			out.Buildpacks = append(out.Buildpacks, v1alpha1.SourceBuildpackMetadata{
+				ID:      bp.ID,
+				Version: bp.Version,
+			})
+		}
+
+		out.SBOM = fmt.Sprintf("%s@%s", ref.Context().Name(), digest)
+	} else {
+		for _, bp := range lifecycle.Buildpacks {
+			out.Buildpacks = append(out.Buildpacks, v1alpha1.SourceBuildpackMetadata{
+				ID:      bp.Key,
+				Version: bp.Version,
+			})
+		}
+	}
+
+	return out, nil
+}
+
+// ReadSBOM reads the bill of materials from the labels of an image built by
+// buildpacks.
+func ReadSBOM(img gcrv1.Image) ([]BOMEntry, error) {
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, ok := cfg.Config.Labels[BuildMetadataLabel]
+	if !ok {
+		return nil, fmt.Errorf("image has no %s label", BuildMetadataLabel)
+	}
+
+	var build buildMetadata
+	if err := json.Unmarshal([]byte(raw), &build); err != nil {
+		return nil, fmt.Errorf("couldn't read %s label: %s", BuildMetadataLabel, err)
+	}
+
+	return build.BOM, nil
+}
+
+// SBOMFetcher reads the software bill of materials of images.
+type SBOMFetcher interface {
+	// FetchSBOM reads the bill of materials of the given image. Only the
+	// image's config is fetched, not its layers.
+	FetchSBOM(image string) ([]BOMEntry, error)
+}
+
+type sbomFetcher struct {
+	imageFetcher RemoteImageFetcher
+	keychain     authn.Keychain
+}
+
+// NewSBOMFetcher creates a new SBOMFetcher that uses the local docker
+// credentials to read images.
+func NewSBOMFetcher(imageFetcher RemoteImageFetcher) SBOMFetcher {
+	return &sbomFetcher{
+		imageFetcher: imageFetcher,
+		keychain:     authn.DefaultKeychain,
+	}
+}
+
+// FetchSBOM implements SBOMFetcher.
+func (f *sbomFetcher) FetchSBOM(image string) ([]BOMEntry, error) {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := f.keychain.Resolve(ref.Context().Registry)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := f.imageFetcher(ref, remote.WithAuth(auth))
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadSBOM(img)
+}
@@ -0,0 +1,149 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildpacks_test
+
+import (
+	"errors"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/buildpacks"
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+const (
+	testDigest            = "sha256:0000000000000000000000000000000000000000000000000000000000000001"
+	testLifecycleMetadata = `{"runImage":{"topLayer":"sha256:abc","reference":"gcr.io/run@sha256:def"},"buildpacks":[{"key":"old-bp","version":"0.1"}]}`
+	testBuildMetadata     = `{"buildpacks":[{"id":"java","version":"1.0"}],"bom":[{"name":"log4j","version":"2.17.1","buildpack":{"id":"java","version":"1.0"}}]}`
+)
+
+func fakeBuiltImage(t *testing.T, labels map[string]string) *FakeImage {
+	fakeImage := NewFakeImage(gomock.NewController(t))
+	fakeImage.EXPECT().ConfigFile().Return(&gcrv1.ConfigFile{
+		Config: gcrv1.Config{Labels: labels},
+	}, nil).AnyTimes()
+	fakeImage.EXPECT().Digest().Return(gcrv1.NewHash(testDigest)).AnyTimes()
+	return fakeImage
+}
+
+func TestReadBuildMetadata(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		labels  map[string]string
+		want    *v1alpha1.SourceBuildMetadata
+		wantErr error
+	}{
+		"build metadata label": {
+			labels: map[string]string{
+				buildpacks.LifecycleMetadataLabel: testLifecycleMetadata,
+				buildpacks.BuildMetadataLabel:     testBuildMetadata,
+			},
+			want: &v1alpha1.SourceBuildMetadata{
+				ImageDigest: testDigest,
+				Stack:       "gcr.io/run@sha256:def",
+				Buildpacks: []v1alpha1.SourceBuildpackMetadata{
+					{ID: "java", Version: "1.0"},
+				},
+				SBOM: "gcr.io/my-app@" + testDigest,
+			},
+		},
+		"lifecycle label only": {
+			labels: map[string]string{
+				buildpacks.LifecycleMetadataLabel: testLifecycleMetadata,
+			},
+			want: &v1alpha1.SourceBuildMetadata{
+				ImageDigest: testDigest,
+				Stack:       "gcr.io/run@sha256:def",
+				Buildpacks: []v1alpha1.SourceBuildpackMetadata{
+					{ID: "old-bp", Version: "0.1"},
+				},
+			},
+		},
+		"not built by buildpacks": {
+			labels:  map[string]string{},
+			wantErr: errors.New(`image "gcr.io/my-app:latest" wasn't built by buildpacks: unexpected end of JSON input`),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ref, err := name.ParseReference("gcr.io/my-app:latest", name.WeakValidation)
+			testutil.AssertNil(t, "err", err)
+
+			got, gotErr := buildpacks.ReadBuildMetadata(ref, fakeBuiltImage(t, tc.labels))
+			if tc.wantErr != nil || gotErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertEqual(t, "metadata", tc.want, got)
+		})
+	}
+}
+
+func TestSBOMFetcher_FetchSBOM(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		fetcher buildpacks.RemoteImageFetcher
+		want    []buildpacks.BOMEntry
+		wantErr error
+	}{
+		"reads bom": {
+			fetcher: func(ref name.Reference, options ...remote.ImageOption) (gcrv1.Image, error) {
+				testutil.AssertEqual(t, "image name", "gcr.io/my-app@"+testDigest, ref.Name())
+				return fakeBuiltImage(t, map[string]string{
+					buildpacks.BuildMetadataLabel: testBuildMetadata,
+				}), nil
+			},
+			want: []buildpacks.BOMEntry{
+				{
+					Name:      "log4j",
+					Version:   "2.17.1",
+					Buildpack: buildpacks.BuildpackRef{ID: "java", Version: "1.0"},
+				},
+			},
+		},
+		"missing label": {
+			fetcher: func(ref name.Reference, options ...remote.ImageOption) (gcrv1.Image, error) {
+				return fakeBuiltImage(t, map[string]string{}), nil
+			},
+			wantErr: errors.New("image has no io.buildpacks.build.metadata label"),
+		},
+		"fetching image fails": {
+			fetcher: func(ref name.Reference, options ...remote.ImageOption) (gcrv1.Image, error) {
+				return nil, errors.New("some-error")
+			},
+			wantErr: errors.New("some-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got, gotErr := buildpacks.NewSBOMFetcher(tc.fetcher).FetchSBOM("gcr.io/my-app@" + testDigest)
+			if tc.wantErr != nil || gotErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertEqual(t, "bom", tc.want, got)
+		})
+	}
+}
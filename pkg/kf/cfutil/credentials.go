@@ -0,0 +1,110 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// Credentials holds service credentials as raw JSON so nested objects,
+// arrays, numbers and booleans keep their type in VCAP_SERVICES.
+type Credentials map[string]json.RawMessage
+
+// CredentialsFromSecretData converts the data of a binding Secret into
+// Credentials.
+//
+// Service Catalog stores string credentials verbatim and JSON encodes all
+// other values so objects, arrays, booleans and null are passed through
+// unchanged. Numbers are only passed through if they survive a round trip
+// through a float64, the way JavaScript clients parse them, so long IDs made of
+// digits stay strings. Everything else is treated as a string.
+//
+// This matches the credentials the broker returned except for strings that
+// look like small numbers or booleans, which can't be told apart.
+func CredentialsFromSecretData(data map[string][]byte) Credentials {
+	out := make(Credentials)
+	for key, value := range data {
+		out[key] = decodeCredential(value)
+	}
+
+	return out
+}
+
+// SecretData converts the credentials into Secret data in the same format
+// Service Catalog uses. Strings that would be mistaken for other JSON values
+// by CredentialsFromSecretData are stored quoted so they round-trip.
+func (c Credentials) SecretData() map[string][]byte {
+	out := make(map[string][]byte)
+	for key, value := range c {
+		out[key] = encodeCredential(value)
+	}
+
+	return out
+}
+
+// isRawCredential returns true if the Secret value should be rendered as
+// JSON rather than as a string.
+func isRawCredential(value []byte) bool {
+	trimmed := bytes.TrimSpace(value)
+	if len(trimmed) == 0 || !json.Valid(trimmed) {
+		return false
+	}
+
+	switch trimmed[0] {
+	case '{', '[', 't', 'f', 'n':
+		return true
+	case '"':
+		// Quoted strings only come from values that were deliberately
+		// encoded by SecretData.
+		return true
+	default:
+		f, err := strconv.ParseFloat(string(trimmed), 64)
+		if err != nil {
+			return false
+		}
+
+		canonical, err := json.Marshal(f)
+		return err == nil && bytes.Equal(canonical, trimmed)
+	}
+}
+
+func decodeCredential(value []byte) json.RawMessage {
+	if isRawCredential(value) {
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, value); err == nil {
+			return compacted.Bytes()
+		}
+	}
+
+	// Marshaling a string can't fail.
+	quoted, _ := json.Marshal(string(value))
+	return quoted
+}
+
+func encodeCredential(value json.RawMessage) []byte {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		// Not a string, store the JSON as-is.
+		return value
+	}
+
+	if isRawCredential([]byte(s)) {
+		return value
+	}
+
+	return []byte(s)
+}
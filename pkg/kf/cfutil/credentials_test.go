@@ -0,0 +1,147 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfutil_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/kf/pkg/kf/cfutil"
+	"github.com/google/kf/pkg/kf/testutil"
+	apiv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+// flattenCredentials converts a broker's credentials to Secret data the way
+// Service Catalog does, strings are stored verbatim and other values are
+// JSON encoded.
+func flattenCredentials(t *testing.T, credentials map[string]interface{}) map[string][]byte {
+	t.Helper()
+
+	out := make(map[string][]byte)
+	for key, value := range credentials {
+		if s, ok := value.(string); ok {
+			out[key] = []byte(s)
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		testutil.AssertNil(t, "marshal", err)
+		out[key] = encoded
+	}
+
+	return out
+}
+
+func TestNewVcapService_brokerGolden(t *testing.T) {
+	t.Parallel()
+
+	payloads, err := filepath.Glob(filepath.Join("testdata", "brokers", "*.json"))
+	testutil.AssertNil(t, "glob", err)
+
+	for _, payload := range payloads {
+		if strings.HasSuffix(payload, ".golden.json") {
+			continue
+		}
+
+		name := strings.TrimSuffix(filepath.Base(payload), ".json")
+		t.Run(name, func(t *testing.T) {
+			contents, err := ioutil.ReadFile(payload)
+			testutil.AssertNil(t, "read payload", err)
+
+			var bindResponse struct {
+				Credentials map[string]interface{} `json:"credentials"`
+			}
+			testutil.AssertNil(t, "unmarshal payload", json.Unmarshal(contents, &bindResponse))
+
+			instance := apiv1beta1.ServiceInstance{}
+			instance.Spec.ClusterServiceClassExternalName = name
+			instance.Spec.ClusterServicePlanExternalName = "standard"
+
+			binding := apiv1beta1.ServiceBinding{}
+			binding.Spec.InstanceRef.Name = "my-instance"
+
+			secret := &corev1.Secret{Data: flattenCredentials(t, bindResponse.Credentials)}
+
+			vcapService, err := cfutil.NewVcapService(apiv1beta1.CommonServiceClassSpec{}, instance, binding, secret)
+			testutil.AssertNil(t, "NewVcapService", err)
+
+			vcapServices := cfutil.VcapServicesMap{}
+			vcapServices.Add(vcapService)
+
+			actual, err := json.MarshalIndent(vcapServices, "", "  ")
+			testutil.AssertNil(t, "marshal VCAP_SERVICES", err)
+
+			golden := filepath.Join("testdata", "brokers", name+".golden.json")
+			if *updateGolden {
+				testutil.AssertNil(t, "write golden", ioutil.WriteFile(golden, append(actual, '\n'), 0644))
+			}
+
+			expected, err := ioutil.ReadFile(golden)
+			testutil.AssertNil(t, "read golden", err)
+			testutil.AssertEqual(t, "VCAP_SERVICES", strings.TrimSpace(string(expected)), string(actual))
+
+			// The credentials apps see must be the ones the broker returned.
+			var rendered cfutil.VcapServicesMap
+			testutil.AssertNil(t, "unmarshal VCAP_SERVICES", json.Unmarshal(actual, &rendered))
+			renderedCredentials, err := json.Marshal(rendered[name][0].Credentials)
+			testutil.AssertNil(t, "marshal credentials", err)
+			brokerCredentials, err := json.Marshal(bindResponse.Credentials)
+			testutil.AssertNil(t, "marshal credentials", err)
+			testutil.AssertJSONEqual(t, string(brokerCredentials), string(renderedCredentials))
+		})
+	}
+}
+
+func ExampleCredentialsFromSecretData() {
+	creds := cfutil.CredentialsFromSecretData(map[string][]byte{
+		"uri":   []byte("mysql://example.com"),
+		"port":  []byte("3306"),
+		"hosts": []byte(`["a.example.com","b.example.com"]`),
+		"tls":   []byte(`{"enabled": true}`),
+	})
+
+	out, err := json.Marshal(creds)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(out))
+
+	// Output: {"hosts":["a.example.com","b.example.com"],"port":3306,"tls":{"enabled":true},"uri":"mysql://example.com"}
+}
+
+func ExampleCredentials_SecretData() {
+	data := cfutil.Credentials{
+		"uri":      json.RawMessage(`"mysql://example.com"`),
+		"port":     json.RawMessage(`3306`),
+		"password": json.RawMessage(`"12345"`),
+	}.SecretData()
+
+	fmt.Printf("uri: %s\n", data["uri"])
+	fmt.Printf("port: %s\n", data["port"])
+	fmt.Printf("password: %s\n", data["password"])
+
+	// Output: uri: mysql://example.com
+	// port: 3306
+	// password: "12345"
+}
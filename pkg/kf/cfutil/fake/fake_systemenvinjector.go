@@ -81,6 +81,21 @@ func (mr *FakeSystemEnvInjectorMockRecorder) GetClassFromInstance(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClassFromInstance", reflect.TypeOf((*FakeSystemEnvInjector)(nil).GetClassFromInstance), arg0)
 }
 
+// GetServiceBindingFiles mocks base method
+func (m *FakeSystemEnvInjector) GetServiceBindingFiles(arg0 *v1alpha1.App, arg1 []v1beta1.ServiceBinding) (map[string]cfutil.ServiceBindingFiles, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceBindingFiles", arg0, arg1)
+	ret0, _ := ret[0].(map[string]cfutil.ServiceBindingFiles)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceBindingFiles indicates an expected call of GetServiceBindingFiles
+func (mr *FakeSystemEnvInjectorMockRecorder) GetServiceBindingFiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceBindingFiles", reflect.TypeOf((*FakeSystemEnvInjector)(nil).GetServiceBindingFiles), arg0, arg1)
+}
+
 // GetVcapService mocks base method
 func (m *FakeSystemEnvInjector) GetVcapService(arg0 string, arg1 *v1beta1.ServiceBinding) (cfutil.VcapService, error) {
 	m.ctrl.T.Helper()
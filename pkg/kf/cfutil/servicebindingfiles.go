@@ -0,0 +1,79 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfutil
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// ServiceBindingRootEnv is the environment variable that holds the
+	// directory service binding files are mounted in.
+	ServiceBindingRootEnv = "SERVICE_BINDING_ROOT"
+
+	// DefaultServiceBindingRoot is the directory service binding files are
+	// mounted in if the App doesn't set ServiceBindingRootEnv.
+	DefaultServiceBindingRoot = "/bindings"
+
+	// ServiceBindingTypeFile holds the type of the service.
+	ServiceBindingTypeFile = "type"
+
+	// ServiceBindingProviderFile holds the provider of the service.
+	ServiceBindingProviderFile = "provider"
+)
+
+// ServiceBindingFiles holds the files of a single service binding by file
+// name.
+type ServiceBindingFiles map[string][]byte
+
+// NewServiceBindingFiles creates the files of a binding following the
+// servicebinding.io spec. There's one file per credential next to the type
+// file, holding the label of the service, and the provider file, holding the
+// broker, if it's known.
+//
+// String credentials are written as-is, other values as JSON. Credentials
+// with names that can't be used as Secret keys are left out.
+func NewServiceBindingFiles(service VcapService, provider string) ServiceBindingFiles {
+	out := make(ServiceBindingFiles)
+	for key, value := range service.Credentials {
+		if len(validation.IsConfigMapKey(key)) > 0 {
+			continue
+		}
+
+		out[key] = credentialFile(value)
+	}
+
+	// The type and provider take precedence over credentials with the same
+	// names because the spec reserves them.
+	out[ServiceBindingTypeFile] = []byte(service.Label)
+	if provider != "" {
+		out[ServiceBindingProviderFile] = []byte(provider)
+	} else {
+		delete(out, ServiceBindingProviderFile)
+	}
+
+	return out
+}
+
+func credentialFile(value json.RawMessage) []byte {
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return []byte(s)
+	}
+
+	return value
+}
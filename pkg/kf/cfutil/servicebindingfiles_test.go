@@ -0,0 +1,54 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cfutil_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/google/kf/pkg/kf/cfutil"
+)
+
+func ExampleNewServiceBindingFiles() {
+	service := cfutil.VcapService{
+		Label: "mysql",
+		Credentials: cfutil.Credentials{
+			"uri":      json.RawMessage(`"mysql://example.com"`),
+			"port":     json.RawMessage(`3306`),
+			"options":  json.RawMessage(`{"tls":true}`),
+			"type":     json.RawMessage(`"overridden"`),
+			"bad/name": json.RawMessage(`"skipped"`),
+		},
+	}
+
+	files := cfutil.NewServiceBindingFiles(service, "my-broker")
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Printf("%s: %s\n", name, files[name])
+	}
+
+	// Output: options: {"tls":true}
+	// port: 3306
+	// provider: my-broker
+	// type: mysql
+	// uri: mysql://example.com
+}
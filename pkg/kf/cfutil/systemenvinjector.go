@@ -42,6 +42,10 @@ type SystemEnvInjector interface {
 
 	// GetClassFromInstance gets the service class for the given instance.
 	GetClassFromInstance(instance *servicecatalogv1beta1.ServiceInstance) (*servicecatalogv1beta1.CommonServiceClassSpec, error)
+
+	// GetServiceBindingFiles gets the servicebinding.io files for the
+	// bindings of the App by binding name.
+	GetServiceBindingFiles(app *v1alpha1.App, serviceBindings []servicecatalogv1beta1.ServiceBinding) (map[string]ServiceBindingFiles, error)
 }
 
 type systemEnvInjector struct {
@@ -69,13 +73,19 @@ func GetVcapServicesMap(appName string, services []VcapService) (VcapServicesMap
 }
 
 func (s *systemEnvInjector) GetVcapService(appName string, binding *servicecatalogv1beta1.ServiceBinding) (VcapService, error) {
+	service, _, err := s.getBrokeredService(binding)
+	return service, err
+}
 
+// getBrokeredService gets the VCAP_SERVICES entry for a Service Catalog
+// binding and the name of the broker that provides the service.
+func (s *systemEnvInjector) getBrokeredService(binding *servicecatalogv1beta1.ServiceBinding) (VcapService, string, error) {
 	secret, err := s.k8sclient.
 		CoreV1().
 		Secrets(binding.Namespace).
 		Get(binding.Spec.SecretName, metav1.GetOptions{})
 	if err != nil {
-		return VcapService{}, fmt.Errorf("couldn't create VCAP_SERVICES, the secret for binding %s couldn't be fetched: %v", binding.Name, err)
+		return VcapService{}, "", fmt.Errorf("couldn't create VCAP_SERVICES, the secret for binding %s couldn't be fetched: %v", binding.Name, err)
 	}
 
 	serviceInstance, err := s.client.
@@ -83,19 +93,27 @@ func (s *systemEnvInjector) GetVcapService(appName string, binding *servicecatal
 		ServiceInstances(binding.Namespace).
 		Get(binding.Spec.InstanceRef.Name, metav1.GetOptions{})
 	if err != nil {
-		return VcapService{}, fmt.Errorf("couldn't get instance: %v", err)
+		return VcapService{}, "", fmt.Errorf("couldn't get instance: %v", err)
 	}
 
-	class, err := s.GetClassFromInstance(serviceInstance)
+	class, brokerName, err := s.getClassAndBroker(serviceInstance)
 	if err != nil {
-		return VcapService{}, fmt.Errorf("couldn't get instance: %v", err)
+		return VcapService{}, "", fmt.Errorf("couldn't get instance: %v", err)
 	}
 
-	return NewVcapService(*class, *serviceInstance, *binding, secret), nil
+	service, err := NewVcapService(*class, *serviceInstance, *binding, secret)
+	return service, brokerName, err
 }
 
 // GetClassFromInstance gets the service class for the given instance.
 func (s *systemEnvInjector) GetClassFromInstance(instance *servicecatalogv1beta1.ServiceInstance) (*servicecatalogv1beta1.CommonServiceClassSpec, error) {
+	class, _, err := s.getClassAndBroker(instance)
+	return class, err
+}
+
+// getClassAndBroker gets the service class for the given instance and the
+// name of the broker that provides it.
+func (s *systemEnvInjector) getClassAndBroker(instance *servicecatalogv1beta1.ServiceInstance) (*servicecatalogv1beta1.CommonServiceClassSpec, string, error) {
 	if ref := instance.Spec.ClusterServiceClassRef; ref != nil {
 		plan, err := s.client.
 			ServicecatalogV1beta1().
@@ -103,10 +121,10 @@ func (s *systemEnvInjector) GetClassFromInstance(instance *servicecatalogv1beta1
 			Get(ref.Name, metav1.GetOptions{})
 
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
-		return &plan.Spec.CommonServiceClassSpec, nil
+		return &plan.Spec.CommonServiceClassSpec, plan.Spec.ClusterServiceBrokerName, nil
 	}
 
 	if ref := instance.Spec.ServiceClassRef; ref != nil {
@@ -116,13 +134,13 @@ func (s *systemEnvInjector) GetClassFromInstance(instance *servicecatalogv1beta1
 			Get(ref.Name, metav1.GetOptions{})
 
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
-		return &plan.Spec.CommonServiceClassSpec, nil
+		return &plan.Spec.CommonServiceClassSpec, plan.Spec.ServiceBrokerName, nil
 	}
 
-	return nil, errors.New("neither ClusterServiceClassRef nor ServiceClassRef were provided")
+	return nil, "", errors.New("neither ClusterServiceClassRef nor ServiceClassRef were provided")
 }
 
 func (s *systemEnvInjector) GetVcapServices(appName string, bindings []servicecatalogv1beta1.ServiceBinding) (services []VcapService, err error) {
@@ -137,6 +155,41 @@ func (s *systemEnvInjector) GetVcapServices(appName string, bindings []serviceca
 	return services, nil
 }
 
+// getUserProvidedVcapServices gets VCAP_SERVICES entries for the bindings on
+// the app that aren't backed by a Service Catalog binding.
+func (s *systemEnvInjector) getUserProvidedVcapServices(app *v1alpha1.App, serviceBindings []servicecatalogv1beta1.ServiceBinding) (services []VcapService, err error) {
+	brokered := make(map[string]bool)
+	for _, binding := range serviceBindings {
+		brokered[binding.Spec.InstanceRef.Name] = true
+	}
+
+	for _, binding := range app.Spec.ServiceBindings {
+		if brokered[binding.Instance] {
+			continue
+		}
+
+		secret, err := s.k8sclient.
+			CoreV1().
+			Secrets(app.Namespace).
+			Get(v1alpha1.UserProvidedServiceSecretName(binding.Instance), metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create VCAP_SERVICES, the user-provided service %s couldn't be fetched: %v", binding.Instance, err)
+		}
+
+		if !v1alpha1.IsUserProvidedService(secret) {
+			return nil, fmt.Errorf("couldn't create VCAP_SERVICES, %s isn't a user-provided service", binding.Instance)
+		}
+
+		service, err := NewUserProvidedVcapService(binding, secret)
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, service)
+	}
+
+	return services, nil
+}
+
 func (s *systemEnvInjector) ComputeSystemEnv(app *v1alpha1.App, serviceBindings []servicecatalogv1beta1.ServiceBinding) (computed []corev1.EnvVar, err error) {
 	va, err := CreateVcapApplication(app)
 	if err != nil {
@@ -149,6 +202,12 @@ func (s *systemEnvInjector) ComputeSystemEnv(app *v1alpha1.App, serviceBindings
 		return nil, err
 	}
 
+	userProvided, err := s.getUserProvidedVcapServices(app, serviceBindings)
+	if err != nil {
+		return nil, err
+	}
+	services = append(services, userProvided...)
+
 	serviceMap, err := GetVcapServicesMap(app.Name, services)
 	if err != nil {
 		return nil, err
@@ -162,3 +221,26 @@ func (s *systemEnvInjector) ComputeSystemEnv(app *v1alpha1.App, serviceBindings
 
 	return
 }
+
+func (s *systemEnvInjector) GetServiceBindingFiles(app *v1alpha1.App, serviceBindings []servicecatalogv1beta1.ServiceBinding) (map[string]ServiceBindingFiles, error) {
+	out := make(map[string]ServiceBindingFiles)
+	for i := range serviceBindings {
+		service, brokerName, err := s.getBrokeredService(&serviceBindings[i])
+		if err != nil {
+			return nil, err
+		}
+
+		out[service.Name] = NewServiceBindingFiles(service, brokerName)
+	}
+
+	userProvided, err := s.getUserProvidedVcapServices(app, serviceBindings)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range userProvided {
+		out[service.Name] = NewServiceBindingFiles(service, "")
+	}
+
+	return out, nil
+}
@@ -15,6 +15,7 @@
 package cfutil_test
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -99,8 +100,8 @@ func Test_GetVcapServices(t *testing.T) {
 				testutil.AssertEqual(t, "label", "my-class", vcapService.Label)
 				testutil.AssertEqual(t, "tags", []string{"database"}, vcapService.Tags)
 				testutil.AssertEqual(t, "plan", "my-plan", vcapService.Plan)
-				testutil.AssertEqual(t, "credentials", map[string]string{}, vcapService.Credentials)
-				testutil.AssertEqual(t, "binding name", "my-binding-name", vcapService.BindingName)
+				testutil.AssertEqual(t, "credentials", cfutil.Credentials{}, vcapService.Credentials)
+				testutil.AssertEqual(t, "binding name", "my-binding-name", *vcapService.BindingName)
 			},
 		},
 	}
@@ -154,6 +155,95 @@ func TestSystemEnvInjector(t *testing.T) {
 	}
 }
 
+func TestSystemEnvInjector_userProvided(t *testing.T) {
+	t.Parallel()
+
+	upsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.UserProvidedServiceSecretName("my-ups"),
+			Namespace: "my-space",
+			Labels: map[string]string{
+				v1alpha1.UserProvidedServiceLabel: "true",
+				v1alpha1.NameLabel:                "my-ups",
+			},
+		},
+		Data: map[string][]byte{
+			"uri":  []byte("postgres://example.com"),
+			"port": []byte("5432"),
+		},
+	}
+
+	otherSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.UserProvidedServiceSecretName("not-ups"),
+			Namespace: "my-space",
+		},
+	}
+
+	bindingName := "my-ups"
+
+	cases := map[string]struct {
+		instance    string
+		wantErr     error
+		wantService cfutil.VcapService
+	}{
+		"user-provided": {
+			instance: "my-ups",
+			wantService: cfutil.VcapService{
+				BindingName:  &bindingName,
+				Name:         "my-ups",
+				InstanceName: "my-ups",
+				Label:        "user-provided",
+				Tags:         []string{},
+				Credentials: cfutil.Credentials{
+					"uri":  json.RawMessage(`"postgres://example.com"`),
+					"port": json.RawMessage(`5432`),
+				},
+				VolumeMounts: []json.RawMessage{},
+			},
+		},
+		"not user-provided": {
+			instance: "not-ups",
+			wantErr:  errors.New("couldn't create VCAP_SERVICES, not-ups isn't a user-provided service"),
+		},
+		"missing": {
+			instance: "missing",
+			wantErr:  errors.New(`couldn't create VCAP_SERVICES, the user-provided service missing couldn't be fetched: secrets "kf-user-provided-missing" not found`),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			servicecatalogClient := servicecatalogclient.NewSimpleClientset()
+			k8sClient := k8sfake.NewSimpleClientset(upsSecret, otherSecret)
+			systemEnvInjector := cfutil.NewSystemEnvInjector(servicecatalogClient, k8sClient)
+
+			upsApp := app.DeepCopy()
+			upsApp.Namespace = "my-space"
+			upsApp.Spec.ServiceBindings = []v1alpha1.AppSpecServiceBinding{
+				{Instance: tc.instance, BindingName: tc.instance},
+			}
+
+			env, err := systemEnvInjector.ComputeSystemEnv(upsApp, nil)
+			if tc.wantErr != nil || err != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, err)
+				return
+			}
+
+			var vcapServices cfutil.VcapServicesMap
+			for _, envVar := range env {
+				if envVar.Name == "VCAP_SERVICES" {
+					testutil.AssertNil(t, "unmarshal", json.Unmarshal([]byte(envVar.Value), &vcapServices))
+				}
+			}
+
+			testutil.AssertEqual(t, "VCAP_SERVICES", cfutil.VcapServicesMap{
+				"user-provided": {tc.wantService},
+			}, vcapServices)
+		})
+	}
+}
+
 func TestSystemEnvInjector_GetClassFromInstance(t *testing.T) {
 	clusterClass := &servicecatalogv1beta1.ClusterServiceClass{
 		ObjectMeta: metav1.ObjectMeta{
@@ -230,3 +320,94 @@ func TestSystemEnvInjector_GetClassFromInstance(t *testing.T) {
 		})
 	}
 }
+
+func TestSystemEnvInjector_GetServiceBindingFiles(t *testing.T) {
+	t.Parallel()
+
+	class := &servicecatalogv1beta1.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mysql-id",
+		},
+		Spec: servicecatalogv1beta1.ClusterServiceClassSpec{
+			ClusterServiceBrokerName: "my-broker",
+		},
+	}
+
+	instance := &servicecatalogv1beta1.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mydb",
+			Namespace: "my-space",
+		},
+		Spec: servicecatalogv1beta1.ServiceInstanceSpec{
+			PlanReference: servicecatalogv1beta1.PlanReference{
+				ClusterServiceClassExternalName: "mysql",
+			},
+			ClusterServiceClassRef: &servicecatalogv1beta1.ClusterObjectReference{
+				Name: "mysql-id",
+			},
+		},
+	}
+
+	binding := servicecatalogv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kf-binding-my-app-db",
+			Namespace: "my-space",
+			Labels:    app.ComponentLabels("db"),
+		},
+		Spec: servicecatalogv1beta1.ServiceBindingSpec{
+			InstanceRef: servicecatalogv1beta1.LocalObjectReference{
+				Name: "mydb",
+			},
+			SecretName: "kf-binding-my-app-db",
+		},
+	}
+
+	bindingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kf-binding-my-app-db",
+			Namespace: "my-space",
+		},
+		Data: map[string][]byte{
+			"uri": []byte("mysql://example.com"),
+		},
+	}
+
+	upsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.UserProvidedServiceSecretName("my-ups"),
+			Namespace: "my-space",
+			Labels: map[string]string{
+				v1alpha1.UserProvidedServiceLabel: "true",
+				v1alpha1.NameLabel:                "my-ups",
+			},
+		},
+		Data: map[string][]byte{
+			"token": []byte("secret-token"),
+		},
+	}
+
+	servicecatalogClient := servicecatalogclient.NewSimpleClientset(class, instance)
+	k8sClient := k8sfake.NewSimpleClientset(bindingSecret, upsSecret)
+	systemEnvInjector := cfutil.NewSystemEnvInjector(servicecatalogClient, k8sClient)
+
+	filesApp := app.DeepCopy()
+	filesApp.Namespace = "my-space"
+	filesApp.Spec.ServiceBindings = []v1alpha1.AppSpecServiceBinding{
+		{Instance: "mydb", BindingName: "db"},
+		{Instance: "my-ups", BindingName: "ups"},
+	}
+
+	files, err := systemEnvInjector.GetServiceBindingFiles(filesApp, []servicecatalogv1beta1.ServiceBinding{binding})
+	testutil.AssertNil(t, "error", err)
+	testutil.AssertEqual(t, "files", map[string]cfutil.ServiceBindingFiles{
+		"db": {
+			"uri":      []byte("mysql://example.com"),
+			"type":     []byte("mysql"),
+			"provider": []byte("my-broker"),
+		},
+		"ups": {
+			"token": []byte("secret-token"),
+			"type":  []byte("user-provided"),
+		},
+	}, files)
+}
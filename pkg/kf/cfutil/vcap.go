@@ -15,6 +15,9 @@
 package cfutil
 
 import (
+	"encoding/json"
+	"fmt"
+
 	kfv1alpha1 "github.com/google/kf/pkg/apis/kf/v1alpha1"
 	apiv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
@@ -38,38 +41,93 @@ func (vm VcapServicesMap) Add(service VcapService) {
 // VcapService represents a single entry in a VCAP_SERVICES map.
 // It holds the credentials for a single service binding.
 type VcapService struct {
-	BindingName  string            `json:"binding_name"`  // The name assigned to the service binding by the user.
-	InstanceName string            `json:"instance_name"` // The name assigned to the service instance by the user.
-	Name         string            `json:"name"`          // The binding_name if it exists; otherwise the instance_name.
-	Label        string            `json:"label"`         // The name of the service offering.
-	Tags         []string          `json:"tags"`          // An array of strings an app can use to identify a service instance.
-	Plan         string            `json:"plan"`          // The service plan selected when the service instance was created.
-	Credentials  map[string]string `json:"credentials"`   // The service-specific credentials needed to access the service instance.
+	BindingName  *string     `json:"binding_name"`  // The name assigned to the service binding by the user, null if it wasn't named.
+	InstanceName string      `json:"instance_name"` // The name assigned to the service instance by the user.
+	Name         string      `json:"name"`          // The binding_name if it exists; otherwise the instance_name.
+	Label        string      `json:"label"`         // The name of the service offering.
+	Tags         []string    `json:"tags"`          // An array of strings an app can use to identify a service instance.
+	Plan         string      `json:"plan"`          // The service plan selected when the service instance was created.
+	Credentials  Credentials `json:"credentials"`   // The service-specific credentials needed to access the service instance.
+
+	// The following fields aren't supported by kf, but cloud_controller_ng
+	// always renders them so they're included with the same empty values for
+	// client libraries that expect them.
+	Provider       *string           `json:"provider"`         // Always null, only v1 brokers set a provider.
+	SyslogDrainURL *string           `json:"syslog_drain_url"` // The URL logs are drained to, only set for user-provided services.
+	VolumeMounts   []json.RawMessage `json:"volume_mounts"`    // Always empty, kf doesn't support volume services.
 }
 
 // NewVcapService creates a new VcapService given a binding and associated
-// secret.
-func NewVcapService(class servicecatalogv1beta1.CommonServiceClassSpec, instance apiv1beta1.ServiceInstance, binding apiv1beta1.ServiceBinding, secret *corev1.Secret) VcapService {
+// secret. The tags of the service offering are followed by any tags the user
+// added to the instance.
+func NewVcapService(class servicecatalogv1beta1.CommonServiceClassSpec, instance apiv1beta1.ServiceInstance, binding apiv1beta1.ServiceBinding, secret *corev1.Secret) (VcapService, error) {
+	instanceTags, err := kfv1alpha1.ServiceTags(instance.Annotations)
+	if err != nil {
+		return VcapService{}, fmt.Errorf("couldn't read tags of service instance %q: %v", instance.Name, err)
+	}
+
 	// See the cloud-controller-ng source for how this is supposed to be built
 	// being that it doesn't seem to be formally fully documented anywhere:
 	// https://github.com/cloudfoundry/cloud_controller_ng/blob/65a75e6c97f49756df96e437e253f033415b2db1/app/presenters/system_environment/service_binding_presenter.rb#L32
 	vs := VcapService{
-		BindingName:  binding.Labels[kfv1alpha1.ComponentLabel],
+		BindingName:  optionalString(binding.Labels[kfv1alpha1.ComponentLabel]),
 		Name:         coalesce(binding.Labels[kfv1alpha1.ComponentLabel], binding.Spec.InstanceRef.Name),
 		InstanceName: binding.Spec.InstanceRef.Name,
 		Label:        coalesce(instance.Spec.ServiceClassExternalName, instance.Spec.ClusterServiceClassExternalName),
 		Plan:         coalesce(instance.Spec.ServicePlanExternalName, instance.Spec.ClusterServicePlanExternalName),
-		Tags:         class.Tags,
-		Credentials:  make(map[string]string),
+		Tags:         append(append([]string{}, class.Tags...), instanceTags...),
+		Credentials:  CredentialsFromSecretData(secret.Data),
+		VolumeMounts: []json.RawMessage{},
+	}
+
+	return vs, nil
+}
+
+// NewUserProvidedVcapService creates a new VcapService for an app binding to
+// a user-provided service instance stored in secret.
+func NewUserProvidedVcapService(binding kfv1alpha1.AppSpecServiceBinding, secret *corev1.Secret) (VcapService, error) {
+	tags, err := kfv1alpha1.UserProvidedServiceTags(secret)
+	if err != nil {
+		return VcapService{}, err
 	}
 
-	// Credentials are stored by the service catalog in a flat map, the data
-	// values are just strings.
-	for sn, sd := range secret.Data {
-		vs.Credentials[sn] = string(sd)
+	// User-provided services don't have plans and always use the same label.
+	// https://github.com/cloudfoundry/cloud_controller_ng/blob/65a75e6c97f49756df96e437e253f033415b2db1/app/presenters/system_environment/service_binding_presenter.rb#L53
+	vs := VcapService{
+		BindingName:  optionalString(binding.BindingName),
+		Name:         coalesce(binding.BindingName, binding.Instance),
+		InstanceName: binding.Instance,
+		Label:        kfv1alpha1.UserProvidedServiceClass,
+		Tags:         nonNilTags(tags),
+		Credentials:  CredentialsFromSecretData(secret.Data),
+		VolumeMounts: []json.RawMessage{},
+	}
+
+	if url := secret.Annotations[kfv1alpha1.UserProvidedServiceSyslogDrainURLAnnotation]; url != "" {
+		vs.SyslogDrainURL = &url
+	}
+
+	return vs, nil
+}
+
+// nonNilTags makes sure tags are rendered as an empty list rather than null
+// like cloud_controller_ng does.
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+
+	return tags
+}
+
+// optionalString returns nil for blank strings so they're rendered as null
+// like cloud_controller_ng does.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
 	}
 
-	return vs
+	return &s
 }
 
 func coalesce(vals ...string) string {
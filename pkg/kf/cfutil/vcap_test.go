@@ -15,6 +15,7 @@
 package cfutil_test
 
 import (
+	"encoding/json"
 	"fmt"
 
 	kfv1alpha1 "github.com/google/kf/pkg/apis/kf/v1alpha1"
@@ -43,6 +44,9 @@ func ExampleNewVcapService() {
 	instance.Name = "my-instance"
 	instance.Spec.ServiceClassExternalName = "my-service"
 	instance.Spec.ServicePlanExternalName = "my-service-plan"
+	instance.Annotations = map[string]string{
+		kfv1alpha1.ServiceTagsAnnotation: `["production"]`,
+	}
 
 	binding := apiv1beta1.ServiceBinding{}
 	binding.Spec.InstanceRef.Name = "my-instance"
@@ -61,12 +65,15 @@ func ExampleNewVcapService() {
 		Tags: []string{"mysql"},
 	}
 
-	vs := cfutil.NewVcapService(class, instance, binding, &secret)
+	vs, err := cfutil.NewVcapService(class, instance, binding, &secret)
+	if err != nil {
+		panic(err)
+	}
 
 	fmt.Printf("Name: %s\n", vs.Name)
 	fmt.Printf("InstanceName: %s\n", vs.InstanceName)
-	fmt.Printf("BindingName: %s\n", vs.BindingName)
-	fmt.Printf("Credentials: %v\n", vs.Credentials)
+	fmt.Printf("BindingName: %s\n", *vs.BindingName)
+	fmt.Printf("Credentials: %s\n", mustMarshal(vs.Credentials))
 	fmt.Printf("Service: %v\n", vs.Label)
 	fmt.Printf("Plan: %v\n", vs.Plan)
 	fmt.Printf("Tags: %v\n", vs.Tags)
@@ -74,8 +81,75 @@ func ExampleNewVcapService() {
 	// Output: Name: custom-binding-name
 	// InstanceName: my-instance
 	// BindingName: custom-binding-name
-	// Credentials: map[key1:value1 key2:value2]
+	// Credentials: {"key1":"value1","key2":"value2"}
 	// Service: my-service
 	// Plan: my-service-plan
+	// Tags: [mysql production]
+}
+
+func ExampleNewVcapService_unnamedBinding() {
+	instance := apiv1beta1.ServiceInstance{}
+	instance.Name = "my-instance"
+
+	binding := apiv1beta1.ServiceBinding{}
+	binding.Spec.InstanceRef.Name = "my-instance"
+
+	vs, err := cfutil.NewVcapService(apiv1beta1.CommonServiceClassSpec{}, instance, binding, &corev1.Secret{})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Name: %s\n", vs.Name)
+	fmt.Printf("BindingName: %s\n", mustMarshal(vs.BindingName))
+
+	// Output: Name: my-instance
+	// BindingName: null
+}
+
+func ExampleNewUserProvidedVcapService() {
+	binding := kfv1alpha1.AppSpecServiceBinding{
+		Instance:    "my-db",
+		BindingName: "custom-binding-name",
+	}
+
+	secret := corev1.Secret{}
+	secret.Annotations = map[string]string{
+		kfv1alpha1.UserProvidedServiceTagsAnnotation:           `["mysql"]`,
+		kfv1alpha1.UserProvidedServiceSyslogDrainURLAnnotation: "syslog://logs.example.com",
+	}
+	secret.Data = map[string][]byte{
+		"uri": []byte("mysql://example.com"),
+	}
+
+	vs, err := cfutil.NewUserProvidedVcapService(binding, &secret)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Name: %s\n", vs.Name)
+	fmt.Printf("InstanceName: %s\n", vs.InstanceName)
+	fmt.Printf("BindingName: %s\n", *vs.BindingName)
+	fmt.Printf("Credentials: %s\n", mustMarshal(vs.Credentials))
+	fmt.Printf("Service: %v\n", vs.Label)
+	fmt.Printf("Plan: %q\n", vs.Plan)
+	fmt.Printf("Tags: %v\n", vs.Tags)
+	fmt.Printf("Syslog Drain: %v\n", *vs.SyslogDrainURL)
+
+	// Output: Name: custom-binding-name
+	// InstanceName: my-db
+	// BindingName: custom-binding-name
+	// Credentials: {"uri":"mysql://example.com"}
+	// Service: user-provided
+	// Plan: ""
 	// Tags: [mysql]
+	// Syslog Drain: syslog://logs.example.com
+}
+
+func mustMarshal(v interface{}) string {
+	out, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(out)
 }
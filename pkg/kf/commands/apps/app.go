@@ -20,7 +20,9 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/apps"
+	"github.com/google/kf/pkg/kf/buildpacks"
 	"github.com/google/kf/pkg/kf/commands/completion"
 	"github.com/google/kf/pkg/kf/commands/config"
 	"github.com/google/kf/pkg/kf/describe"
@@ -30,15 +32,26 @@ import (
 )
 
 // NewGetAppCommand creates a command to get details about a single application.
-func NewGetAppCommand(p *config.KfParams, appsClient apps.Client) *cobra.Command {
+func NewGetAppCommand(
+	p *config.KfParams,
+	appsClient apps.Client,
+	sbomFetcher buildpacks.SBOMFetcher,
+) *cobra.Command {
 	printFlags := genericclioptions.NewPrintFlags("")
+	var sbom bool
 
 	var cmd = &cobra.Command{
-		Use:     "app APP_NAME",
-		Short:   "Print information about a deployed app",
-		Long:    `Prints information about a deployed app.`,
-		Example: `kf app my-app`,
-		Args:    cobra.ExactArgs(1),
+		Use:   "app APP_NAME",
+		Short: "Print information about a deployed app",
+		Long: `Prints information about a deployed app.
+
+		Use --sbom to print the provenance of the app's image and the software
+		bill of materials recorded by the buildpacks that built it.`,
+		Example: `
+  kf app my-app
+  kf app my-app --sbom
+  `,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := utils.ValidateNamespace(p); err != nil {
 				return err
@@ -47,7 +60,7 @@ func NewGetAppCommand(p *config.KfParams, appsClient apps.Client) *cobra.Command
 			appName := args[0]
 			w := cmd.OutOrStdout()
 
-			// Print status messages to stderr so stdout is syntatically valid output
+			// Print status messages to stderr so stdout is syntactically valid output
 			// if the user wanted JSON, YAML, etc.
 			fmt.Fprintf(cmd.ErrOrStderr(), "Getting app %s in namespace: %s\n", appName, p.Namespace)
 
@@ -56,6 +69,11 @@ func NewGetAppCommand(p *config.KfParams, appsClient apps.Client) *cobra.Command
 				return err
 			}
 
+			if sbom {
+				cmd.SilenceUsage = true
+				return printSBOM(w, sbomFetcher, app)
+			}
+
 			if printFlags.OutputFlagSpecified() {
 				printer, err := printFlags.ToPrinter()
 				if err != nil {
@@ -87,6 +105,7 @@ func NewGetAppCommand(p *config.KfParams, appsClient apps.Client) *cobra.Command
 				status := app.Status
 
 				fmt.Fprintf(w, "Image:\t%s\n", status.Image)
+				describe.SourceBuildMetadata(w, status.BuildMetadata)
 
 				kfApp := apps.NewFromApp(app)
 				fmt.Fprintf(w, "Cluster URL\t%s\n", kfApp.GetClusterURL())
@@ -103,6 +122,13 @@ func NewGetAppCommand(p *config.KfParams, appsClient apps.Client) *cobra.Command
 
 	printFlags.AddFlags(cmd)
 
+	cmd.Flags().BoolVar(
+		&sbom,
+		"sbom",
+		false,
+		"Print the build metadata and software bill of materials of the app's image.",
+	)
+
 	// Override output format to be sorted so our generated documents are deterministic
 	{
 		allowedFormats := printFlags.AllowedFormats()
@@ -114,3 +140,30 @@ func NewGetAppCommand(p *config.KfParams, appsClient apps.Client) *cobra.Command
 
 	return cmd
 }
+
+// printSBOM writes the build metadata of the app followed by the software
+// bill of materials of its image.
+func printSBOM(w io.Writer, sbomFetcher buildpacks.SBOMFetcher, app *v1alpha1.App) error {
+	metadata := app.Status.BuildMetadata
+
+	describe.SourceBuildMetadata(w, metadata)
+	fmt.Fprintln(w)
+
+	if metadata == nil || metadata.SBOM == "" {
+		return fmt.Errorf("app %q has no software bill of materials, only apps built with buildpacks record one", app.Name)
+	}
+
+	bom, err := sbomFetcher.FetchSBOM(metadata.SBOM)
+	if err != nil {
+		return fmt.Errorf("failed to fetch software bill of materials: %s", err)
+	}
+
+	describe.TabbedWriter(w, func(w io.Writer) {
+		fmt.Fprintln(w, "Name\tVersion\tBuildpack")
+		for _, entry := range bom {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", entry.Name, entry.Version, entry.Buildpack.ID)
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apps
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/apps/fake"
+	"github.com/google/kf/pkg/kf/buildpacks"
+	fakebuildpacks "github.com/google/kf/pkg/kf/buildpacks/fake"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestGetAppCommand_sbom(t *testing.T) {
+	t.Parallel()
+
+	builtApp := &v1alpha1.App{}
+	builtApp.Name = "my-app"
+	builtApp.Status.BuildMetadata = &v1alpha1.SourceBuildMetadata{
+		ImageDigest: "sha256:123",
+		SBOM:        "gcr.io/my-app@sha256:123",
+		Buildpacks: []v1alpha1.SourceBuildpackMetadata{
+			{ID: "java", Version: "1.0"},
+		},
+	}
+
+	containerApp := &v1alpha1.App{}
+	containerApp.Name = "container-app"
+
+	cases := map[string]struct {
+		Namespace       string
+		Args            []string
+		ExpectedStrings []string
+		ExpectedErr     error
+		Setup           func(t *testing.T, fake *fake.FakeClient, sbomFetcher *fakebuildpacks.FakeSBOMFetcher)
+	}{
+		"prints sbom": {
+			Namespace:       "default",
+			Args:            []string{"my-app", "--sbom"},
+			ExpectedStrings: []string{"sha256:123", "java", "log4j", "2.17.1"},
+			Setup: func(t *testing.T, fake *fake.FakeClient, sbomFetcher *fakebuildpacks.FakeSBOMFetcher) {
+				fake.EXPECT().Get("default", "my-app").Return(builtApp, nil)
+				sbomFetcher.EXPECT().FetchSBOM("gcr.io/my-app@sha256:123").Return([]buildpacks.BOMEntry{
+					{Name: "log4j", Version: "2.17.1", Buildpack: buildpacks.BuildpackRef{ID: "java"}},
+				}, nil)
+			},
+		},
+		"no build metadata": {
+			Namespace:   "default",
+			Args:        []string{"container-app", "--sbom"},
+			ExpectedErr: errors.New(`app "container-app" has no software bill of materials, only apps built with buildpacks record one`),
+			Setup: func(t *testing.T, fake *fake.FakeClient, sbomFetcher *fakebuildpacks.FakeSBOMFetcher) {
+				fake.EXPECT().Get("default", "container-app").Return(containerApp, nil)
+			},
+		},
+		"fetching sbom fails": {
+			Namespace:   "default",
+			Args:        []string{"my-app", "--sbom"},
+			ExpectedErr: errors.New("failed to fetch software bill of materials: some-error"),
+			Setup: func(t *testing.T, fake *fake.FakeClient, sbomFetcher *fakebuildpacks.FakeSBOMFetcher) {
+				fake.EXPECT().Get("default", "my-app").Return(builtApp, nil)
+				sbomFetcher.EXPECT().FetchSBOM(gomock.Any()).Return(nil, errors.New("some-error"))
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fake := fake.NewFakeClient(ctrl)
+			sbomFetcher := fakebuildpacks.NewFakeSBOMFetcher(ctrl)
+
+			if tc.Setup != nil {
+				tc.Setup(t, fake, sbomFetcher)
+			}
+
+			buf := new(bytes.Buffer)
+			p := &config.KfParams{
+				Namespace: tc.Namespace,
+			}
+
+			cmd := NewGetAppCommand(p, fake, sbomFetcher)
+			cmd.SetOutput(buf)
+			cmd.SetArgs(tc.Args)
+			_, actualErr := cmd.ExecuteC()
+			if tc.ExpectedErr != nil || actualErr != nil {
+				testutil.AssertErrorsEqual(t, tc.ExpectedErr, actualErr)
+				return
+			}
+
+			testutil.AssertContainsAll(t, buf.String(), tc.ExpectedStrings)
+			testutil.AssertEqual(t, "SilenceUsage", true, cmd.SilenceUsage)
+
+			ctrl.Finish()
+		})
+	}
+}
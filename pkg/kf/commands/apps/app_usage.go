@@ -0,0 +1,122 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apps
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/kf/pkg/kf/commands/completion"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// NewAppUsageCommand creates a command to print the resources requested by
+// apps.
+func NewAppUsageCommand(
+	p *config.KfParams,
+	usageReporter spaces.UsageReporter,
+) *cobra.Command {
+	printFlags := genericclioptions.NewPrintFlags("")
+
+	var cmd = &cobra.Command{
+		Use:   "app-usage [APP_NAME]",
+		Short: "Print the resources requested by apps",
+		Long: `Prints the CPU and memory requested by an app's latest ready revision
+		multiplied by its instances. Autoscaled apps are counted at their maximum.
+
+		If no app is given, the usage of every app in the targeted space is
+		printed. Use --output json or --output yaml to read the report from
+		chargeback tooling.`,
+		Example: `
+  kf app-usage
+  kf app-usage my-app
+  kf app-usage my-app --output json
+  `,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			w := cmd.OutOrStdout()
+
+			// Print status messages to stderr so stdout is syntactically valid output
+			// if the user wanted JSON, YAML, etc. Reports are built without a kind
+			// so it's set here for the printer to work.
+			var report runtime.Object
+			if len(args) == 0 {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Getting app usage in namespace: %s\n", p.Namespace)
+
+				usage, err := usageReporter.SpaceUsage(p.Namespace)
+				if err != nil {
+					return err
+				}
+
+				if !printFlags.OutputFlagSpecified() {
+					describe.AppUsageList(w, usage.Apps)
+					return nil
+				}
+
+				list := &spaces.AppUsageList{Items: usage.Apps}
+				list.GetObjectKind().SetGroupVersionKind(list.GetGroupVersionKind())
+				report = list
+			} else {
+				appName := args[0]
+				fmt.Fprintf(cmd.ErrOrStderr(), "Getting usage of app %s in namespace: %s\n", appName, p.Namespace)
+
+				usage, err := usageReporter.AppUsage(p.Namespace, appName)
+				if err != nil {
+					return err
+				}
+
+				if !printFlags.OutputFlagSpecified() {
+					describe.AppUsage(w, usage)
+					return nil
+				}
+
+				usage.GetObjectKind().SetGroupVersionKind(usage.GetGroupVersionKind())
+				report = usage
+			}
+
+			printer, err := printFlags.ToPrinter()
+			if err != nil {
+				return err
+			}
+
+			return printer.PrintObj(report, w)
+		},
+	}
+
+	printFlags.AddFlags(cmd)
+
+	// Override output format to be sorted so our generated documents are deterministic
+	{
+		allowedFormats := printFlags.AllowedFormats()
+		sort.Strings(allowedFormats)
+		cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(allowedFormats, "|"))
+	}
+
+	completion.MarkArgCompletionSupported(cmd, completion.AppCompletion)
+
+	return cmd
+}
@@ -0,0 +1,140 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apps
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/google/kf/pkg/kf/spaces/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestAppUsageCommand(t *testing.T) {
+	t.Parallel()
+
+	appUsage := spaces.AppUsage{
+		Name:        "my-app",
+		Revision:    "my-app-abc",
+		Instances:   2,
+		CPU:         resource.MustParse("500m"),
+		Memory:      resource.MustParse("1Gi"),
+		TotalCPU:    resource.MustParse("1"),
+		TotalMemory: resource.MustParse("2Gi"),
+	}
+
+	cases := map[string]struct {
+		Namespace       string
+		Args            []string
+		ExpectedStrings []string
+		ExpectedErr     error
+		Setup           func(t *testing.T, fake *fake.FakeUsageReporter)
+	}{
+		"no namespace": {
+			Args:        []string{"my-app"},
+			ExpectedErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"single app": {
+			Namespace:       "default",
+			Args:            []string{"my-app"},
+			ExpectedStrings: []string{"my-app-abc", "500m", "1Gi", "2Gi"},
+			Setup: func(t *testing.T, fake *fake.FakeUsageReporter) {
+				fake.EXPECT().AppUsage("default", "my-app").Return(&appUsage, nil)
+			},
+		},
+		"single app json": {
+			Namespace:       "default",
+			Args:            []string{"my-app", "--output", "json"},
+			ExpectedStrings: []string{`"kind": "AppUsage"`, `"name": "my-app"`, `"instances": 2`, `"totalCPU": "1"`},
+			Setup: func(t *testing.T, fake *fake.FakeUsageReporter) {
+				fake.EXPECT().AppUsage("default", "my-app").Return(&appUsage, nil)
+			},
+		},
+		"all apps": {
+			Namespace:       "default",
+			Args:            []string{},
+			ExpectedStrings: []string{"Apps", "my-app", "500m", "2Gi"},
+			Setup: func(t *testing.T, fake *fake.FakeUsageReporter) {
+				fake.EXPECT().SpaceUsage("default").Return(&spaces.SpaceUsage{
+					Space: "default",
+					Apps:  []spaces.AppUsage{appUsage},
+				}, nil)
+			},
+		},
+		"single app yaml": {
+			Namespace:       "default",
+			Args:            []string{"my-app", "-o", "yaml"},
+			ExpectedStrings: []string{"kind: AppUsage", "name: my-app", "totalMemory: 2Gi"},
+			Setup: func(t *testing.T, fake *fake.FakeUsageReporter) {
+				fake.EXPECT().AppUsage("default", "my-app").Return(&appUsage, nil)
+			},
+		},
+		"all apps json": {
+			Namespace:       "default",
+			Args:            []string{"--output", "json"},
+			ExpectedStrings: []string{`"kind": "AppUsageList"`, `"items"`, `"name": "my-app"`},
+			Setup: func(t *testing.T, fake *fake.FakeUsageReporter) {
+				fake.EXPECT().SpaceUsage("default").Return(&spaces.SpaceUsage{
+					Space: "default",
+					Apps:  []spaces.AppUsage{appUsage},
+				}, nil)
+			},
+		},
+		"reporter error": {
+			Namespace:   "default",
+			Args:        []string{"my-app"},
+			ExpectedErr: errors.New("some-error"),
+			Setup: func(t *testing.T, fake *fake.FakeUsageReporter) {
+				fake.EXPECT().AppUsage(gomock.Any(), gomock.Any()).Return(nil, errors.New("some-error"))
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fake := fake.NewFakeUsageReporter(ctrl)
+
+			if tc.Setup != nil {
+				tc.Setup(t, fake)
+			}
+
+			buf := new(bytes.Buffer)
+			p := &config.KfParams{
+				Namespace: tc.Namespace,
+			}
+
+			cmd := NewAppUsageCommand(p, fake)
+			cmd.SetOutput(buf)
+			cmd.SetArgs(tc.Args)
+			_, actualErr := cmd.ExecuteC()
+			if tc.ExpectedErr != nil || actualErr != nil {
+				testutil.AssertErrorsEqual(t, tc.ExpectedErr, actualErr)
+				return
+			}
+
+			testutil.AssertContainsAll(t, buf.String(), tc.ExpectedStrings)
+			testutil.AssertEqual(t, "SilenceUsage", true, cmd.SilenceUsage)
+
+			ctrl.Finish()
+		})
+	}
+}
@@ -47,7 +47,7 @@ type SrcImageBuilder interface {
 type KontextFilter = func(path string) (bool, error)
 
 // SrcImageBuilderFunc converts a func into a SrcImageBuilder.
-type SrcImageBuilderFunc func(dir, srcImage string, rebase bool, filter KontextFilter) error
+type SrcImageBuilderFunc func(dir, srcImage string, filter KontextFilter) error
 
 // BuildSrcImage implements SrcImageBuilder.
 func (f SrcImageBuilderFunc) BuildSrcImage(dir, srcImage string, filter KontextFilter) error {
@@ -59,7 +59,7 @@ func (f SrcImageBuilderFunc) BuildSrcImage(dir, srcImage string, filter KontextF
 	log.SetOutput(os.Stdout)
 
 	log.Printf("Uploading %s to image %s", dir, srcImage)
-	err := f(dir, srcImage, false, filter)
+	err := f(dir, srcImage, filter)
 
 	log.SetPrefix(oldPrefix)
 	log.SetFlags(oldFlags)
@@ -81,6 +81,9 @@ func NewPushCommand(
 		sourceImage         string
 		containerImage      string
 		dockerfilePath      string
+		dockerfileTarget    string
+		buildArgs           []string
+		buildSecrets        []string
 		manifestFile        string
 		instances           int
 		minScale            int
@@ -172,6 +175,17 @@ func NewPushCommand(
 				overrides.Args = containerArgs
 				overrides.Entrypoint = containerEntrypoint
 				overrides.Dockerfile.Path = dockerfilePath
+				overrides.Dockerfile.Target = dockerfileTarget
+				overrides.Dockerfile.Secrets = buildSecrets
+
+				// Read Dockerfile build args from cli args
+				if len(buildArgs) > 0 {
+					buildArgVars, err := envutil.ParseCLIEnvVars(buildArgs)
+					if err != nil {
+						return err
+					}
+					overrides.Dockerfile.BuildArgs = envutil.EnvVarsToMap(buildArgVars)
+				}
 
 				// Read environment variables from cli args
 				envVars, err := envutil.ParseCLIEnvVars(envs)
@@ -297,6 +311,10 @@ func NewPushCommand(
 
 				if app.Docker.Image == "" {
 					// buildpack or Dockerfile app
+					if err := validateStack(space, app); err != nil {
+						return err
+					}
+
 					registry := containerRegistry
 					switch {
 					case registry != "":
@@ -313,7 +331,7 @@ func NewPushCommand(
 					default:
 						imageName = apps.JoinRepositoryImage(registry, apps.SourceImageName(p.Namespace, app.Name))
 
-						// Kontext has to have a absolute path.
+						// The source image builder needs an absolute path.
 						srcPath, err = filepath.Abs(srcPath)
 						if err != nil {
 							return err
@@ -337,6 +355,9 @@ func NewPushCommand(
 						apps.WithPushBuildpack(app.Buildpack()),
 						apps.WithPushStack(app.Stack),
 						apps.WithPushDockerfilePath(app.Dockerfile.Path),
+						apps.WithPushDockerfileBuildArgs(app.Dockerfile.BuildArgs),
+						apps.WithPushDockerfileTarget(app.Dockerfile.Target),
+						apps.WithPushDockerfileSecrets(app.Dockerfile.Secrets),
 					)
 				} else {
 					if containerRegistry != "" {
@@ -427,7 +448,7 @@ func NewPushCommand(
 		"stack",
 		"s",
 		"",
-		"Base image to use for to use for apps created with a buildpack.",
+		"Stack to use for apps created with a buildpack, see 'kf stacks' for the options in the space.",
 	)
 
 	pushCmd.Flags().StringVar(
@@ -452,6 +473,27 @@ func NewPushCommand(
 		"Path to the Dockerfile to build. Relative to the source root.",
 	)
 
+	pushCmd.Flags().StringArrayVar(
+		&buildArgs,
+		"build-arg",
+		nil,
+		"Set Dockerfile build arguments. Multiple can be set by using the flag multiple times (e.g., NAME=VALUE).",
+	)
+
+	pushCmd.Flags().StringVar(
+		&dockerfileTarget,
+		"target",
+		"",
+		"Stage of a multi-stage Dockerfile to build.",
+	)
+
+	pushCmd.Flags().StringArrayVar(
+		&buildSecrets,
+		"build-secret",
+		nil,
+		"Secret in the space to mount under "+v1alpha1.DockerfileBuildSecretsPath+" while building the Dockerfile. Multiple can be set by using the flag multiple times.",
+	)
+
 	pushCmd.Flags().StringVarP(
 		&manifestFile,
 		"manifest",
@@ -618,6 +660,22 @@ func spaceDefaultDomain(space *v1alpha1.Space) (string, error) {
 	return "", errors.New("space does not have a default domain")
 }
 
+// validateStack checks that the stack and buildpack the app is built with are
+// available in the space. Spaces without stacks allow any value.
+func validateStack(space *v1alpha1.Space, app manifest.Application) error {
+	stacks := v1alpha1.BuildpackStacks(space.Status.BuildpackStacks)
+	if len(stacks) == 0 || app.Dockerfile.Path != "" {
+		return nil
+	}
+
+	stack, err := stacks.Find(app.Stack)
+	if err != nil {
+		return err
+	}
+
+	return stack.ValidateBuildpack(app.Buildpack())
+}
+
 func setupRoutes(space *v1alpha1.Space, app manifest.Application) (routes []v1alpha1.RouteSpecFields, err error) {
 	if app.NoRoute != nil && *app.NoRoute {
 		return nil, nil
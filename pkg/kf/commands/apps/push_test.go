@@ -98,7 +98,7 @@ func TestPushCommand(t *testing.T) {
 				"--args", "b",
 			},
 			wantImagePrefix: "some-reg.io/src-some-namespace-example-app",
-			srcImageBuilder: func(dir, srcImage string, rebase bool, filter func(path string) (bool, error)) error {
+			srcImageBuilder: func(dir, srcImage string, filter func(path string) (bool, error)) error {
 				testutil.AssertEqual(t, "path", true, strings.Contains(dir, "example-app"))
 				testutil.AssertEqual(t, "path is abs", true, filepath.IsAbs(dir))
 				return nil
@@ -128,7 +128,7 @@ func TestPushCommand(t *testing.T) {
 			args: []string{
 				"app-name",
 			},
-			srcImageBuilder: func(dir, srcImage string, rebase bool, filter func(path string) (bool, error)) error {
+			srcImageBuilder: func(dir, srcImage string, filter func(path string) (bool, error)) error {
 				cwd, err := os.Getwd()
 				testutil.AssertNil(t, "cwd err", err)
 				testutil.AssertEqual(t, "path", cwd, dir)
@@ -215,7 +215,7 @@ func TestPushCommand(t *testing.T) {
 				"app-name",
 				"--manifest", "testdata/manifest-services.yaml",
 			},
-			srcImageBuilder: func(dir, srcImage string, rebase bool, filter func(path string) (bool, error)) error {
+			srcImageBuilder: func(dir, srcImage string, filter func(path string) (bool, error)) error {
 				cwd, err := os.Getwd()
 				testutil.AssertNil(t, "cwd err", err)
 				testutil.AssertEqual(t, "path", cwd, dir)
@@ -267,11 +267,75 @@ func TestPushCommand(t *testing.T) {
 				apps.WithPushBuildpack("java,tomcat"),
 			),
 		},
+		"stack not in space": {
+			namespace: "some-namespace",
+			args: []string{
+				"app-name",
+				"--stack", "windows",
+			},
+			targetSpace: &v1alpha1.Space{
+				Spec: v1alpha1.SpaceSpec{
+					Execution: defaultSpaceSpecExecution,
+				},
+				Status: v1alpha1.SpaceStatus{
+					BuildpackStacks: []v1alpha1.BuildpackStack{
+						{Name: "cflinuxfs3", BuilderImage: "some-builder"},
+					},
+				},
+			},
+			wantErr: errors.New(`stack "windows" isn't available, choose one of: cflinuxfs3`),
+		},
+		"buildpack disabled on stack": {
+			namespace: "some-namespace",
+			args: []string{
+				"app-name",
+				"--stack", "cflinuxfs3",
+				"--buildpack", "php",
+			},
+			targetSpace: &v1alpha1.Space{
+				Spec: v1alpha1.SpaceSpec{
+					Execution: defaultSpaceSpecExecution,
+				},
+				Status: v1alpha1.SpaceStatus{
+					BuildpackStacks: []v1alpha1.BuildpackStack{
+						{
+							Name:         "cflinuxfs3",
+							BuilderImage: "some-builder",
+							Buildpacks: []v1alpha1.BuildpackCatalogEntry{
+								{ID: "php", Disabled: true},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New(`buildpack "php" isn't enabled on stack "cflinuxfs3"`),
+		},
+		"stack in space": {
+			namespace: "some-namespace",
+			args: []string{
+				"app-name",
+				"--stack", "cflinuxfs3",
+			},
+			targetSpace: &v1alpha1.Space{
+				Spec: v1alpha1.SpaceSpec{
+					Execution: defaultSpaceSpecExecution,
+				},
+				Status: v1alpha1.SpaceStatus{
+					BuildpackStacks: []v1alpha1.BuildpackStack{
+						{Name: "cflinuxfs3", BuilderImage: "some-builder"},
+					},
+				},
+			},
+			wantOpts: append(defaultOptions,
+				apps.WithPushNamespace("some-namespace"),
+				apps.WithPushStack("cflinuxfs3"),
+			),
+		},
 		"SrcImageBuilder returns an error": {
 			namespace: "some-namespace",
 			args:      []string{"app-name"},
 			wantErr:   errors.New("some error"),
-			srcImageBuilder: func(dir, srcImage string, rebase bool, filter func(path string) (bool, error)) error {
+			srcImageBuilder: func(dir, srcImage string, filter func(path string) (bool, error)) error {
 				return errors.New("some error")
 			},
 		},
@@ -549,10 +613,36 @@ func TestPushCommand(t *testing.T) {
 				apps.WithPushDockerfilePath("testdata/dockerfile-app/Dockerfile"),
 			),
 		},
+		"dockerfile build options": {
+			namespace: "some-namespace",
+			args: []string{
+				"dockerfile-app",
+				"--dockerfile", "testdata/dockerfile-app/Dockerfile",
+				"--build-arg", "VERSION=1.2.3",
+				"--target", "release",
+				"--build-secret", "npmrc",
+			},
+			wantOpts: append(defaultOptions,
+				apps.WithPushNamespace("some-namespace"),
+				apps.WithPushDockerfilePath("testdata/dockerfile-app/Dockerfile"),
+				apps.WithPushDockerfileBuildArgs(map[string]string{"VERSION": "1.2.3"}),
+				apps.WithPushDockerfileTarget("release"),
+				apps.WithPushDockerfileSecrets([]string{"npmrc"}),
+			),
+		},
+		"invalid build arg": {
+			namespace: "some-namespace",
+			args: []string{
+				"dockerfile-app",
+				"--dockerfile", "testdata/dockerfile-app/Dockerfile",
+				"--build-arg", "VERSION",
+			},
+			wantErr: errors.New("malformed environment variable: VERSION"),
+		},
 	} {
 		t.Run(tn, func(t *testing.T) {
 			if tc.srcImageBuilder == nil {
-				tc.srcImageBuilder = func(dir, srcImage string, rebase bool, filter func(path string) (bool, error)) error { return nil }
+				tc.srcImageBuilder = func(dir, srcImage string, filter func(path string) (bool, error)) error { return nil }
 			}
 
 			ctrl := gomock.NewController(t)
@@ -570,6 +660,7 @@ func TestPushCommand(t *testing.T) {
 					actualOpts := apps.PushOptions(opts)
 					testutil.AssertEqual(t, "namespace", expectOpts.Namespace(), actualOpts.Namespace())
 					testutil.AssertEqual(t, "buildpack", expectOpts.Buildpack(), actualOpts.Buildpack())
+					testutil.AssertEqual(t, "stack", expectOpts.Stack(), actualOpts.Stack())
 					testutil.AssertEqual(t, "grpc", expectOpts.Grpc(), actualOpts.Grpc())
 					testutil.AssertEqual(t, "env vars", expectOpts.EnvironmentVariables(), actualOpts.EnvironmentVariables())
 					testutil.AssertEqual(t, "instances", expectOpts.AppSpecInstances(), actualOpts.AppSpecInstances())
@@ -581,6 +672,9 @@ func TestPushCommand(t *testing.T) {
 					testutil.AssertEqual(t, "command", expectOpts.Command(), actualOpts.Command())
 					testutil.AssertEqual(t, "args", expectOpts.Args(), actualOpts.Args())
 					testutil.AssertEqual(t, "Dockerfile path", expectOpts.DockerfilePath(), actualOpts.DockerfilePath())
+					testutil.AssertEqual(t, "Dockerfile build args", expectOpts.DockerfileBuildArgs(), actualOpts.DockerfileBuildArgs())
+					testutil.AssertEqual(t, "Dockerfile target", expectOpts.DockerfileTarget(), actualOpts.DockerfileTarget())
+					testutil.AssertEqual(t, "Dockerfile secrets", expectOpts.DockerfileSecrets(), actualOpts.DockerfileSecrets())
 
 					if !strings.HasPrefix(actualOpts.SourceImage(), tc.wantImagePrefix) {
 						t.Errorf("Wanted srcImage to start with %s got: %s", tc.wantImagePrefix, actualOpts.SourceImage())
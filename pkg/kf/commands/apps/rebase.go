@@ -0,0 +1,252 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apps
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/apps"
+	"github.com/google/kf/pkg/kf/buildpacks"
+	"github.com/google/kf/pkg/kf/commands/completion"
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/spf13/cobra"
+)
+
+// NewRebaseCommand creates a command capable of rebasing apps onto the
+// latest version of their stack.
+func NewRebaseCommand(
+	p *config.KfParams,
+	client apps.Client,
+	rebaser buildpacks.Rebaser,
+) *cobra.Command {
+	var (
+		async   utils.AsyncFlags
+		allApps bool
+		stack   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rebase [APP_NAME]",
+		Short: "Rebase apps onto the latest version of their stack without rebuilding",
+		Long: `Rebase replaces the layers of the run image (stack) in an app's image with
+		the layers of the latest version of the same stack. Detect and build
+		aren't run again, so rebasing is much faster than restaging.
+
+		Only apps built with buildpacks can be rebased. If the space has a
+		buildpack catalog, --stack is the name of a stack in the catalog and
+		apps are rebased onto its run image.
+		`,
+		Example: `
+  kf rebase myapp
+  kf rebase --all-apps --stack gcr.io/buildpacks/run:latest
+		`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			switch {
+			case allApps && len(args) > 0:
+				return errors.New("APP_NAME can't be used with --all-apps")
+			case allApps && stack == "":
+				return errors.New("--stack is required with --all-apps")
+			case !allApps && len(args) == 0:
+				return errors.New("APP_NAME or --all-apps is required")
+			}
+
+			cmd.SilenceUsage = true
+
+			space, err := p.GetTargetSpaceOrDefault()
+			if err != nil {
+				return err
+			}
+
+			var toRebase []v1alpha1.App
+			if allApps {
+				stackName, _, err := resolveStack(space, stack)
+				if err != nil {
+					return err
+				}
+
+				appList, err := client.List(p.Namespace)
+				if err != nil {
+					return fmt.Errorf("failed to list apps: %s", err)
+				}
+
+				for _, app := range appList {
+					if !app.Spec.Source.IsBuildpackBuild() {
+						continue
+					}
+
+					// Apps on stacks that were removed from the catalog can't
+					// match.
+					appStack, _, err := resolveStack(space, app.Spec.Source.BuildpackBuild.Stack)
+					if err == nil && appStack == stackName {
+						toRebase = append(toRebase, app)
+					}
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Rebasing %d app(s) on stack %q\n", len(toRebase), stack)
+			} else {
+				app, err := client.Get(p.Namespace, args[0])
+				if err != nil {
+					return fmt.Errorf("failed to rebase app: %s", err)
+				}
+
+				toRebase = append(toRebase, *app)
+			}
+
+			var failed []string
+			for _, app := range toRebase {
+				if err := rebaseApp(cmd.OutOrStdout(), client, rebaser, space, &app, stack, async); err != nil {
+					if !allApps {
+						return fmt.Errorf("failed to rebase app: %s", err)
+					}
+
+					fmt.Fprintf(cmd.OutOrStdout(), "failed to rebase %q: %s\n", app.Name, err)
+					failed = append(failed, app.Name)
+				}
+			}
+
+			if len(failed) > 0 {
+				return fmt.Errorf("failed to rebase apps: %s", strings.Join(failed, ", "))
+			}
+
+			return nil
+		},
+	}
+
+	async.Add(cmd)
+
+	cmd.Flags().BoolVar(
+		&allApps,
+		"all-apps",
+		false,
+		"Rebase every app in the space built on the stack given by --stack.",
+	)
+
+	cmd.Flags().StringVarP(
+		&stack,
+		"stack",
+		"s",
+		"",
+		"Stack to rebase onto. Defaults to the stack the app was built with.",
+	)
+
+	completion.MarkArgCompletionSupported(cmd, completion.AppCompletion)
+
+	return cmd
+}
+
+func rebaseApp(
+	w io.Writer,
+	client apps.Client,
+	rebaser buildpacks.Rebaser,
+	space *v1alpha1.Space,
+	app *v1alpha1.App,
+	stack string,
+	async utils.AsyncFlags,
+) error {
+	if !app.Spec.Source.IsBuildpackBuild() {
+		return fmt.Errorf("app %q was not built with buildpacks", app.Name)
+	}
+
+	if app.Status.Image == "" {
+		return fmt.Errorf("app %q has not been built yet", app.Name)
+	}
+
+	if stack == "" {
+		stack = app.Spec.Source.BuildpackBuild.Stack
+	}
+
+	_, runImage, err := resolveStack(space, stack)
+	if err != nil {
+		return err
+	}
+
+	destination, err := rebaseImageDestination(app)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Rebasing %q onto %s\n", app.Name, runImage)
+
+	rebasedImage, err := rebaser.Rebase(app.Status.Image, runImage, destination)
+	switch {
+	case err == buildpacks.ErrUpToDate:
+		fmt.Fprintf(w, "%q is already up to date\n", app.Name)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	updated, err := client.Rebase(app.Namespace, app.Name, rebasedImage)
+	if err != nil {
+		return err
+	}
+
+	if async.IsSynchronous() {
+		if err := client.DeployLogsForApp(w, updated); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "%q successfully rebased\n", app.Name)
+	}
+
+	return nil
+}
+
+// resolveStack returns the name apps use to select the stack and the run
+// image of the stack. Spaces with a buildpack catalog select stacks by name,
+// otherwise the stack is the run image itself.
+func resolveStack(space *v1alpha1.Space, stack string) (name, runImage string, err error) {
+	stacks := v1alpha1.BuildpackStacks(space.Status.BuildpackStacks)
+	if len(stacks) == 0 {
+		return stack, stack, nil
+	}
+
+	found, err := stacks.Find(stack)
+	if err != nil {
+		return "", "", err
+	}
+
+	return found.Name, found.RunImage, nil
+}
+
+// rebaseImageDestination returns the tag the rebased image will be written to.
+// The image is put in the same repository as the app's current image and
+// tagged with the UpdateRequests of the Source that will be created.
+func rebaseImageDestination(app *v1alpha1.App) (string, error) {
+	image := app.Status.Image
+
+	// Strip the digest or tag from the current image.
+	if idx := strings.LastIndex(image, "@"); idx >= 0 {
+		image = image[:idx]
+	} else if idx := strings.LastIndex(image, ":"); idx > strings.LastIndex(image, "/") {
+		image = image[:idx]
+	}
+
+	if image == "" {
+		return "", fmt.Errorf("couldn't determine repository of image %q", app.Status.Image)
+	}
+
+	return fmt.Sprintf("%s:%x", image, app.Spec.Source.UpdateRequests+1), nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apps
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/apps/fake"
+	"github.com/google/kf/pkg/kf/buildpacks"
+	fakebuildpacks "github.com/google/kf/pkg/kf/buildpacks/fake"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestRebase(t *testing.T) {
+	t.Parallel()
+
+	builtApp := func(name, stack string) *v1alpha1.App {
+		app := &v1alpha1.App{}
+		app.Name = name
+		app.Namespace = "default"
+		app.Spec.Source.UpdateRequests = 9
+		app.Spec.Source.BuildpackBuild.Source = "some-source"
+		app.Spec.Source.BuildpackBuild.Stack = stack
+		app.Status.Image = "gcr.io/reg/app_default_" + name + ":9"
+		return app
+	}
+
+	catalogStacks := []v1alpha1.BuildpackStack{
+		{Name: "cflinuxfs3", RunImage: "gcr.io/cflinuxfs3/run:latest", Default: true},
+		{Name: "distroless", RunImage: "gcr.io/distroless/run:latest"},
+	}
+
+	containerApp := &v1alpha1.App{}
+	containerApp.Name = "container-app"
+	containerApp.Spec.Source.ContainerImage.Image = "mysql"
+
+	cases := map[string]struct {
+		Namespace       string
+		Args            []string
+		Stacks          []v1alpha1.BuildpackStack
+		ExpectedStrings []string
+		ExpectedErr     error
+		Setup           func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser)
+	}{
+		"rebases app": {
+			Namespace:       "default",
+			Args:            []string{"my-app"},
+			ExpectedStrings: []string{"my-app", "successfully rebased"},
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().Get("default", "my-app").Return(builtApp("my-app", "run:latest"), nil)
+				rebaser.EXPECT().
+					Rebase("gcr.io/reg/app_default_my-app:9", "run:latest", "gcr.io/reg/app_default_my-app:a").
+					Return("gcr.io/reg/app_default_my-app@sha256:123", nil)
+				fake.EXPECT().Rebase("default", "my-app", "gcr.io/reg/app_default_my-app@sha256:123")
+				fake.EXPECT().DeployLogsForApp(gomock.Any(), gomock.Any())
+			},
+		},
+		"rebases app async": {
+			Namespace: "default",
+			Args:      []string{"--async", "my-app"},
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().Get("default", "my-app").Return(builtApp("my-app", "run:latest"), nil)
+				rebaser.EXPECT().Rebase(gomock.Any(), gomock.Any(), gomock.Any()).Return("some-image", nil)
+				fake.EXPECT().Rebase("default", "my-app", "some-image")
+			},
+		},
+		"already up to date": {
+			Namespace:       "default",
+			Args:            []string{"my-app"},
+			ExpectedStrings: []string{"already up to date"},
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().Get("default", "my-app").Return(builtApp("my-app", "run:latest"), nil)
+				rebaser.EXPECT().Rebase(gomock.Any(), gomock.Any(), gomock.Any()).Return("", buildpacks.ErrUpToDate)
+			},
+		},
+		"non-buildpack app": {
+			Namespace:   "default",
+			Args:        []string{"container-app"},
+			ExpectedErr: errors.New(`failed to rebase app: app "container-app" was not built with buildpacks`),
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().Get("default", "container-app").Return(containerApp, nil)
+			},
+		},
+		"rebase fails": {
+			Namespace:   "default",
+			Args:        []string{"my-app"},
+			ExpectedErr: errors.New("failed to rebase app: some-error"),
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().Get("default", "my-app").Return(builtApp("my-app", "run:latest"), nil)
+				rebaser.EXPECT().Rebase(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some-error"))
+			},
+		},
+		"all apps on stack": {
+			Namespace:       "default",
+			Args:            []string{"--all-apps", "--stack", "run:latest", "--async"},
+			ExpectedStrings: []string{"Rebasing 1 app(s)"},
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().List("default").Return([]v1alpha1.App{
+					*builtApp("app-a", "run:latest"),
+					*builtApp("app-b", "other:latest"),
+					*containerApp,
+				}, nil)
+				rebaser.EXPECT().Rebase(gomock.Any(), "run:latest", gomock.Any()).Return("some-image", nil)
+				fake.EXPECT().Rebase("default", "app-a", "some-image")
+			},
+		},
+		"all apps reports failures": {
+			Namespace:   "default",
+			Args:        []string{"--all-apps", "--stack", "run:latest", "--async"},
+			ExpectedErr: errors.New("failed to rebase apps: app-a"),
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().List("default").Return([]v1alpha1.App{
+					*builtApp("app-a", "run:latest"),
+					*builtApp("app-b", "run:latest"),
+				}, nil)
+				rebaser.EXPECT().Rebase("gcr.io/reg/app_default_app-a:9", gomock.Any(), gomock.Any()).Return("", errors.New("some-error"))
+				rebaser.EXPECT().Rebase("gcr.io/reg/app_default_app-b:9", gomock.Any(), gomock.Any()).Return("some-image", nil)
+				fake.EXPECT().Rebase("default", "app-b", "some-image")
+			},
+		},
+		"catalog stack": {
+			Namespace:       "default",
+			Args:            []string{"my-app"},
+			Stacks:          catalogStacks,
+			ExpectedStrings: []string{"gcr.io/cflinuxfs3/run:latest"},
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().Get("default", "my-app").Return(builtApp("my-app", "cflinuxfs3"), nil)
+				rebaser.EXPECT().
+					Rebase("gcr.io/reg/app_default_my-app:9", "gcr.io/cflinuxfs3/run:latest", gomock.Any()).
+					Return("", buildpacks.ErrUpToDate)
+			},
+		},
+		"catalog default stack": {
+			Namespace: "default",
+			Args:      []string{"my-app"},
+			Stacks:    catalogStacks,
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().Get("default", "my-app").Return(builtApp("my-app", ""), nil)
+				rebaser.EXPECT().
+					Rebase(gomock.Any(), "gcr.io/cflinuxfs3/run:latest", gomock.Any()).
+					Return("", buildpacks.ErrUpToDate)
+			},
+		},
+		"catalog stack missing": {
+			Namespace:   "default",
+			Args:        []string{"--stack", "windows", "my-app"},
+			Stacks:      catalogStacks,
+			ExpectedErr: errors.New(`failed to rebase app: stack "windows" isn't available, choose one of: cflinuxfs3, distroless`),
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().Get("default", "my-app").Return(builtApp("my-app", "cflinuxfs3"), nil)
+			},
+		},
+		"all apps on catalog stack": {
+			Namespace:       "default",
+			Args:            []string{"--all-apps", "--stack", "cflinuxfs3", "--async"},
+			Stacks:          catalogStacks,
+			ExpectedStrings: []string{"Rebasing 2 app(s)"},
+			Setup: func(t *testing.T, fake *fake.FakeClient, rebaser *fakebuildpacks.FakeRebaser) {
+				fake.EXPECT().List("default").Return([]v1alpha1.App{
+					*builtApp("app-a", "cflinuxfs3"),
+					*builtApp("app-b", "distroless"),
+					*builtApp("app-c", ""),
+					*builtApp("app-d", "removed"),
+				}, nil)
+				rebaser.EXPECT().Rebase("gcr.io/reg/app_default_app-a:9", "gcr.io/cflinuxfs3/run:latest", gomock.Any()).Return("some-image", nil)
+				rebaser.EXPECT().Rebase("gcr.io/reg/app_default_app-c:9", "gcr.io/cflinuxfs3/run:latest", gomock.Any()).Return("some-image", nil)
+				fake.EXPECT().Rebase("default", "app-a", "some-image")
+				fake.EXPECT().Rebase("default", "app-c", "some-image")
+			},
+		},
+		"all apps without stack": {
+			Namespace:   "default",
+			Args:        []string{"--all-apps"},
+			ExpectedErr: errors.New("--stack is required with --all-apps"),
+		},
+		"all apps with app name": {
+			Namespace:   "default",
+			Args:        []string{"--all-apps", "--stack", "run", "my-app"},
+			ExpectedErr: errors.New("APP_NAME can't be used with --all-apps"),
+		},
+		"no app name": {
+			Namespace:   "default",
+			Args:        []string{},
+			ExpectedErr: errors.New("APP_NAME or --all-apps is required"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fake := fake.NewFakeClient(ctrl)
+			rebaser := fakebuildpacks.NewFakeRebaser(ctrl)
+
+			if tc.Setup != nil {
+				tc.Setup(t, fake, rebaser)
+			}
+
+			buf := new(bytes.Buffer)
+			p := &config.KfParams{
+				Namespace: tc.Namespace,
+			}
+			p.SetTargetSpaceToDefault()
+			p.TargetSpace.Status.BuildpackStacks = tc.Stacks
+
+			cmd := NewRebaseCommand(p, fake, rebaser)
+			cmd.SetOutput(buf)
+			cmd.SetArgs(tc.Args)
+			_, actualErr := cmd.ExecuteC()
+			if tc.ExpectedErr != nil || actualErr != nil {
+				testutil.AssertErrorsEqual(t, tc.ExpectedErr, actualErr)
+				return
+			}
+
+			testutil.AssertContainsAll(t, buf.String(), tc.ExpectedStrings)
+			testutil.AssertEqual(t, "SilenceUsage", true, cmd.SilenceUsage)
+
+			ctrl.Finish()
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/buildpacks"
 	"github.com/google/kf/pkg/kf/commands/config"
 	"github.com/google/kf/pkg/kf/describe"
@@ -35,8 +36,10 @@ func NewBuildpacksCommand(p *config.KfParams, l buildpacks.Client) *cobra.Comman
 		Long: `List the buildpacks available in the space to applications being built
 		with buildpacks.
 
-		Buildpack support is determined by the buildpack builder image and can
-		change from one space to the next.
+		If operators have configured a buildpack catalog for the space, the
+		buildpacks of each stack come from the catalog. Otherwise, buildpack
+		support is determined by the buildpack builder image and can change from
+		one space to the next.
 		`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := utils.ValidateNamespace(p); err != nil {
@@ -50,6 +53,15 @@ func NewBuildpacksCommand(p *config.KfParams, l buildpacks.Client) *cobra.Comman
 
 			fmt.Fprintf(cmd.OutOrStdout(), "Getting buildpacks in space: %s\n", p.Namespace)
 
+			if stacks := space.Status.BuildpackStacks; len(stacks) > 0 {
+				if err := listCatalogBuildpacks(cmd.OutOrStdout(), l, stacks); err != nil {
+					cmd.SilenceUsage = !utils.ConfigError(err)
+					return err
+				}
+
+				return nil
+			}
+
 			bps, err := l.List(space.Spec.BuildpackBuild.BuilderImage)
 			if err != nil {
 				cmd.SilenceUsage = !utils.ConfigError(err)
@@ -70,3 +82,58 @@ func NewBuildpacksCommand(p *config.KfParams, l buildpacks.Client) *cobra.Comman
 
 	return buildpacksCmd
 }
+
+// listCatalogBuildpacks lists the buildpacks of each stack in the catalog.
+// Stacks that don't restrict their buildpacks list everything on their
+// builder.
+func listCatalogBuildpacks(out io.Writer, l buildpacks.Client, stacks []v1alpha1.BuildpackStack) error {
+	type row struct {
+		stack   string
+		id      string
+		version string
+		enabled bool
+	}
+
+	var rows []row
+	for _, stack := range stacks {
+		if len(stack.Buildpacks) > 0 {
+			for _, bp := range stack.Buildpacks {
+				rows = append(rows, row{stack.Name, bp.ID, bp.Version, !bp.Disabled})
+			}
+
+			continue
+		}
+
+		bps, err := l.List(stack.BuilderImage)
+		if err != nil {
+			return err
+		}
+
+		for _, bp := range bps {
+			rows = append(rows, row{stack.Name, bp.ID, bp.Version, true})
+		}
+	}
+
+	describe.TabbedWriter(out, func(w io.Writer) {
+		fmt.Fprintln(w, "Stack\tName\tPosition\tVersion\tEnabled")
+
+		// Disabled buildpacks are never detected so they don't get a
+		// position.
+		position := 0
+		for i, r := range rows {
+			if i > 0 && rows[i-1].stack != r.stack {
+				position = 0
+			}
+
+			if !r.enabled {
+				fmt.Fprintf(w, "%s\t%s\t-\t%s\t%v\n", r.stack, r.id, r.version, r.enabled)
+				continue
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%v\n", r.stack, r.id, position, r.version, r.enabled)
+			position++
+		}
+	})
+
+	return nil
+}
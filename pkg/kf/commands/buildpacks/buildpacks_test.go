@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/buildpacks"
 	"github.com/google/kf/pkg/kf/buildpacks/fake"
 	cbuildpacks "github.com/google/kf/pkg/kf/commands/buildpacks"
@@ -66,6 +67,51 @@ func TestBuildpacks(t *testing.T) {
 				testutil.AssertContainsAll(t, buffer.String(), []string{"bp-1", "bp-2"})
 			},
 		},
+		"lists catalog buildpacks": {
+			Namespace: "my-space",
+			Setup: func(t *testing.T, fake *fake.FakeClient, params *config.KfParams) {
+				params.TargetSpace.Status.BuildpackStacks = []v1alpha1.BuildpackStack{
+					{
+						Name:         "cflinuxfs3",
+						BuilderImage: "cflinuxfs3-builder",
+						Buildpacks: []v1alpha1.BuildpackCatalogEntry{
+							{ID: "java", Version: "1.0"},
+							{ID: "php", Disabled: true},
+						},
+					},
+					{
+						Name:         "distroless",
+						BuilderImage: "distroless-builder",
+					},
+				}
+
+				fake.EXPECT().List("distroless-builder").Return([]buildpacks.Buildpack{{ID: "go"}}, nil)
+			},
+			BufferF: func(t *testing.T, buffer *bytes.Buffer) {
+				testutil.AssertContainsAll(t, buffer.String(), []string{"cflinuxfs3", "java", "1.0", "php", "false", "distroless", "go"})
+			},
+		},
+		"catalog positions skip disabled buildpacks": {
+			Namespace: "my-space",
+			Setup: func(t *testing.T, fake *fake.FakeClient, params *config.KfParams) {
+				params.TargetSpace.Status.BuildpackStacks = []v1alpha1.BuildpackStack{
+					{
+						Name:         "cflinuxfs3",
+						BuilderImage: "cflinuxfs3-builder",
+						Buildpacks: []v1alpha1.BuildpackCatalogEntry{
+							{ID: "java", Version: "1.0"},
+							{ID: "php", Version: "2.0", Disabled: true},
+							{ID: "go", Version: "3.0"},
+						},
+					},
+				}
+			},
+			BufferF: func(t *testing.T, buffer *bytes.Buffer) {
+				testutil.AssertRegexp(t, "java position", `java\s+0\s+1\.0`, buffer.String())
+				testutil.AssertRegexp(t, "php position", `php\s+-\s+2\.0`, buffer.String())
+				testutil.AssertRegexp(t, "go position", `go\s+1\s+3\.0`, buffer.String())
+			},
+		},
 	} {
 		t.Run(tn, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
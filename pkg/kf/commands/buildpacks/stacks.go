@@ -35,8 +35,9 @@ func NewStacksCommand(p *config.KfParams, l buildpacks.Client) *cobra.Command {
 		Long: `List the stacks available in the space to applications being built
 		with buildpacks.
 
-		Stack support is determined by the buildpack builder image so they can
-		change from one space to the next.
+		If operators have configured a buildpack catalog for the space, the
+		stacks come from the catalog. Otherwise, stack support is determined by
+		the buildpack builder image so they can change from one space to the next.
 		`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := utils.ValidateNamespace(p); err != nil {
@@ -50,6 +51,18 @@ func NewStacksCommand(p *config.KfParams, l buildpacks.Client) *cobra.Command {
 
 			fmt.Fprintf(cmd.OutOrStdout(), "Getting stacks in space: %s\n", p.Namespace)
 
+			if stacks := space.Status.BuildpackStacks; len(stacks) > 0 {
+				describe.TabbedWriter(cmd.OutOrStdout(), func(w io.Writer) {
+					fmt.Fprintln(w, "Name\tDefault\tBuilder\tDescription")
+
+					for _, s := range stacks {
+						fmt.Fprintf(w, "%s\t%v\t%s\t%s\n", s.Name, s.Default, s.BuilderImage, s.Description)
+					}
+				})
+
+				return nil
+			}
+
 			stacks, err := l.Stacks(space.Spec.BuildpackBuild.BuilderImage)
 			if err != nil {
 				cmd.SilenceUsage = !utils.ConfigError(err)
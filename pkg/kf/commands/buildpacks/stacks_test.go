@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/buildpacks/fake"
 	cbuildpacks "github.com/google/kf/pkg/kf/commands/buildpacks"
 	"github.com/google/kf/pkg/kf/commands/config"
@@ -64,6 +65,18 @@ func TestStacks(t *testing.T) {
 				testutil.AssertContainsAll(t, buffer.String(), []string{"s-1", "s-2"})
 			},
 		},
+		"lists catalog stacks": {
+			Namespace: "my-space",
+			Setup: func(t *testing.T, fake *fake.FakeClient, params *config.KfParams) {
+				params.TargetSpace.Status.BuildpackStacks = []v1alpha1.BuildpackStack{
+					{Name: "cflinuxfs3", BuilderImage: "cflinuxfs3-builder", Default: true},
+					{Name: "distroless", BuilderImage: "distroless-builder", Description: "Minimal images"},
+				}
+			},
+			BufferF: func(t *testing.T, buffer *bytes.Buffer) {
+				testutil.AssertContainsAll(t, buffer.String(), []string{"cflinuxfs3", "cflinuxfs3-builder", "true", "distroless", "Minimal images"})
+			},
+		},
 	} {
 		t.Run(tn, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -15,14 +15,31 @@
 package builds
 
 import (
+	"io"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
 	"github.com/google/kf/pkg/kf/internal/genericcli"
 	"github.com/google/kf/pkg/kf/sources"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic"
 )
 
 // NewGetBuildCommand allows users to get builds.
 func NewGetBuildCommand(p *config.KfParams, client dynamic.Interface) *cobra.Command {
-	return genericcli.NewDescribeCommand(sources.NewResourceInfo(), p, client)
+	return genericcli.NewDescribeCommand(sources.NewResourceInfo(), p, client, describeBuildMetadata)
+}
+
+// describeBuildMetadata prints the provenance recorded by a successful build.
+func describeBuildMetadata(w io.Writer, resource *unstructured.Unstructured) error {
+	source := &v1alpha1.Source{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(resource.Object, source); err != nil {
+		return err
+	}
+
+	describe.SourceBuildMetadata(w, source.Status.BuildMetadata)
+	return nil
 }
@@ -0,0 +1,81 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builds
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/testutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+)
+
+func TestNewGetBuildCommand(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		metadata        *v1alpha1.SourceBuildMetadata
+		expectedStrings []string
+	}{
+		"no metadata": {
+			expectedStrings: []string{"my-build", "Build Metadata: <empty>"},
+		},
+		"metadata": {
+			metadata: &v1alpha1.SourceBuildMetadata{
+				ImageDigest: "sha256:111",
+				Stack:       "gcr.io/run@sha256:222",
+				SBOM:        "gcr.io/my-app@sha256:111",
+				Buildpacks: []v1alpha1.SourceBuildpackMetadata{
+					{ID: "java", Version: "1.0"},
+				},
+			},
+			expectedStrings: []string{
+				"Build Metadata:",
+				"sha256:111",
+				"gcr.io/run@sha256:222",
+				"gcr.io/my-app@sha256:111",
+				"java:",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			source := &v1alpha1.Source{}
+			source.APIVersion = "kf.dev/v1alpha1"
+			source.Kind = "Source"
+			source.Name = "my-build"
+			source.Namespace = "my-ns"
+			source.Status.BuildMetadata = tc.metadata
+
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(source)
+			testutil.AssertNil(t, "conversion error", err)
+
+			client := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), &unstructured.Unstructured{Object: obj})
+
+			buf := new(bytes.Buffer)
+			cmd := NewGetBuildCommand(&config.KfParams{Namespace: "my-ns"}, client)
+			cmd.SetOutput(buf)
+			cmd.SetArgs([]string{"my-build"})
+
+			testutil.AssertNil(t, "error", cmd.Execute())
+			testutil.AssertContainsAll(t, buf.String(), tc.expectedStrings)
+		})
+	}
+}
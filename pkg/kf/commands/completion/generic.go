@@ -26,6 +26,9 @@ const (
 	// AppCompletion is the type for completing apps
 	AppCompletion = "apps"
 
+	// OrganizationCompletion is the type for completing organizations
+	OrganizationCompletion = "organizations"
+
 	// SourceCompletion is the type for completing sources
 	SourceCompletion = "sources"
 
@@ -48,6 +51,12 @@ var namespacedTypes = map[string]schema.GroupVersionResource{
 }
 
 var globalTypes = map[string]schema.GroupVersionResource{
+	OrganizationCompletion: {
+		Group:    "kf.dev",
+		Version:  "v1alpha1",
+		Resource: "organizations",
+	},
+
 	SpaceCompletion: {
 		Group:    "kf.dev",
 		Version:  "v1alpha1",
@@ -22,6 +22,7 @@ func ExampleKnownGenericTypes() {
 	}
 
 	// Output: apps
+	// organizations
 	// sources
 	// spaces
 }
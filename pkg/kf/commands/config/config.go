@@ -53,6 +53,10 @@ type KfParams struct {
 	// Namespace holds the namespace kf should connect to by default.
 	Namespace string `json:"space"`
 
+	// Organization holds the organization kf should use by default. New
+	// spaces are created in it.
+	Organization string `json:"organization,omitempty"`
+
 	// KubeCfgFile holds the path to the kubeconfig.
 	KubeCfgFile string `json:"kubeconfig"`
 
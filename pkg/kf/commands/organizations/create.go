@@ -0,0 +1,150 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	"github.com/google/kf/pkg/kf/organizations"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// NewCreateOrganizationCommand allows users to create organizations.
+func NewCreateOrganizationCommand(p *config.KfParams, client organizations.Client) *cobra.Command {
+	var (
+		containerRegistry string
+		builderImage      string
+		domains           []string
+		memory            string
+		cpu               string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create-org ORG",
+		Short: "Create an organization",
+		Long: `Create an organization to group spaces.
+
+		Spaces created with --org inherit the organization's container
+		registry, builder image and domains unless they set their own. If the
+		organization has a quota, every space in it must have a quota for the
+		same resources and their sum can't exceed the organization's.
+		`,
+		Example: `kf create-org my-org --container-registry gcr.io/my-project --domain my-org.example.com --memory 100Gi`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			name := args[0]
+
+			toCreate := &v1alpha1.Organization{}
+			toCreate.Name = name
+			toCreate.Spec.Defaults.ContainerRegistry = containerRegistry
+			toCreate.Spec.Defaults.BuilderImage = builderImage
+
+			for i, domain := range domains {
+				toCreate.Spec.Defaults.Domains = append(
+					toCreate.Spec.Defaults.Domains,
+					v1alpha1.SpaceDomain{Domain: domain, Default: i == 0},
+				)
+			}
+
+			quotaInputs := map[corev1.ResourceName]string{
+				corev1.ResourceMemory: memory,
+				corev1.ResourceCPU:    cpu,
+			}
+			for resourceName, value := range quotaInputs {
+				if value == "" {
+					continue
+				}
+
+				quantity, err := resource.ParseQuantity(value)
+				if err != nil {
+					return fmt.Errorf("couldn't parse resource quantity %s: %v", value, err)
+				}
+
+				if toCreate.Spec.Quota == nil {
+					toCreate.Spec.Quota = corev1.ResourceList{}
+				}
+				toCreate.Spec.Quota[resourceName] = quantity
+			}
+
+			if _, err := client.Create(toCreate); err != nil {
+				return err
+			}
+
+			w := cmd.OutOrStdout()
+
+			fmt.Fprintln(w, "Organization requested, waiting for it to be ready")
+			org, err := client.WaitFor(context.Background(), name, 1*time.Second, organizations.IsStatusFinal)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(w, "Organization created")
+			describe.DuckStatus(w, org.Status.Status)
+			fmt.Fprintln(w)
+
+			fmt.Fprintf(w, "Create spaces in the organization with: kf create-space SPACE --org %s\n", name)
+			fmt.Fprintf(w, "Target the organization with: kf target -o %s\n", name)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(
+		&containerRegistry,
+		"container-registry",
+		"",
+		"Container registry spaces in the organization store built apps and sources in.",
+	)
+
+	cmd.Flags().StringVar(
+		&builderImage,
+		"builder-image",
+		"",
+		"Buildpack builder image spaces in the organization use.",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&domains,
+		"domain",
+		nil,
+		"Sets the domains spaces in the organization inherit. The first provided domain will be the default.",
+	)
+
+	cmd.Flags().StringVarP(
+		&memory,
+		"memory",
+		"m",
+		"",
+		"Total amount of memory the spaces in the organization can have (e.g. 10Gi, 500Mi) (default: unlimited)",
+	)
+
+	cmd.Flags().StringVarP(
+		&cpu,
+		"cpu",
+		"c",
+		"",
+		"Total amount of CPU the spaces in the organization can have (e.g. 400m) (default: unlimited)",
+	)
+
+	return cmd
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organizations
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/organizations/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestNewCreateOrganizationCommand(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		wantErr error
+		args    []string
+		setup   func(t *testing.T, fakeOrgs *fake.FakeClient)
+	}{
+		"invalid number of args": {
+			args:    []string{},
+			wantErr: errors.New("accepts 1 arg(s), received 0"),
+		},
+		"object passed through": {
+			args: []string{"my-org", "--container-registry=some-registry", "--builder-image=some-builder", "--domain=domain-1", "--domain=domain-2", "--memory=10Gi"},
+			setup: func(t *testing.T, fakeOrgs *fake.FakeClient) {
+				fakeOrgs.
+					EXPECT().
+					Create(gomock.Any()).
+					Do(func(org *v1alpha1.Organization) {
+						testutil.AssertEqual(t, "sets name", "my-org", org.Name)
+						testutil.AssertEqual(t, "sets container registry", "some-registry", org.Spec.Defaults.ContainerRegistry)
+						testutil.AssertEqual(t, "sets builder image", "some-builder", org.Spec.Defaults.BuilderImage)
+						testutil.AssertEqual(t, "sets domains", []v1alpha1.SpaceDomain{{Domain: "domain-1", Default: true}, {Domain: "domain-2"}}, org.Spec.Defaults.Domains)
+						testutil.AssertEqual(t, "sets quota", corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("10Gi")}, org.Spec.Quota)
+					})
+
+				fakeOrgs.EXPECT().WaitFor(gomock.Any(), "my-org", 1*time.Second, gomock.Any()).Return(&v1alpha1.Organization{}, nil)
+			},
+		},
+		"invalid quota": {
+			args:    []string{"my-org", "--cpu=lots"},
+			wantErr: errors.New("couldn't parse resource quantity lots: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'"),
+		},
+		"server failure": {
+			args: []string{"my-org"},
+			setup: func(t *testing.T, fakeOrgs *fake.FakeClient) {
+				fakeOrgs.
+					EXPECT().
+					Create(gomock.Any()).
+					Return(nil, errors.New("some-server-error"))
+			},
+			wantErr: errors.New("some-server-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeOrgs := fake.NewFakeClient(ctrl)
+
+			if tc.setup != nil {
+				tc.setup(t, fakeOrgs)
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := NewCreateOrganizationCommand(&config.KfParams{}, fakeOrgs)
+			c.SetOutput(buffer)
+			c.SetArgs(tc.args)
+
+			gotErr := c.Execute()
+			testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+
+			ctrl.Finish()
+		})
+	}
+}
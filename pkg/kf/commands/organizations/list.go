@@ -0,0 +1,78 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organizations
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	"github.com/google/kf/pkg/kf/organizations"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+)
+
+// NewListOrganizationsCommand allows users to list organizations.
+func NewListOrganizationsCommand(p *config.KfParams, client organizations.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orgs",
+		Short: "List all kf organizations",
+		Long: `List organizations and their statuses for the currently targeted cluster.
+
+		The output of this command is similar to what you'd get by running:
+
+		    kubectl get organizations.kf.dev
+
+		`,
+		Example: `kf orgs`,
+		Args:    cobra.ExactArgs(0),
+		Aliases: []string{"organizations"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			list, err := client.List()
+			if err != nil {
+				return err
+			}
+
+			describe.TabbedWriter(cmd.OutOrStdout(), func(w io.Writer) {
+				fmt.Fprintln(w, "Name\tSpaces\tAge\tReady\tReason")
+				for _, org := range list {
+					ready := ""
+					reason := ""
+					if cond := org.Status.GetCondition(v1alpha1.OrganizationConditionReady); cond != nil {
+						ready = fmt.Sprintf("%v", cond.Status)
+						reason = cond.Reason
+					}
+
+					fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s",
+						org.Name,
+						len(org.Status.Spaces),
+						table.ConvertToHumanReadableDateType(org.CreationTimestamp),
+						ready,
+						reason,
+					)
+					fmt.Fprintln(w)
+				}
+			})
+
+			return nil
+		},
+	}
+
+	return cmd
+}
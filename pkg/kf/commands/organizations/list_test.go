@@ -0,0 +1,104 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organizations
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/organizations/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	"knative.dev/pkg/apis"
+)
+
+func TestNewListOrganizationsCommand(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		args  []string
+		setup func(t *testing.T, fakeOrgs *fake.FakeClient)
+
+		wantErr         error
+		expectedStrings []string
+	}{
+		"invalid number of args": {
+			args:    []string{"asdf"},
+			wantErr: errors.New("accepts 0 arg(s), received 1"),
+		},
+		"no contents": {
+			setup: func(t *testing.T, fakeOrgs *fake.FakeClient) {
+				fakeOrgs.
+					EXPECT().
+					List().
+					Return([]v1alpha1.Organization{}, nil)
+			},
+			expectedStrings: []string{"Name", "Spaces", "Age", "Ready", "Reason"},
+		},
+		"contents": {
+			setup: func(t *testing.T, fakeOrgs *fake.FakeClient) {
+				org := v1alpha1.Organization{}
+				org.Name = "my-org"
+				org.Status.Spaces = []string{"dev", "prod"}
+				org.Status.Conditions = []apis.Condition{{
+					Type:   "Ready",
+					Status: "TESTING",
+					Reason: "SomeMessage",
+				}}
+
+				fakeOrgs.
+					EXPECT().
+					List().
+					Return([]v1alpha1.Organization{org}, nil)
+			},
+			expectedStrings: []string{"my-org", "2", "TESTING", "SomeMessage"},
+		},
+		"server failure": {
+			setup: func(t *testing.T, fakeOrgs *fake.FakeClient) {
+				fakeOrgs.
+					EXPECT().
+					List().
+					Return(nil, errors.New("some-server-error"))
+			},
+			wantErr: errors.New("some-server-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeOrgs := fake.NewFakeClient(ctrl)
+
+			if tc.setup != nil {
+				tc.setup(t, fakeOrgs)
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := NewListOrganizationsCommand(&config.KfParams{}, fakeOrgs)
+			c.SetOutput(buffer)
+			c.SetArgs(tc.args)
+
+			gotErr := c.Execute()
+			testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+			testutil.AssertContainsAll(t, buffer.String(), tc.expectedStrings)
+
+			ctrl.Finish()
+		})
+	}
+}
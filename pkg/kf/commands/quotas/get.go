@@ -42,16 +42,19 @@ func NewGetQuotaCommand(p *config.KfParams, client spaces.Client) *cobra.Command
 			}
 
 			describe.TabbedWriter(cmd.OutOrStdout(), func(w io.Writer) {
-				fmt.Fprintln(w, "Memory\tCPU\tRoutes")
+				fmt.Fprintln(w, "Memory\tCPU\tRoutes\tService Instances\tService Bindings\tApp Instances")
 
 				kfspace := spaces.NewFromSpace(space)
 				mem, _ := kfspace.GetMemory()
 				cpu, _ := kfspace.GetCPU()
-				routes, _ := kfspace.GetServices()
-				fmt.Fprintf(w, "%v\t%v\t%v\n",
+				kfQuota := kfspace.GetKfQuota()
+				fmt.Fprintf(w, "%v\t%v\t%d\t%d\t%d\t%d\n",
 					mem.String(),
 					cpu.String(),
-					routes.String())
+					countOrZero(kfQuota.Routes),
+					countOrZero(kfQuota.ServiceInstances),
+					countOrZero(kfQuota.ServiceBindings),
+					countOrZero(kfQuota.AppInstances))
 			})
 			return nil
 		},
@@ -61,3 +64,13 @@ func NewGetQuotaCommand(p *config.KfParams, client spaces.Client) *cobra.Command
 
 	return cmd
 }
+
+// countOrZero returns the limit or 0 if the quota is unlimited, matching how
+// unlimited quantities are shown.
+func countOrZero(limit *int64) int64 {
+	if limit == nil {
+		return 0
+	}
+
+	return *limit
+}
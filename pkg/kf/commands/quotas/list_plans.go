@@ -0,0 +1,129 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quotas
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	"github.com/google/kf/pkg/kf/quotaplans"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// NewListQuotaPlansCommand allows users to list named quotas and how much
+// of them the spaces using them consume.
+func NewListQuotaPlansCommand(p *config.KfParams, plansClient quotaplans.Client, spacesClient spaces.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quotas",
+		Short: "List named quotas and their usage",
+		Long: `List the named quotas created with kf create-quota-plan and the
+		usage of each space assigned to one.
+		`,
+		Example:    "kf quotas",
+		Args:       cobra.ExactArgs(0),
+		Aliases:    []string{"quota-plans"},
+		SuggestFor: []string{"space-quotas"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			plans, err := plansClient.List()
+			if err != nil {
+				return err
+			}
+
+			spaceList, err := spacesClient.List()
+			if err != nil {
+				return err
+			}
+
+			spacesByPlan := make(map[string][]v1alpha1.Space)
+			for _, space := range spaceList {
+				if plan := space.Spec.ResourceLimits.QuotaPlan; plan != "" {
+					spacesByPlan[plan] = append(spacesByPlan[plan], space)
+				}
+			}
+
+			describe.TabbedWriter(cmd.OutOrStdout(), func(w io.Writer) {
+				fmt.Fprintln(w, "Name\tMemory\tCPU\tRoutes\tService Instances\tService Bindings\tApp Instances\tInstance Memory\tSpaces")
+				for _, plan := range plans {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+						plan.Name,
+						quantityOrUnlimited(plan.Spec.Memory),
+						quantityOrUnlimited(plan.Spec.CPU),
+						countOrUnlimited(plan.Spec.Routes),
+						countOrUnlimited(plan.Spec.ServiceInstances),
+						countOrUnlimited(plan.Spec.ServiceBindings),
+						countOrUnlimited(plan.Spec.AppInstances),
+						quantityOrUnlimited(plan.Spec.AppMemory),
+						len(spacesByPlan[plan.Name]),
+					)
+				}
+			})
+
+			for _, plan := range plans {
+				planSpaces := spacesByPlan[plan.Name]
+				if len(planSpaces) == 0 {
+					continue
+				}
+
+				fmt.Fprintln(cmd.OutOrStdout())
+				describe.SectionWriter(cmd.OutOrStdout(), fmt.Sprintf("Usage of %s", plan.Name), func(w io.Writer) {
+					fmt.Fprintln(w, "Space\tResource\tUsed\tHard")
+					for _, space := range planSpaces {
+						hard := space.Status.Quota.Hard
+						var names []string
+						for name := range hard {
+							names = append(names, string(name))
+						}
+						sort.Strings(names)
+
+						for _, name := range names {
+							used := space.Status.Quota.Used[v1.ResourceName(name)]
+							limit := hard[v1.ResourceName(name)]
+							fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", space.Name, name, used.String(), limit.String())
+						}
+					}
+				})
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func quantityOrUnlimited(q *resource.Quantity) string {
+	if q == nil {
+		return "unlimited"
+	}
+
+	return q.String()
+}
+
+func countOrUnlimited(c *int64) string {
+	if c == nil {
+		return "unlimited"
+	}
+
+	return fmt.Sprintf("%d", *c)
+}
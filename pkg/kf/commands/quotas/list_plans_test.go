@@ -0,0 +1,123 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quotas
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	quotaplansfake "github.com/google/kf/pkg/kf/quotaplans/fake"
+	spacesfake "github.com/google/kf/pkg/kf/spaces/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestListQuotaPlansCommand(t *testing.T) {
+	t.Parallel()
+	for tn, tc := range map[string]struct {
+		args    []string
+		wantErr error
+		setup   func(t *testing.T, fakePlans *quotaplansfake.FakeClient, fakeSpaces *spacesfake.FakeClient)
+		assert  func(t *testing.T, buffer *bytes.Buffer)
+	}{
+		"too many args": {
+			args:    []string{"small"},
+			wantErr: errors.New("accepts 0 arg(s), received 1"),
+		},
+		"list plans error": {
+			wantErr: errors.New("some-error"),
+			setup: func(t *testing.T, fakePlans *quotaplansfake.FakeClient, fakeSpaces *spacesfake.FakeClient) {
+				fakePlans.EXPECT().List().Return(nil, errors.New("some-error"))
+			},
+		},
+		"list spaces error": {
+			wantErr: errors.New("some-error"),
+			setup: func(t *testing.T, fakePlans *quotaplansfake.FakeClient, fakeSpaces *spacesfake.FakeClient) {
+				fakePlans.EXPECT().List().Return(nil, nil)
+				fakeSpaces.EXPECT().List().Return(nil, errors.New("some-error"))
+			},
+		},
+		"shows plans and usage": {
+			setup: func(t *testing.T, fakePlans *quotaplansfake.FakeClient, fakeSpaces *spacesfake.FakeClient) {
+				memory := resource.MustParse("10Gi")
+				routes := int64(20)
+				small := v1alpha1.QuotaPlan{}
+				small.Name = "small"
+				small.Spec.Memory = &memory
+				small.Spec.Routes = &routes
+
+				unused := v1alpha1.QuotaPlan{}
+				unused.Name = "unused-plan"
+
+				fakePlans.EXPECT().List().Return([]v1alpha1.QuotaPlan{small, unused}, nil)
+
+				space := v1alpha1.Space{}
+				space.Name = "my-space"
+				space.Spec.ResourceLimits.QuotaPlan = "small"
+				space.Status.Quota.Hard = corev1.ResourceList{
+					corev1.ResourceMemory: memory,
+				}
+				space.Status.Quota.Used = corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("2Gi"),
+				}
+
+				fakeSpaces.EXPECT().List().Return([]v1alpha1.Space{space, {}}, nil)
+			},
+			assert: func(t *testing.T, buffer *bytes.Buffer) {
+				testutil.AssertContainsAll(t, buffer.String(), []string{
+					"small", "10Gi", "20", "unlimited", "unused-plan",
+					"Usage of small", "my-space", "memory", "2Gi",
+				})
+
+				testutil.AssertEqual(t, "unused plan usage", false, bytes.Contains(buffer.Bytes(), []byte("Usage of unused-plan")))
+			},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakePlans := quotaplansfake.NewFakeClient(ctrl)
+			fakeSpaces := spacesfake.NewFakeClient(ctrl)
+
+			if tc.setup != nil {
+				tc.setup(t, fakePlans, fakeSpaces)
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := NewListQuotaPlansCommand(&config.KfParams{}, fakePlans, fakeSpaces)
+			c.SetOutput(buffer)
+
+			c.SetArgs(tc.args)
+			gotErr := c.Execute()
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			if tc.assert != nil {
+				tc.assert(t, buffer)
+			}
+
+			testutil.AssertNil(t, "Command err", gotErr)
+
+			ctrl.Finish()
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quotas
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/quotaplans"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateQuotaPlanCommand allows users to create named quotas that spaces
+// can share.
+func NewCreateQuotaPlanCommand(p *config.KfParams, client quotaplans.Client) *cobra.Command {
+	var flags quotaPlanFlags
+
+	cmd := &cobra.Command{
+		Use:   "create-quota-plan QUOTA [-m MEMORY] [-c CPU] [-r ROUTES] [-s SERVICE_INSTANCES] [-b SERVICE_BINDINGS] [-a APP_INSTANCES] [-i INSTANCE_MEMORY]",
+		Short: "Create a named quota that spaces can share",
+		Long: `Create a named quota that spaces can share.
+
+		Assign the quota to spaces with kf set-space-quota. Limits that aren't
+		set are unlimited.
+		`,
+		Example:    "kf create-quota-plan small --memory 10Gi --routes 20 --app-instances 10 --instance-memory 1Gi",
+		Args:       cobra.ExactArgs(1),
+		SuggestFor: []string{"create-space-quota"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			plan := &v1alpha1.QuotaPlan{}
+			plan.Name = args[0]
+			if err := flags.apply(cmd, &plan.Spec); err != nil {
+				return err
+			}
+
+			if _, err := client.Create(plan); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Quota plan %q created\n", plan.Name)
+			return nil
+		},
+	}
+
+	flags.register(cmd)
+
+	return cmd
+}
+
+// NewUpdateQuotaPlanCommand allows users to change a named quota. Spaces
+// using the quota are updated to the new limits.
+func NewUpdateQuotaPlanCommand(p *config.KfParams, client quotaplans.Client) *cobra.Command {
+	var flags quotaPlanFlags
+
+	cmd := &cobra.Command{
+		Use:   "update-quota-plan QUOTA [-m MEMORY] [-c CPU] [-r ROUTES] [-s SERVICE_INSTANCES] [-b SERVICE_BINDINGS] [-a APP_INSTANCES] [-i INSTANCE_MEMORY]",
+		Short: "Update a named quota and the spaces using it",
+		Long: `Update the limits of a named quota. Every space using the quota
+		gets the new limits.
+
+		Only the limits passed as flags change. Pass -1 to remove a limit.
+		`,
+		Example:    "kf update-quota-plan small --memory 20Gi --routes -1",
+		Args:       cobra.ExactArgs(1),
+		SuggestFor: []string{"update-space-quota"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			_, err := client.Transform(args[0], quotaplans.DiffWrapper(cmd.OutOrStdout(), func(plan *v1alpha1.QuotaPlan) error {
+				return flags.apply(cmd, &plan.Spec)
+			}))
+
+			return err
+		},
+	}
+
+	flags.register(cmd)
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quotas
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// quotaPlanFlags holds the flags that set the limits of a QuotaPlan.
+type quotaPlanFlags struct {
+	memory           string
+	cpu              string
+	routes           string
+	serviceInstances string
+	serviceBindings  string
+	appInstances     string
+	appMemory        string
+}
+
+// register adds the flags to the command.
+func (f *quotaPlanFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.memory, "memory", "m", "", "Total amount of memory apps in a space can have (e.g. 10Gi, 500Mi)")
+	cmd.Flags().StringVarP(&f.cpu, "cpu", "c", "", "Total amount of CPU apps in a space can have (e.g. 400m)")
+	cmd.Flags().StringVarP(&f.routes, "routes", "r", "", "Maximum number of routes in a space")
+	cmd.Flags().StringVarP(&f.serviceInstances, "service-instances", "s", "", "Maximum number of service instances in a space")
+	cmd.Flags().StringVarP(&f.serviceBindings, "service-bindings", "b", "", "Maximum number of service bindings of apps in a space")
+	cmd.Flags().StringVarP(&f.appInstances, "app-instances", "a", "", "Maximum number of app instances in a space")
+	cmd.Flags().StringVarP(&f.appMemory, "instance-memory", "i", "", "Maximum amount of memory an app instance can have (e.g. 1Gi)")
+}
+
+// apply sets the limits of the flags the user passed on the spec. Passing
+// -1 removes the limit.
+func (f *quotaPlanFlags) apply(cmd *cobra.Command, spec *v1alpha1.QuotaPlanSpec) error {
+	quantities := []struct {
+		flag   string
+		value  string
+		target **resource.Quantity
+	}{
+		{"memory", f.memory, &spec.Memory},
+		{"cpu", f.cpu, &spec.CPU},
+		{"instance-memory", f.appMemory, &spec.AppMemory},
+	}
+	for _, q := range quantities {
+		if !cmd.Flags().Changed(q.flag) {
+			continue
+		}
+
+		if q.value == "-1" {
+			*q.target = nil
+			continue
+		}
+
+		quantity, err := resource.ParseQuantity(q.value)
+		if err != nil {
+			return fmt.Errorf("couldn't parse resource quantity %s: %v", q.value, err)
+		}
+		*q.target = &quantity
+	}
+
+	counts := []struct {
+		flag   string
+		value  string
+		target **int64
+	}{
+		{"routes", f.routes, &spec.Routes},
+		{"service-instances", f.serviceInstances, &spec.ServiceInstances},
+		{"service-bindings", f.serviceBindings, &spec.ServiceBindings},
+		{"app-instances", f.appInstances, &spec.AppInstances},
+	}
+	for _, c := range counts {
+		if !cmd.Flags().Changed(c.flag) {
+			continue
+		}
+
+		if c.value == "-1" {
+			*c.target = nil
+			continue
+		}
+
+		count, err := strconv.ParseInt(c.value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("couldn't parse %s %q: must be a whole number", c.flag, c.value)
+		}
+		*c.target = &count
+	}
+
+	return nil
+}
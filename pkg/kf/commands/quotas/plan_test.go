@@ -0,0 +1,174 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quotas
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/quotaplans"
+	"github.com/google/kf/pkg/kf/quotaplans/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestCreateQuotaPlanCommand(t *testing.T) {
+	t.Parallel()
+	for tn, tc := range map[string]struct {
+		args    []string
+		wantErr error
+		setup   func(t *testing.T, fakeClient *fake.FakeClient)
+		assert  func(t *testing.T, buffer *bytes.Buffer)
+	}{
+		"invalid number of args": {
+			args:    []string{},
+			wantErr: errors.New("accepts 1 arg(s), received 0"),
+		},
+		"invalid quantity": {
+			args:    []string{"small", "-m", "100z"},
+			wantErr: errors.New("couldn't parse resource quantity 100z: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'"),
+		},
+		"invalid count": {
+			args:    []string{"small", "-r", "ten"},
+			wantErr: errors.New(`couldn't parse routes "ten": must be a whole number`),
+		},
+		"create error": {
+			args:    []string{"small"},
+			wantErr: errors.New("some-error"),
+			setup: func(t *testing.T, fakeClient *fake.FakeClient) {
+				fakeClient.EXPECT().Create(gomock.Any()).Return(nil, errors.New("some-error"))
+			},
+		},
+		"sets limits": {
+			args: []string{"small", "-m", "10Gi", "-r", "20", "-a", "5", "-i", "1Gi"},
+			setup: func(t *testing.T, fakeClient *fake.FakeClient) {
+				fakeClient.EXPECT().Create(gomock.Any()).DoAndReturn(func(plan *v1alpha1.QuotaPlan) (*v1alpha1.QuotaPlan, error) {
+					testutil.AssertEqual(t, "name", "small", plan.Name)
+					testutil.AssertEqual(t, "memory", resource.MustParse("10Gi"), *plan.Spec.Memory)
+					testutil.AssertEqual(t, "app memory", resource.MustParse("1Gi"), *plan.Spec.AppMemory)
+					testutil.AssertEqual(t, "routes", int64(20), *plan.Spec.Routes)
+					testutil.AssertEqual(t, "app instances", int64(5), *plan.Spec.AppInstances)
+					testutil.AssertEqual(t, "cpu", (*resource.Quantity)(nil), plan.Spec.CPU)
+					testutil.AssertEqual(t, "service instances", (*int64)(nil), plan.Spec.ServiceInstances)
+					return plan, nil
+				})
+			},
+			assert: func(t *testing.T, buffer *bytes.Buffer) {
+				testutil.AssertContainsAll(t, buffer.String(), []string{`Quota plan "small" created`})
+			},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeClient := fake.NewFakeClient(ctrl)
+
+			if tc.setup != nil {
+				tc.setup(t, fakeClient)
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := NewCreateQuotaPlanCommand(&config.KfParams{}, fakeClient)
+			c.SetOutput(buffer)
+
+			c.SetArgs(tc.args)
+			gotErr := c.Execute()
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			if tc.assert != nil {
+				tc.assert(t, buffer)
+			}
+
+			testutil.AssertNil(t, "Command err", gotErr)
+			testutil.AssertEqual(t, "SilenceUsage", true, c.SilenceUsage)
+
+			ctrl.Finish()
+		})
+	}
+}
+
+func TestUpdateQuotaPlanCommand(t *testing.T) {
+	t.Parallel()
+	for tn, tc := range map[string]struct {
+		args    []string
+		wantErr error
+		setup   func(t *testing.T, fakeClient *fake.FakeClient)
+	}{
+		"invalid number of args": {
+			args:    []string{},
+			wantErr: errors.New("accepts 1 arg(s), received 0"),
+		},
+		"update error": {
+			args:    []string{"small", "-m", "20Gi"},
+			wantErr: errors.New("some-error"),
+			setup: func(t *testing.T, fakeClient *fake.FakeClient) {
+				fakeClient.EXPECT().Transform("small", gomock.Any()).Return(nil, errors.New("some-error"))
+			},
+		},
+		"only changes set flags": {
+			args: []string{"small", "-m", "20Gi", "-r", "-1"},
+			setup: func(t *testing.T, fakeClient *fake.FakeClient) {
+				fakeClient.EXPECT().Transform("small", gomock.Any()).DoAndReturn(func(name string, mutator quotaplans.Mutator) (*v1alpha1.QuotaPlan, error) {
+					memory := resource.MustParse("10Gi")
+					cpu := resource.MustParse("4")
+					routes := int64(10)
+					plan := &v1alpha1.QuotaPlan{}
+					plan.Spec.Memory = &memory
+					plan.Spec.CPU = &cpu
+					plan.Spec.Routes = &routes
+
+					testutil.AssertNil(t, "mutator err", mutator(plan))
+					testutil.AssertEqual(t, "memory", resource.MustParse("20Gi"), *plan.Spec.Memory)
+					testutil.AssertEqual(t, "cpu", resource.MustParse("4"), *plan.Spec.CPU)
+					testutil.AssertEqual(t, "routes", (*int64)(nil), plan.Spec.Routes)
+					return plan, nil
+				})
+			},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeClient := fake.NewFakeClient(ctrl)
+
+			if tc.setup != nil {
+				tc.setup(t, fakeClient)
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := NewUpdateQuotaPlanCommand(&config.KfParams{}, fakeClient)
+			c.SetOutput(buffer)
+
+			c.SetArgs(tc.args)
+			gotErr := c.Execute()
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertNil(t, "Command err", gotErr)
+			testutil.AssertEqual(t, "SilenceUsage", true, c.SilenceUsage)
+
+			ctrl.Finish()
+		})
+	}
+}
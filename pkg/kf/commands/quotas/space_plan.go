@@ -0,0 +1,94 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quotas
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/completion"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/quotaplans"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/spf13/cobra"
+)
+
+// NewSetSpaceQuotaCommand allows users to assign a named quota to a space.
+func NewSetSpaceQuotaCommand(p *config.KfParams, spacesClient spaces.Client, plansClient quotaplans.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-space-quota SPACE QUOTA",
+		Short: "Assign a named quota to a space",
+		Long: `Assign a named quota to a space. The space gets the limits of the
+		quota and any later changes made with kf update-quota-plan.
+
+		Limits set on the space with kf update-quota take precedence over the
+		named quota.
+		`,
+		Example: "kf set-space-quota my-space small",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			spaceName, planName := args[0], args[1]
+
+			if _, err := plansClient.Get(planName); err != nil {
+				return fmt.Errorf("couldn't find quota plan %q: %v", planName, err)
+			}
+
+			_, err := spacesClient.Transform(spaceName, spaces.DiffWrapper(cmd.OutOrStdout(), func(space *v1alpha1.Space) error {
+				spaces.NewFromSpace(space).SetQuotaPlan(planName)
+				return nil
+			}))
+
+			return err
+		},
+	}
+
+	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
+
+	return cmd
+}
+
+// NewUnsetSpaceQuotaCommand allows users to remove a named quota from a
+// space.
+func NewUnsetSpaceQuotaCommand(p *config.KfParams, spacesClient spaces.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unset-space-quota SPACE QUOTA",
+		Short:   "Remove a named quota from a space",
+		Example: "kf unset-space-quota my-space small",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			spaceName, planName := args[0], args[1]
+
+			_, err := spacesClient.Transform(spaceName, spaces.DiffWrapper(cmd.OutOrStdout(), func(space *v1alpha1.Space) error {
+				kfspace := spaces.NewFromSpace(space)
+				if current := kfspace.GetQuotaPlan(); current != planName {
+					return fmt.Errorf("space %q isn't using quota plan %q", spaceName, planName)
+				}
+
+				kfspace.SetQuotaPlan("")
+				return nil
+			}))
+
+			return err
+		},
+	}
+
+	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
+
+	return cmd
+}
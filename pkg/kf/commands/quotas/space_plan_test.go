@@ -0,0 +1,145 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quotas
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	quotaplansfake "github.com/google/kf/pkg/kf/quotaplans/fake"
+	"github.com/google/kf/pkg/kf/spaces"
+	spacesfake "github.com/google/kf/pkg/kf/spaces/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestSetSpaceQuotaCommand(t *testing.T) {
+	t.Parallel()
+	for tn, tc := range map[string]struct {
+		args    []string
+		wantErr error
+		setup   func(t *testing.T, fakeSpaces *spacesfake.FakeClient, fakePlans *quotaplansfake.FakeClient)
+	}{
+		"invalid number of args": {
+			args:    []string{"my-space"},
+			wantErr: errors.New("accepts 2 arg(s), received 1"),
+		},
+		"missing plan": {
+			args:    []string{"my-space", "small"},
+			wantErr: errors.New(`couldn't find quota plan "small": not found`),
+			setup: func(t *testing.T, fakeSpaces *spacesfake.FakeClient, fakePlans *quotaplansfake.FakeClient) {
+				fakePlans.EXPECT().Get("small").Return(nil, errors.New("not found"))
+			},
+		},
+		"sets plan": {
+			args: []string{"my-space", "small"},
+			setup: func(t *testing.T, fakeSpaces *spacesfake.FakeClient, fakePlans *quotaplansfake.FakeClient) {
+				fakePlans.EXPECT().Get("small").Return(&v1alpha1.QuotaPlan{}, nil)
+				fakeSpaces.EXPECT().Transform("my-space", gomock.Any()).DoAndReturn(func(name string, mutator spaces.Mutator) (*v1alpha1.Space, error) {
+					space := &v1alpha1.Space{}
+					testutil.AssertNil(t, "mutator err", mutator(space))
+					testutil.AssertEqual(t, "quota plan", "small", space.Spec.ResourceLimits.QuotaPlan)
+					return space, nil
+				})
+			},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeSpaces := spacesfake.NewFakeClient(ctrl)
+			fakePlans := quotaplansfake.NewFakeClient(ctrl)
+
+			if tc.setup != nil {
+				tc.setup(t, fakeSpaces, fakePlans)
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := NewSetSpaceQuotaCommand(&config.KfParams{}, fakeSpaces, fakePlans)
+			c.SetOutput(buffer)
+
+			c.SetArgs(tc.args)
+			gotErr := c.Execute()
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertNil(t, "Command err", gotErr)
+			testutil.AssertEqual(t, "SilenceUsage", true, c.SilenceUsage)
+
+			ctrl.Finish()
+		})
+	}
+}
+
+func TestUnsetSpaceQuotaCommand(t *testing.T) {
+	t.Parallel()
+	for tn, tc := range map[string]struct {
+		args        []string
+		currentPlan string
+		wantErr     error
+	}{
+		"invalid number of args": {
+			args:    []string{"my-space"},
+			wantErr: errors.New("accepts 2 arg(s), received 1"),
+		},
+		"different plan": {
+			args:        []string{"my-space", "small"},
+			currentPlan: "large",
+			wantErr:     errors.New(`space "my-space" isn't using quota plan "small"`),
+		},
+		"clears plan": {
+			args:        []string{"my-space", "small"},
+			currentPlan: "small",
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeSpaces := spacesfake.NewFakeClient(ctrl)
+
+			fakeSpaces.EXPECT().Transform("my-space", gomock.Any()).DoAndReturn(func(name string, mutator spaces.Mutator) (*v1alpha1.Space, error) {
+				space := &v1alpha1.Space{}
+				space.Spec.ResourceLimits.QuotaPlan = tc.currentPlan
+				if err := mutator(space); err != nil {
+					return nil, err
+				}
+
+				testutil.AssertEqual(t, "quota plan", "", space.Spec.ResourceLimits.QuotaPlan)
+				return space, nil
+			}).AnyTimes()
+
+			buffer := &bytes.Buffer{}
+
+			c := NewUnsetSpaceQuotaCommand(&config.KfParams{}, fakeSpaces)
+			c.SetOutput(buffer)
+
+			c.SetArgs(tc.args)
+			gotErr := c.Execute()
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertNil(t, "Command err", gotErr)
+			testutil.AssertEqual(t, "SilenceUsage", true, c.SilenceUsage)
+
+			ctrl.Finish()
+		})
+	}
+}
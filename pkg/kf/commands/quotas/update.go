@@ -30,14 +30,10 @@ const (
 
 // NewUpdateQuotaCommand allows users to create a quota for a space.
 func NewUpdateQuotaCommand(p *config.KfParams, client spaces.Client) *cobra.Command {
-	var (
-		memory string
-		cpu    string
-		routes string
-	)
+	var values quotaValues
 
 	cmd := &cobra.Command{
-		Use:        "update-quota SPACE_NAME [-m MEMORY] [-r ROUTES] [-c CPU]",
+		Use:        "update-quota SPACE_NAME [-m MEMORY] [-c CPU] [-r ROUTES] [-s SERVICE_INSTANCES] [-b SERVICE_BINDINGS] [-a APP_INSTANCES]",
 		Short:      "Update the quota for a space",
 		Example:    "kf update-quota my-space --memory 100Gi --routes 50",
 		Args:       cobra.ExactArgs(1),
@@ -50,7 +46,7 @@ func NewUpdateQuotaCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 
 			_, err := client.Transform(spaceName, spaces.DiffWrapper(cmd.OutOrStdout(), func(space *v1alpha1.Space) error {
 				kfspace := spaces.NewFromSpace(space)
-				return setQuotaValues(memory, cpu, routes, kfspace)
+				return setQuotaValues(values, kfspace)
 			}))
 
 			return err
@@ -58,7 +54,7 @@ func NewUpdateQuotaCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 	}
 
 	cmd.Flags().StringVarP(
-		&memory,
+		&values.memory,
 		"memory",
 		"m",
 		defaultQuota,
@@ -66,7 +62,7 @@ func NewUpdateQuotaCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 	)
 
 	cmd.Flags().StringVarP(
-		&cpu,
+		&values.cpu,
 		"cpu",
 		"c",
 		defaultQuota,
@@ -74,13 +70,37 @@ func NewUpdateQuotaCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 	)
 
 	cmd.Flags().StringVarP(
-		&routes,
+		&values.routes,
 		"routes",
 		"r",
 		defaultQuota,
 		"Maximum number of routes the space can have (default: unlimited)",
 	)
 
+	cmd.Flags().StringVarP(
+		&values.serviceInstances,
+		"service-instances",
+		"s",
+		defaultQuota,
+		"Maximum number of service instances the space can have (default: unlimited)",
+	)
+
+	cmd.Flags().StringVarP(
+		&values.serviceBindings,
+		"service-bindings",
+		"b",
+		defaultQuota,
+		"Maximum number of service bindings the apps in the space can have (default: unlimited)",
+	)
+
+	cmd.Flags().StringVarP(
+		&values.appInstances,
+		"app-instances",
+		"a",
+		defaultQuota,
+		"Maximum number of app instances the space can have (default: unlimited)",
+	)
+
 	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
 
 	return cmd
@@ -109,6 +109,56 @@ func TestUpdateQuotaCommand(t *testing.T) {
 					})
 			},
 		},
+		"routes clear the legacy services quota": {
+			args:      []string{"some-quota", "-r", "10"},
+			namespace: "some-namespace",
+			setup: func(t *testing.T, fakeUpdater *fake.FakeClient) {
+				fakeUpdater.
+					EXPECT().
+					Transform(gomock.Any(), gomock.Any()).
+					Do(func(spaceName string, transformer spaces.Mutator) error {
+						kfspace, err := newDummyKfSpace("1024M", "4")
+						testutil.AssertNil(t, "Parse resource quantity err", err)
+						kfspace.SetServices(resource.MustParse("5"))
+						transformer(kfspace.ToSpace())
+
+						testutil.AssertEqual(t, "Routes", int64(10), *kfspace.GetKfQuota().Routes)
+
+						_, servicesQuotaExists := kfspace.GetServices()
+						testutil.AssertEqual(t, "Services quota exists", false, servicesQuotaExists)
+
+						_, memoryQuotaExists := kfspace.GetMemory()
+						testutil.AssertEqual(t, "Memory quota exists", true, memoryQuotaExists)
+						return err
+					})
+			},
+		},
+		"kf resource quotas": {
+			args:      []string{"some-quota", "-r", "10", "-s", "5", "-a", "0"},
+			namespace: "some-namespace",
+			setup: func(t *testing.T, fakeUpdater *fake.FakeClient) {
+				fakeUpdater.
+					EXPECT().
+					Transform(gomock.Any(), gomock.Any()).
+					Do(func(spaceName string, transformer spaces.Mutator) error {
+						kfspace, err := newDummyKfSpace("1024M", "4")
+						testutil.AssertNil(t, "Parse resource quantity err", err)
+						appInstances := int64(3)
+						kfspace.GetKfQuota().AppInstances = &appInstances
+						transformer(kfspace.ToSpace())
+
+						kfQuota := kfspace.GetKfQuota()
+						testutil.AssertEqual(t, "Routes", int64(10), *kfQuota.Routes)
+						testutil.AssertEqual(t, "Service instances", int64(5), *kfQuota.ServiceInstances)
+						testutil.AssertEqual(t, "Service bindings", (*int64)(nil), kfQuota.ServiceBindings)
+						testutil.AssertEqual(t, "App instances reset", (*int64)(nil), kfQuota.AppInstances)
+
+						_, servicesQuotaExists := kfspace.GetServices()
+						testutil.AssertEqual(t, "Services quota exists", false, servicesQuotaExists)
+						return err
+					})
+			},
+		},
 	} {
 		t.Run(tn, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
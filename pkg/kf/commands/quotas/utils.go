@@ -21,16 +21,25 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
+// quotaValues holds the quota flags passed to a command.
+type quotaValues struct {
+	memory           string
+	cpu              string
+	routes           string
+	serviceInstances string
+	serviceBindings  string
+	appInstances     string
+}
+
 // setQuotaValues updates a KfSpace to have the inputted resource quota values.
-func setQuotaValues(memory string, cpu string, routes string, kfspace *spaces.KfSpace) error {
+func setQuotaValues(values quotaValues, kfspace *spaces.KfSpace) error {
 	var quotaInputs = []struct {
 		Value    string
 		Setter   func(r resource.Quantity)
 		Resetter func()
 	}{
-		{memory, kfspace.SetMemory, kfspace.ResetMemory},
-		{cpu, kfspace.SetCPU, kfspace.ResetCPU},
-		{routes, kfspace.SetServices, kfspace.ResetServices},
+		{values.memory, kfspace.SetMemory, kfspace.ResetMemory},
+		{values.cpu, kfspace.SetCPU, kfspace.ResetCPU},
 	}
 
 	// Only update resource quotas for inputted flags
@@ -48,5 +57,41 @@ func setQuotaValues(memory string, cpu string, routes string, kfspace *spaces.Kf
 			}
 		}
 	}
+
+	// Kubernetes can't count kf resources so their limits are kept on the
+	// space and enforced by the webhook.
+	kfQuota := kfspace.GetKfQuota()
+	var countInputs = []struct {
+		Value  string
+		Target **int64
+	}{
+		{values.routes, &kfQuota.Routes},
+		{values.serviceInstances, &kfQuota.ServiceInstances},
+		{values.serviceBindings, &kfQuota.ServiceBindings},
+		{values.appInstances, &kfQuota.AppInstances},
+	}
+
+	for _, count := range countInputs {
+		if count.Value != defaultQuota {
+			quantity, err := resource.ParseQuantity(count.Value)
+			if err != nil {
+				return fmt.Errorf("couldn't parse resource quantity %s: %v", count.Value, err)
+			}
+			// Passing in 0 for a resource resets its quota to unlimited
+			if quantity.IsZero() {
+				*count.Target = nil
+			} else {
+				limit := quantity.Value()
+				*count.Target = &limit
+			}
+		}
+	}
+
+	// Route limits used to be stored as a Kubernetes services quota, which
+	// would keep limiting the space if it were left behind.
+	if values.routes != defaultQuota {
+		kfspace.ResetServices()
+	}
+
 	return nil
 }
@@ -83,10 +83,12 @@ func NewKfCommand() *cobra.Command {
 				InjectDelete(p),
 				InjectApps(p),
 				InjectGetApp(p),
+				InjectAppUsage(p),
 				InjectStart(p),
 				InjectStop(p),
 				InjectRestart(p),
 				InjectRestage(p),
+				InjectRebase(p),
 				InjectScale(p),
 				InjectLogs(p),
 				InjectProxy(p),
@@ -124,15 +126,34 @@ func NewKfCommand() *cobra.Command {
 				InjectGetQuota(p),
 				InjectUpdateQuota(p),
 				InjectDeleteQuota(p),
+				InjectQuotaPlans(p),
+				InjectCreateQuotaPlan(p),
+				InjectUpdateQuotaPlan(p),
+				InjectSetSpaceQuota(p),
+				InjectUnsetSpaceQuota(p),
+			},
+		},
+		{
+			Name: "Security Groups",
+			Commands: []*cobra.Command{
+				InjectSecurityGroups(p),
+				InjectCreateSecurityGroup(p),
+				InjectBindSecurityGroup(p),
+				InjectUnbindSecurityGroup(p),
 			},
 		},
 		{
 			Name: "Services",
 			Commands: []*cobra.Command{
 				InjectCreateService(p),
+				InjectUpdateService(p),
+				InjectCreateUserProvidedService(p),
+				InjectUpdateUserProvidedService(p),
 				InjectDeleteService(p),
 				InjectGetService(p),
 				InjectListServices(p),
+				InjectShareService(p),
+				InjectUnshareService(p),
 				InjectMarketplace(p),
 			},
 		},
@@ -145,11 +166,31 @@ func NewKfCommand() *cobra.Command {
 				InjectVcapServices(p),
 			},
 		},
+		{
+			Name: "Service Keys",
+			Commands: []*cobra.Command{
+				InjectCreateServiceKey(p),
+				InjectListServiceKeys(p),
+				InjectGetServiceKey(p),
+				InjectDeleteServiceKey(p),
+			},
+		},
 		{
 			Name: "Service Brokers",
 			Commands: []*cobra.Command{
 				InjectCreateServiceBroker(p),
+				InjectListServiceBrokers(p),
+				InjectUpdateServiceBroker(p),
 				InjectDeleteServiceBroker(p),
+				InjectEnableServiceAccess(p),
+				InjectDisableServiceAccess(p),
+			},
+		},
+		{
+			Name: "Organizations",
+			Commands: []*cobra.Command{
+				InjectOrganizations(p),
+				InjectCreateOrganization(p),
 			},
 		},
 		{
@@ -158,8 +199,12 @@ func NewKfCommand() *cobra.Command {
 				InjectSpaces(p),
 				InjectSpace(p),
 				InjectCreateSpace(p),
+				InjectSpaceTemplates(p),
 				InjectDeleteSpace(p),
 				InjectConfigSpace(p),
+				InjectSetSpaceRole(p),
+				InjectUnsetSpaceRole(p),
+				InjectSpaceUsers(p),
 			},
 		},
 		{
@@ -185,7 +230,7 @@ func NewKfCommand() *cobra.Command {
 
 				completionCommand(rootCmd),
 				install.NewInstallCommand(),
-				NewTargetCommand(p),
+				InjectTarget(p),
 				NewVersionCommand(Version, runtime.GOOS),
 				NewDebugCommand(p, config.GetKubernetes(p)),
 				InjectNamesCommand(p),
@@ -0,0 +1,132 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securitygroups
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/completion"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/securitygroups"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/spf13/cobra"
+)
+
+// NewBindSecurityGroupCommand allows users to bind a SecurityGroup to a
+// space.
+func NewBindSecurityGroupCommand(p *config.KfParams, spacesClient spaces.Client, groupsClient securitygroups.Client) *cobra.Command {
+	var lifecycle string
+
+	cmd := &cobra.Command{
+		Use:   "bind-security-group SECURITY_GROUP SPACE [--lifecycle running|staging]",
+		Short: "Bind a security group to a space",
+		Long: `Bind a security group to a space. Once a lifecycle has a group
+		bound, its pods can only reach destinations outside the cluster that
+		one of the bound groups allows. DNS queries to the cluster DNS and
+		traffic within the cluster are always allowed.
+
+		The running lifecycle applies to app instances, the staging lifecycle
+		applies to builds.
+		`,
+		Example: "kf bind-security-group public-https my-space --lifecycle staging",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			groupName, spaceName := args[0], args[1]
+			binding, err := makeBinding(groupName, lifecycle)
+			if err != nil {
+				return err
+			}
+
+			if _, err := groupsClient.Get(groupName); err != nil {
+				return fmt.Errorf("couldn't find security group %q: %v", groupName, err)
+			}
+
+			_, err = spacesClient.Transform(spaceName, spaces.DiffWrapper(cmd.OutOrStdout(), func(space *v1alpha1.Space) error {
+				spaces.NewFromSpace(space).BindSecurityGroup(binding)
+				return nil
+			}))
+
+			return err
+		},
+	}
+
+	registerLifecycleFlag(cmd, &lifecycle)
+	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
+
+	return cmd
+}
+
+// NewUnbindSecurityGroupCommand allows users to remove a SecurityGroup from
+// a space.
+func NewUnbindSecurityGroupCommand(p *config.KfParams, spacesClient spaces.Client) *cobra.Command {
+	var lifecycle string
+
+	cmd := &cobra.Command{
+		Use:     "unbind-security-group SECURITY_GROUP SPACE [--lifecycle running|staging]",
+		Short:   "Unbind a security group from a space",
+		Example: "kf unbind-security-group public-https my-space --lifecycle staging",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			groupName, spaceName := args[0], args[1]
+			binding, err := makeBinding(groupName, lifecycle)
+			if err != nil {
+				return err
+			}
+
+			_, err = spacesClient.Transform(spaceName, spaces.DiffWrapper(cmd.OutOrStdout(), func(space *v1alpha1.Space) error {
+				if !spaces.NewFromSpace(space).UnbindSecurityGroup(binding) {
+					return fmt.Errorf("security group %q isn't bound to space %q for %s", groupName, spaceName, lifecycle)
+				}
+
+				return nil
+			}))
+
+			return err
+		},
+	}
+
+	registerLifecycleFlag(cmd, &lifecycle)
+	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
+
+	return cmd
+}
+
+func registerLifecycleFlag(cmd *cobra.Command, lifecycle *string) {
+	cmd.Flags().StringVar(
+		lifecycle,
+		"lifecycle",
+		string(v1alpha1.SecurityGroupLifecycleRunning),
+		"Lifecycle phase the group applies to, either running or staging",
+	)
+}
+
+func makeBinding(groupName, lifecycle string) (v1alpha1.SpaceSecurityGroup, error) {
+	binding := v1alpha1.SpaceSecurityGroup{
+		Name:      groupName,
+		Lifecycle: v1alpha1.SecurityGroupLifecycle(lifecycle),
+	}
+
+	switch binding.Lifecycle {
+	case v1alpha1.SecurityGroupLifecycleRunning, v1alpha1.SecurityGroupLifecycleStaging:
+		return binding, nil
+	default:
+		return binding, fmt.Errorf("lifecycle must be running or staging, got %q", lifecycle)
+	}
+}
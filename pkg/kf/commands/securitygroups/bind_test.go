@@ -0,0 +1,171 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securitygroups
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	securitygroupsfake "github.com/google/kf/pkg/kf/securitygroups/fake"
+	"github.com/google/kf/pkg/kf/spaces"
+	spacesfake "github.com/google/kf/pkg/kf/spaces/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestBindSecurityGroupCommand(t *testing.T) {
+	t.Parallel()
+
+	for tn, tc := range map[string]struct {
+		args    []string
+		wantErr error
+		setup   func(t *testing.T, fakeSpaces *spacesfake.FakeClient, fakeGroups *securitygroupsfake.FakeClient)
+	}{
+		"invalid number of args": {
+			args:    []string{"public-https"},
+			wantErr: errors.New("accepts 2 arg(s), received 1"),
+		},
+		"invalid lifecycle": {
+			args:    []string{"public-https", "my-space", "--lifecycle", "building"},
+			wantErr: errors.New(`lifecycle must be running or staging, got "building"`),
+		},
+		"missing group": {
+			args:    []string{"public-https", "my-space"},
+			wantErr: errors.New(`couldn't find security group "public-https": not found`),
+			setup: func(t *testing.T, fakeSpaces *spacesfake.FakeClient, fakeGroups *securitygroupsfake.FakeClient) {
+				fakeGroups.EXPECT().Get("public-https").Return(nil, errors.New("not found"))
+			},
+		},
+		"binds running by default": {
+			args: []string{"public-https", "my-space"},
+			setup: func(t *testing.T, fakeSpaces *spacesfake.FakeClient, fakeGroups *securitygroupsfake.FakeClient) {
+				fakeGroups.EXPECT().Get("public-https").Return(&v1alpha1.SecurityGroup{}, nil)
+				fakeSpaces.EXPECT().Transform("my-space", gomock.Any()).DoAndReturn(func(name string, mutator spaces.Mutator) (*v1alpha1.Space, error) {
+					space := &v1alpha1.Space{}
+					testutil.AssertNil(t, "mutator err", mutator(space))
+					testutil.AssertEqual(t, "security groups", []v1alpha1.SpaceSecurityGroup{
+						{Name: "public-https", Lifecycle: v1alpha1.SecurityGroupLifecycleRunning},
+					}, space.Spec.Security.SecurityGroups)
+					return space, nil
+				})
+			},
+		},
+		"binds staging": {
+			args: []string{"public-https", "my-space", "--lifecycle", "staging"},
+			setup: func(t *testing.T, fakeSpaces *spacesfake.FakeClient, fakeGroups *securitygroupsfake.FakeClient) {
+				fakeGroups.EXPECT().Get("public-https").Return(&v1alpha1.SecurityGroup{}, nil)
+				fakeSpaces.EXPECT().Transform("my-space", gomock.Any()).DoAndReturn(func(name string, mutator spaces.Mutator) (*v1alpha1.Space, error) {
+					space := &v1alpha1.Space{}
+					testutil.AssertNil(t, "mutator err", mutator(space))
+					testutil.AssertEqual(t, "security groups", []v1alpha1.SpaceSecurityGroup{
+						{Name: "public-https", Lifecycle: v1alpha1.SecurityGroupLifecycleStaging},
+					}, space.Spec.Security.SecurityGroups)
+					return space, nil
+				})
+			},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeSpaces := spacesfake.NewFakeClient(ctrl)
+			fakeGroups := securitygroupsfake.NewFakeClient(ctrl)
+
+			if tc.setup != nil {
+				tc.setup(t, fakeSpaces, fakeGroups)
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := NewBindSecurityGroupCommand(&config.KfParams{}, fakeSpaces, fakeGroups)
+			c.SetOutput(buffer)
+
+			c.SetArgs(tc.args)
+			gotErr := c.Execute()
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertNil(t, "Command err", gotErr)
+			testutil.AssertEqual(t, "SilenceUsage", true, c.SilenceUsage)
+
+			ctrl.Finish()
+		})
+	}
+}
+
+func TestUnbindSecurityGroupCommand(t *testing.T) {
+	t.Parallel()
+
+	for tn, tc := range map[string]struct {
+		args    []string
+		bound   []v1alpha1.SpaceSecurityGroup
+		wantErr error
+	}{
+		"invalid number of args": {
+			args:    []string{"public-https"},
+			wantErr: errors.New("accepts 2 arg(s), received 1"),
+		},
+		"not bound for lifecycle": {
+			args: []string{"public-https", "my-space"},
+			bound: []v1alpha1.SpaceSecurityGroup{
+				{Name: "public-https", Lifecycle: v1alpha1.SecurityGroupLifecycleStaging},
+			},
+			wantErr: errors.New(`security group "public-https" isn't bound to space "my-space" for running`),
+		},
+		"unbinds": {
+			args: []string{"public-https", "my-space", "--lifecycle", "staging"},
+			bound: []v1alpha1.SpaceSecurityGroup{
+				{Name: "public-https", Lifecycle: v1alpha1.SecurityGroupLifecycleStaging},
+			},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeSpaces := spacesfake.NewFakeClient(ctrl)
+
+			fakeSpaces.EXPECT().Transform("my-space", gomock.Any()).DoAndReturn(func(name string, mutator spaces.Mutator) (*v1alpha1.Space, error) {
+				space := &v1alpha1.Space{}
+				space.Spec.Security.SecurityGroups = tc.bound
+				if err := mutator(space); err != nil {
+					return nil, err
+				}
+
+				testutil.AssertEqual(t, "security groups", []v1alpha1.SpaceSecurityGroup(nil), space.Spec.Security.SecurityGroups)
+				return space, nil
+			}).AnyTimes()
+
+			buffer := &bytes.Buffer{}
+
+			c := NewUnbindSecurityGroupCommand(&config.KfParams{}, fakeSpaces)
+			c.SetOutput(buffer)
+
+			c.SetArgs(tc.args)
+			gotErr := c.Execute()
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertNil(t, "Command err", gotErr)
+			testutil.AssertEqual(t, "SilenceUsage", true, c.SilenceUsage)
+
+			ctrl.Finish()
+		})
+	}
+}
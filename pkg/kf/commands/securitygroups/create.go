@@ -0,0 +1,70 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securitygroups
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/securitygroups"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateSecurityGroupCommand allows users to create SecurityGroups from a
+// file of rules.
+func NewCreateSecurityGroupCommand(p *config.KfParams, client securitygroups.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-security-group SECURITY_GROUP PATH_TO_RULES_FILE",
+		Short: "Create a security group from a file of egress rules",
+		Long: `Create a security group from a JSON file containing a list of
+		egress rules. Each rule has a protocol (tcp, udp or all), a destination
+		(an IP, a CIDR block or a range like 10.0.0.1-10.0.0.255), optional
+		ports (like 443 or 80,8000-8080) and an optional description, for
+		example [{"protocol": "tcp", "destination": "0.0.0.0/0", "ports": "443"}].
+
+		Bind the group to spaces with kf bind-security-group.
+		`,
+		Example: "kf create-security-group public-https rules.json",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			name, path := args[0], args[1]
+
+			contents, err := ioutil.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("couldn't read rules file: %v", err)
+			}
+
+			group := &v1alpha1.SecurityGroup{}
+			group.Name = name
+			if err := json.Unmarshal(contents, &group.Spec.Rules); err != nil {
+				return fmt.Errorf("couldn't parse rules file %q: %v", path, err)
+			}
+
+			if _, err := client.Create(group); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Security group %q created\n", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,117 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securitygroups
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/securitygroups/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestCreateSecurityGroupCommand(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "security-groups")
+	testutil.AssertNil(t, "TempDir err", err)
+	defer os.RemoveAll(dir)
+
+	goodRules := filepath.Join(dir, "good.json")
+	testutil.AssertNil(t, "WriteFile err", ioutil.WriteFile(goodRules, []byte(`[
+		{"protocol": "tcp", "destination": "10.0.0.0/8", "ports": "443", "description": "internal https"}
+	]`), 0644))
+
+	badRules := filepath.Join(dir, "bad.json")
+	testutil.AssertNil(t, "WriteFile err", ioutil.WriteFile(badRules, []byte(`{"protocol": "tcp"}`), 0644))
+
+	for tn, tc := range map[string]struct {
+		args       []string
+		wantErr    error
+		wantOutput string
+		setup      func(t *testing.T, fakeGroups *fake.FakeClient)
+	}{
+		"invalid number of args": {
+			args:    []string{"public-https"},
+			wantErr: errors.New("accepts 2 arg(s), received 1"),
+		},
+		"missing file": {
+			args:    []string{"public-https", filepath.Join(dir, "missing.json")},
+			wantErr: errors.New("couldn't read rules file: open " + filepath.Join(dir, "missing.json") + ": no such file or directory"),
+		},
+		"not a list": {
+			args:    []string{"public-https", badRules},
+			wantErr: errors.New(`couldn't parse rules file "` + badRules + `": json: cannot unmarshal object into Go value of type []v1alpha1.SecurityGroupRule`),
+		},
+		"creates group": {
+			args:       []string{"public-https", goodRules},
+			wantOutput: "Security group \"public-https\" created\n",
+			setup: func(t *testing.T, fakeGroups *fake.FakeClient) {
+				fakeGroups.EXPECT().Create(gomock.Any()).DoAndReturn(func(group *v1alpha1.SecurityGroup) (*v1alpha1.SecurityGroup, error) {
+					testutil.AssertEqual(t, "name", "public-https", group.Name)
+					testutil.AssertEqual(t, "rules", []v1alpha1.SecurityGroupRule{
+						{
+							Protocol:    v1alpha1.SecurityGroupProtocolTCP,
+							Destination: "10.0.0.0/8",
+							Ports:       "443",
+							Description: "internal https",
+						},
+					}, group.Spec.Rules)
+					return group, nil
+				})
+			},
+		},
+		"create fails": {
+			args:    []string{"public-https", goodRules},
+			wantErr: errors.New("some-server-error"),
+			setup: func(t *testing.T, fakeGroups *fake.FakeClient) {
+				fakeGroups.EXPECT().Create(gomock.Any()).Return(nil, errors.New("some-server-error"))
+			},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeGroups := fake.NewFakeClient(ctrl)
+
+			if tc.setup != nil {
+				tc.setup(t, fakeGroups)
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := NewCreateSecurityGroupCommand(&config.KfParams{}, fakeGroups)
+			c.SetOutput(buffer)
+
+			c.SetArgs(tc.args)
+			gotErr := c.Execute()
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertNil(t, "Command err", gotErr)
+			testutil.AssertEqual(t, "output", tc.wantOutput, buffer.String())
+
+			ctrl.Finish()
+		})
+	}
+}
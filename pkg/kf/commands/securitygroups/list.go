@@ -0,0 +1,87 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securitygroups
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	"github.com/google/kf/pkg/kf/securitygroups"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/spf13/cobra"
+)
+
+// NewListSecurityGroupsCommand allows users to list SecurityGroups, their
+// rules and the spaces they're bound to.
+func NewListSecurityGroupsCommand(p *config.KfParams, groupsClient securitygroups.Client, spacesClient spaces.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "security-groups",
+		Short:   "List security groups and the spaces they're bound to",
+		Example: "kf security-groups",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			groups, err := groupsClient.List()
+			if err != nil {
+				return err
+			}
+
+			spaceList, err := spacesClient.List()
+			if err != nil {
+				return err
+			}
+
+			bindings := make(map[string][]string)
+			for _, space := range spaceList {
+				for _, b := range space.Spec.Security.SecurityGroups {
+					bindings[b.Name] = append(bindings[b.Name], fmt.Sprintf("%s (%s)", space.Name, b.Lifecycle))
+				}
+			}
+
+			describe.TabbedWriter(cmd.OutOrStdout(), func(w io.Writer) {
+				fmt.Fprintln(w, "Name\tRules\tBound Spaces")
+				for _, group := range groups {
+					fmt.Fprintf(w, "%s\t%s\t%s\n",
+						group.Name,
+						formatRules(group.Spec.Rules),
+						strings.Join(bindings[group.Name], ", "),
+					)
+				}
+			})
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func formatRules(rules []v1alpha1.SecurityGroupRule) string {
+	var out []string
+	for _, rule := range rules {
+		formatted := fmt.Sprintf("%s %s", rule.Protocol, rule.Destination)
+		if rule.Ports != "" {
+			formatted += ":" + rule.Ports
+		}
+		out = append(out, formatted)
+	}
+
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,95 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securitygroups
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	securitygroupsfake "github.com/google/kf/pkg/kf/securitygroups/fake"
+	spacesfake "github.com/google/kf/pkg/kf/spaces/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+)
+
+func TestListSecurityGroupsCommand(t *testing.T) {
+	t.Parallel()
+
+	for tn, tc := range map[string]struct {
+		args            []string
+		setup           func(t *testing.T, fakeGroups *securitygroupsfake.FakeClient, fakeSpaces *spacesfake.FakeClient)
+		wantErr         error
+		expectedStrings []string
+	}{
+		"invalid number of args": {
+			args:    []string{"public-https"},
+			wantErr: errors.New("accepts 0 arg(s), received 1"),
+		},
+		"groups and bindings": {
+			setup: func(t *testing.T, fakeGroups *securitygroupsfake.FakeClient, fakeSpaces *spacesfake.FakeClient) {
+				group := v1alpha1.SecurityGroup{}
+				group.Name = "public-https"
+				group.Spec.Rules = []v1alpha1.SecurityGroupRule{
+					{Protocol: v1alpha1.SecurityGroupProtocolTCP, Destination: "0.0.0.0/0", Ports: "443"},
+				}
+				fakeGroups.EXPECT().List().Return([]v1alpha1.SecurityGroup{group}, nil)
+
+				space := v1alpha1.Space{}
+				space.Name = "my-space"
+				space.Spec.Security.SecurityGroups = []v1alpha1.SpaceSecurityGroup{
+					{Name: "public-https", Lifecycle: v1alpha1.SecurityGroupLifecycleStaging},
+				}
+				fakeSpaces.EXPECT().List().Return([]v1alpha1.Space{space}, nil)
+			},
+			expectedStrings: []string{"Name", "Rules", "Bound Spaces", "public-https", "tcp 0.0.0.0/0:443", "my-space (staging)"},
+		},
+		"server failure": {
+			setup: func(t *testing.T, fakeGroups *securitygroupsfake.FakeClient, fakeSpaces *spacesfake.FakeClient) {
+				fakeGroups.EXPECT().List().Return(nil, errors.New("some-server-error"))
+			},
+			wantErr: errors.New("some-server-error"),
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeGroups := securitygroupsfake.NewFakeClient(ctrl)
+			fakeSpaces := spacesfake.NewFakeClient(ctrl)
+
+			if tc.setup != nil {
+				tc.setup(t, fakeGroups, fakeSpaces)
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := NewListSecurityGroupsCommand(&config.KfParams{}, fakeGroups, fakeSpaces)
+			c.SetOutput(buffer)
+
+			c.SetArgs(tc.args)
+			gotErr := c.Execute()
+			if tc.wantErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertNil(t, "Command err", gotErr)
+			testutil.AssertContainsAll(t, buffer.String(), tc.expectedStrings)
+
+			ctrl.Finish()
+		})
+	}
+}
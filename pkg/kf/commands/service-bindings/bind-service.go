@@ -30,17 +30,26 @@ import (
 // NewBindServiceCommand allows users to bind apps to service instances.
 func NewBindServiceCommand(p *config.KfParams, client apps.Client) *cobra.Command {
 	var (
-		bindingName  string
-		configAsJSON string
-		async        utils.AsyncFlags
+		bindingName   string
+		configAsJSON  string
+		instanceSpace string
+		mountFiles    bool
+		async         utils.AsyncFlags
 	)
 
 	createCmd := &cobra.Command{
-		Use:     "bind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--binding-name BINDING_NAME]",
+		Use:     "bind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--binding-name BINDING_NAME] [--instance-space SPACE] [--mount-files]",
 		Aliases: []string{"bs"},
 		Short:   "Bind a service instance to an app",
-		Example: `  kf bind-service myapp mydb -c '{"permissions":"read-only"}'`,
-		Args:    cobra.ExactArgs(2),
+		Example: `
+  kf bind-service myapp mydb -c '{"permissions":"read-only"}'
+
+  # Binds to mydb that was shared from the space db-space
+  kf bind-service myapp mydb --instance-space db-space
+
+  # Mounts the credentials as files in $SERVICE_BINDING_ROOT/mydb
+  kf bind-service myapp mydb --mount-files`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			appName := args[0]
 			instanceName := args[1]
@@ -57,9 +66,11 @@ func NewBindServiceCommand(p *config.KfParams, client apps.Client) *cobra.Comman
 			}
 
 			binding := &v1alpha1.AppSpecServiceBinding{
-				Instance:    instanceName,
-				Parameters:  parameters,
-				BindingName: bindingName,
+				Instance:      instanceName,
+				InstanceSpace: instanceSpace,
+				Parameters:    parameters,
+				BindingName:   bindingName,
+				MountFiles:    mountFiles,
 			}
 
 			if _, err := client.BindService(p.Namespace, appName, binding); err != nil {
@@ -93,6 +104,18 @@ func NewBindServiceCommand(p *config.KfParams, client apps.Client) *cobra.Comman
 		"",
 		"Name to expose service instance to app process with (default: service instance name)")
 
+	createCmd.Flags().StringVar(
+		&instanceSpace,
+		"instance-space",
+		"",
+		"Space of the service instance if it was shared from another space (default: target space)")
+
+	createCmd.Flags().BoolVar(
+		&mountFiles,
+		"mount-files",
+		false,
+		"Mount the credentials as files in $SERVICE_BINDING_ROOT/BINDING_NAME following the servicebinding.io spec")
+
 	async.Add(createCmd)
 
 	return createCmd
@@ -103,6 +103,32 @@ func TestNewBindServiceCommand(t *testing.T) {
 				f.EXPECT().WaitForConditionServiceBindingsReadyTrue(gomock.Any(), "custom-ns", "APP_NAME", gomock.Any())
 			},
 		},
+		"shared instance": {
+			Args:      []string{"APP_NAME", "SERVICE_INSTANCE", "--instance-space=other-space"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeClient) {
+				f.EXPECT().BindService("custom-ns", "APP_NAME", &v1alpha1.AppSpecServiceBinding{
+					Instance:      "SERVICE_INSTANCE",
+					InstanceSpace: "other-space",
+					Parameters:    json.RawMessage(`{}`),
+				})
+
+				f.EXPECT().WaitForConditionServiceBindingsReadyTrue(gomock.Any(), "custom-ns", "APP_NAME", gomock.Any())
+			},
+		},
+		"mount files": {
+			Args:      []string{"APP_NAME", "SERVICE_INSTANCE", "--mount-files"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeClient) {
+				f.EXPECT().BindService("custom-ns", "APP_NAME", &v1alpha1.AppSpecServiceBinding{
+					Instance:   "SERVICE_INSTANCE",
+					Parameters: json.RawMessage(`{}`),
+					MountFiles: true,
+				})
+
+				f.EXPECT().WaitForConditionServiceBindingsReadyTrue(gomock.Any(), "custom-ns", "APP_NAME", gomock.Any())
+			},
+		},
 		"bad config path": {
 			Args:        []string{"APP_NAME", "SERVICE_INSTANCE", `--config=/some/bad/path`},
 			Namespace:   "custom-ns",
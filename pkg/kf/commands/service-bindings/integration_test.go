@@ -89,19 +89,21 @@ func TestIntegration_VcapServices(t *testing.T) {
 					withServiceBinding(ctx, t, kf, func(ctx context.Context) {
 						vcs := extractVcapServices(ctx, t, kf)
 
+						bindingName := ServiceInstanceFromContext(ctx)
 						expected := cfutil.VcapServicesMap{
 							ServiceClassFromContext(ctx): []cfutil.VcapService{
 								{
-									BindingName:  ServiceInstanceFromContext(ctx),
+									BindingName:  &bindingName,
 									InstanceName: ServiceInstanceFromContext(ctx),
 									Name:         ServiceInstanceFromContext(ctx),
 									Label:        ServiceClassFromContext(ctx),
 									Tags:         []string{"fake-tag"},
 									Plan:         ServicePlanFromContext(ctx),
-									Credentials: map[string]string{
-										"password": "fake-pw",
-										"username": "fake-user",
+									Credentials: cfutil.Credentials{
+										"password": json.RawMessage(`"fake-pw"`),
+										"username": json.RawMessage(`"fake-user"`),
 									},
+									VolumeMounts: []json.RawMessage{},
 								},
 							},
 						}
@@ -130,19 +132,21 @@ func TestIntegration_VcapServices_customBindingName(t *testing.T) {
 
 					vcs := extractVcapServices(ctx, t, kf)
 
+					bindingName := "my-binding"
 					expected := cfutil.VcapServicesMap{
 						ServiceClassFromContext(ctx): []cfutil.VcapService{
 							{
-								BindingName:  "my-binding",
+								BindingName:  &bindingName,
 								InstanceName: ServiceInstanceFromContext(ctx),
 								Name:         "my-binding",
 								Label:        ServiceClassFromContext(ctx),
 								Tags:         []string{"fake-tag"},
 								Plan:         ServicePlanFromContext(ctx),
-								Credentials: map[string]string{
-									"password": "fake-pw",
-									"username": "fake-user",
+								Credentials: cfutil.Credentials{
+									"password": json.RawMessage(`"fake-pw"`),
+									"username": json.RawMessage(`"fake-user"`),
 								},
+								VolumeMounts: []json.RawMessage{},
 							},
 						},
 					}
@@ -0,0 +1,160 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// AuthUsernameKey is the key of the username in a broker auth Secret.
+	AuthUsernameKey = "username"
+
+	// AuthPasswordKey is the key of the password in a broker auth Secret.
+	AuthPasswordKey = "password"
+)
+
+// AuthSecretName gets the name of the Secret that holds the basic auth
+// credentials of a broker. The Secret of a space scoped broker is in the
+// space, the Secret of a cluster broker is in the kf namespace.
+func AuthSecretName(brokerName string) string {
+	return fmt.Sprintf("kf-broker-auth-%s", brokerName)
+}
+
+// brokerCredentials holds the credentials the user passed to a command.
+type brokerCredentials struct {
+	username string
+	password string
+}
+
+func (c brokerCredentials) set() bool {
+	return c.username != "" || c.password != ""
+}
+
+func clusterAuthInfo(brokerName string) *servicecatalogv1beta1.ClusterServiceBrokerAuthInfo {
+	return &servicecatalogv1beta1.ClusterServiceBrokerAuthInfo{
+		Basic: &servicecatalogv1beta1.ClusterBasicAuthConfig{
+			SecretRef: &servicecatalogv1beta1.ObjectReference{
+				Namespace: v1alpha1.KfNamespace,
+				Name:      AuthSecretName(brokerName),
+			},
+		},
+	}
+}
+
+func spaceAuthInfo(brokerName string) *servicecatalogv1beta1.ServiceBrokerAuthInfo {
+	return &servicecatalogv1beta1.ServiceBrokerAuthInfo{
+		Basic: &servicecatalogv1beta1.BasicAuthConfig{
+			SecretRef: &servicecatalogv1beta1.LocalObjectReference{
+				Name: AuthSecretName(brokerName),
+			},
+		},
+	}
+}
+
+// applyAuthSecret creates or updates the Secret with the credentials of a
+// broker. The Secret is owned by the broker so it's deleted with it.
+func applyAuthSecret(
+	secrets v1.SecretsGetter,
+	namespace string,
+	broker metav1.Object,
+	kind string,
+	creds brokerCredentials,
+) error {
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      AuthSecretName(broker.GetName()),
+			Namespace: namespace,
+			Labels: map[string]string{
+				v1alpha1.ManagedByLabel: "kf",
+				v1alpha1.ComponentLabel: "broker-auth",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(broker, servicecatalogv1beta1.SchemeGroupVersion.WithKind(kind)),
+			},
+		},
+		Data: map[string][]byte{
+			AuthUsernameKey: []byte(creds.username),
+			AuthPasswordKey: []byte(creds.password),
+		},
+	}
+
+	existing, err := secrets.Secrets(namespace).Get(desired.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = secrets.Secrets(namespace).Create(desired)
+	case err == nil:
+		existing = existing.DeepCopy()
+		existing.Labels = v1alpha1.UnionMaps(existing.Labels, desired.Labels)
+		existing.Data = desired.Data
+		_, err = secrets.Secrets(namespace).Update(existing)
+	}
+
+	if err != nil {
+		return fmt.Errorf("couldn't store the credentials of broker %q: %v", broker.GetName(), err)
+	}
+
+	return nil
+}
+
+// brokerCondition gets the status, reason and message of the Ready condition
+// of a broker. Catalog fetch errors are reported through it.
+func brokerCondition(status servicecatalogv1beta1.CommonServiceBrokerStatus) (ready, reason, message string) {
+	for _, cond := range status.Conditions {
+		if cond.Type == servicecatalogv1beta1.ServiceBrokerConditionReady {
+			return fmt.Sprintf("%v", cond.Status), cond.Reason, cond.Message
+		}
+	}
+
+	return "", "", ""
+}
+
+// credentialArgs validates commands that take optional CF style
+// USERNAME PASSWORD arguments before the URL.
+func credentialArgs(allowed ...int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		for _, n := range allowed {
+			if len(args) == n {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("accepts %s arg(s), received %d", joinCounts(allowed), len(args))
+	}
+}
+
+func joinCounts(counts []int) string {
+	out := ""
+	for i, n := range counts {
+		switch {
+		case i == 0:
+		case i == len(counts)-1:
+			out += " or "
+		default:
+			out += ", "
+		}
+		out += fmt.Sprintf("%d", n)
+	}
+
+	return out
+}
@@ -17,18 +17,21 @@ package servicebrokers
 import (
 	"fmt"
 
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned"
 	"github.com/google/kf/pkg/kf/commands/config"
 	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
 	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/spf13/cobra"
 )
 
 // NewCreateServiceBrokerCommand adds a service broker (either cluster or namespaced) to the service catalog.
-// TODO (juliaguo): Add user/pw args to match cf
-func NewCreateServiceBrokerCommand(p *config.KfParams, client servicecatalogclient.Interface) *cobra.Command {
+// If a username and password are given they're stored in a Secret the broker
+// uses for basic auth.
+func NewCreateServiceBrokerCommand(p *config.KfParams, client servicecatalogclient.Interface, secrets v1.SecretsGetter) *cobra.Command {
 	var (
 		serviceBrokerName string
 		url               string
@@ -36,14 +39,21 @@ func NewCreateServiceBrokerCommand(p *config.KfParams, client servicecatalogclie
 	)
 
 	createCmd := &cobra.Command{
-		Use:     "create-service-broker BROKER_NAME URL",
+		Use:     "create-service-broker BROKER_NAME [USERNAME PASSWORD] URL",
 		Aliases: []string{"csb"},
 		Short:   "Add a service broker to service catalog",
-		Example: `  kf create-service-broker mybroker http://mybroker.broker.svc.cluster.local`,
-		Args:    cobra.ExactArgs(2),
+		Example: `  kf create-service-broker mybroker http://mybroker.broker.svc.cluster.local
+  kf create-service-broker mybroker user pass http://mybroker.broker.svc.cluster.local
+  kf create-service-broker mybroker http://mybroker.broker.svc.cluster.local --space-scoped`,
+		Args: credentialArgs(2, 4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			serviceBrokerName = args[0]
-			url = args[1]
+			url = args[len(args)-1]
+
+			var creds brokerCredentials
+			if len(args) == 4 {
+				creds = brokerCredentials{username: args[1], password: args[2]}
+			}
 
 			cmd.SilenceUsage = true
 
@@ -65,7 +75,15 @@ func NewCreateServiceBrokerCommand(p *config.KfParams, client servicecatalogclie
 						},
 					},
 				}
-				_, err = client.ServicecatalogV1beta1().ServiceBrokers(p.Namespace).Create(desiredBroker)
+				if creds.set() {
+					desiredBroker.Spec.AuthInfo = spaceAuthInfo(serviceBrokerName)
+				}
+
+				var broker *servicecatalogv1beta1.ServiceBroker
+				broker, err = client.ServicecatalogV1beta1().ServiceBrokers(p.Namespace).Create(desiredBroker)
+				if err == nil && creds.set() {
+					err = applyAuthSecret(secrets, p.Namespace, broker, "ServiceBroker", creds)
+				}
 			} else {
 				desiredBroker := &servicecatalogv1beta1.ClusterServiceBroker{
 					ObjectMeta: metav1.ObjectMeta{
@@ -77,11 +95,19 @@ func NewCreateServiceBrokerCommand(p *config.KfParams, client servicecatalogclie
 						},
 					},
 				}
-				_, err = client.ServicecatalogV1beta1().ClusterServiceBrokers().Create(desiredBroker)
+				if creds.set() {
+					desiredBroker.Spec.AuthInfo = clusterAuthInfo(serviceBrokerName)
+				}
+
+				var broker *servicecatalogv1beta1.ClusterServiceBroker
+				broker, err = client.ServicecatalogV1beta1().ClusterServiceBrokers().Create(desiredBroker)
+				if err == nil && creds.set() {
+					err = applyAuthSecret(secrets, v1alpha1.KfNamespace, broker, "ClusterServiceBroker", creds)
+				}
 			}
 
 			if err == nil {
-				fmt.Fprintf(cmd.OutOrStdout(), "Creating service broker entry, run `kf marketplace` to check the status. %s", utils.AsyncLogSuffix)
+				fmt.Fprintf(cmd.OutOrStdout(), "Creating service broker entry, run `kf service-brokers` to check the status. %s", utils.AsyncLogSuffix)
 			}
 
 			return err
@@ -0,0 +1,90 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/fake"
+	servicebrokerscmd "github.com/google/kf/pkg/kf/commands/service-brokers"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNewCreateServiceBrokerCommand(t *testing.T) {
+	cases := map[string]brokerTest{
+		"wrong number of args": {
+			Args:        []string{"mybroker", "user", "http://example.com"},
+			ExpectedErr: errors.New("accepts 2 or 4 arg(s), received 3"),
+		},
+		"empty namespace": {
+			Args:        []string{"mybroker", "http://example.com"},
+			ExpectedErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"cluster broker": {
+			Args:            []string{"mybroker", "http://example.com"},
+			Namespace:       "custom-ns",
+			ExpectedStrings: []string{"kf service-brokers"},
+			Validate: func(t *testing.T, client *fake.Clientset, k8s *k8sfake.Clientset) {
+				broker, err := client.ServicecatalogV1beta1().ClusterServiceBrokers().Get("mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "url", "http://example.com", broker.Spec.URL)
+				testutil.AssertNil(t, "auth", broker.Spec.AuthInfo)
+			},
+		},
+		"cluster broker with credentials": {
+			Args:      []string{"mybroker", "user", "pass", "http://example.com"},
+			Namespace: "custom-ns",
+			Validate: func(t *testing.T, client *fake.Clientset, k8s *k8sfake.Clientset) {
+				broker, err := client.ServicecatalogV1beta1().ClusterServiceBrokers().Get("mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "url", "http://example.com", broker.Spec.URL)
+				testutil.AssertEqual(t, "secret ref", servicecatalogv1beta1.ObjectReference{
+					Namespace: "kf",
+					Name:      "kf-broker-auth-mybroker",
+				}, *broker.Spec.AuthInfo.Basic.SecretRef)
+
+				secret, err := k8s.CoreV1().Secrets("kf").Get("kf-broker-auth-mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "username", "user", string(secret.Data[servicebrokerscmd.AuthUsernameKey]))
+				testutil.AssertEqual(t, "password", "pass", string(secret.Data[servicebrokerscmd.AuthPasswordKey]))
+				testutil.AssertEqual(t, "owner", "mybroker", secret.OwnerReferences[0].Name)
+			},
+		},
+		"space broker with credentials": {
+			Args:      []string{"mybroker", "user", "pass", "http://example.com", "--space-scoped"},
+			Namespace: "custom-ns",
+			Validate: func(t *testing.T, client *fake.Clientset, k8s *k8sfake.Clientset) {
+				broker, err := client.ServicecatalogV1beta1().ServiceBrokers("custom-ns").Get("mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "secret name", "kf-broker-auth-mybroker", broker.Spec.AuthInfo.Basic.SecretRef.Name)
+
+				secret, err := k8s.CoreV1().Secrets("custom-ns").Get("kf-broker-auth-mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "owner kind", "ServiceBroker", secret.OwnerReferences[0].Kind)
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runTest(t, tc, servicebrokerscmd.NewCreateServiceBrokerCommand)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers
+
+import (
+	"fmt"
+
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewDisableServiceAccessCommand stops spaces from using the plans of a
+// service.
+func NewDisableServiceAccessCommand(p *config.KfParams, client kfv1alpha1.ServicePlanVisibilitiesGetter) *cobra.Command {
+	target := &serviceAccessTarget{}
+
+	disableCmd := &cobra.Command{
+		Use:   "disable-service-access SERVICE",
+		Short: "Stop spaces from using the plans of a service",
+		Long: `Stop spaces from using the plans of a service from a cluster service broker.
+
+		Without --space the plans can't be used in any space until they're
+		enabled again with enable-service-access.
+
+		With --space the plans are disabled in a space they were enabled in.
+		Existing service instances aren't changed.`,
+		Example: `  kf disable-service-access mydb
+  kf disable-service-access mydb -p small -s dev`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target.serviceName = args[0]
+
+			cmd.SilenceUsage = true
+
+			visibilities := client.ServicePlanVisibilities()
+			visibility, err := visibilities.Get(target.visibilityName(), metav1.GetOptions{})
+			switch {
+			case apierrors.IsNotFound(err) && target.space != "":
+				return fmt.Errorf("%s isn't limited to spaces, run `kf disable-service-access` without --space first", target)
+			case apierrors.IsNotFound(err):
+				_, err = visibilities.Create(target.newVisibility())
+			case err != nil:
+				return err
+			default:
+				visibility = visibility.DeepCopy()
+				if target.space == "" {
+					visibility.Spec.Spaces = nil
+				} else {
+					visibility.Spec.Spaces = removeSpace(visibility.Spec.Spaces, target.space)
+				}
+				_, err = visibilities.Update(visibility)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if target.space == "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "Disabled access to %s in all spaces\n", target)
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "Disabled access to %s in space %q\n", target, target.space)
+			}
+			return nil
+		},
+	}
+
+	target.addFlags(disableCmd)
+
+	return disableCmd
+}
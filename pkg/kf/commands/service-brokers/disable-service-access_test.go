@@ -0,0 +1,73 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers_test
+
+import (
+	"errors"
+	"testing"
+
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	servicebrokerscmd "github.com/google/kf/pkg/kf/commands/service-brokers"
+	"github.com/google/kf/pkg/kf/testutil"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewDisableServiceAccessCommand(t *testing.T) {
+	cases := map[string]accessTest{
+		"wrong number of args": {
+			Args:        []string{},
+			ExpectedErr: errors.New("accepts 1 arg(s), received 0"),
+		},
+		"all spaces": {
+			Args:            []string{"mydb", "-b", "mybroker"},
+			ExpectedStrings: []string{`Disabled access to service "mydb" from broker "mybroker" in all spaces`},
+		},
+		"all spaces with enabled spaces": {
+			Args:    []string{"mydb"},
+			Objects: []runtime.Object{dummyVisibility("mydb", "", "dev")},
+			Validate: func(t *testing.T, client *kffake.Clientset) {
+				visibility := getVisibility(t, client, "mydb", "")
+				testutil.AssertEqual(t, "spaces", 0, len(visibility.Spec.Spaces))
+			},
+		},
+		"single plan": {
+			Args: []string{"mydb", "-p", "small"},
+			Validate: func(t *testing.T, client *kffake.Clientset) {
+				visibility := getVisibility(t, client, "mydb", "small")
+				testutil.AssertEqual(t, "plan", "small", visibility.Spec.PlanName)
+				testutil.AssertEqual(t, "spaces", 0, len(visibility.Spec.Spaces))
+			},
+		},
+		"space": {
+			Args:            []string{"mydb", "-s", "dev"},
+			Objects:         []runtime.Object{dummyVisibility("mydb", "", "dev", "prod")},
+			ExpectedStrings: []string{`Disabled access to service "mydb" in space "dev"`},
+			Validate: func(t *testing.T, client *kffake.Clientset) {
+				visibility := getVisibility(t, client, "mydb", "")
+				testutil.AssertEqual(t, "spaces", []string{"prod"}, visibility.Spec.Spaces)
+			},
+		},
+		"space of unrestricted service": {
+			Args:        []string{"mydb", "-s", "dev"},
+			ExpectedErr: errors.New("service \"mydb\" isn't limited to spaces, run `kf disable-service-access` without --space first"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runAccessTest(t, tc, servicebrokerscmd.NewDisableServiceAccessCommand)
+		})
+	}
+}
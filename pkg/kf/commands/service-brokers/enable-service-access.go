@@ -0,0 +1,85 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers
+
+import (
+	"fmt"
+
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewEnableServiceAccessCommand allows spaces to use the plans of a service.
+func NewEnableServiceAccessCommand(p *config.KfParams, client kfv1alpha1.ServicePlanVisibilitiesGetter) *cobra.Command {
+	target := &serviceAccessTarget{}
+
+	enableCmd := &cobra.Command{
+		Use:   "enable-service-access SERVICE",
+		Short: "Allow spaces to use the plans of a service",
+		Long: `Allow spaces to use the plans of a service from a cluster service broker.
+
+		Without --space the restriction created by disable-service-access with
+		the same flags is removed and the plans can be used in every space.
+
+		With --space the plans are enabled in the space. Plans that are enabled
+		in some spaces can only be used in those spaces.`,
+		Example: `  kf enable-service-access mydb
+  kf enable-service-access mydb -p small -s dev`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target.serviceName = args[0]
+
+			cmd.SilenceUsage = true
+
+			visibilities := client.ServicePlanVisibilities()
+
+			if target.space == "" {
+				err := visibilities.Delete(target.visibilityName(), &metav1.DeleteOptions{})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Enabled access to %s in all spaces\n", target)
+				return nil
+			}
+
+			visibility, err := visibilities.Get(target.visibilityName(), metav1.GetOptions{})
+			switch {
+			case apierrors.IsNotFound(err):
+				_, err = visibilities.Create(target.newVisibility(target.space))
+			case err != nil:
+				return err
+			case !visibility.Spec.EnabledIn(target.space):
+				visibility = visibility.DeepCopy()
+				visibility.Spec.Spaces = append(visibility.Spec.Spaces, target.space)
+				_, err = visibilities.Update(visibility)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Enabled access to %s in space %q\n", target, target.space)
+			return nil
+		},
+	}
+
+	target.addFlags(enableCmd)
+
+	return enableCmd
+}
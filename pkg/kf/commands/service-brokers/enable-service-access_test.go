@@ -0,0 +1,106 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	servicebrokerscmd "github.com/google/kf/pkg/kf/commands/service-brokers"
+	"github.com/google/kf/pkg/kf/testutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func dummyVisibility(service, plan string, spaces ...string) *v1alpha1.ServicePlanVisibility {
+	visibility := &v1alpha1.ServicePlanVisibility{
+		Spec: v1alpha1.ServicePlanVisibilitySpec{
+			ServiceName: service,
+			PlanName:    plan,
+			Spaces:      spaces,
+		},
+	}
+	visibility.Name = v1alpha1.ServicePlanVisibilityName("", service, plan)
+	return visibility
+}
+
+func getVisibility(t *testing.T, client *kffake.Clientset, service, plan string) *v1alpha1.ServicePlanVisibility {
+	t.Helper()
+
+	visibility, err := client.KfV1alpha1().
+		ServicePlanVisibilities().
+		Get(v1alpha1.ServicePlanVisibilityName("", service, plan), metav1.GetOptions{})
+	testutil.AssertNil(t, "err", err)
+	return visibility
+}
+
+func TestNewEnableServiceAccessCommand(t *testing.T) {
+	cases := map[string]accessTest{
+		"wrong number of args": {
+			Args:        []string{},
+			ExpectedErr: errors.New("accepts 1 arg(s), received 0"),
+		},
+		"all spaces": {
+			Args:            []string{"mydb"},
+			Objects:         []runtime.Object{dummyVisibility("mydb", "", "dev")},
+			ExpectedStrings: []string{`Enabled access to service "mydb" in all spaces`},
+			Validate: func(t *testing.T, client *kffake.Clientset) {
+				_, err := client.KfV1alpha1().
+					ServicePlanVisibilities().
+					Get(v1alpha1.ServicePlanVisibilityName("", "mydb", ""), metav1.GetOptions{})
+				testutil.AssertEqual(t, "not found", true, apierrors.IsNotFound(err))
+			},
+		},
+		"all spaces already enabled": {
+			Args:            []string{"mydb"},
+			ExpectedStrings: []string{`Enabled access to service "mydb" in all spaces`},
+		},
+		"new space": {
+			Args:            []string{"mydb", "--plan", "small", "--space", "dev"},
+			ExpectedStrings: []string{`Enabled access to plan "small" of service "mydb" in space "dev"`},
+			Validate: func(t *testing.T, client *kffake.Clientset) {
+				visibility := getVisibility(t, client, "mydb", "small")
+				testutil.AssertEqual(t, "service", "mydb", visibility.Spec.ServiceName)
+				testutil.AssertEqual(t, "plan", "small", visibility.Spec.PlanName)
+				testutil.AssertEqual(t, "spaces", []string{"dev"}, visibility.Spec.Spaces)
+			},
+		},
+		"additional space": {
+			Args:    []string{"mydb", "-s", "prod"},
+			Objects: []runtime.Object{dummyVisibility("mydb", "", "dev")},
+			Validate: func(t *testing.T, client *kffake.Clientset) {
+				visibility := getVisibility(t, client, "mydb", "")
+				testutil.AssertEqual(t, "spaces", []string{"dev", "prod"}, visibility.Spec.Spaces)
+			},
+		},
+		"space already enabled": {
+			Args:    []string{"mydb", "-s", "dev"},
+			Objects: []runtime.Object{dummyVisibility("mydb", "", "dev")},
+			Validate: func(t *testing.T, client *kffake.Clientset) {
+				visibility := getVisibility(t, client, "mydb", "")
+				testutil.AssertEqual(t, "spaces", []string{"dev"}, visibility.Spec.Spaces)
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runAccessTest(t, tc, servicebrokerscmd.NewEnableServiceAccessCommand)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers_test
+
+import (
+	"bytes"
+	"testing"
+
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned"
+	"github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/fake"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/testutil"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+type commandFactory func(p *config.KfParams, client servicecatalogclient.Interface, secrets v1.SecretsGetter) *cobra.Command
+
+type brokerTest struct {
+	Args      []string
+	Namespace string
+	Objects   []runtime.Object
+
+	ExpectedErr     error
+	ExpectedStrings []string
+	Validate        func(t *testing.T, client *fake.Clientset, k8s *k8sfake.Clientset)
+}
+
+func runTest(t *testing.T, tc brokerTest, newCommand commandFactory) {
+	client := fake.NewSimpleClientset(tc.Objects...)
+	k8s := k8sfake.NewSimpleClientset()
+
+	buf := new(bytes.Buffer)
+	p := &config.KfParams{
+		Namespace: tc.Namespace,
+	}
+
+	cmd := newCommand(p, client, k8s.CoreV1())
+	cmd.SetOutput(buf)
+	cmd.SetArgs(tc.Args)
+	_, actualErr := cmd.ExecuteC()
+	if tc.ExpectedErr != nil || actualErr != nil {
+		testutil.AssertErrorsEqual(t, tc.ExpectedErr, actualErr)
+		return
+	}
+
+	testutil.AssertContainsAll(t, buf.String(), tc.ExpectedStrings)
+	testutil.AssertEqual(t, "SilenceUsage", true, cmd.SilenceUsage)
+
+	if tc.Validate != nil {
+		tc.Validate(t, client, k8s)
+	}
+}
+
+// withoutSecrets adapts commands that don't manage credentials to a
+// commandFactory.
+func withoutSecrets(newCommand func(*config.KfParams, servicecatalogclient.Interface) *cobra.Command) commandFactory {
+	return func(p *config.KfParams, client servicecatalogclient.Interface, _ v1.SecretsGetter) *cobra.Command {
+		return newCommand(p, client)
+	}
+}
+
+type accessCommandFactory func(p *config.KfParams, client kfv1alpha1.ServicePlanVisibilitiesGetter) *cobra.Command
+
+type accessTest struct {
+	Args    []string
+	Objects []runtime.Object
+
+	ExpectedErr     error
+	ExpectedStrings []string
+	Validate        func(t *testing.T, client *kffake.Clientset)
+}
+
+func runAccessTest(t *testing.T, tc accessTest, newCommand accessCommandFactory) {
+	client := kffake.NewSimpleClientset(tc.Objects...)
+
+	buf := new(bytes.Buffer)
+	cmd := newCommand(&config.KfParams{}, client.KfV1alpha1())
+	cmd.SetOutput(buf)
+	cmd.SetArgs(tc.Args)
+	_, actualErr := cmd.ExecuteC()
+	if tc.ExpectedErr != nil || actualErr != nil {
+		testutil.AssertErrorsEqual(t, tc.ExpectedErr, actualErr)
+		return
+	}
+
+	testutil.AssertContainsAll(t, buf.String(), tc.ExpectedStrings)
+	testutil.AssertEqual(t, "SilenceUsage", true, cmd.SilenceUsage)
+
+	if tc.Validate != nil {
+		tc.Validate(t, client)
+	}
+}
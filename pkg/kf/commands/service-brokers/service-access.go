@@ -0,0 +1,99 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+// serviceAccessTarget holds the flags that select the plans and space of the
+// enable-service-access and disable-service-access commands.
+type serviceAccessTarget struct {
+	serviceName string
+	brokerName  string
+	planName    string
+	space       string
+}
+
+func (t *serviceAccessTarget) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(
+		&t.brokerName,
+		"broker",
+		"b",
+		"",
+		"Only change the access of plans from this broker.")
+
+	cmd.Flags().StringVarP(
+		&t.planName,
+		"plan",
+		"p",
+		"",
+		"Only change the access of this plan.")
+
+	cmd.Flags().StringVarP(
+		&t.space,
+		"space",
+		"s",
+		"",
+		"Only change the access of this space.")
+}
+
+// visibilityName is the name of the ServicePlanVisibility for the target.
+func (t *serviceAccessTarget) visibilityName() string {
+	return v1alpha1.ServicePlanVisibilityName(t.brokerName, t.serviceName, t.planName)
+}
+
+// newVisibility creates a ServicePlanVisibility for the target that enables
+// the spaces.
+func (t *serviceAccessTarget) newVisibility(spaces ...string) *v1alpha1.ServicePlanVisibility {
+	visibility := &v1alpha1.ServicePlanVisibility{
+		Spec: v1alpha1.ServicePlanVisibilitySpec{
+			BrokerName:  t.brokerName,
+			ServiceName: t.serviceName,
+			PlanName:    t.planName,
+			Spaces:      spaces,
+		},
+	}
+	visibility.Name = t.visibilityName()
+
+	return visibility
+}
+
+// String describes the plans of the target.
+func (t *serviceAccessTarget) String() string {
+	desc := fmt.Sprintf("service %q", t.serviceName)
+	if t.planName != "" {
+		desc = fmt.Sprintf("plan %q of %s", t.planName, desc)
+	}
+	if t.brokerName != "" {
+		desc = fmt.Sprintf("%s from broker %q", desc, t.brokerName)
+	}
+
+	return desc
+}
+
+func removeSpace(spaces []string, space string) []string {
+	var out []string
+	for _, s := range spaces {
+		if s != space {
+			out = append(out, s)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,106 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// NewListServiceBrokersCommand lists the cluster service brokers and the
+// service brokers of the targeted space.
+func NewListServiceBrokersCommand(p *config.KfParams, client servicecatalogclient.Interface) *cobra.Command {
+	return &cobra.Command{
+		Use:     "service-brokers",
+		Short:   "List the service brokers available in the space",
+		Example: `  kf service-brokers`,
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			clusterBrokers, err := client.ServicecatalogV1beta1().ClusterServiceBrokers().List(metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+
+			spaceBrokers, err := client.ServicecatalogV1beta1().ServiceBrokers(p.Namespace).List(metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+
+			describe.TabbedWriter(cmd.OutOrStdout(), func(w io.Writer) {
+				fmt.Fprintln(w, "Name\tScope\tURL\tAuth\tReady\tReason\tCatalog Fetched\tMessage")
+
+				for _, broker := range clusterBrokers.Items {
+					auth := broker.Spec.AuthInfo != nil && broker.Spec.AuthInfo.Basic != nil
+					writeBrokerRow(w, broker.Name, "cluster", broker.Spec.CommonServiceBrokerSpec, auth, broker.Status.CommonServiceBrokerStatus)
+				}
+
+				for _, broker := range spaceBrokers.Items {
+					auth := broker.Spec.AuthInfo != nil && broker.Spec.AuthInfo.Basic != nil
+					writeBrokerRow(w, broker.Name, "space", broker.Spec.CommonServiceBrokerSpec, auth, broker.Status.CommonServiceBrokerStatus)
+				}
+			})
+
+			return nil
+		},
+	}
+}
+
+func writeBrokerRow(
+	w io.Writer,
+	name string,
+	scope string,
+	spec servicecatalogv1beta1.CommonServiceBrokerSpec,
+	basicAuth bool,
+	status servicecatalogv1beta1.CommonServiceBrokerStatus,
+) {
+	ready, reason, message := brokerCondition(status)
+
+	auth := "none"
+	if basicAuth {
+		auth = "basic"
+	}
+
+	fetched := "never"
+	if status.LastCatalogRetrievalTime != nil {
+		fetched = duration.HumanDuration(time.Since(status.LastCatalogRetrievalTime.Time)) + " ago"
+	}
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		name,
+		scope,
+		spec.URL,
+		auth,
+		ready,
+		reason,
+		fetched,
+		message,
+	)
+}
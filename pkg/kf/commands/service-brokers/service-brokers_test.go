@@ -0,0 +1,71 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers_test
+
+import (
+	"errors"
+	"testing"
+
+	servicebrokerscmd "github.com/google/kf/pkg/kf/commands/service-brokers"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewListServiceBrokersCommand(t *testing.T) {
+	failing := dummySpaceBroker("custom-ns", "space-broker", "http://space")
+	failing.Status.Conditions = []servicecatalogv1beta1.ServiceBrokerCondition{{
+		Type:    servicecatalogv1beta1.ServiceBrokerConditionReady,
+		Status:  servicecatalogv1beta1.ConditionFalse,
+		Reason:  "ErrorFetchingCatalog",
+		Message: "connection refused",
+	}}
+
+	authed := dummyClusterBroker("cluster-broker", "http://cluster")
+	authed.Spec.AuthInfo = &servicecatalogv1beta1.ClusterServiceBrokerAuthInfo{
+		Basic: &servicecatalogv1beta1.ClusterBasicAuthConfig{
+			SecretRef: &servicecatalogv1beta1.ObjectReference{Namespace: "kf", Name: "kf-broker-auth-cluster-broker"},
+		},
+	}
+
+	cases := map[string]brokerTest{
+		"wrong number of args": {
+			Args:        []string{"foo"},
+			ExpectedErr: errors.New("accepts 0 arg(s), received 1"),
+		},
+		"empty namespace": {
+			ExpectedErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"lists brokers": {
+			Namespace: "custom-ns",
+			Objects: []runtime.Object{
+				authed,
+				failing,
+				dummySpaceBroker("other-ns", "hidden-broker", "http://other"),
+			},
+			ExpectedStrings: []string{
+				"Name", "Scope", "URL", "Auth", "Ready", "Catalog Fetched",
+				"cluster-broker", "cluster", "http://cluster", "basic", "never",
+				"space-broker", "space", "http://space", "none", "False", "ErrorFetchingCatalog", "connection refused",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runTest(t, tc, withoutSecrets(servicebrokerscmd.NewListServiceBrokersCommand))
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned"
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// NewUpdateServiceBrokerCommand changes the URL or credentials of a service
+// broker (either cluster or namespaced) and makes service catalog fetch its
+// catalog again.
+func NewUpdateServiceBrokerCommand(p *config.KfParams, client servicecatalogclient.Interface, secrets v1.SecretsGetter) *cobra.Command {
+	var spaceScoped bool
+
+	updateCmd := &cobra.Command{
+		Use:   "update-service-broker BROKER_NAME [USERNAME PASSWORD] [URL]",
+		Short: "Update a service broker and refresh its catalog",
+		Long: `Update the URL or basic auth credentials of a service broker.
+
+		The catalog of the broker is fetched again even if nothing else changes,
+		so new services and plans show up in the marketplace.`,
+		Example: `  kf update-service-broker mybroker
+  kf update-service-broker mybroker http://newbroker.broker.svc.cluster.local
+  kf update-service-broker mybroker user newpass
+  kf update-service-broker mybroker user newpass http://newbroker.broker.svc.cluster.local`,
+		Args: cobra.RangeArgs(1, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serviceBrokerName := args[0]
+
+			var (
+				url   string
+				creds brokerCredentials
+			)
+			switch len(args) {
+			case 2:
+				url = args[1]
+			case 3:
+				creds = brokerCredentials{username: args[1], password: args[2]}
+			case 4:
+				creds = brokerCredentials{username: args[1], password: args[2]}
+				url = args[3]
+			}
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			var err error
+			if spaceScoped {
+				err = updateSpaceBroker(p.Namespace, client, secrets, serviceBrokerName, url, creds)
+			} else {
+				err = updateClusterBroker(client, secrets, serviceBrokerName, url, creds)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Updating service broker %q, run `kf service-brokers` to check the status. %s", serviceBrokerName, utils.AsyncLogSuffix)
+			return nil
+		},
+	}
+
+	updateCmd.Flags().BoolVar(
+		&spaceScoped,
+		"space-scoped",
+		false,
+		"Set to update a space scoped service broker.")
+
+	return updateCmd
+}
+
+func updateClusterBroker(client servicecatalogclient.Interface, secrets v1.SecretsGetter, name, url string, creds brokerCredentials) error {
+	brokers := client.ServicecatalogV1beta1().ClusterServiceBrokers()
+	broker, err := brokers.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// Store the credentials first so the catalog is fetched with them.
+	if creds.set() {
+		if err := applyAuthSecret(secrets, v1alpha1.KfNamespace, broker, "ClusterServiceBroker", creds); err != nil {
+			return err
+		}
+	}
+
+	broker = broker.DeepCopy()
+	if url != "" {
+		broker.Spec.URL = url
+	}
+	if creds.set() {
+		broker.Spec.AuthInfo = clusterAuthInfo(name)
+	}
+	broker.Spec.RelistRequests++
+
+	_, err = brokers.Update(broker)
+	return err
+}
+
+func updateSpaceBroker(namespace string, client servicecatalogclient.Interface, secrets v1.SecretsGetter, name, url string, creds brokerCredentials) error {
+	brokers := client.ServicecatalogV1beta1().ServiceBrokers(namespace)
+	broker, err := brokers.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// Store the credentials first so the catalog is fetched with them.
+	if creds.set() {
+		if err := applyAuthSecret(secrets, namespace, broker, "ServiceBroker", creds); err != nil {
+			return err
+		}
+	}
+
+	broker = broker.DeepCopy()
+	if url != "" {
+		broker.Spec.URL = url
+	}
+	if creds.set() {
+		broker.Spec.AuthInfo = spaceAuthInfo(name)
+	}
+	broker.Spec.RelistRequests++
+
+	_, err = brokers.Update(broker)
+	return err
+}
@@ -0,0 +1,120 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebrokers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/fake"
+	servicebrokerscmd "github.com/google/kf/pkg/kf/commands/service-brokers"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func dummyClusterBroker(name, url string) *servicecatalogv1beta1.ClusterServiceBroker {
+	broker := &servicecatalogv1beta1.ClusterServiceBroker{}
+	broker.Name = name
+	broker.Spec.URL = url
+	return broker
+}
+
+func dummySpaceBroker(namespace, name, url string) *servicecatalogv1beta1.ServiceBroker {
+	broker := &servicecatalogv1beta1.ServiceBroker{}
+	broker.Namespace = namespace
+	broker.Name = name
+	broker.Spec.URL = url
+	return broker
+}
+
+func TestNewUpdateServiceBrokerCommand(t *testing.T) {
+	cases := map[string]brokerTest{
+		"wrong number of args": {
+			Args:        []string{},
+			ExpectedErr: errors.New("accepts between 1 and 4 arg(s), received 0"),
+		},
+		"empty namespace": {
+			Args:        []string{"mybroker"},
+			ExpectedErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"missing broker": {
+			Args:        []string{"mybroker"},
+			Namespace:   "custom-ns",
+			ExpectedErr: errors.New(`clusterservicebrokers.servicecatalog.k8s.io "mybroker" not found`),
+		},
+		"refresh only": {
+			Args:            []string{"mybroker"},
+			Namespace:       "custom-ns",
+			Objects:         []runtime.Object{dummyClusterBroker("mybroker", "http://old")},
+			ExpectedStrings: []string{`Updating service broker "mybroker"`},
+			Validate: func(t *testing.T, client *fake.Clientset, k8s *k8sfake.Clientset) {
+				broker, err := client.ServicecatalogV1beta1().ClusterServiceBrokers().Get("mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "url", "http://old", broker.Spec.URL)
+				testutil.AssertEqual(t, "relist requests", int64(1), broker.Spec.RelistRequests)
+			},
+		},
+		"new url": {
+			Args:      []string{"mybroker", "http://new"},
+			Namespace: "custom-ns",
+			Objects:   []runtime.Object{dummyClusterBroker("mybroker", "http://old")},
+			Validate: func(t *testing.T, client *fake.Clientset, k8s *k8sfake.Clientset) {
+				broker, err := client.ServicecatalogV1beta1().ClusterServiceBrokers().Get("mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "url", "http://new", broker.Spec.URL)
+				testutil.AssertNil(t, "auth", broker.Spec.AuthInfo)
+			},
+		},
+		"new credentials": {
+			Args:      []string{"mybroker", "user", "pass"},
+			Namespace: "custom-ns",
+			Objects:   []runtime.Object{dummyClusterBroker("mybroker", "http://old")},
+			Validate: func(t *testing.T, client *fake.Clientset, k8s *k8sfake.Clientset) {
+				broker, err := client.ServicecatalogV1beta1().ClusterServiceBrokers().Get("mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "url", "http://old", broker.Spec.URL)
+				testutil.AssertEqual(t, "secret name", "kf-broker-auth-mybroker", broker.Spec.AuthInfo.Basic.SecretRef.Name)
+
+				secret, err := k8s.CoreV1().Secrets("kf").Get("kf-broker-auth-mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "password", "pass", string(secret.Data[servicebrokerscmd.AuthPasswordKey]))
+			},
+		},
+		"space broker": {
+			Args:      []string{"mybroker", "user", "pass", "http://new", "--space-scoped"},
+			Namespace: "custom-ns",
+			Objects:   []runtime.Object{dummySpaceBroker("custom-ns", "mybroker", "http://old")},
+			Validate: func(t *testing.T, client *fake.Clientset, k8s *k8sfake.Clientset) {
+				broker, err := client.ServicecatalogV1beta1().ServiceBrokers("custom-ns").Get("mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+				testutil.AssertEqual(t, "url", "http://new", broker.Spec.URL)
+				testutil.AssertEqual(t, "relist requests", int64(1), broker.Spec.RelistRequests)
+
+				_, err = k8s.CoreV1().Secrets("custom-ns").Get("kf-broker-auth-mybroker", metav1.GetOptions{})
+				testutil.AssertNil(t, "err", err)
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runTest(t, tc, servicebrokerscmd.NewUpdateServiceBrokerCommand)
+		})
+	}
+}
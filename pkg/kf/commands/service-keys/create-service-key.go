@@ -0,0 +1,87 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicekeys
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	servicebindings "github.com/google/kf/pkg/kf/service-bindings"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateServiceKeyCommand allows users to create service keys.
+func NewCreateServiceKeyCommand(p *config.KfParams, client servicebindings.KeysClient) *cobra.Command {
+	var (
+		configAsJSON string
+		async        utils.AsyncFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:     "create-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON]",
+		Aliases: []string{"csk"},
+		Short:   "Create a service key for a service instance",
+		Long: `Creates credentials for a service instance without binding it to an app.
+
+The credentials can be viewed with 'kf service-key'.`,
+		Example: `
+  # Creates a service key named migrations for mydb
+  kf create-service-key mydb migrations
+
+  # Creates a read-only service key
+  kf create-service-key mydb reporting -c '{"permissions":"read-only"}'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName := args[0]
+			keyName := args[1]
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			params, err := services.ParseJSONOrFile(configAsJSON)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Create(p.Namespace, instanceName, keyName, params); err != nil {
+				return err
+			}
+
+			action := fmt.Sprintf("Creating service key %q for service instance %q in space %q", keyName, instanceName, p.Namespace)
+			return async.AwaitAndLog(cmd.OutOrStdout(), action, func() error {
+				_, err := client.WaitForReady(context.Background(), p.Namespace, instanceName, keyName, 1*time.Second)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(
+		&configAsJSON,
+		"config",
+		"c",
+		"{}",
+		"JSON object containing service-specific configuration parameters, provided in-line or in a file")
+
+	async.Add(cmd)
+
+	return cmd
+}
@@ -0,0 +1,96 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicekeys_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	cfutilfake "github.com/google/kf/pkg/kf/cfutil/fake"
+	servicekeyscmd "github.com/google/kf/pkg/kf/commands/service-keys"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/service-bindings/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestNewCreateServiceKeyCommand(t *testing.T) {
+	cases := map[string]serviceKeyTest{
+		"wrong number of args": {
+			Args:        []string{"mydb"},
+			ExpectedErr: errors.New("accepts 2 arg(s), received 1"),
+		},
+		"empty namespace": {
+			Args:        []string{"mydb", "mykey"},
+			ExpectedErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"invalid config": {
+			Args:        []string{"mydb", "mykey", "-c", "not-json-or-file"},
+			Namespace:   "custom-ns",
+			ExpectedErr: errors.New("couldn't read file: open not-json-or-file: no such file or directory"),
+		},
+		"creates and waits": {
+			Args:      []string{"mydb", "mykey", "-c", `{"role":"admin"}`},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, _ *cfutilfake.FakeSystemEnvInjector) {
+				gomock.InOrder(
+					f.EXPECT().
+						Create("custom-ns", "mydb", "mykey", gomock.Any()).
+						DoAndReturn(func(_, _, _ string, params json.RawMessage) (*servicecatalogv1beta1.ServiceBinding, error) {
+							testutil.AssertJSONEqual(t, `{"role":"admin"}`, string(params))
+							return dummyKey("mydb", "mykey", ""), nil
+						}),
+					f.EXPECT().
+						WaitForReady(gomock.Any(), "custom-ns", "mydb", "mykey", gomock.Any()).
+						Return(dummyKey("mydb", "mykey", servicecatalogv1beta1.ConditionTrue), nil),
+				)
+			},
+			ExpectedStrings: []string{`Creating service key "mykey" for service instance "mydb" in space "custom-ns"`, "Success"},
+		},
+		"async": {
+			Args:      []string{"mydb", "mykey", "--async"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, _ *cfutilfake.FakeSystemEnvInjector) {
+				f.EXPECT().Create("custom-ns", "mydb", "mykey", gomock.Any())
+			},
+			ExpectedStrings: []string{"asynchronously"},
+		},
+		"create fails": {
+			Args:      []string{"mydb", "mykey"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, _ *cfutilfake.FakeSystemEnvInjector) {
+				f.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("api-error"))
+			},
+			ExpectedErr: errors.New("api-error"),
+		},
+		"broker fails": {
+			Args:      []string{"mydb", "mykey"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, _ *cfutilfake.FakeSystemEnvInjector) {
+				f.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+				f.EXPECT().WaitForReady(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("create failed"))
+			},
+			ExpectedErr: errors.New("create failed"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runTest(t, tc, withoutInjector(servicekeyscmd.NewCreateServiceKeyCommand))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicekeys
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	servicebindings "github.com/google/kf/pkg/kf/service-bindings"
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteServiceKeyCommand allows users to delete service keys.
+func NewDeleteServiceKeyCommand(p *config.KfParams, client servicebindings.KeysClient) *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete-service-key SERVICE_INSTANCE SERVICE_KEY",
+		Aliases: []string{"dsk"},
+		Short:   "Delete a service key",
+		Example: "kf delete-service-key mydb migrations",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName := args[0]
+			keyName := args[1]
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			if err := client.Delete(p.Namespace, instanceName, keyName); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Deleted service key %q for service instance %q in space %q\n", keyName, instanceName, p.Namespace)
+			return nil
+		},
+	}
+}
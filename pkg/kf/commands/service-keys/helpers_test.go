@@ -0,0 +1,91 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicekeys_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	kfv1alpha1 "github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/cfutil"
+	cfutilfake "github.com/google/kf/pkg/kf/cfutil/fake"
+	"github.com/google/kf/pkg/kf/commands/config"
+	servicebindings "github.com/google/kf/pkg/kf/service-bindings"
+	"github.com/google/kf/pkg/kf/service-bindings/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+type commandFactory func(p *config.KfParams, client servicebindings.KeysClient, injector cfutil.SystemEnvInjector) *cobra.Command
+
+type serviceKeyTest struct {
+	Args      []string
+	Setup     func(t *testing.T, f *fake.FakeKeysClient, injector *cfutilfake.FakeSystemEnvInjector)
+	Namespace string
+
+	ExpectedErr     error
+	ExpectedStrings []string
+}
+
+func runTest(t *testing.T, tc serviceKeyTest, newCommand commandFactory) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := fake.NewFakeKeysClient(ctrl)
+	injector := cfutilfake.NewFakeSystemEnvInjector(ctrl)
+	if tc.Setup != nil {
+		tc.Setup(t, client, injector)
+	}
+
+	buf := new(bytes.Buffer)
+	p := &config.KfParams{
+		Namespace: tc.Namespace,
+	}
+
+	cmd := newCommand(p, client, injector)
+	cmd.SetOutput(buf)
+	cmd.SetArgs(tc.Args)
+	_, actualErr := cmd.ExecuteC()
+	if tc.ExpectedErr != nil || actualErr != nil {
+		testutil.AssertErrorsEqual(t, tc.ExpectedErr, actualErr)
+		return
+	}
+
+	testutil.AssertContainsAll(t, buf.String(), tc.ExpectedStrings)
+	testutil.AssertEqual(t, "SilenceUsage", true, cmd.SilenceUsage)
+}
+
+// withoutInjector adapts commands that don't read credentials to a
+// commandFactory.
+func withoutInjector(newCommand func(*config.KfParams, servicebindings.KeysClient) *cobra.Command) commandFactory {
+	return func(p *config.KfParams, client servicebindings.KeysClient, _ cfutil.SystemEnvInjector) *cobra.Command {
+		return newCommand(p, client)
+	}
+}
+
+func dummyKey(instanceName, keyName string, status servicecatalogv1beta1.ConditionStatus) *servicecatalogv1beta1.ServiceBinding {
+	key := &servicecatalogv1beta1.ServiceBinding{}
+	key.Name = kfv1alpha1.ServiceKeyBindingName(instanceName, keyName)
+	key.Labels = kfv1alpha1.ServiceKeyLabels(keyName)
+	key.Spec.InstanceRef.Name = instanceName
+	if status != "" {
+		key.Status.Conditions = []servicecatalogv1beta1.ServiceBindingCondition{
+			{Type: servicecatalogv1beta1.ServiceBindingConditionReady, Status: status, Reason: "SomeReason"},
+		}
+	}
+	return key
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicekeys
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/kf/pkg/kf/cfutil"
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	servicebindings "github.com/google/kf/pkg/kf/service-bindings"
+	"github.com/spf13/cobra"
+)
+
+// NewGetServiceKeyCommand allows users to view the credentials in a service
+// key.
+func NewGetServiceKeyCommand(
+	p *config.KfParams,
+	client servicebindings.KeysClient,
+	injector cfutil.SystemEnvInjector,
+) *cobra.Command {
+	return &cobra.Command{
+		Use:   "service-key SERVICE_INSTANCE SERVICE_KEY",
+		Short: "Print the credentials in a service key",
+		Long: `Prints the credentials in a service key as JSON.
+
+The output has the same shape as an entry in VCAP_SERVICES so tools that read
+credentials from apps can be run against it.`,
+		Example: "kf service-key mydb migrations",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName := args[0]
+			keyName := args[1]
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			key, err := client.Get(p.Namespace, instanceName, keyName)
+			if err != nil {
+				return err
+			}
+
+			switch status, reason := readyCondition(*key); {
+			case status == "":
+				return fmt.Errorf("service key %q isn't ready yet", keyName)
+			case status != "True":
+				return fmt.Errorf("service key %q isn't ready: %s", keyName, reason)
+			}
+
+			service, err := injector.GetVcapService("", key)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(service, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicekeys_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/kf/cfutil"
+	cfutilfake "github.com/google/kf/pkg/kf/cfutil/fake"
+	servicekeyscmd "github.com/google/kf/pkg/kf/commands/service-keys"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/service-bindings/fake"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestNewGetServiceKeyCommand(t *testing.T) {
+	bindingName := "migrations"
+	service := cfutil.VcapService{
+		BindingName:  &bindingName,
+		Name:         "migrations",
+		InstanceName: "mydb",
+		Label:        "db-service",
+		Plan:         "silver",
+		Tags:         []string{"mysql"},
+		Credentials:  cfutil.Credentials{"password": json.RawMessage(`"hunter2"`)},
+		VolumeMounts: []json.RawMessage{},
+	}
+
+	cases := map[string]serviceKeyTest{
+		"wrong number of args": {
+			Args:        []string{"mydb"},
+			ExpectedErr: errors.New("accepts 2 arg(s), received 1"),
+		},
+		"empty namespace": {
+			Args:        []string{"mydb", "migrations"},
+			ExpectedErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"prints credentials as a VCAP_SERVICES entry": {
+			Args:      []string{"mydb", "migrations"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, injector *cfutilfake.FakeSystemEnvInjector) {
+				key := dummyKey("mydb", "migrations", servicecatalogv1beta1.ConditionTrue)
+				f.EXPECT().Get("custom-ns", "mydb", "migrations").Return(key, nil)
+				injector.EXPECT().GetVcapService("", key).Return(service, nil)
+			},
+			ExpectedStrings: []string{
+				`"binding_name": "migrations"`,
+				`"instance_name": "mydb"`,
+				`"label": "db-service"`,
+				`"plan": "silver"`,
+				`"password": "hunter2"`,
+				`"volume_mounts": []`,
+			},
+		},
+		"key isn't ready": {
+			Args:      []string{"mydb", "migrations"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, _ *cfutilfake.FakeSystemEnvInjector) {
+				f.EXPECT().Get("custom-ns", "mydb", "migrations").Return(dummyKey("mydb", "migrations", servicecatalogv1beta1.ConditionFalse), nil)
+			},
+			ExpectedErr: errors.New(`service key "migrations" isn't ready: SomeReason`),
+		},
+		"key is still being created": {
+			Args:      []string{"mydb", "migrations"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, _ *cfutilfake.FakeSystemEnvInjector) {
+				f.EXPECT().Get("custom-ns", "mydb", "migrations").Return(dummyKey("mydb", "migrations", ""), nil)
+			},
+			ExpectedErr: errors.New(`service key "migrations" isn't ready yet`),
+		},
+		"credentials can't be read": {
+			Args:      []string{"mydb", "migrations"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, injector *cfutilfake.FakeSystemEnvInjector) {
+				f.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(dummyKey("mydb", "migrations", servicecatalogv1beta1.ConditionTrue), nil)
+				injector.EXPECT().GetVcapService(gomock.Any(), gomock.Any()).Return(cfutil.VcapService{}, errors.New("secret-error"))
+			},
+			ExpectedErr: errors.New("secret-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runTest(t, tc, servicekeyscmd.NewGetServiceKeyCommand)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicekeys
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	servicebindings "github.com/google/kf/pkg/kf/service-bindings"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+// NewListServiceKeysCommand allows users to list the service keys of a
+// service instance.
+func NewListServiceKeysCommand(p *config.KfParams, client servicebindings.KeysClient) *cobra.Command {
+	return &cobra.Command{
+		Use:     "service-keys SERVICE_INSTANCE",
+		Aliases: []string{"sk"},
+		Short:   "List the service keys of a service instance",
+		Example: "kf service-keys mydb",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName := args[0]
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			keys, err := client.List(p.Namespace, instanceName)
+			if err != nil {
+				return err
+			}
+
+			describe.TabbedWriter(cmd.OutOrStdout(), func(w io.Writer) {
+				fmt.Fprintln(w, "Name\tReady\tReason")
+				for _, key := range keys {
+					status, reason := readyCondition(key)
+					fmt.Fprintf(w, "%s\t%s\t%s\n", servicebindings.ServiceKeyName(&key), status, reason)
+				}
+			})
+
+			return nil
+		},
+	}
+}
+
+func readyCondition(key servicecatalogv1beta1.ServiceBinding) (status, reason string) {
+	for _, cond := range key.Status.Conditions {
+		if cond.Type == servicecatalogv1beta1.ServiceBindingConditionReady {
+			return fmt.Sprintf("%v", cond.Status), cond.Reason
+		}
+	}
+
+	return "", ""
+}
@@ -0,0 +1,64 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicekeys_test
+
+import (
+	"errors"
+	"testing"
+
+	cfutilfake "github.com/google/kf/pkg/kf/cfutil/fake"
+	servicekeyscmd "github.com/google/kf/pkg/kf/commands/service-keys"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/service-bindings/fake"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestNewListServiceKeysCommand(t *testing.T) {
+	cases := map[string]serviceKeyTest{
+		"wrong number of args": {
+			Args:        []string{},
+			ExpectedErr: errors.New("accepts 1 arg(s), received 0"),
+		},
+		"empty namespace": {
+			Args:        []string{"mydb"},
+			ExpectedErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"lists keys": {
+			Args:      []string{"mydb"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, _ *cfutilfake.FakeSystemEnvInjector) {
+				f.EXPECT().List("custom-ns", "mydb").Return([]servicecatalogv1beta1.ServiceBinding{
+					*dummyKey("mydb", "migrations", servicecatalogv1beta1.ConditionTrue),
+					*dummyKey("mydb", "reporting", servicecatalogv1beta1.ConditionFalse),
+				}, nil)
+			},
+			ExpectedStrings: []string{"Name", "Ready", "migrations", "True", "reporting", "False", "SomeReason"},
+		},
+		"list fails": {
+			Args:      []string{"mydb"},
+			Namespace: "custom-ns",
+			Setup: func(t *testing.T, f *fake.FakeKeysClient, _ *cfutilfake.FakeSystemEnvInjector) {
+				f.EXPECT().List("custom-ns", "mydb").Return(nil, errors.New("api-error"))
+			},
+			ExpectedErr: errors.New("api-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runTest(t, tc, withoutInjector(servicekeyscmd.NewListServiceKeysCommand))
+		})
+	}
+}
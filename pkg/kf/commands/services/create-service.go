@@ -70,6 +70,7 @@ func NewCreateServiceCommand(p *config.KfParams, client services.Client, marketp
 				PlanName:    planName,
 				ServiceName: serviceName,
 				BrokerName:  broker,
+				Space:       p.Namespace,
 			}
 
 			matchingClusterPlans, err := marketplaceClient.ListClusterPlans(planFilters)
@@ -24,6 +24,7 @@ import (
 	"github.com/google/kf/pkg/kf/commands/config"
 	servicescmd "github.com/google/kf/pkg/kf/commands/services"
 	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/marketplace"
 	marketplacefake "github.com/google/kf/pkg/kf/marketplace/fake"
 	servicesfake "github.com/google/kf/pkg/kf/services/fake"
 	"github.com/google/kf/pkg/kf/testutil"
@@ -96,6 +97,19 @@ func TestNewCreateServiceCommand(t *testing.T) {
 			},
 			expectErr: errors.New("no plan free found for class db-service for the service-broker testbroker"),
 		},
+		"plans hidden in space": {
+			namespace: "test-ns",
+			args:      []string{"db-service", "premium", "mydb"},
+			setup: func(t *testing.T, fakes fakes) {
+				fakes.marketplace.EXPECT().ListClusterPlans(marketplace.ListPlanOptions{
+					PlanName:    "premium",
+					ServiceName: "db-service",
+					Space:       "test-ns",
+				})
+				fakes.marketplace.EXPECT().ListNamespacedPlans("test-ns", gomock.Any())
+			},
+			expectErr: errors.New("no plan premium found for class db-service for all service-brokers"),
+		},
 		"multiple plan matches": {
 			namespace: "test-ns",
 			args:      []string{"db-service", "free", "mydb", "-b", "testbroker"},
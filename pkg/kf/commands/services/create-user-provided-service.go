@@ -0,0 +1,128 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/spf13/cobra"
+)
+
+// NewCreateUserProvidedServiceCommand allows users to create service
+// instances with credentials they supply.
+func NewCreateUserProvidedServiceCommand(p *config.KfParams, client services.UserProvidedClient) *cobra.Command {
+	var (
+		credentials     string
+		tags            string
+		syslogDrainURL  string
+		routeServiceURL string
+	)
+
+	createCmd := &cobra.Command{
+		Use:     "create-user-provided-service SERVICE_INSTANCE [-p CREDENTIALS] [-t TAGS] [-l SYSLOG_DRAIN_URL] [-r ROUTE_SERVICE_URL]",
+		Aliases: []string{"cups"},
+		Short:   "Create a user-provided service instance",
+		Long: `Creates a service instance for a service that isn't managed by a
+broker. The credentials are stored in the space and injected into the
+VCAP_SERVICES of bound apps under the user-provided label.`,
+		Example: `
+  # Creates a service instance pointing at an existing database
+  kf create-user-provided-service mydb -p '{"username":"admin","password":"pa55w0rd"}' -t mysql,db
+
+  # Reads the credentials from a file
+  kf create-user-provided-service mydb -p ~/workspace/tmp/credentials.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName := args[0]
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			creds, err := services.ParseCredentials(credentials)
+			if err != nil {
+				return err
+			}
+
+			service := &services.UserProvidedService{
+				Name:            instanceName,
+				Credentials:     creds,
+				Tags:            parseTags(tags),
+				SyslogDrainURL:  syslogDrainURL,
+				RouteServiceURL: routeServiceURL,
+			}
+
+			if err := client.Create(p.Namespace, service); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Created user-provided service instance %q in space %q\n", instanceName, p.Namespace)
+			describe.UserProvidedService(cmd.OutOrStdout(), service)
+			return nil
+		},
+	}
+
+	addUserProvidedFlags(createCmd, &credentials, &tags, &syslogDrainURL, &routeServiceURL)
+
+	return createCmd
+}
+
+func addUserProvidedFlags(cmd *cobra.Command, credentials, tags, syslogDrainURL, routeServiceURL *string) {
+	cmd.Flags().StringVarP(
+		credentials,
+		"credentials",
+		"p",
+		"{}",
+		"Valid JSON object containing the credentials, provided in-line or in a file.")
+
+	cmd.Flags().StringVarP(
+		tags,
+		"tags",
+		"t",
+		"",
+		"Comma separated tags for the service instance.")
+
+	cmd.Flags().StringVarP(
+		syslogDrainURL,
+		"syslog-drain-url",
+		"l",
+		"",
+		"URL logs of bound apps are drained to.")
+
+	cmd.Flags().StringVarP(
+		routeServiceURL,
+		"route-service-url",
+		"r",
+		"",
+		"URL of a route service requests to bound routes are proxied through.")
+}
+
+func parseTags(tags string) []string {
+	var out []string
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			out = append(out, tag)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,101 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/kf/cfutil"
+	"github.com/google/kf/pkg/kf/commands/config"
+	servicescmd "github.com/google/kf/pkg/kf/commands/services"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/google/kf/pkg/kf/services/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	"github.com/spf13/cobra"
+)
+
+func newCreateUserProvidedServiceCommand(p *config.KfParams, _ services.Client, client services.UserProvidedClient) *cobra.Command {
+	return servicescmd.NewCreateUserProvidedServiceCommand(p, client)
+}
+
+func TestNewCreateUserProvidedServiceCommand(t *testing.T) {
+	cases := map[string]serviceTest{
+		"too few params": {
+			Args:        []string{},
+			ExpectedErr: errors.New("accepts 1 arg(s), received 0"),
+		},
+		"empty namespace": {
+			Args:        []string{"mydb"},
+			ExpectedErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"bad credentials": {
+			Args:        []string{"mydb", "--credentials=/some/bad/path"},
+			Namespace:   "custom-ns",
+			ExpectedErr: errors.New("couldn't read file: open /some/bad/path: no such file or directory"),
+		},
+		"defaults": {
+			Args:      []string{"mydb"},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Create("custom-ns", &services.UserProvidedService{
+					Name:        "mydb",
+					Credentials: cfutil.Credentials{},
+				})
+			},
+			ExpectedStrings: []string{`Created user-provided service instance "mydb"`},
+		},
+		"all flags": {
+			Args: []string{
+				"mydb",
+				"-p", `{"username":"admin","port":3306}`,
+				"-t", "mysql, db",
+				"-l", "syslog://logs.example.com",
+				"-r", "https://proxy.example.com",
+			},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Create("custom-ns", gomock.Any()).DoAndReturn(func(ns string, service *services.UserProvidedService) error {
+					testutil.AssertEqual(t, "service", &services.UserProvidedService{
+						Name:            "mydb",
+						Credentials:     cfutil.Credentials{"username": json.RawMessage(`"admin"`), "port": json.RawMessage(`3306`)},
+						Tags:            []string{"mysql", "db"},
+						SyslogDrainURL:  "syslog://logs.example.com",
+						RouteServiceURL: "https://proxy.example.com",
+					}, service)
+					return nil
+				})
+			},
+			ExpectedStrings: []string{"mydb", "mysql, db", "username", "port"},
+		},
+		"bad server call": {
+			Args:      []string{"mydb"},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Create("custom-ns", gomock.Any()).Return(errors.New("server-call-error"))
+			},
+			ExpectedErr: errors.New("server-call-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runTest(t, tc, newCreateUserProvidedServiceCommand)
+		})
+	}
+}
@@ -23,10 +23,11 @@ import (
 	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
 	"github.com/google/kf/pkg/kf/services"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 // NewDeleteServiceCommand allows users to delete service instances.
-func NewDeleteServiceCommand(p *config.KfParams, client services.Client) *cobra.Command {
+func NewDeleteServiceCommand(p *config.KfParams, client services.Client, userProvidedClient services.UserProvidedClient) *cobra.Command {
 	var async utils.AsyncFlags
 
 	deleteCmd := &cobra.Command{
@@ -44,6 +45,16 @@ func NewDeleteServiceCommand(p *config.KfParams, client services.Client) *cobra.
 				return err
 			}
 
+			// User-provided services are deleted immediately, there's no
+			// broker to wait on.
+			switch err := userProvidedClient.Delete(p.Namespace, instanceName); {
+			case err == nil:
+				fmt.Fprintf(cmd.OutOrStdout(), "Deleted user-provided service instance %q in space %q\n", instanceName, p.Namespace)
+				return nil
+			case !apierrors.IsNotFound(err):
+				return err
+			}
+
 			if err := client.Delete(p.Namespace, instanceName); err != nil {
 				return err
 			}
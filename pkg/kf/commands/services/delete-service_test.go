@@ -50,6 +50,22 @@ func TestNewDeleteServiceCommand(t *testing.T) {
 			},
 			ExpectedErr: errors.New("server-call-error"),
 		},
+		"user-provided service": {
+			Args:      []string{"my-ups"},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Delete("custom-ns", "my-ups").Return(nil)
+			},
+			ExpectedStrings: []string{`Deleted user-provided service instance "my-ups"`},
+		},
+		"deleting user-provided service fails": {
+			Args:      []string{"my-ups"},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Delete("custom-ns", "my-ups").Return(errors.New("ups-error"))
+			},
+			ExpectedErr: errors.New("ups-error"),
+		},
 		"async skips wait": {
 			Args:      []string{"mydb", "--async"},
 			Namespace: "custom-ns",
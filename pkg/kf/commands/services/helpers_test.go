@@ -26,10 +26,12 @@ import (
 	"github.com/google/kf/pkg/kf/testutil"
 	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
-type commandFactory func(p *config.KfParams, client services.Client) *cobra.Command
+type commandFactory func(p *config.KfParams, client services.Client, userProvidedClient services.UserProvidedClient) *cobra.Command
 
 func dummyServerInstance(instanceName string) *v1beta1.ServiceInstance {
 	instance := v1beta1.ServiceInstance{}
@@ -50,6 +52,10 @@ type serviceTest struct {
 	Setup     func(t *testing.T, f *fake.FakeClient)
 	Namespace string
 
+	// UserProvidedSetup configures the user-provided service client, if nil
+	// the space has no user-provided services.
+	UserProvidedSetup func(t *testing.T, f *fake.FakeUserProvidedClient)
+
 	ExpectedErr     error
 	ExpectedStrings []string
 }
@@ -64,12 +70,19 @@ func runTest(t *testing.T, tc serviceTest, newCommand commandFactory) {
 		tc.Setup(t, client)
 	}
 
+	userProvidedClient := fake.NewFakeUserProvidedClient(ctrl)
+	if tc.UserProvidedSetup != nil {
+		tc.UserProvidedSetup(t, userProvidedClient)
+	} else {
+		noUserProvidedServices(userProvidedClient)
+	}
+
 	buf := new(bytes.Buffer)
 	p := &config.KfParams{
 		Namespace: tc.Namespace,
 	}
 
-	cmd := newCommand(p, client)
+	cmd := newCommand(p, client, userProvidedClient)
 	cmd.SetOutput(buf)
 	cmd.SetArgs(tc.Args)
 	_, actualErr := cmd.ExecuteC()
@@ -79,3 +92,17 @@ func runTest(t *testing.T, tc serviceTest, newCommand commandFactory) {
 
 	testutil.AssertContainsAll(t, buf.String(), tc.ExpectedStrings)
 }
+
+func userProvidedNotFound(name string) error {
+	return apierrors.NewNotFound(schema.GroupResource{Resource: "user-provided services"}, name)
+}
+
+func noUserProvidedServices(f *fake.FakeUserProvidedClient) {
+	f.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(ns, name string) (*services.UserProvidedService, error) {
+		return nil, userProvidedNotFound(name)
+	}).AnyTimes()
+	f.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(ns, name string) error {
+		return userProvidedNotFound(name)
+	}).AnyTimes()
+	f.EXPECT().List(gomock.Any()).Return(nil, nil).AnyTimes()
+}
@@ -15,15 +15,25 @@
 package services
 
 import (
+	"fmt"
+
 	"github.com/google/kf/pkg/kf/commands/config"
 	"github.com/google/kf/pkg/kf/describe"
 	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
 	"github.com/google/kf/pkg/kf/services"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
-// NewGetServiceCommand allows users to get a service instance.
-func NewGetServiceCommand(p *config.KfParams, client services.Client) *cobra.Command {
+// NewGetServiceCommand allows users to get a service instance along with the
+// history of operations the broker ran on it.
+func NewGetServiceCommand(
+	p *config.KfParams,
+	client services.Client,
+	userProvidedClient services.UserProvidedClient,
+	events v1.EventsGetter,
+) *cobra.Command {
 	serviceCommand := &cobra.Command{
 		Use:     "service SERVICE_INSTANCE",
 		Short:   "Show service instance info",
@@ -38,12 +48,32 @@ func NewGetServiceCommand(p *config.KfParams, client services.Client) *cobra.Com
 
 			cmd.SilenceUsage = true
 
+			userProvided, err := userProvidedClient.Get(p.Namespace, instanceName)
+			switch {
+			case err == nil:
+				describe.UserProvidedService(cmd.OutOrStdout(), userProvided)
+				return nil
+			case !apierrors.IsNotFound(err):
+				return err
+			}
+
 			instance, err := client.Get(p.Namespace, instanceName)
 			if err != nil {
 				return err
 			}
 
 			describe.ServiceInstance(cmd.OutOrStdout(), instance)
+			if instance == nil {
+				return nil
+			}
+
+			operations, err := services.ListOperations(events, p.Namespace, instanceName)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout())
+			describe.ServiceInstanceOperations(cmd.OutOrStdout(), operations)
 
 			return nil
 		},
@@ -15,16 +15,40 @@
 package services_test
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/kf/cfutil"
+	"github.com/google/kf/pkg/kf/commands/config"
 	servicescmd "github.com/google/kf/pkg/kf/commands/services"
 	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/services"
 	"github.com/google/kf/pkg/kf/services/fake"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 )
 
 func TestNewGetServiceCommand(t *testing.T) {
+	provisioned := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "mydb.1", Namespace: "custom-ns"},
+		InvolvedObject: corev1.ObjectReference{
+			Kind: "ServiceInstance",
+			Name: "mydb",
+		},
+		Type:    "Normal",
+		Reason:  "ProvisionedSuccessfully",
+		Message: "The instance was provisioned successfully",
+	}
+
+	newCommand := func(p *config.KfParams, client services.Client, userProvidedClient services.UserProvidedClient) *cobra.Command {
+		events := k8sfake.NewSimpleClientset(provisioned).CoreV1()
+		return servicescmd.NewGetServiceCommand(p, client, userProvidedClient, events)
+	}
+
 	cases := map[string]serviceTest{
 		"too few params": {
 			Args:        []string{},
@@ -47,7 +71,7 @@ func TestNewGetServiceCommand(t *testing.T) {
 			Setup: func(t *testing.T, f *fake.FakeClient) {
 				f.EXPECT().Get(gomock.Any(), "mydb").Return(dummyServerInstance("mydb-instance1"), nil)
 			},
-			ExpectedStrings: []string{"mydb-instance1"},
+			ExpectedStrings: []string{"mydb-instance1", "Last Operation", "Operations", "ProvisionedSuccessfully"},
 		},
 		"service not found": {
 			Args:      []string{"some-missing-service"},
@@ -57,6 +81,25 @@ func TestNewGetServiceCommand(t *testing.T) {
 			},
 			ExpectedStrings: []string{"<empty>"},
 		},
+		"user-provided service": {
+			Args:      []string{"my-ups"},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Get("custom-ns", "my-ups").Return(&services.UserProvidedService{
+					Name:        "my-ups",
+					Credentials: cfutil.Credentials{"password": json.RawMessage(`"secret"`)},
+				}, nil)
+			},
+			ExpectedStrings: []string{"my-ups", "user-provided", "password"},
+		},
+		"fetching user-provided service fails": {
+			Args:      []string{"my-ups"},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Get("custom-ns", "my-ups").Return(nil, errors.New("ups-error"))
+			},
+			ExpectedErr: errors.New("ups-error"),
+		},
 		"bad server call": {
 			Args:      []string{"mydb"},
 			Namespace: "custom-ns",
@@ -69,7 +112,7 @@ func TestNewGetServiceCommand(t *testing.T) {
 
 	for tn, tc := range cases {
 		t.Run(tn, func(t *testing.T) {
-			runTest(t, tc, servicescmd.NewGetServiceCommand)
+			runTest(t, tc, newCommand)
 		})
 	}
 }
@@ -26,6 +26,7 @@ import (
 	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
 	"github.com/google/kf/pkg/kf/marketplace"
 	"github.com/google/kf/pkg/kf/services"
+	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/spf13/cobra"
 )
 
@@ -33,14 +34,20 @@ import (
 func NewListServicesCommand(
 	p *config.KfParams,
 	client services.Client,
+	userProvidedClient services.UserProvidedClient,
 	appsClient apps.Client,
 	marketplaceClient marketplace.ClientInterface,
+	sharesClient services.SharesClient,
 ) *cobra.Command {
 	servicesCommand := &cobra.Command{
 		Use:     "services",
 		Aliases: []string{"s"},
 		Short:   "List service instances",
-		Long:    `Lists all service instances in the target space.`,
+		Long: `Lists all service instances in the target space.
+
+Service instances shared with the target space from other spaces are listed
+too, the Sharing column shows where they're shared from. Instances in the
+target space show the spaces they're shared with.`,
 		Example: `kf services`,
 		Args:    cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -55,6 +62,22 @@ func NewListServicesCommand(
 				return err
 			}
 
+			userProvided, err := userProvidedClient.List(p.Namespace)
+			if err != nil {
+				return err
+			}
+
+			shares, err := sharesClient.List(p.Namespace)
+			if err != nil {
+				return err
+			}
+			sharedWith := services.SharedWithSpaces(shares)
+
+			sharedFrom, err := sharesClient.ListSharedWith(p.Namespace)
+			if err != nil {
+				return err
+			}
+
 			apps, err := appsClient.List(p.Namespace)
 			if err != nil {
 				return err
@@ -62,9 +85,11 @@ func NewListServicesCommand(
 			ma := mapAppToServices(apps)
 
 			describe.TabbedWriter(cmd.OutOrStdout(), func(w io.Writer) {
-				fmt.Fprintln(w, "Name\tService\tPlan\tBound Apps\tLast Operation\tBroker")
-				for _, instance := range instances {
+				fmt.Fprintln(w, "Name\tService\tPlan\tBound Apps\tStatus\tLast Operation\tMessage\tBroker\tSharing")
+
+				printInstance := func(instance v1beta1.ServiceInstance, sharing string) {
 					lastCond := services.LastStatusCondition(instance)
+					lastOperation := services.GetLastOperation(instance)
 					var brokerInfo string
 					brokerInfo, err = marketplaceClient.BrokerName(instance)
 					if err != nil {
@@ -81,13 +106,55 @@ func NewListServicesCommand(
 
 					fmt.Fprintf(
 						w,
-						"%s\t%s\t%s\t%s\t%s\t%s\n",
+						"%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 						instance.Name,                         // Name
 						className,                             // Service
 						planName,                              // Plan
 						strings.Join(ma[instance.Name], ", "), // Bound Apps
-						lastCond.Reason,                       // Last Operation
+						lastCond.Reason,                       // Status
+						lastOperation,                         // Last Operation
+						lastOperation.Description,             // Message
 						brokerInfo,                            // Broker
+						sharing,                               // Sharing
+					)
+				}
+
+				for _, instance := range instances {
+					var sharing string
+					if spaces, ok := sharedWith[instance.Name]; ok {
+						sharing = fmt.Sprintf("shared with: %s", strings.Join(spaces, ", "))
+					}
+
+					printInstance(instance, sharing)
+				}
+
+				for _, share := range sharedFrom {
+					sharing := fmt.Sprintf("shared from: %s", share.Namespace)
+
+					instance, getErr := client.Get(share.Namespace, share.Spec.InstanceName)
+					if getErr != nil {
+						boundApps := strings.Join(ma[share.Spec.InstanceName], ", ")
+						fmt.Fprintf(
+							w,
+							"%s\t\t\t%s\t\t\t%s\t\t%s\n",
+							share.Spec.InstanceName, // Name
+							boundApps,               // Bound Apps
+							getErr,                  // Message
+							sharing,                 // Sharing
+						)
+						continue
+					}
+
+					printInstance(*instance, sharing)
+				}
+
+				for _, instance := range userProvided {
+					fmt.Fprintf(
+						w,
+						"%s\t%s\t\t%s\t\t\t\t\t\n",
+						instance.Name,                         // Name
+						v1alpha1.UserProvidedServiceClass,     // Service
+						strings.Join(ma[instance.Name], ", "), // Bound Apps
 					)
 				}
 			})
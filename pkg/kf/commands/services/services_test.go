@@ -37,6 +37,7 @@ func TestNewServicesCommand(t *testing.T) {
 		serviceTest
 		AppSetup         func(t *testing.T, f *fakeapps.FakeClient)
 		MarketplaceSetup func(t *testing.T, f *fakemarketplace.FakeClientInterface)
+		SharesSetup      func(t *testing.T, f *fake.FakeSharesClient)
 	}{
 		"too many params": {
 			serviceTest: serviceTest{
@@ -123,10 +124,81 @@ func TestNewServicesCommand(t *testing.T) {
 					"service-1", "service-2", // Binding Names
 					"app-1", "app-2", // Bound Apps
 					"some-broker",              // Broker Names
-					"CorrectStatus", "Unknown", // Last Operation
+					"CorrectStatus", "Unknown", // Status
+					"create in progress", // Last Operation
 				},
 			},
 		},
+		"user-provided services": {
+			AppSetup: func(t *testing.T, f *fakeapps.FakeClient) {
+				f.EXPECT().List("test-ns").Return([]v1alpha1.App{
+					boundApp("app-1", "my-ups"),
+				}, nil)
+			},
+			serviceTest: serviceTest{
+				Namespace: "test-ns",
+				Setup: func(t *testing.T, f *fake.FakeClient) {
+					f.EXPECT().List(gomock.Any()).Return([]v1beta1.ServiceInstance{}, nil)
+				},
+				UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+					f.EXPECT().List("test-ns").Return([]services.UserProvidedService{
+						{Name: "my-ups"},
+					}, nil)
+				},
+				ExpectedStrings: []string{"my-ups", "user-provided", "app-1"},
+			},
+		},
+		"listing user-provided services fails": {
+			serviceTest: serviceTest{
+				Namespace:   "test-ns",
+				ExpectedErr: errors.New("ups-error"),
+				Setup: func(t *testing.T, f *fake.FakeClient) {
+					f.EXPECT().List(gomock.Any()).Return([]v1beta1.ServiceInstance{}, nil)
+				},
+				UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+					f.EXPECT().List("test-ns").Return(nil, errors.New("ups-error"))
+				},
+			},
+		},
+		"shared instances": {
+			SharesSetup: func(t *testing.T, f *fake.FakeSharesClient) {
+				f.EXPECT().List("test-ns").Return([]v1alpha1.ServiceInstanceShare{
+					dummyShare("test-ns", "service-1", "space-a"),
+					dummyShare("test-ns", "service-1", "space-b"),
+				}, nil)
+				f.EXPECT().ListSharedWith("test-ns").Return([]v1alpha1.ServiceInstanceShare{
+					dummyShare("db-space", "shared-db", "test-ns"),
+					dummyShare("gone-space", "gone-db", "test-ns"),
+				}, nil)
+			},
+			serviceTest: serviceTest{
+				Namespace: "test-ns",
+				Setup: func(t *testing.T, f *fake.FakeClient) {
+					f.EXPECT().List(gomock.Any()).Return([]v1beta1.ServiceInstance{
+						*dummyServerInstance("service-1"),
+					}, nil)
+					f.EXPECT().Get("db-space", "shared-db").Return(dummyServerInstance("shared-db"), nil)
+					f.EXPECT().Get("gone-space", "gone-db").Return(nil, errors.New("get-error"))
+				},
+				ExpectedStrings: []string{
+					"shared with: space-a, space-b",
+					"shared-db", "shared from: db-space",
+					"gone-db", "get-error", "shared from: gone-space",
+				},
+			},
+		},
+		"listing shares fails": {
+			SharesSetup: func(t *testing.T, f *fake.FakeSharesClient) {
+				f.EXPECT().List("test-ns").Return(nil, errors.New("shares-error"))
+			},
+			serviceTest: serviceTest{
+				Namespace: "test-ns",
+				Setup: func(t *testing.T, f *fake.FakeClient) {
+					f.EXPECT().List(gomock.Any()).Return([]v1beta1.ServiceInstance{}, nil)
+				},
+				ExpectedErr: errors.New("shares-error"),
+			},
+		},
 		"bad server call": {
 			serviceTest: serviceTest{
 				Namespace:   "test-ns",
@@ -156,8 +228,17 @@ func TestNewServicesCommand(t *testing.T) {
 				marketplaceClient.EXPECT().BrokerName(gomock.Any()).Return("some-broker", nil).AnyTimes()
 			}
 
-			runTest(t, tc.serviceTest, func(p *config.KfParams, client services.Client) *cobra.Command {
-				return servicescmd.NewListServicesCommand(p, client, appClient, marketplaceClient)
+			sharesClient := fake.NewFakeSharesClient(gomock.NewController(t))
+			if tc.SharesSetup != nil {
+				tc.SharesSetup(t, sharesClient)
+			} else {
+				// Give default empty shares response
+				sharesClient.EXPECT().List(gomock.Any()).AnyTimes()
+				sharesClient.EXPECT().ListSharedWith(gomock.Any()).AnyTimes()
+			}
+
+			runTest(t, tc.serviceTest, func(p *config.KfParams, client services.Client, userProvidedClient services.UserProvidedClient) *cobra.Command {
+				return servicescmd.NewListServicesCommand(p, client, userProvidedClient, appClient, marketplaceClient, sharesClient)
 			})
 		})
 	}
@@ -173,3 +254,16 @@ func boundApp(name, bindingName string) v1alpha1.App {
 		},
 	}
 }
+
+func dummyShare(namespace, instanceName, space string) v1alpha1.ServiceInstanceShare {
+	return v1alpha1.ServiceInstanceShare{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.ServiceInstanceShareName(instanceName, space),
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ServiceInstanceShareSpec{
+			InstanceName: instanceName,
+			Space:        space,
+		},
+	}
+}
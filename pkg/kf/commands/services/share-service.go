@@ -0,0 +1,101 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// NewShareServiceCommand allows users to share service instances with other
+// spaces.
+func NewShareServiceCommand(
+	p *config.KfParams,
+	client services.Client,
+	userProvidedClient services.UserProvidedClient,
+	sharesClient services.SharesClient,
+	spacesClient spaces.Client,
+) *cobra.Command {
+	var space string
+
+	shareCmd := &cobra.Command{
+		Use:   "share-service SERVICE_INSTANCE -s OTHER_SPACE",
+		Short: "Share a service instance with another space",
+		Long: `Shares a service instance with another space.
+
+Apps in the other space can bind to the instance by passing --instance-space
+to bind-service. The grant is stored in the space of the instance, so only
+users that can manage the instance can share it.
+
+User-provided service instances can't be shared.`,
+		Example: "kf share-service mydb -s other-space",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName := args[0]
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			switch space {
+			case "":
+				return errors.New("--space is required")
+			case p.Namespace:
+				return errors.New("service instances can't be shared with their own space")
+			}
+
+			switch _, err := userProvidedClient.Get(p.Namespace, instanceName); {
+			case err == nil:
+				return errors.New("user-provided service instances can't be shared")
+			case !apierrors.IsNotFound(err):
+				return err
+			}
+
+			if _, err := client.Get(p.Namespace, instanceName); err != nil {
+				return err
+			}
+
+			if _, err := spacesClient.Get(space); err != nil {
+				return err
+			}
+
+			if err := sharesClient.Share(p.Namespace, instanceName, space); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Shared service instance %q in space %q with space %q\n", instanceName, p.Namespace, space)
+			return nil
+		},
+	}
+
+	shareCmd.Flags().StringVarP(
+		&space,
+		"space",
+		"s",
+		"",
+		"Space to share the service instance with.",
+	)
+
+	return shareCmd
+}
@@ -0,0 +1,152 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	servicescmd "github.com/google/kf/pkg/kf/commands/services"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/google/kf/pkg/kf/services/fake"
+	fakespaces "github.com/google/kf/pkg/kf/spaces/fake"
+	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/spf13/cobra"
+)
+
+func TestNewShareServiceCommand(t *testing.T) {
+	cases := map[string]struct {
+		serviceTest
+		SharesSetup func(t *testing.T, f *fake.FakeSharesClient)
+		SpacesSetup func(t *testing.T, f *fakespaces.FakeClient)
+	}{
+		"too few params": {
+			serviceTest: serviceTest{
+				Args:        []string{},
+				ExpectedErr: errors.New("accepts 1 arg(s), received 0"),
+			},
+		},
+		"empty namespace": {
+			serviceTest: serviceTest{
+				Args:        []string{"mydb", "-s", "other-space"},
+				ExpectedErr: errors.New(utils.EmptyNamespaceError),
+			},
+		},
+		"missing space": {
+			serviceTest: serviceTest{
+				Args:        []string{"mydb"},
+				Namespace:   "custom-ns",
+				ExpectedErr: errors.New("--space is required"),
+			},
+		},
+		"own space": {
+			serviceTest: serviceTest{
+				Args:        []string{"mydb", "-s", "custom-ns"},
+				Namespace:   "custom-ns",
+				ExpectedErr: errors.New("service instances can't be shared with their own space"),
+			},
+		},
+		"user-provided service": {
+			serviceTest: serviceTest{
+				Args:      []string{"my-ups", "-s", "other-space"},
+				Namespace: "custom-ns",
+				UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+					f.EXPECT().Get("custom-ns", "my-ups").Return(&services.UserProvidedService{Name: "my-ups"}, nil)
+				},
+				ExpectedErr: errors.New("user-provided service instances can't be shared"),
+			},
+		},
+		"missing instance": {
+			serviceTest: serviceTest{
+				Args:      []string{"mydb", "-s", "other-space"},
+				Namespace: "custom-ns",
+				Setup: func(t *testing.T, f *fake.FakeClient) {
+					f.EXPECT().Get("custom-ns", "mydb").Return(nil, errors.New("not-found"))
+				},
+				ExpectedErr: errors.New("not-found"),
+			},
+		},
+		"missing space object": {
+			SpacesSetup: func(t *testing.T, f *fakespaces.FakeClient) {
+				f.EXPECT().Get("other-space").Return(nil, errors.New("no-space"))
+			},
+			serviceTest: serviceTest{
+				Args:      []string{"mydb", "-s", "other-space"},
+				Namespace: "custom-ns",
+				Setup: func(t *testing.T, f *fake.FakeClient) {
+					f.EXPECT().Get("custom-ns", "mydb").Return(&v1beta1.ServiceInstance{}, nil)
+				},
+				ExpectedErr: errors.New("no-space"),
+			},
+		},
+		"shares": {
+			SpacesSetup: func(t *testing.T, f *fakespaces.FakeClient) {
+				f.EXPECT().Get("other-space").Return(&v1alpha1.Space{}, nil)
+			},
+			SharesSetup: func(t *testing.T, f *fake.FakeSharesClient) {
+				f.EXPECT().Share("custom-ns", "mydb", "other-space").Return(nil)
+			},
+			serviceTest: serviceTest{
+				Args:      []string{"mydb", "--space", "other-space"},
+				Namespace: "custom-ns",
+				Setup: func(t *testing.T, f *fake.FakeClient) {
+					f.EXPECT().Get("custom-ns", "mydb").Return(&v1beta1.ServiceInstance{}, nil)
+				},
+				ExpectedStrings: []string{`Shared service instance "mydb" in space "custom-ns" with space "other-space"`},
+			},
+		},
+		"sharing fails": {
+			SpacesSetup: func(t *testing.T, f *fakespaces.FakeClient) {
+				f.EXPECT().Get("other-space").Return(&v1alpha1.Space{}, nil)
+			},
+			SharesSetup: func(t *testing.T, f *fake.FakeSharesClient) {
+				f.EXPECT().Share("custom-ns", "mydb", "other-space").Return(errors.New("share-error"))
+			},
+			serviceTest: serviceTest{
+				Args:      []string{"mydb", "-s", "other-space"},
+				Namespace: "custom-ns",
+				Setup: func(t *testing.T, f *fake.FakeClient) {
+					f.EXPECT().Get("custom-ns", "mydb").Return(&v1beta1.ServiceInstance{}, nil)
+				},
+				ExpectedErr: errors.New("share-error"),
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sharesClient := fake.NewFakeSharesClient(ctrl)
+			if tc.SharesSetup != nil {
+				tc.SharesSetup(t, sharesClient)
+			}
+
+			spacesClient := fakespaces.NewFakeClient(ctrl)
+			if tc.SpacesSetup != nil {
+				tc.SpacesSetup(t, spacesClient)
+			}
+
+			runTest(t, tc.serviceTest, func(p *config.KfParams, client services.Client, userProvidedClient services.UserProvidedClient) *cobra.Command {
+				return servicescmd.NewShareServiceCommand(p, client, userProvidedClient, sharesClient, spacesClient)
+			})
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/spf13/cobra"
+)
+
+// NewUnshareServiceCommand allows users to stop sharing service instances
+// with other spaces.
+func NewUnshareServiceCommand(p *config.KfParams, sharesClient services.SharesClient) *cobra.Command {
+	var space string
+
+	unshareCmd := &cobra.Command{
+		Use:   "unshare-service SERVICE_INSTANCE -s OTHER_SPACE",
+		Short: "Unshare a service instance from another space",
+		Long: `Stops sharing a service instance with another space.
+
+Bindings apps in the other space have to the instance are deleted.`,
+		Example: "kf unshare-service mydb -s other-space",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName := args[0]
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			if space == "" {
+				return errors.New("--space is required")
+			}
+
+			if err := sharesClient.Unshare(p.Namespace, instanceName, space); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Unshared service instance %q in space %q from space %q\n", instanceName, p.Namespace, space)
+			return nil
+		},
+	}
+
+	unshareCmd.Flags().StringVarP(
+		&space,
+		"space",
+		"s",
+		"",
+		"Space to unshare the service instance from.",
+	)
+
+	return unshareCmd
+}
@@ -0,0 +1,91 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/kf/commands/config"
+	servicescmd "github.com/google/kf/pkg/kf/commands/services"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/google/kf/pkg/kf/services/fake"
+	"github.com/spf13/cobra"
+)
+
+func TestNewUnshareServiceCommand(t *testing.T) {
+	cases := map[string]struct {
+		serviceTest
+		SharesSetup func(t *testing.T, f *fake.FakeSharesClient)
+	}{
+		"too few params": {
+			serviceTest: serviceTest{
+				Args:        []string{},
+				ExpectedErr: errors.New("accepts 1 arg(s), received 0"),
+			},
+		},
+		"empty namespace": {
+			serviceTest: serviceTest{
+				Args:        []string{"mydb", "-s", "other-space"},
+				ExpectedErr: errors.New(utils.EmptyNamespaceError),
+			},
+		},
+		"missing space": {
+			serviceTest: serviceTest{
+				Args:        []string{"mydb"},
+				Namespace:   "custom-ns",
+				ExpectedErr: errors.New("--space is required"),
+			},
+		},
+		"unshares": {
+			SharesSetup: func(t *testing.T, f *fake.FakeSharesClient) {
+				f.EXPECT().Unshare("custom-ns", "mydb", "other-space").Return(nil)
+			},
+			serviceTest: serviceTest{
+				Args:            []string{"mydb", "-s", "other-space"},
+				Namespace:       "custom-ns",
+				ExpectedStrings: []string{`Unshared service instance "mydb" in space "custom-ns" from space "other-space"`},
+			},
+		},
+		"unsharing fails": {
+			SharesSetup: func(t *testing.T, f *fake.FakeSharesClient) {
+				f.EXPECT().Unshare("custom-ns", "mydb", "other-space").Return(errors.New("unshare-error"))
+			},
+			serviceTest: serviceTest{
+				Args:        []string{"mydb", "-s", "other-space"},
+				Namespace:   "custom-ns",
+				ExpectedErr: errors.New("unshare-error"),
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sharesClient := fake.NewFakeSharesClient(ctrl)
+			if tc.SharesSetup != nil {
+				tc.SharesSetup(t, sharesClient)
+			}
+
+			runTest(t, tc.serviceTest, func(p *config.KfParams, _ services.Client, _ services.UserProvidedClient) *cobra.Command {
+				return servicescmd.NewUnshareServiceCommand(p, sharesClient)
+			})
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/marketplace"
+	"github.com/google/kf/pkg/kf/services"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// NewUpdateServiceCommand allows users to change the plan, parameters and
+// tags of service instances.
+func NewUpdateServiceCommand(p *config.KfParams, client services.Client, marketplaceClient marketplace.ClientInterface) *cobra.Command {
+	var (
+		planName     string
+		configAsJSON string
+		tags         string
+		async        utils.AsyncFlags
+	)
+
+	updateCmd := &cobra.Command{
+		Use:   "update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS]",
+		Short: "Update a service instance",
+		Long: `Updates the plan, provisioning parameters or tags of a service instance.
+
+Plan and parameter changes are sent to the service broker, which may reject
+them. Tags are added to the tags of the service offering in VCAP_SERVICES of
+bound apps.`,
+		Example: `
+  # Upgrades mydb to the gold plan
+  kf update-service mydb -p gold
+
+  # Changes the provisioning configuration of mydb
+  kf update-service mydb -c '{"ram_gb":8}'
+
+  # Replaces the tags of mydb
+  kf update-service mydb -t "mysql,production"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName := args[0]
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			flags := cmd.Flags()
+			changePlan := flags.Changed("plan")
+			changeParams := flags.Changed("config")
+			changeTags := flags.Changed("tags")
+
+			if !changePlan && !changeParams && !changeTags {
+				fmt.Fprintln(cmd.OutOrStdout(), "No flags specified. No changes were made.")
+				return nil
+			}
+
+			var paramBytes []byte
+			if changeParams {
+				var err error
+				if paramBytes, err = services.ParseJSONOrFile(configAsJSON); err != nil {
+					return err
+				}
+			}
+
+			instance, err := client.Get(p.Namespace, instanceName)
+			if err != nil {
+				return err
+			}
+
+			if changePlan {
+				if err := validatePlan(marketplaceClient, p.Namespace, instance, planName); err != nil {
+					return err
+				}
+			}
+
+			updated, err := client.Transform(p.Namespace, instanceName, func(instance *servicecatalogv1beta1.ServiceInstance) error {
+				if changePlan {
+					// Service Catalog resolves the new plan reference.
+					if isNamespacedInstance(instance) {
+						instance.Spec.ServicePlanExternalName = planName
+						instance.Spec.ServicePlanRef = nil
+					} else {
+						instance.Spec.ClusterServicePlanExternalName = planName
+						instance.Spec.ClusterServicePlanRef = nil
+					}
+				}
+
+				if changeParams {
+					instance.Spec.Parameters = &runtime.RawExtension{Raw: paramBytes}
+				}
+
+				if changeTags {
+					instance.Annotations = v1alpha1.SetServiceTags(instance.Annotations, parseTags(tags))
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			// Tags are kf metadata, the broker only needs to be involved if
+			// the spec changed.
+			if changePlan || changeParams {
+				action := fmt.Sprintf("Updating service instance %q in space %q", instanceName, p.Namespace)
+				if err := async.AwaitAndLog(cmd.OutOrStdout(), action, func() (err error) {
+					updated, err = client.WaitForUpdateSuccess(context.Background(), p.Namespace, instanceName, 1*time.Second)
+					return
+				}); err != nil {
+					return err
+				}
+			}
+
+			describe.ServiceInstance(cmd.OutOrStdout(), updated)
+			return nil
+		},
+	}
+
+	async.Add(updateCmd)
+
+	updateCmd.Flags().StringVarP(
+		&planName,
+		"plan",
+		"p",
+		"",
+		"Name of the plan to change the instance to.")
+
+	updateCmd.Flags().StringVarP(
+		&configAsJSON,
+		"config",
+		"c",
+		"{}",
+		"Valid JSON object containing service-specific configuration parameters, provided in-line or in a file.")
+
+	updateCmd.Flags().StringVarP(
+		&tags,
+		"tags",
+		"t",
+		"",
+		"Comma separated tags for the service instance, replaces the existing tags.")
+
+	return updateCmd
+}
+
+func isNamespacedInstance(instance *servicecatalogv1beta1.ServiceInstance) bool {
+	return instance.Spec.ServiceClassRef != nil || instance.Spec.ServiceClassExternalName != ""
+}
+
+// validatePlan checks the plan is offered for the instance's service by the
+// broker that provisioned it.
+func validatePlan(marketplaceClient marketplace.ClientInterface, namespace string, instance *servicecatalogv1beta1.ServiceInstance, planName string) error {
+	broker, err := marketplaceClient.BrokerName(*instance)
+	if err != nil {
+		return err
+	}
+
+	filter := marketplace.ListPlanOptions{
+		PlanName:   planName,
+		BrokerName: broker,
+		Space:      namespace,
+	}
+
+	var matches int
+	if isNamespacedInstance(instance) {
+		filter.ServiceName = instance.Spec.ServiceClassExternalName
+		plans, err := marketplaceClient.ListNamespacedPlans(namespace, filter)
+		if err != nil {
+			return err
+		}
+		matches = len(plans)
+	} else {
+		filter.ServiceName = instance.Spec.ClusterServiceClassExternalName
+		plans, err := marketplaceClient.ListClusterPlans(filter)
+		if err != nil {
+			return err
+		}
+		matches = len(plans)
+	}
+
+	if matches == 0 {
+		return fmt.Errorf("no plan %s found for class %s for the service-broker %s", planName, filter.ServiceName, broker)
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	servicescmd "github.com/google/kf/pkg/kf/commands/services"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/marketplace"
+	marketplacefake "github.com/google/kf/pkg/kf/marketplace/fake"
+	"github.com/google/kf/pkg/kf/services"
+	servicesfake "github.com/google/kf/pkg/kf/services/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func clusterServiceInstance() *servicecatalogv1beta1.ServiceInstance {
+	instance := dummyServerInstance("mydb")
+	instance.Spec.PlanReference = servicecatalogv1beta1.PlanReference{
+		ClusterServiceClassExternalName: "db-service",
+		ClusterServicePlanExternalName:  "free",
+	}
+	instance.Spec.ClusterServicePlanRef = &servicecatalogv1beta1.ClusterObjectReference{Name: "free-plan-guid"}
+	return instance
+}
+
+func TestNewUpdateServiceCommand(t *testing.T) {
+	type fakes struct {
+		services    *servicesfake.FakeClient
+		marketplace *marketplacefake.FakeClientInterface
+	}
+
+	// expectTransform applies the mutator the command passes to Transform to
+	// the given instance and checks the result.
+	expectTransform := func(t *testing.T, f *servicesfake.FakeClient, instance *servicecatalogv1beta1.ServiceInstance, check func(*servicecatalogv1beta1.ServiceInstance)) {
+		f.EXPECT().Get("test-ns", "mydb").Return(instance.DeepCopy(), nil)
+		f.EXPECT().Transform("test-ns", "mydb", gomock.Any()).DoAndReturn(func(ns, name string, mutator services.Mutator) (*servicecatalogv1beta1.ServiceInstance, error) {
+			out := instance.DeepCopy()
+			testutil.AssertNil(t, "mutator err", mutator(out))
+			check(out)
+			return out, nil
+		})
+	}
+
+	cases := map[string]struct {
+		args            []string
+		namespace       string
+		setup           func(*testing.T, fakes)
+		expectErr       error
+		expectedStrings []string
+	}{
+		"bad number of args": {
+			expectErr: errors.New("accepts 1 arg(s), received 0"),
+		},
+		"bad namespace": {
+			args:      []string{"mydb", "-p", "gold"},
+			expectErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"no flags": {
+			namespace:       "test-ns",
+			args:            []string{"mydb"},
+			expectedStrings: []string{"No changes were made"},
+		},
+		"bad config path": {
+			namespace: "test-ns",
+			args:      []string{"mydb", "--config=/some/bad/path"},
+			expectErr: errors.New("couldn't read file: open /some/bad/path: no such file or directory"),
+		},
+		"instance not found": {
+			namespace: "test-ns",
+			args:      []string{"mydb", "-p", "gold"},
+			setup: func(t *testing.T, fakes fakes) {
+				fakes.services.EXPECT().Get("test-ns", "mydb").Return(nil, errors.New("not-found"))
+			},
+			expectErr: errors.New("not-found"),
+		},
+		"plan not offered": {
+			namespace: "test-ns",
+			args:      []string{"mydb", "-p", "gold"},
+			setup: func(t *testing.T, fakes fakes) {
+				fakes.services.EXPECT().Get("test-ns", "mydb").Return(clusterServiceInstance(), nil)
+				fakes.marketplace.EXPECT().BrokerName(gomock.Any()).Return("testbroker", nil)
+				fakes.marketplace.EXPECT().ListClusterPlans(marketplace.ListPlanOptions{
+					PlanName:    "gold",
+					ServiceName: "db-service",
+					BrokerName:  "testbroker",
+					Space:       "test-ns",
+				})
+			},
+			expectErr: errors.New("no plan gold found for class db-service for the service-broker testbroker"),
+		},
+		"change cluster plan": {
+			namespace: "test-ns",
+			args:      []string{"mydb", "-p", "gold"},
+			setup: func(t *testing.T, fakes fakes) {
+				fakes.marketplace.EXPECT().BrokerName(gomock.Any()).Return("testbroker", nil)
+				fakes.marketplace.EXPECT().ListClusterPlans(gomock.Any()).Return([]servicecatalogv1beta1.ClusterServicePlan{{}}, nil)
+				expectTransform(t, fakes.services, clusterServiceInstance(), func(instance *servicecatalogv1beta1.ServiceInstance) {
+					testutil.AssertEqual(t, "plan", "gold", instance.Spec.ClusterServicePlanExternalName)
+					testutil.AssertEqual(t, "plan ref cleared", true, instance.Spec.ClusterServicePlanRef == nil)
+				})
+				fakes.services.EXPECT().WaitForUpdateSuccess(gomock.Any(), "test-ns", "mydb", gomock.Any()).Return(clusterServiceInstance(), nil)
+			},
+			expectedStrings: []string{"Updating service instance", "Success"},
+		},
+		"change namespaced plan": {
+			namespace: "test-ns",
+			args:      []string{"mydb", "-p", "gold"},
+			setup: func(t *testing.T, fakes fakes) {
+				instance := dummyServerInstance("mydb")
+				instance.Spec.PlanReference = servicecatalogv1beta1.PlanReference{
+					ServiceClassExternalName: "db-service",
+					ServicePlanExternalName:  "free",
+				}
+
+				fakes.marketplace.EXPECT().BrokerName(gomock.Any()).Return("testbroker", nil)
+				fakes.marketplace.EXPECT().ListNamespacedPlans("test-ns", gomock.Any()).Return([]servicecatalogv1beta1.ServicePlan{{}}, nil)
+				expectTransform(t, fakes.services, instance, func(instance *servicecatalogv1beta1.ServiceInstance) {
+					testutil.AssertEqual(t, "plan", "gold", instance.Spec.ServicePlanExternalName)
+				})
+				fakes.services.EXPECT().WaitForUpdateSuccess(gomock.Any(), "test-ns", "mydb", gomock.Any()).Return(instance, nil)
+			},
+		},
+		"change parameters": {
+			namespace: "test-ns",
+			args:      []string{"mydb", "-c", `{"ram_gb":8}`},
+			setup: func(t *testing.T, fakes fakes) {
+				expectTransform(t, fakes.services, clusterServiceInstance(), func(instance *servicecatalogv1beta1.ServiceInstance) {
+					testutil.AssertEqual(t, "params", &runtime.RawExtension{Raw: json.RawMessage(`{"ram_gb":8}`)}, instance.Spec.Parameters)
+					testutil.AssertEqual(t, "plan unchanged", "free", instance.Spec.ClusterServicePlanExternalName)
+				})
+				fakes.services.EXPECT().WaitForUpdateSuccess(gomock.Any(), "test-ns", "mydb", gomock.Any()).Return(clusterServiceInstance(), nil)
+			},
+		},
+		"change tags doesn't wait": {
+			namespace: "test-ns",
+			args:      []string{"mydb", "-t", "mysql, production"},
+			setup: func(t *testing.T, fakes fakes) {
+				expectTransform(t, fakes.services, clusterServiceInstance(), func(instance *servicecatalogv1beta1.ServiceInstance) {
+					tags, err := v1alpha1.ServiceTags(instance.Annotations)
+					testutil.AssertNil(t, "tags err", err)
+					testutil.AssertEqual(t, "tags", []string{"mysql", "production"}, tags)
+				})
+			},
+		},
+		"broker rejects update": {
+			namespace: "test-ns",
+			args:      []string{"mydb", "-c", `{"ram_gb":8}`},
+			setup: func(t *testing.T, fakes fakes) {
+				expectTransform(t, fakes.services, clusterServiceInstance(), func(*servicecatalogv1beta1.ServiceInstance) {})
+				fakes.services.EXPECT().
+					WaitForUpdateSuccess(gomock.Any(), "test-ns", "mydb", gomock.Any()).
+					Return(nil, errors.New("update failed, message: plan change not supported reason: UpdateInstanceCallFailed"))
+			},
+			expectErr: errors.New("update failed, message: plan change not supported reason: UpdateInstanceCallFailed"),
+		},
+		"async": {
+			namespace: "test-ns",
+			args:      []string{"mydb", "-c", `{"ram_gb":8}`, "--async"},
+			setup: func(t *testing.T, fakes fakes) {
+				expectTransform(t, fakes.services, clusterServiceInstance(), func(*servicecatalogv1beta1.ServiceInstance) {})
+				// expect WaitForUpdateSuccess not to be called
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sClient := servicesfake.NewFakeClient(ctrl)
+			mClient := marketplacefake.NewFakeClientInterface(ctrl)
+			if tc.setup != nil {
+				tc.setup(t, fakes{
+					services:    sClient,
+					marketplace: mClient,
+				})
+			}
+
+			buf := new(bytes.Buffer)
+			p := &config.KfParams{
+				Namespace: tc.namespace,
+			}
+
+			cmd := servicescmd.NewUpdateServiceCommand(p, sClient, mClient)
+			cmd.SetOutput(buf)
+			cmd.SetArgs(tc.args)
+			_, actualErr := cmd.ExecuteC()
+			if tc.expectErr != nil || actualErr != nil {
+				testutil.AssertErrorsEqual(t, tc.expectErr, actualErr)
+				return
+			}
+
+			testutil.AssertContainsAll(t, buf.String(), tc.expectedStrings)
+		})
+	}
+}
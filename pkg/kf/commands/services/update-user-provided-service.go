@@ -0,0 +1,97 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/spf13/cobra"
+)
+
+// NewUpdateUserProvidedServiceCommand allows users to change the credentials
+// and settings of user-provided service instances.
+func NewUpdateUserProvidedServiceCommand(p *config.KfParams, client services.UserProvidedClient) *cobra.Command {
+	var (
+		credentials     string
+		tags            string
+		syslogDrainURL  string
+		routeServiceURL string
+	)
+
+	updateCmd := &cobra.Command{
+		Use:     "update-user-provided-service SERVICE_INSTANCE [-p CREDENTIALS] [-t TAGS] [-l SYSLOG_DRAIN_URL] [-r ROUTE_SERVICE_URL]",
+		Aliases: []string{"uups"},
+		Short:   "Update a user-provided service instance",
+		Long: `Updates a user-provided service instance. Only the values that are
+specified are changed. Bound apps pick up the changes the next time they're
+reconciled.`,
+		Example: `
+  # Replaces the credentials of mydb
+  kf update-user-provided-service mydb -p '{"username":"admin","password":"n3wpa55"}'
+
+  # Removes all tags from mydb
+  kf update-user-provided-service mydb -t ""`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			instanceName := args[0]
+
+			cmd.SilenceUsage = true
+
+			if err := utils.ValidateNamespace(p); err != nil {
+				return err
+			}
+
+			service, err := client.Get(p.Namespace, instanceName)
+			if err != nil {
+				return err
+			}
+
+			flags := cmd.Flags()
+			if flags.Changed("credentials") {
+				if service.Credentials, err = services.ParseCredentials(credentials); err != nil {
+					return err
+				}
+			}
+
+			if flags.Changed("tags") {
+				service.Tags = parseTags(tags)
+			}
+
+			if flags.Changed("syslog-drain-url") {
+				service.SyslogDrainURL = syslogDrainURL
+			}
+
+			if flags.Changed("route-service-url") {
+				service.RouteServiceURL = routeServiceURL
+			}
+
+			if err := client.Update(p.Namespace, service); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Updated user-provided service instance %q in space %q\n", instanceName, p.Namespace)
+			describe.UserProvidedService(cmd.OutOrStdout(), service)
+			return nil
+		},
+	}
+
+	addUserProvidedFlags(updateCmd, &credentials, &tags, &syslogDrainURL, &routeServiceURL)
+
+	return updateCmd
+}
@@ -0,0 +1,106 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/kf/cfutil"
+	"github.com/google/kf/pkg/kf/commands/config"
+	servicescmd "github.com/google/kf/pkg/kf/commands/services"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/google/kf/pkg/kf/services/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	"github.com/spf13/cobra"
+)
+
+func newUpdateUserProvidedServiceCommand(p *config.KfParams, _ services.Client, client services.UserProvidedClient) *cobra.Command {
+	return servicescmd.NewUpdateUserProvidedServiceCommand(p, client)
+}
+
+func existingUserProvidedService() *services.UserProvidedService {
+	return &services.UserProvidedService{
+		Name:            "mydb",
+		Credentials:     cfutil.Credentials{"username": json.RawMessage(`"admin"`)},
+		Tags:            []string{"mysql"},
+		SyslogDrainURL:  "syslog://logs.example.com",
+		RouteServiceURL: "https://proxy.example.com",
+	}
+}
+
+func TestNewUpdateUserProvidedServiceCommand(t *testing.T) {
+	cases := map[string]serviceTest{
+		"too few params": {
+			Args:        []string{},
+			ExpectedErr: errors.New("accepts 1 arg(s), received 0"),
+		},
+		"empty namespace": {
+			Args:        []string{"mydb"},
+			ExpectedErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"service not found": {
+			Args:      []string{"mydb"},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Get("custom-ns", "mydb").Return(nil, userProvidedNotFound("mydb"))
+			},
+			ExpectedErr: userProvidedNotFound("mydb"),
+		},
+		"only changed values are updated": {
+			Args:      []string{"mydb", "-p", `{"username":"root"}`, "-t", ""},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Get("custom-ns", "mydb").Return(existingUserProvidedService(), nil)
+				f.EXPECT().Update("custom-ns", gomock.Any()).DoAndReturn(func(ns string, service *services.UserProvidedService) error {
+					testutil.AssertEqual(t, "service", &services.UserProvidedService{
+						Name:            "mydb",
+						Credentials:     cfutil.Credentials{"username": json.RawMessage(`"root"`)},
+						SyslogDrainURL:  "syslog://logs.example.com",
+						RouteServiceURL: "https://proxy.example.com",
+					}, service)
+					return nil
+				})
+			},
+			ExpectedStrings: []string{`Updated user-provided service instance "mydb"`},
+		},
+		"bad credentials": {
+			Args:      []string{"mydb", "-p", "/some/bad/path"},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Get("custom-ns", "mydb").Return(existingUserProvidedService(), nil)
+			},
+			ExpectedErr: errors.New("couldn't read file: open /some/bad/path: no such file or directory"),
+		},
+		"bad server call": {
+			Args:      []string{"mydb", "-l", ""},
+			Namespace: "custom-ns",
+			UserProvidedSetup: func(t *testing.T, f *fake.FakeUserProvidedClient) {
+				f.EXPECT().Get("custom-ns", "mydb").Return(existingUserProvidedService(), nil)
+				f.EXPECT().Update("custom-ns", gomock.Any()).Return(errors.New("server-call-error"))
+			},
+			ExpectedErr: errors.New("server-call-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			runTest(t, tc, newUpdateUserProvidedServiceCommand)
+		})
+	}
+}
@@ -17,6 +17,7 @@ package spaces
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
@@ -60,10 +61,16 @@ func NewConfigSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 		newUnsetBuildpackEnvMutator(),
 		newSetContainerRegistryMutator(),
 		newSetBuildpackBuilderMutator(),
+		newSetBuildpackCatalogMutator(),
 		newAppendDomainMutator(),
 		newSetDefaultDomainMutator(),
 		newRemoveDomainMutator(),
 		newBuildServiceAccountMutator(),
+		newSetServiceBindingFilesMutator(),
+		newSetPlacementProfileMutator(),
+		newUnsetPlacementProfileMutator(),
+		newSetDeletionProtectionMutator(),
+		newUnsetDeletionProtectionMutator(),
 	}
 
 	for _, sm := range subcommands {
@@ -77,12 +84,15 @@ func NewConfigSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 		newGetBuildpackEnvAccessor(),
 		newGetDomainsAccessor(),
 		newGetBuildServiceAccountAccessor(),
+		newGetPolicyAccessor(),
 	}
 
 	for _, sa := range accessors {
 		cmd.AddCommand(sa.ToCommand(p, client))
 	}
 
+	cmd.AddCommand(newSetPolicyCommand(p, client))
+
 	cmd.AddCommand(
 		quotas.NewGetQuotaCommand(p, client),
 		quotas.NewUpdateQuotaCommand(p, client),
@@ -105,15 +115,15 @@ func (sm spaceMutator) exampleCommands() string {
 	buffer := &bytes.Buffer{}
 	fmt.Fprintln(buffer)
 	fmt.Fprintf(buffer, "  # Configure the space \"my-space\"\n")
-	fmt.Fprintf(buffer, "  kf configure-space %s my-space %s\n", sm.Name, joinedArgs)
+	fmt.Fprintf(buffer, "  %s\n", strings.TrimSpace(fmt.Sprintf("kf configure-space %s my-space %s", sm.Name, joinedArgs)))
 	fmt.Fprintf(buffer, "  # Configure the targeted space\n")
-	fmt.Fprintf(buffer, "  kf configure-space %s %s\n", sm.Name, joinedArgs)
+	fmt.Fprintf(buffer, "  %s\n", strings.TrimSpace(fmt.Sprintf("kf configure-space %s %s", sm.Name, joinedArgs)))
 	return buffer.String()
 }
 
 func (sm spaceMutator) ToCommand(p *config.KfParams, client spaces.Client) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     fmt.Sprintf("%s [SPACE_NAME] %s", sm.Name, strings.Join(sm.Args, " ")),
+		Use:     strings.TrimSpace(fmt.Sprintf("%s [SPACE_NAME] %s", sm.Name, strings.Join(sm.Args, " "))),
 		Short:   sm.Short,
 		Long:    sm.Short,
 		Args:    cobra.RangeArgs(len(sm.Args), 1+len(sm.Args)),
@@ -184,6 +194,24 @@ func newSetBuildpackBuilderMutator() spaceMutator {
 	}
 }
 
+func newSetBuildpackCatalogMutator() spaceMutator {
+	return spaceMutator{
+		Name:        "set-buildpack-catalog",
+		Short:       "Set the BuildpackCatalog that lists the stacks of the space.",
+		Args:        []string{"CATALOG_NAME"},
+		ExampleArgs: []string{"my-catalog"},
+		Init: func(args []string) (spaces.Mutator, error) {
+			catalog := args[0]
+
+			return func(space *v1alpha1.Space) error {
+				space.Spec.BuildpackBuild.Catalog = catalog
+
+				return nil
+			}, nil
+		},
+	}
+}
+
 func newSetEnvMutator() spaceMutator {
 	return spaceMutator{
 		Name:        "set-env",
@@ -348,6 +376,82 @@ func newBuildServiceAccountMutator() spaceMutator {
 	}
 }
 
+func newSetServiceBindingFilesMutator() spaceMutator {
+	return spaceMutator{
+		Name:        "set-service-binding-files",
+		Short:       "Set whether service binding credentials are mounted as files in all apps of the space.",
+		Args:        []string{"ENABLED"},
+		ExampleArgs: []string{"true"},
+		Init: func(args []string) (spaces.Mutator, error) {
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return nil, fmt.Errorf("ENABLED must be true or false, got %q", args[0])
+			}
+
+			return func(space *v1alpha1.Space) error {
+				space.Spec.Execution.ServiceBindingFiles = enabled
+				return nil
+			}, nil
+		},
+	}
+}
+
+func newSetPlacementProfileMutator() spaceMutator {
+	return spaceMutator{
+		Name:        "set-placement-profile",
+		Short:       "Set the PlacementProfile that controls which nodes apps and builds in the space run on.",
+		Args:        []string{"PROFILE_NAME"},
+		ExampleArgs: []string{"pci"},
+		Init: func(args []string) (spaces.Mutator, error) {
+			profile := args[0]
+
+			return func(space *v1alpha1.Space) error {
+				space.Spec.PlacementProfile = profile
+				return nil
+			}, nil
+		},
+	}
+}
+
+func newUnsetPlacementProfileMutator() spaceMutator {
+	return spaceMutator{
+		Name:  "unset-placement-profile",
+		Short: "Stop using a PlacementProfile for apps and builds in the space.",
+		Init: func(args []string) (spaces.Mutator, error) {
+			return func(space *v1alpha1.Space) error {
+				space.Spec.PlacementProfile = ""
+				return nil
+			}, nil
+		},
+	}
+}
+
+func newSetDeletionProtectionMutator() spaceMutator {
+	return spaceMutator{
+		Name:  "set-deletion-protection",
+		Short: "Protect the space and everything in it from being deleted.",
+		Init: func(args []string) (spaces.Mutator, error) {
+			return func(space *v1alpha1.Space) error {
+				space.Spec.DeletionProtection = true
+				return nil
+			}, nil
+		},
+	}
+}
+
+func newUnsetDeletionProtectionMutator() spaceMutator {
+	return spaceMutator{
+		Name:  "unset-deletion-protection",
+		Short: "Allow the space to be deleted.",
+		Init: func(args []string) (spaces.Mutator, error) {
+			return func(space *v1alpha1.Space) error {
+				space.Spec.DeletionProtection = false
+				return nil
+			}, nil
+		},
+	}
+}
+
 type spaceAccessor struct {
 	Name     string
 	Short    string
@@ -31,6 +31,7 @@ import (
 
 func TestNewConfigSpaceCommand(t *testing.T) {
 	space := "my-space"
+	maxAppInstances := int64(5)
 
 	cases := map[string]struct {
 		args     []string
@@ -148,6 +149,88 @@ func TestNewConfigSpaceCommand(t *testing.T) {
 			},
 		},
 
+		"set-buildpack-catalog valid": {
+			args: []string{"set-buildpack-catalog", space, "my-catalog"},
+			validate: func(t *testing.T, space *v1alpha1.Space) {
+				testutil.AssertEqual(t, "catalog", "my-catalog", space.Spec.BuildpackBuild.Catalog)
+			},
+		},
+
+		"set-placement-profile valid": {
+			args: []string{"set-placement-profile", space, "pci"},
+			validate: func(t *testing.T, space *v1alpha1.Space) {
+				testutil.AssertEqual(t, "placement profile", "pci", space.Spec.PlacementProfile)
+			},
+		},
+
+		"unset-placement-profile valid": {
+			space: v1alpha1.Space{
+				Spec: v1alpha1.SpaceSpec{
+					PlacementProfile: "pci",
+				},
+			},
+			args: []string{"unset-placement-profile", space},
+			validate: func(t *testing.T, space *v1alpha1.Space) {
+				testutil.AssertEqual(t, "placement profile", "", space.Spec.PlacementProfile)
+			},
+		},
+
+		"set-deletion-protection valid": {
+			args: []string{"set-deletion-protection", space},
+			validate: func(t *testing.T, space *v1alpha1.Space) {
+				testutil.AssertEqual(t, "deletion protection", true, space.Spec.DeletionProtection)
+			},
+		},
+
+		"unset-deletion-protection valid": {
+			space: v1alpha1.Space{
+				Spec: v1alpha1.SpaceSpec{
+					DeletionProtection: true,
+				},
+			},
+			args: []string{"unset-deletion-protection", space},
+			validate: func(t *testing.T, space *v1alpha1.Space) {
+				testutil.AssertEqual(t, "deletion protection", false, space.Spec.DeletionProtection)
+			},
+		},
+
+		"set-policy valid": {
+			space: v1alpha1.Space{
+				Spec: v1alpha1.SpaceSpec{
+					Policy: v1alpha1.SpacePolicy{DisableContainerImages: true},
+				},
+			},
+			args: []string{"set-policy", space, "--allowed-stacks", "cflinuxfs3,cflinuxfs4", "--max-app-instances", "5", "--health-check-type", "http"},
+			validate: func(t *testing.T, space *v1alpha1.Space) {
+				policy := space.Spec.Policy
+				testutil.AssertEqual(t, "allowed stacks", []string{"cflinuxfs3", "cflinuxfs4"}, policy.AllowedStacks)
+				testutil.AssertEqual(t, "max app instances", int64(5), *policy.MaxAppInstances)
+				testutil.AssertEqual(t, "health check type", v1alpha1.HealthCheckTypeHTTP, policy.RequiredHealthCheckType)
+				testutil.AssertEqual(t, "container images disabled", true, policy.DisableContainerImages)
+			},
+		},
+
+		"set-policy removes limits": {
+			space: v1alpha1.Space{
+				Spec: v1alpha1.SpaceSpec{
+					Policy: v1alpha1.SpacePolicy{
+						AllowedRegistries:      []string{"gcr.io/my-project"},
+						DisableContainerImages: true,
+						MaxAppInstances:        &maxAppInstances,
+					},
+				},
+			},
+			args: []string{"set-policy", space, "--allowed-registries", "", "--disable-container-images=false", "--max-app-instances", "-1"},
+			validate: func(t *testing.T, space *v1alpha1.Space) {
+				testutil.AssertEqual(t, "policy", v1alpha1.SpacePolicy{AllowedRegistries: []string{}}, space.Spec.Policy)
+			},
+		},
+
+		"set-policy no flags": {
+			args:    []string{"set-policy", space},
+			wantErr: errors.New("at least one policy flag must be set"),
+		},
+
 		"append-domain valid": {
 			args: []string{"append-domain", space, "example.com"},
 			validate: func(t *testing.T, space *v1alpha1.Space) {
@@ -221,6 +304,13 @@ func TestNewConfigSpaceCommand(t *testing.T) {
 				testutil.AssertEqual(t, "build-service-account", "some-other-service-account", space.Spec.Security.BuildServiceAccount)
 			},
 		},
+
+		"set-service-binding-files valid": {
+			args: []string{"set-service-binding-files", space, "true"},
+			validate: func(t *testing.T, space *v1alpha1.Space) {
+				testutil.AssertEqual(t, "service binding files", true, space.Spec.Execution.ServiceBindingFiles)
+			},
+		},
 	}
 
 	for tn, tc := range cases {
@@ -268,6 +358,10 @@ func TestNewConfigSpaceCommand_accessors(t *testing.T) {
 			Security: v1alpha1.SpaceSpecSecurity{
 				BuildServiceAccount: "some-service-account",
 			},
+			Policy: v1alpha1.SpacePolicy{
+				AllowedStacks:          []string{"cflinuxfs3"},
+				DisableContainerImages: true,
+			},
 			BuildpackBuild: v1alpha1.SpaceSpecBuildpackBuild{
 				ContainerRegistry: "gcr.io/foo",
 				BuilderImage:      "gcr.io/buildpack-builder:latest",
@@ -338,6 +432,14 @@ func TestNewConfigSpaceCommand_accessors(t *testing.T) {
 			wantOutput: `- default: true
   domain: example.com
 - domain: other-example.com
+`,
+		},
+		"get-policy valid": {
+			args:  []string{"get-policy", "space-name"},
+			space: space,
+			wantOutput: `allowedStacks:
+- cflinuxfs3
+disableContainerImages: true
 `,
 		},
 		"get-build-service-account valid": {
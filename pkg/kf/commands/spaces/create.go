@@ -16,6 +16,7 @@ package spaces
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -23,35 +24,94 @@ import (
 	"github.com/google/kf/pkg/kf/commands/config"
 	"github.com/google/kf/pkg/kf/describe"
 	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/google/kf/pkg/kf/spacetemplates"
 
 	"github.com/spf13/cobra"
 )
 
-// NewCreateSpaceCommand allows users to create spaces.
-func NewCreateSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Command {
+// NewCreateSpaceCommand allows users to create spaces, optionally copying the
+// configuration of an existing space or a SpaceTemplate.
+func NewCreateSpaceCommand(p *config.KfParams, client spaces.Client, templatesClient spacetemplates.Client) *cobra.Command {
 	var (
+		organization        string
 		containerRegistry   string
 		buildServiceAccount string
 		domains             []string
+		from                string
+		template            string
+		includeConfig       bool
 	)
 
 	cmd := &cobra.Command{
-		Use:     "create-space SPACE",
-		Short:   "Create a space",
-		Example: `kf create-space my-space --container-registry gcr.io/my-project --domain myspace.example.com --build-service-account myserviceaccount`,
-		Args:    cobra.ExactArgs(1),
+		Use:   "create-space SPACE",
+		Short: "Create a space",
+		Long: `Create a space.
+
+		The new space can copy the configuration of an existing space with --from
+		or of a SpaceTemplate with --template. Flags set on the command line
+		override the copied values.
+
+		Copying from a space never copies apps or roles. Environment variables
+		and quotas are only copied if --include-config is set.
+		`,
+		Example: `
+		kf create-space my-space --container-registry gcr.io/my-project --domain myspace.example.com --build-service-account myserviceaccount
+		kf create-space my-space --from existing-space
+		kf create-space my-space --from existing-space --include-config
+		kf create-space my-space --template pci
+		`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 
+			if from != "" && template != "" {
+				return errors.New("--from and --template can't be used together")
+			}
+
+			if includeConfig && from == "" {
+				return errors.New("--include-config can only be used with --from")
+			}
+
 			name := args[0]
 
 			toCreate := spaces.NewKfSpace()
+
+			switch {
+			case from != "":
+				source, err := client.Get(from)
+				if err != nil {
+					return fmt.Errorf("couldn't get space %q to copy: %v", from, err)
+				}
+				toCreate.CopySpecFrom(source, includeConfig)
+
+			case template != "":
+				spaceTemplate, err := templatesClient.Get(template)
+				if err != nil {
+					return fmt.Errorf("couldn't get space template %q: %v", template, err)
+				}
+				toCreate.ApplyTemplate(spaceTemplate)
+			}
+
 			toCreate.SetName(name)
-			toCreate.SetContainerRegistry(containerRegistry)
-			toCreate.SetBuildServiceAccount(buildServiceAccount)
 
-			for i, domain := range domains {
-				toCreate.AppendDomains(v1alpha1.SpaceDomain{Domain: domain, Default: i == 0})
+			// Copied spaces keep their organization unless one was asked for.
+			if cmd.Flags().Changed("org") || toCreate.GetOrganization() == "" {
+				toCreate.SetOrganization(organization)
+			}
+
+			if containerRegistry != "" {
+				toCreate.SetContainerRegistry(containerRegistry)
+			}
+
+			if buildServiceAccount != "" {
+				toCreate.SetBuildServiceAccount(buildServiceAccount)
+			}
+
+			if len(domains) > 0 {
+				toCreate.Spec.Execution.Domains = nil
+				for i, domain := range domains {
+					toCreate.AppendDomains(v1alpha1.SpaceDomain{Domain: domain, Default: i == 0})
+				}
 			}
 
 			if _, err := client.Create(toCreate.ToSpace()); err != nil {
@@ -74,6 +134,14 @@ func NewCreateSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().StringVarP(
+		&organization,
+		"org",
+		"o",
+		p.Organization,
+		"Organization the space belongs to. The space inherits the organization's defaults.",
+	)
+
 	cmd.Flags().StringVar(
 		&containerRegistry,
 		"container-registry",
@@ -95,5 +163,26 @@ func NewCreateSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 		"Sets the valid domains for the space. The first provided domain will be the default.",
 	)
 
+	cmd.Flags().StringVar(
+		&from,
+		"from",
+		"",
+		"Existing space to copy the configuration of. Apps and roles aren't copied.",
+	)
+
+	cmd.Flags().StringVar(
+		&template,
+		"template",
+		"",
+		"SpaceTemplate to create the space from.",
+	)
+
+	cmd.Flags().BoolVar(
+		&includeConfig,
+		"include-config",
+		false,
+		"Also copy environment variables and quotas of the space given by --from.",
+	)
+
 	return cmd
 }
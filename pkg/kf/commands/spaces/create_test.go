@@ -24,7 +24,9 @@ import (
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/commands/config"
 	"github.com/google/kf/pkg/kf/spaces/fake"
+	spacetemplatesfake "github.com/google/kf/pkg/kf/spacetemplates/fake"
 	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestNewCreateSpaceCommand(t *testing.T) {
@@ -33,7 +35,7 @@ func TestNewCreateSpaceCommand(t *testing.T) {
 	cases := map[string]struct {
 		wantErr error
 		args    []string
-		setup   func(t *testing.T, fakeSpaces *fake.FakeClient)
+		setup   func(t *testing.T, fakeSpaces *fake.FakeClient, fakeTemplates *spacetemplatesfake.FakeClient)
 	}{
 		"invalid number of args": {
 			args:    []string{},
@@ -41,7 +43,7 @@ func TestNewCreateSpaceCommand(t *testing.T) {
 		},
 		"object passed through": {
 			args: []string{"my-ns", "--container-registry=some-registry", "--domain=domain-1", "--domain=domain-2", "--build-service-account=some-service-account"},
-			setup: func(t *testing.T, fakeSpaces *fake.FakeClient) {
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeTemplates *spacetemplatesfake.FakeClient) {
 				fakeSpaces.
 					EXPECT().
 					Create(gomock.Any()).
@@ -55,9 +57,22 @@ func TestNewCreateSpaceCommand(t *testing.T) {
 				fakeSpaces.EXPECT().WaitFor(gomock.Any(), "my-ns", 1*time.Second, gomock.Any()).Return(&v1alpha1.Space{}, nil)
 			},
 		},
+		"organization": {
+			args: []string{"my-ns", "--org=my-org"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeTemplates *spacetemplatesfake.FakeClient) {
+				fakeSpaces.
+					EXPECT().
+					Create(gomock.Any()).
+					Do(func(space *v1alpha1.Space) {
+						testutil.AssertEqual(t, "sets organization", "my-org", space.Spec.Organization)
+					})
+
+				fakeSpaces.EXPECT().WaitFor(gomock.Any(), "my-ns", 1*time.Second, gomock.Any()).Return(&v1alpha1.Space{}, nil)
+			},
+		},
 		"server failure": {
 			args: []string{"my-ns"},
-			setup: func(t *testing.T, fakeSpaces *fake.FakeClient) {
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeTemplates *spacetemplatesfake.FakeClient) {
 				fakeSpaces.
 					EXPECT().
 					Create(gomock.Any()).
@@ -65,20 +80,102 @@ func TestNewCreateSpaceCommand(t *testing.T) {
 			},
 			wantErr: errors.New("some-server-error"),
 		},
+		"copies existing space": {
+			args: []string{"my-ns", "--from=existing", "--domain=new-domain"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeTemplates *spacetemplatesfake.FakeClient) {
+				fakeSpaces.EXPECT().Get("existing").Return(existingSpace(), nil)
+				fakeSpaces.
+					EXPECT().
+					Create(gomock.Any()).
+					Do(func(space *v1alpha1.Space) {
+						testutil.AssertEqual(t, "sets name", "my-ns", space.Name)
+						testutil.AssertEqual(t, "keeps organization", "existing-org", space.Spec.Organization)
+						testutil.AssertEqual(t, "copies container registry", "existing-registry", space.Spec.BuildpackBuild.ContainerRegistry)
+						testutil.AssertEqual(t, "replaces domains", []v1alpha1.SpaceDomain{{Domain: "new-domain", Default: true}}, space.Spec.Execution.Domains)
+						testutil.AssertEqual(t, "roles", 0, len(space.Spec.Security.Roles))
+						testutil.AssertEqual(t, "env", 0, len(space.Spec.Execution.Env))
+						testutil.AssertEqual(t, "quota plan", "", space.Spec.ResourceLimits.QuotaPlan)
+					})
+
+				fakeSpaces.EXPECT().WaitFor(gomock.Any(), "my-ns", 1*time.Second, gomock.Any()).Return(&v1alpha1.Space{}, nil)
+			},
+		},
+		"copies existing space with config": {
+			args: []string{"my-ns", "--from=existing", "--include-config", "--org=my-org"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeTemplates *spacetemplatesfake.FakeClient) {
+				fakeSpaces.EXPECT().Get("existing").Return(existingSpace(), nil)
+				fakeSpaces.
+					EXPECT().
+					Create(gomock.Any()).
+					Do(func(space *v1alpha1.Space) {
+						testutil.AssertEqual(t, "keeps domains", []v1alpha1.SpaceDomain{{Domain: "existing-domain", Default: true}}, space.Spec.Execution.Domains)
+						testutil.AssertEqual(t, "roles", 0, len(space.Spec.Security.Roles))
+						testutil.AssertEqual(t, "env", []corev1.EnvVar{{Name: "FOO", Value: "bar"}}, space.Spec.Execution.Env)
+						testutil.AssertEqual(t, "quota plan", "small", space.Spec.ResourceLimits.QuotaPlan)
+					})
+
+				fakeSpaces.EXPECT().WaitFor(gomock.Any(), "my-ns", 1*time.Second, gomock.Any()).Return(&v1alpha1.Space{}, nil)
+			},
+		},
+		"missing source space": {
+			args: []string{"my-ns", "--from=existing"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeTemplates *spacetemplatesfake.FakeClient) {
+				fakeSpaces.EXPECT().Get("existing").Return(nil, errors.New("not found"))
+			},
+			wantErr: errors.New(`couldn't get space "existing" to copy: not found`),
+		},
+		"creates from template": {
+			args: []string{"my-ns", "--template=pci"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeTemplates *spacetemplatesfake.FakeClient) {
+				template := &v1alpha1.SpaceTemplate{}
+				template.Name = "pci"
+				template.Spec.BuildpackBuild.ContainerRegistry = "template-registry"
+				template.Spec.ResourceLimits.QuotaPlan = "large"
+				fakeTemplates.EXPECT().Get("pci").Return(template, nil)
+
+				fakeSpaces.
+					EXPECT().
+					Create(gomock.Any()).
+					Do(func(space *v1alpha1.Space) {
+						testutil.AssertEqual(t, "sets name", "my-ns", space.Name)
+						testutil.AssertEqual(t, "sets organization", "target-org", space.Spec.Organization)
+						testutil.AssertEqual(t, "container registry", "template-registry", space.Spec.BuildpackBuild.ContainerRegistry)
+						testutil.AssertEqual(t, "quota plan", "large", space.Spec.ResourceLimits.QuotaPlan)
+					})
+
+				fakeSpaces.EXPECT().WaitFor(gomock.Any(), "my-ns", 1*time.Second, gomock.Any()).Return(&v1alpha1.Space{}, nil)
+			},
+		},
+		"missing template": {
+			args: []string{"my-ns", "--template=pci"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeTemplates *spacetemplatesfake.FakeClient) {
+				fakeTemplates.EXPECT().Get("pci").Return(nil, errors.New("not found"))
+			},
+			wantErr: errors.New(`couldn't get space template "pci": not found`),
+		},
+		"from and template": {
+			args:    []string{"my-ns", "--from=existing", "--template=pci"},
+			wantErr: errors.New("--from and --template can't be used together"),
+		},
+		"include config without from": {
+			args:    []string{"my-ns", "--include-config"},
+			wantErr: errors.New("--include-config can only be used with --from"),
+		},
 	}
 
 	for tn, tc := range cases {
 		t.Run(tn, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			fakeSpaces := fake.NewFakeClient(ctrl)
+			fakeTemplates := spacetemplatesfake.NewFakeClient(ctrl)
 
 			if tc.setup != nil {
-				tc.setup(t, fakeSpaces)
+				tc.setup(t, fakeSpaces, fakeTemplates)
 			}
 
 			buffer := &bytes.Buffer{}
 
-			c := NewCreateSpaceCommand(&config.KfParams{Namespace: "default"}, fakeSpaces)
+			c := NewCreateSpaceCommand(&config.KfParams{Namespace: "default", Organization: "target-org"}, fakeSpaces, fakeTemplates)
 			c.SetOutput(buffer)
 			c.SetArgs(tc.args)
 
@@ -89,3 +186,15 @@ func TestNewCreateSpaceCommand(t *testing.T) {
 		})
 	}
 }
+
+func existingSpace() *v1alpha1.Space {
+	space := &v1alpha1.Space{}
+	space.Name = "existing"
+	space.Spec.Organization = "existing-org"
+	space.Spec.BuildpackBuild.ContainerRegistry = "existing-registry"
+	space.Spec.Execution.Domains = []v1alpha1.SpaceDomain{{Domain: "existing-domain", Default: true}}
+	space.Spec.Execution.Env = []corev1.EnvVar{{Name: "FOO", Value: "bar"}}
+	space.Spec.Security.Roles = []v1alpha1.SpaceRole{{Role: v1alpha1.SpaceDeveloper, Kind: "User", Name: "alice"}}
+	space.Spec.ResourceLimits.QuotaPlan = "small"
+	return space
+}
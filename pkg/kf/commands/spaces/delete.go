@@ -17,23 +17,32 @@ package spaces
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/google/kf/pkg/kf/commands/completion"
 	"github.com/google/kf/pkg/kf/commands/config"
+	installutil "github.com/google/kf/pkg/kf/commands/install/util"
+	"github.com/google/kf/pkg/kf/describe"
 	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
 	"github.com/google/kf/pkg/kf/spaces"
 	"github.com/spf13/cobra"
 )
 
 // NewDeleteSpaceCommand allows users to delete spaces.
-func NewDeleteSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Command {
-	var async utils.AsyncFlags
+func NewDeleteSpaceCommand(p *config.KfParams, client spaces.Client, contentsLister spaces.ContentsLister) *cobra.Command {
+	var (
+		async utils.AsyncFlags
+		force bool
+	)
 
 	cmd := &cobra.Command{
-		Use:     "delete-space SPACE",
-		Short:   "Delete a space",
-		Example: `kf delete-space my-space`,
+		Use:   "delete-space SPACE",
+		Short: "Delete a space",
+		Example: `
+		kf delete-space my-space
+		kf delete-space my-space --force
+		`,
 		Long: `Delete a space and all its contents.
 
 		This will delete a space's:
@@ -46,6 +55,15 @@ func NewDeleteSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 		* The backing Kubernetes namespace
 		* Anything else in that namespace
 
+		The apps, service instances, service bindings, routes and route claims
+		that will be deleted are listed before asking for confirmation. If the
+		command isn't run from a terminal it fails instead of prompting unless
+		--force is set. Service instances are deprovisioned by their brokers
+		before the namespace is removed.
+
+		Spaces with deletion protection turned on can't be deleted until it's
+		turned off with 'kf configure-space unset-deletion-protection'.
+
 		NOTE: Space deletion is asynchronous and may take a long time to complete
 		depending on the number of items in the space.
 
@@ -58,6 +76,36 @@ func NewDeleteSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 
 			name := args[0]
 
+			space, err := client.Get(name)
+			if err != nil {
+				return fmt.Errorf("failed to get space: %s", err)
+			}
+
+			if space.Spec.DeletionProtection {
+				return fmt.Errorf("space %q is protected from deletion, run 'kf configure-space unset-deletion-protection %s' first", name, name)
+			}
+
+			contents, err := contentsLister.ListContents(name)
+			if err != nil {
+				return fmt.Errorf("failed to list the contents of space: %s", err)
+			}
+
+			describeContents(cmd.OutOrStdout(), name, contents)
+
+			if !force {
+				// Scripts can't answer the prompt so they must opt in to
+				// deleting spaces.
+				if !utils.IsInteractive(cmd) {
+					return fmt.Errorf("can't confirm deletion of space %q without a terminal, use --force to delete without confirmation", name)
+				}
+
+				shouldDelete, err := installutil.SelectYesNo(context.Background(), fmt.Sprintf("Really delete space %s?", name))
+				if err != nil || !shouldDelete {
+					fmt.Fprintln(cmd.OutOrStdout(), "Skipping deletion, use --force to delete without confirmation")
+					return err
+				}
+			}
+
 			if err := client.Delete(name); err != nil {
 				return fmt.Errorf("failed to delete space: %s", err)
 			}
@@ -72,7 +120,38 @@ func NewDeleteSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Comm
 
 	async.Add(cmd)
 
+	cmd.Flags().BoolVar(
+		&force,
+		"force",
+		false,
+		"Delete the space without a confirmation prompt.",
+	)
+
 	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
 
 	return cmd
 }
+
+func describeContents(w io.Writer, name string, contents *spaces.Contents) {
+	if contents.IsEmpty() {
+		fmt.Fprintf(w, "Space %q is empty.\n", name)
+		return
+	}
+
+	fmt.Fprintf(w, "Deleting space %q will also delete:\n", name)
+	describe.IndentWriter(w, func(w io.Writer) {
+		describeNames(w, "Apps", contents.Apps)
+		describeNames(w, "Service Instances", contents.ServiceInstances)
+		describeNames(w, "Service Bindings", contents.ServiceBindings)
+		describeNames(w, "Routes", contents.Routes)
+		describeNames(w, "Route Claims", contents.RouteClaims)
+	})
+}
+
+func describeNames(w io.Writer, title string, names []string) {
+	describe.SectionWriter(w, title, func(w io.Writer) {
+		for _, name := range names {
+			fmt.Fprintln(w, name)
+		}
+	})
+}
@@ -20,7 +20,9 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/spaces"
 	"github.com/google/kf/pkg/kf/spaces/fake"
 	"github.com/google/kf/pkg/kf/testutil"
 )
@@ -29,30 +31,91 @@ func TestNewDeleteSpaceCommand(t *testing.T) {
 	t.Parallel()
 
 	cases := map[string]struct {
-		wantErr error
-		args    []string
-		setup   func(t *testing.T, fakeSpaces *fake.FakeClient)
+		wantErr         error
+		args            []string
+		setup           func(t *testing.T, fakeSpaces *fake.FakeClient, fakeContents *fake.FakeContentsLister)
+		expectedStrings []string
 	}{
 		"invalid number of args": {
 			args:    []string{},
 			wantErr: errors.New("accepts 1 arg(s), received 0"),
 		},
 		"calls delete": {
-			args: []string{"my-ns"},
-			setup: func(t *testing.T, fakeSpaces *fake.FakeClient) {
+			args: []string{"my-ns", "--force"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeContents *fake.FakeContentsLister) {
+				fakeSpaces.EXPECT().Get("my-ns").Return(&v1alpha1.Space{}, nil)
+				fakeContents.EXPECT().ListContents("my-ns").Return(&spaces.Contents{}, nil)
 				fakeSpaces.EXPECT().Delete("my-ns")
 				fakeSpaces.EXPECT().WaitForDeletion(gomock.Any(), "my-ns", gomock.Any())
 			},
+			expectedStrings: []string{`Space "my-ns" is empty.`},
 		},
 		"calls delete async doesn't wait": {
-			args: []string{"my-ns", "--async"},
-			setup: func(t *testing.T, fakeSpaces *fake.FakeClient) {
+			args: []string{"my-ns", "--force", "--async"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeContents *fake.FakeContentsLister) {
+				fakeSpaces.EXPECT().Get("my-ns").Return(&v1alpha1.Space{}, nil)
+				fakeContents.EXPECT().ListContents("my-ns").Return(&spaces.Contents{}, nil)
 				fakeSpaces.EXPECT().Delete(gomock.Any())
 			},
 		},
-		"server failure": {
+		"reports contents": {
+			args: []string{"my-ns", "--force", "--async"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeContents *fake.FakeContentsLister) {
+				fakeSpaces.EXPECT().Get("my-ns").Return(&v1alpha1.Space{}, nil)
+				fakeContents.EXPECT().ListContents("my-ns").Return(&spaces.Contents{
+					Apps:             []string{"my-app"},
+					ServiceInstances: []string{"my-db"},
+					ServiceBindings:  []string{"kf-binding-my-app-my-db (my-db)"},
+					Routes:           []string{"my-app.example.com/"},
+				}, nil)
+				fakeSpaces.EXPECT().Delete("my-ns")
+			},
+			expectedStrings: []string{
+				`Deleting space "my-ns" will also delete:`,
+				"Apps:", "my-app",
+				"Service Instances:", "my-db",
+				"Service Bindings:", "kf-binding-my-app-my-db (my-db)",
+				"Routes:", "my-app.example.com/",
+				"Route Claims: <empty>",
+			},
+		},
+		"no terminal": {
 			args: []string{"my-ns"},
-			setup: func(t *testing.T, fakeSpaces *fake.FakeClient) {
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeContents *fake.FakeContentsLister) {
+				fakeSpaces.EXPECT().Get("my-ns").Return(&v1alpha1.Space{}, nil)
+				fakeContents.EXPECT().ListContents("my-ns").Return(&spaces.Contents{}, nil)
+			},
+			wantErr: errors.New(`can't confirm deletion of space "my-ns" without a terminal, use --force to delete without confirmation`),
+		},
+		"protected space": {
+			args: []string{"my-ns", "--force"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeContents *fake.FakeContentsLister) {
+				space := &v1alpha1.Space{}
+				space.Spec.DeletionProtection = true
+				fakeSpaces.EXPECT().Get("my-ns").Return(space, nil)
+			},
+			wantErr: errors.New(`space "my-ns" is protected from deletion, run 'kf configure-space unset-deletion-protection my-ns' first`),
+		},
+		"missing space": {
+			args: []string{"my-ns", "--force"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeContents *fake.FakeContentsLister) {
+				fakeSpaces.EXPECT().Get("my-ns").Return(nil, errors.New("not found"))
+			},
+			wantErr: errors.New("failed to get space: not found"),
+		},
+		"listing contents fails": {
+			args: []string{"my-ns", "--force"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeContents *fake.FakeContentsLister) {
+				fakeSpaces.EXPECT().Get("my-ns").Return(&v1alpha1.Space{}, nil)
+				fakeContents.EXPECT().ListContents("my-ns").Return(nil, errors.New("some-server-error"))
+			},
+			wantErr: errors.New("failed to list the contents of space: some-server-error"),
+		},
+		"server failure": {
+			args: []string{"my-ns", "--force"},
+			setup: func(t *testing.T, fakeSpaces *fake.FakeClient, fakeContents *fake.FakeContentsLister) {
+				fakeSpaces.EXPECT().Get("my-ns").Return(&v1alpha1.Space{}, nil)
+				fakeContents.EXPECT().ListContents("my-ns").Return(&spaces.Contents{}, nil)
 				fakeSpaces.
 					EXPECT().
 					Delete("my-ns").
@@ -66,19 +129,22 @@ func TestNewDeleteSpaceCommand(t *testing.T) {
 		t.Run(tn, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			fakeSpaces := fake.NewFakeClient(ctrl)
+			fakeContents := fake.NewFakeContentsLister(ctrl)
 
 			if tc.setup != nil {
-				tc.setup(t, fakeSpaces)
+				tc.setup(t, fakeSpaces, fakeContents)
 			}
 
 			buffer := &bytes.Buffer{}
 
-			c := NewDeleteSpaceCommand(&config.KfParams{Namespace: "default"}, fakeSpaces)
+			c := NewDeleteSpaceCommand(&config.KfParams{Namespace: "default"}, fakeSpaces, fakeContents)
 			c.SetOutput(buffer)
+			c.SetIn(&bytes.Buffer{})
 			c.SetArgs(tc.args)
 
 			gotErr := c.Execute()
 			testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+			testutil.AssertContainsAll(t, buffer.String(), tc.expectedStrings)
 
 			ctrl.Finish()
 		})
@@ -15,19 +15,27 @@
 package spaces
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/commands/completion"
 	"github.com/google/kf/pkg/kf/commands/config"
 	"github.com/google/kf/pkg/kf/describe"
 	"github.com/google/kf/pkg/kf/spaces"
 
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
 // NewGetSpaceCommand allows users to create spaces.
-func NewGetSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Command {
+func NewGetSpaceCommand(p *config.KfParams, client spaces.Client, usageReporter spaces.UsageReporter) *cobra.Command {
+	printFlags := genericclioptions.NewPrintFlags("")
+	var usage bool
+
 	cmd := &cobra.Command{
 		Use:   "space SPACE",
 		Short: "Show space info",
@@ -37,12 +45,26 @@ func NewGetSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Command
 
 		    kubectl describe space.kf.dev SPACE
 
+		Use --usage to instead print the CPU and memory requested by each app's
+		latest ready revision multiplied by its instances, and the resources
+		consumed by builds. Autoscaled apps are counted at their maximum. Build
+		consumption only counts the build pods that still exist in the space,
+		so builds whose pods were deleted aren't included. Use --output json or
+		--output yaml to read the report from chargeback tooling.
 		`,
-		Example: `kf space my-space`,
-		Args:    cobra.ExactArgs(1),
+		Example: `
+  kf space my-space
+  kf space my-space --usage
+  kf space my-space --usage --output json
+  `,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 
+			if printFlags.OutputFlagSpecified() && !usage {
+				return errors.New("--output can only be used with --usage")
+			}
+
 			name := args[0]
 
 			space, err := client.Get(name)
@@ -52,6 +74,28 @@ func NewGetSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Command
 
 			w := cmd.OutOrStdout()
 
+			if usage {
+				report, err := usageReporter.SpaceUsage(space.Name)
+				if err != nil {
+					return err
+				}
+
+				if printFlags.OutputFlagSpecified() {
+					printer, err := printFlags.ToPrinter()
+					if err != nil {
+						return err
+					}
+
+					// Reports are built without a kind, set it so the printer
+					// will work.
+					report.GetObjectKind().SetGroupVersionKind(report.GetGroupVersionKind())
+					return printer.PrintObj(report, w)
+				}
+
+				describe.SpaceUsage(w, report)
+				return nil
+			}
+
 			describe.ObjectMeta(w, space.ObjectMeta)
 			fmt.Fprintln(w)
 
@@ -62,6 +106,7 @@ func NewGetSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Command
 				security := space.Spec.Security
 				fmt.Fprintf(w, "Developers can read logs?\t%v\n", security.EnableDeveloperLogsAccess)
 				fmt.Fprintf(w, "Build Service Account:\t%s\n", security.BuildServiceAccount)
+				fmt.Fprintf(w, "Deletion protected?\t%v\n", space.Spec.DeletionProtection)
 			})
 			fmt.Fprintln(w)
 
@@ -69,7 +114,22 @@ func NewGetSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Command
 				buildpackBuild := space.Spec.BuildpackBuild
 				fmt.Fprintf(w, "Builder Image:\t%q\n", buildpackBuild.BuilderImage)
 				fmt.Fprintf(w, "Container Registry:\t%q\n", buildpackBuild.ContainerRegistry)
+				fmt.Fprintf(w, "Buildpack Catalog:\t%q\n", buildpackBuild.Catalog)
 				describe.EnvVars(w, buildpackBuild.Env)
+
+				describe.SectionWriter(w, "Stacks", func(w io.Writer) {
+					stacks := space.Status.BuildpackStacks
+					if len(stacks) == 0 {
+						return
+					}
+
+					describe.TabbedWriter(w, func(w io.Writer) {
+						fmt.Fprintln(w, "Name\tDefault?\tBuilder")
+						for _, stack := range stacks {
+							fmt.Fprintf(w, "%s\t%t\t%s\n", stack.Name, stack.Default, stack.BuilderImage)
+						}
+					})
+				})
 			})
 			fmt.Fprintln(w)
 
@@ -92,12 +152,65 @@ func NewGetSpaceCommand(p *config.KfParams, client spaces.Client) *cobra.Command
 			})
 			fmt.Fprintln(w)
 
+			describe.SectionWriter(w, "Placement", func(w io.Writer) {
+				fmt.Fprintf(w, "Placement Profile:\t%q\n", space.Spec.PlacementProfile)
+
+				for _, placement := range []struct {
+					name      string
+					placement v1alpha1.PodPlacement
+				}{
+					{name: "Apps", placement: space.Status.ExecutionPlacement},
+					{name: "Builds", placement: space.Status.BuildPlacement},
+				} {
+					describe.SectionWriter(w, placement.name, func(w io.Writer) {
+						describe.SectionWriter(w, "Node Selector", func(w io.Writer) {
+							describe.Labels(w, placement.placement.NodeSelector)
+						})
+						describe.SectionWriter(w, "Tolerations", func(w io.Writer) {
+							for _, toleration := range placement.placement.Tolerations {
+								fmt.Fprintf(w, "%s %s %s:%s\n", toleration.Key, toleration.Operator, toleration.Value, toleration.Effect)
+							}
+						})
+						fmt.Fprintf(w, "Affinity?\t%t\n", placement.placement.Affinity != nil)
+					})
+				}
+			})
+			fmt.Fprintln(w)
+
+			describe.SectionWriter(w, "Policy", func(w io.Writer) {
+				policy := space.Spec.Policy
+				fmt.Fprintf(w, "Allowed Stacks:\t%s\n", strings.Join(policy.AllowedStacks, ", "))
+				fmt.Fprintf(w, "Container Images Disabled?\t%t\n", policy.DisableContainerImages)
+				fmt.Fprintf(w, "Allowed Registries:\t%s\n", strings.Join(policy.AllowedRegistries, ", "))
+				if policy.MaxAppInstances != nil {
+					fmt.Fprintf(w, "Max App Instances:\t%d\n", *policy.MaxAppInstances)
+				}
+				fmt.Fprintf(w, "Required Health Check:\t%s\n", policy.RequiredHealthCheckType)
+			})
+			fmt.Fprintln(w)
+
 			printAdditionalCommands(w, space.Name)
 
 			return nil
 		},
 	}
 
+	cmd.Flags().BoolVar(
+		&usage,
+		"usage",
+		false,
+		"Print the resources requested by apps and consumed by the build pods still in the space.",
+	)
+
+	printFlags.AddFlags(cmd)
+
+	// Override output format to be sorted so our generated documents are deterministic
+	{
+		allowedFormats := printFlags.AllowedFormats()
+		sort.Strings(allowedFormats)
+		cmd.Flag("output").Usage = fmt.Sprintf("Output format of --usage. One of: %s.", strings.Join(allowedFormats, "|"))
+	}
+
 	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
 
 	return cmd
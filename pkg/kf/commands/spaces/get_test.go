@@ -22,9 +22,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/spaces"
 	"github.com/google/kf/pkg/kf/spaces/fake"
 	"github.com/google/kf/pkg/kf/testutil"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"knative.dev/pkg/apis"
 )
 
@@ -36,6 +38,7 @@ func TestNewGetSpaceCommand(t *testing.T) {
 	goodSpace.Spec = v1alpha1.SpaceSpec{}
 	goodSpace.Spec.Security.EnableDeveloperLogsAccess = true
 	goodSpace.Spec.Security.BuildServiceAccount = "some-service-account"
+	goodSpace.Spec.DeletionProtection = true
 	goodSpace.Status.Conditions = []apis.Condition{{
 		Type:   "Ready",
 		Status: "TESTING",
@@ -49,11 +52,34 @@ func TestNewGetSpaceCommand(t *testing.T) {
 		{Domain: "domain-1.com", Default: true},
 		{Domain: "domain-2.com"},
 	}
+	goodSpace.Spec.PlacementProfile = "pci"
+	goodSpace.Spec.Policy.AllowedStacks = []string{"cflinuxfs3", "cflinuxfs4"}
+	goodSpace.Spec.Policy.RequiredHealthCheckType = v1alpha1.HealthCheckTypeHTTP
+	goodSpace.Status.ExecutionPlacement.NodeSelector = map[string]string{"pool": "pci-nodes"}
+	goodSpace.Status.ExecutionPlacement.Tolerations = []corev1.Toleration{
+		{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "pci", Effect: corev1.TaintEffectNoSchedule},
+	}
+
+	usage := &spaces.SpaceUsage{
+		Space: "my-space",
+		Apps: []spaces.AppUsage{{
+			Name:        "my-app",
+			Instances:   2,
+			CPU:         resource.MustParse("500m"),
+			Memory:      resource.MustParse("1Gi"),
+			TotalCPU:    resource.MustParse("1"),
+			TotalMemory: resource.MustParse("2Gi"),
+		}},
+		TotalCPU:    resource.MustParse("1"),
+		TotalMemory: resource.MustParse("2Gi"),
+		Builds:      spaces.BuildUsage{Builds: 1, Seconds: 60, CPUSeconds: 60, MemoryGiBSeconds: 120},
+	}
 
 	cases := map[string]struct {
 		wantErr    error
 		args       []string
 		space      *v1alpha1.Space
+		usage      *spaces.SpaceUsage
 		wantOutput []string
 	}{
 		"invalid number of args": {
@@ -68,7 +94,7 @@ func TestNewGetSpaceCommand(t *testing.T) {
 		"security": {
 			args:       []string{"my-space"},
 			space:      goodSpace,
-			wantOutput: []string{"Security", "read logs?", "true", "Build Service Account", "some-service-account"},
+			wantOutput: []string{"Security", "read logs?", "true", "Build Service Account", "some-service-account", "Deletion protected?"},
 		},
 		"build": {
 			args:       []string{"my-space"},
@@ -80,6 +106,38 @@ func TestNewGetSpaceCommand(t *testing.T) {
 			space:      goodSpace,
 			wantOutput: []string{"Execution", "ExecVar", "ExecVal", "domain-1.com", "domain-2.com"},
 		},
+		"placement": {
+			args:       []string{"my-space"},
+			space:      goodSpace,
+			wantOutput: []string{"Placement", `"pci"`, "pool=pci-nodes", "dedicated Equal pci:NoSchedule"},
+		},
+		"policy": {
+			args:       []string{"my-space"},
+			space:      goodSpace,
+			wantOutput: []string{"Policy", "cflinuxfs3, cflinuxfs4", "Container Images Disabled?", "Required Health Check", "http"},
+		},
+		"usage": {
+			args:       []string{"my-space", "--usage"},
+			space:      goodSpace,
+			usage:      usage,
+			wantOutput: []string{"Usage", "my-app", "500m", "2Gi", "Builds", "120"},
+		},
+		"usage json": {
+			args:       []string{"my-space", "--usage", "--output", "json"},
+			space:      goodSpace,
+			usage:      usage,
+			wantOutput: []string{`"kind": "SpaceUsage"`, `"space": "my-space"`, `"totalMemory": "2Gi"`, `"memoryGiBSeconds": 120`},
+		},
+		"output without usage": {
+			args:    []string{"my-space", "--output", "json"},
+			wantErr: errors.New("--output can only be used with --usage"),
+		},
+		"usage yaml": {
+			args:       []string{"my-space", "--usage", "--output", "yaml"},
+			space:      goodSpace,
+			usage:      usage,
+			wantOutput: []string{"kind: SpaceUsage", "space: my-space", "memoryGiBSeconds: 120"},
+		},
 		"client error": {
 			args:    []string{"my-space"},
 			space:   nil,
@@ -91,6 +149,7 @@ func TestNewGetSpaceCommand(t *testing.T) {
 		t.Run(tn, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			fakeSpaces := fake.NewFakeClient(ctrl)
+			fakeUsage := fake.NewFakeUsageReporter(ctrl)
 
 			if tc.space != nil {
 				fakeSpaces.EXPECT().Get(gomock.Any()).Return(tc.space, nil)
@@ -98,9 +157,13 @@ func TestNewGetSpaceCommand(t *testing.T) {
 				fakeSpaces.EXPECT().Get(gomock.Any()).Return(nil, errors.New("does not exist"))
 			}
 
+			if tc.usage != nil {
+				fakeUsage.EXPECT().SpaceUsage("my-space").Return(tc.usage, nil)
+			}
+
 			buffer := &bytes.Buffer{}
 
-			c := NewGetSpaceCommand(&config.KfParams{Namespace: "default"}, fakeSpaces)
+			c := NewGetSpaceCommand(&config.KfParams{Namespace: "default"}, fakeSpaces, fakeUsage)
 			c.SetOutput(buffer)
 			c.SetArgs(tc.args)
 
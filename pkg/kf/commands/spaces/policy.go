@@ -0,0 +1,170 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"errors"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/completion"
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/spf13/cobra"
+)
+
+// policyFlags holds the flags of set-policy that change the policy.
+var policyFlags = []string{
+	"allowed-stacks",
+	"disable-container-images",
+	"allowed-registries",
+	"max-app-instances",
+	"health-check-type",
+}
+
+// newSetPolicyCommand creates a command to change the policy apps in a space
+// must follow. Only the flags the user sets are changed.
+func newSetPolicyCommand(p *config.KfParams, client spaces.Client) *cobra.Command {
+	var (
+		allowedStacks          []string
+		disableContainerImages bool
+		allowedRegistries      []string
+		maxAppInstances        int64
+		healthCheckType        string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set-policy [SPACE_NAME]",
+		Short: "Set the policy apps in the space must follow.",
+		Long: `Set the policy apps in the space must follow.
+
+		The policy is enforced when apps are created, their source changes or
+		they're scaled up. Only the policies given as flags are changed, pass
+		an empty value to remove a policy.`,
+		Example: `
+  # Only allow the cflinuxfs3 stack and images from gcr.io/my-project
+  kf configure-space set-policy my-space --allowed-stacks cflinuxfs3 --allowed-registries gcr.io/my-project
+  # Reject container images and require HTTP health checks in the targeted space
+  kf configure-space set-policy --disable-container-images --health-check-type http
+  # Remove the instance limit
+  kf configure-space set-policy my-space --max-app-instances -1
+  `,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var spaceName string
+			if len(args) == 0 {
+				if err := utils.ValidateNamespace(p); err != nil {
+					return err
+				}
+				spaceName = p.Namespace
+			} else {
+				spaceName = args[0]
+			}
+
+			flags := cmd.Flags()
+			changed := false
+			for _, name := range policyFlags {
+				changed = changed || flags.Changed(name)
+			}
+
+			if !changed {
+				return errors.New("at least one policy flag must be set")
+			}
+
+			cmd.SilenceUsage = true
+
+			_, err := client.Transform(spaceName, spaces.DiffWrapper(cmd.OutOrStdout(), func(space *v1alpha1.Space) error {
+				policy := &space.Spec.Policy
+
+				if flags.Changed("allowed-stacks") {
+					policy.AllowedStacks = allowedStacks
+				}
+
+				if flags.Changed("disable-container-images") {
+					policy.DisableContainerImages = disableContainerImages
+				}
+
+				if flags.Changed("allowed-registries") {
+					policy.AllowedRegistries = allowedRegistries
+				}
+
+				if flags.Changed("max-app-instances") {
+					if maxAppInstances < 0 {
+						policy.MaxAppInstances = nil
+					} else {
+						policy.MaxAppInstances = &maxAppInstances
+					}
+				}
+
+				if flags.Changed("health-check-type") {
+					policy.RequiredHealthCheckType = v1alpha1.HealthCheckType(healthCheckType)
+				}
+
+				return nil
+			}))
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringSliceVar(
+		&allowedStacks,
+		"allowed-stacks",
+		nil,
+		"Stacks buildpack apps can be built on (default: any)",
+	)
+
+	cmd.Flags().BoolVar(
+		&disableContainerImages,
+		"disable-container-images",
+		false,
+		"Reject apps pushed with --docker-image",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&allowedRegistries,
+		"allowed-registries",
+		nil,
+		"Registries, optionally with a path, that --docker-image apps can be pulled from (default: any)",
+	)
+
+	cmd.Flags().Int64Var(
+		&maxAppInstances,
+		"max-app-instances",
+		-1,
+		"Maximum number of instances of a single app, -1 for no limit",
+	)
+
+	cmd.Flags().StringVar(
+		&healthCheckType,
+		"health-check-type",
+		"",
+		"Health check type every app must use: http or port (default: any)",
+	)
+
+	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
+
+	return cmd
+}
+
+func newGetPolicyAccessor() spaceAccessor {
+	return spaceAccessor{
+		Name:  "get-policy",
+		Short: "Get the policy apps in the space must follow.",
+		Accessor: func(space *v1alpha1.Space) interface{} {
+			return space.Spec.Policy
+		},
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/completion"
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/spf13/cobra"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// NewSetSpaceRoleCommand creates a command to assign a role in a space.
+func NewSetSpaceRoleCommand(p *config.KfParams, client spaces.Client) *cobra.Command {
+	return newSpaceRoleCommand(p, client, spaceRoleCommand{
+		Use:   "set-space-role",
+		Short: "Assign a role to a user or group in a space",
+		Long: `Assign a role to a user or group in a space.
+
+		SpaceManagers can assign the SpaceDeveloper and SpaceAuditor roles but
+		only administrators can assign the SpaceManager role.
+		`,
+		Example: `
+  # Make alice a developer in the targeted space
+  kf set-space-role alice@example.com SpaceDeveloper
+
+  # Make a group auditors of the space "my-space"
+  kf set-space-role my-space auditors@example.com SpaceAuditor --type group
+  `,
+		Apply: func(space *spaces.KfSpace, role v1alpha1.SpaceRole) bool {
+			return space.AddRole(role)
+		},
+		Changed:   "Assigned %s %q the role %s in space %q\n",
+		Unchanged: "%s %q already has the role %s in space %q\n",
+	})
+}
+
+// NewUnsetSpaceRoleCommand creates a command to remove a role in a space.
+func NewUnsetSpaceRoleCommand(p *config.KfParams, client spaces.Client) *cobra.Command {
+	return newSpaceRoleCommand(p, client, spaceRoleCommand{
+		Use:   "unset-space-role",
+		Short: "Remove a role from a user or group in a space",
+		Long: `Remove a role from a user or group in a space.
+
+		SpaceManagers can remove the SpaceDeveloper and SpaceAuditor roles but
+		only administrators can remove the SpaceManager role.
+		`,
+		Example: `
+  # Remove alice as a developer in the targeted space
+  kf unset-space-role alice@example.com SpaceDeveloper
+
+  # Remove a group as auditors of the space "my-space"
+  kf unset-space-role my-space auditors@example.com SpaceAuditor --type group
+  `,
+		Apply: func(space *spaces.KfSpace, role v1alpha1.SpaceRole) bool {
+			return space.RemoveRole(role)
+		},
+		Changed:   "Removed the role %[3]s from %[1]s %[2]q in space %[4]q\n",
+		Unchanged: "%s %q doesn't have the role %s in space %q\n",
+	})
+}
+
+type spaceRoleCommand struct {
+	Use     string
+	Short   string
+	Long    string
+	Example string
+
+	// Apply changes the role assignment and returns true if the space was
+	// modified.
+	Apply func(space *spaces.KfSpace, role v1alpha1.SpaceRole) bool
+
+	// Changed and Unchanged are printed with the kind and name of the
+	// subject, the role and the space.
+	Changed   string
+	Unchanged string
+}
+
+func newSpaceRoleCommand(p *config.KfParams, client spaces.Client, src spaceRoleCommand) *cobra.Command {
+	var subjectType string
+
+	cmd := &cobra.Command{
+		Use:     src.Use + " [SPACE_NAME] NAME ROLE",
+		Short:   src.Short,
+		Long:    src.Long,
+		Example: src.Example,
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spaceName, args, err := spaceNameFromArgs(p, args, 2)
+			if err != nil {
+				return err
+			}
+
+			role, err := newSpaceRole(args[0], args[1], subjectType)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			changed := false
+			if _, err := client.Transform(spaceName, func(space *v1alpha1.Space) error {
+				changed = src.Apply(spaces.NewFromSpace(space), role)
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			msg := src.Unchanged
+			if changed {
+				msg = src.Changed
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), msg, role.Kind, role.Name, role.Role, spaceName)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(
+		&subjectType,
+		"type",
+		"user",
+		"Type of the subject, either user or group.",
+	)
+
+	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
+
+	return cmd
+}
+
+// NewSpaceUsersCommand creates a command to list the roles assigned in a
+// space.
+func NewSpaceUsersCommand(p *config.KfParams, client spaces.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "space-users [SPACE_NAME]",
+		Short: "List the users and groups with roles in a space",
+		Example: `
+  # List the users of the targeted space
+  kf space-users
+
+  # List the users of the space "my-space"
+  kf space-users my-space
+  `,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spaceName, _, err := spaceNameFromArgs(p, args, 0)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			space, err := client.Get(spaceName)
+			if err != nil {
+				return err
+			}
+
+			printSpaceUsers(cmd.OutOrStdout(), space)
+			return nil
+		},
+	}
+
+	completion.MarkArgCompletionSupported(cmd, completion.SpaceCompletion)
+
+	return cmd
+}
+
+func printSpaceUsers(w io.Writer, space *v1alpha1.Space) {
+	type subject struct {
+		Kind string
+		Name string
+	}
+
+	roles := make(map[subject][]string)
+	var subjects []subject
+	for _, name := range v1alpha1.SpaceRoleNames {
+		for _, r := range space.Spec.Security.Roles {
+			if r.Role != name {
+				continue
+			}
+
+			s := subject{Kind: r.Kind, Name: r.Name}
+			if _, ok := roles[s]; !ok {
+				subjects = append(subjects, s)
+			}
+			roles[s] = append(roles[s], string(r.Role))
+		}
+	}
+
+	sort.Slice(subjects, func(i, j int) bool {
+		if subjects[i].Name != subjects[j].Name {
+			return subjects[i].Name < subjects[j].Name
+		}
+		return subjects[i].Kind < subjects[j].Kind
+	})
+
+	fmt.Fprintf(w, "Getting users in space %s\n", space.Name)
+	describe.TabbedWriter(w, func(w io.Writer) {
+		fmt.Fprintln(w, "Name\tType\tRoles")
+		for _, s := range subjects {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", s.Name, s.Kind, strings.Join(roles[s], ", "))
+		}
+	})
+}
+
+// spaceNameFromArgs returns the space named in the optional first argument
+// or the targeted space and the remaining arguments.
+func spaceNameFromArgs(p *config.KfParams, args []string, required int) (string, []string, error) {
+	if len(args) > required {
+		return args[0], args[1:], nil
+	}
+
+	if err := utils.ValidateNamespace(p); err != nil {
+		return "", nil, err
+	}
+
+	return p.Namespace, args, nil
+}
+
+// newSpaceRole creates a SpaceRole from user input. Role names are case
+// insensitive.
+func newSpaceRole(name, role, subjectType string) (v1alpha1.SpaceRole, error) {
+	out := v1alpha1.SpaceRole{Name: name}
+
+	for _, r := range v1alpha1.SpaceRoleNames {
+		if strings.EqualFold(string(r), role) {
+			out.Role = r
+		}
+	}
+	if out.Role == "" {
+		return out, fmt.Errorf("ROLE must be one of %s, got %q", joinRoleNames(), role)
+	}
+
+	switch strings.ToLower(subjectType) {
+	case "user":
+		out.Kind = rbacv1.UserKind
+	case "group":
+		out.Kind = rbacv1.GroupKind
+	default:
+		return out, fmt.Errorf("--type must be user or group, got %q", subjectType)
+	}
+
+	return out, nil
+}
+
+func joinRoleNames() string {
+	var names []string
+	for _, r := range v1alpha1.SpaceRoleNames {
+		names = append(names, string(r))
+	}
+
+	return strings.Join(names, ", ")
+}
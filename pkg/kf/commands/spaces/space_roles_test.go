@@ -0,0 +1,211 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
+	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/google/kf/pkg/kf/spaces/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	"github.com/spf13/cobra"
+)
+
+func TestSpaceRoleCommands(t *testing.T) {
+	t.Parallel()
+
+	alice := v1alpha1.SpaceRole{Role: v1alpha1.SpaceDeveloper, Kind: "User", Name: "alice"}
+	devs := v1alpha1.SpaceRole{Role: v1alpha1.SpaceAuditor, Kind: "Group", Name: "devs"}
+
+	cases := map[string]struct {
+		command    func(*config.KfParams, spaces.Client) *cobra.Command
+		namespace  string
+		args       []string
+		roles      []v1alpha1.SpaceRole
+		wantSpace  string
+		wantRoles  []v1alpha1.SpaceRole
+		wantErr    error
+		wantOutput string
+	}{
+		"set wrong number of args": {
+			command: NewSetSpaceRoleCommand,
+			args:    []string{"alice"},
+			wantErr: errors.New("accepts between 2 and 3 arg(s), received 1"),
+		},
+		"set in targeted space": {
+			command:    NewSetSpaceRoleCommand,
+			namespace:  "my-space",
+			args:       []string{"alice", "SpaceDeveloper"},
+			wantSpace:  "my-space",
+			wantRoles:  []v1alpha1.SpaceRole{alice},
+			wantOutput: "Assigned User \"alice\" the role SpaceDeveloper in space \"my-space\"\n",
+		},
+		"set in named space": {
+			command:    NewSetSpaceRoleCommand,
+			args:       []string{"other-space", "devs", "spaceauditor", "--type", "group"},
+			roles:      []v1alpha1.SpaceRole{alice},
+			wantSpace:  "other-space",
+			wantRoles:  []v1alpha1.SpaceRole{alice, devs},
+			wantOutput: "Assigned Group \"devs\" the role SpaceAuditor in space \"other-space\"\n",
+		},
+		"set already assigned": {
+			command:    NewSetSpaceRoleCommand,
+			namespace:  "my-space",
+			args:       []string{"alice", "SpaceDeveloper"},
+			roles:      []v1alpha1.SpaceRole{alice},
+			wantSpace:  "my-space",
+			wantRoles:  []v1alpha1.SpaceRole{alice},
+			wantOutput: "User \"alice\" already has the role SpaceDeveloper in space \"my-space\"\n",
+		},
+		"set invalid role": {
+			command:   NewSetSpaceRoleCommand,
+			namespace: "my-space",
+			args:      []string{"alice", "OrgManager"},
+			wantErr:   errors.New(`ROLE must be one of SpaceManager, SpaceDeveloper, SpaceAuditor, got "OrgManager"`),
+		},
+		"set invalid type": {
+			command:   NewSetSpaceRoleCommand,
+			namespace: "my-space",
+			args:      []string{"alice", "SpaceDeveloper", "--type", "robot"},
+			wantErr:   errors.New(`--type must be user or group, got "robot"`),
+		},
+		"set no space targeted": {
+			command: NewSetSpaceRoleCommand,
+			args:    []string{"alice", "SpaceDeveloper"},
+			wantErr: errors.New(utils.EmptyNamespaceError),
+		},
+		"unset": {
+			command:    NewUnsetSpaceRoleCommand,
+			namespace:  "my-space",
+			args:       []string{"alice", "SpaceDeveloper"},
+			roles:      []v1alpha1.SpaceRole{alice, devs},
+			wantSpace:  "my-space",
+			wantRoles:  []v1alpha1.SpaceRole{devs},
+			wantOutput: "Removed the role SpaceDeveloper from User \"alice\" in space \"my-space\"\n",
+		},
+		"unset not assigned": {
+			command:    NewUnsetSpaceRoleCommand,
+			namespace:  "my-space",
+			args:       []string{"alice", "SpaceAuditor"},
+			roles:      []v1alpha1.SpaceRole{alice},
+			wantSpace:  "my-space",
+			wantRoles:  []v1alpha1.SpaceRole{alice},
+			wantOutput: "User \"alice\" doesn't have the role SpaceAuditor in space \"my-space\"\n",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeSpaces := fake.NewFakeClient(ctrl)
+
+			output := &v1alpha1.Space{}
+			output.Spec.Security.Roles = tc.roles
+			if tc.wantSpace != "" {
+				fakeSpaces.EXPECT().Transform(tc.wantSpace, gomock.Any()).DoAndReturn(func(spaceName string, transformer spaces.Mutator) (*v1alpha1.Space, error) {
+					if err := transformer(output); err != nil {
+						return nil, err
+					}
+					return output, nil
+				})
+			}
+
+			buffer := &bytes.Buffer{}
+
+			c := tc.command(&config.KfParams{Namespace: tc.namespace}, fakeSpaces)
+			c.SetOutput(buffer)
+			c.SetArgs(tc.args)
+
+			gotErr := c.Execute()
+			if tc.wantErr != nil || gotErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertEqual(t, "roles", tc.wantRoles, output.Spec.Security.Roles)
+			testutil.AssertEqual(t, "output", tc.wantOutput, buffer.String())
+
+			ctrl.Finish()
+		})
+	}
+}
+
+func TestNewSpaceUsersCommand(t *testing.T) {
+	t.Parallel()
+
+	space := &v1alpha1.Space{}
+	space.Name = "my-space"
+	space.Spec.Security.Roles = []v1alpha1.SpaceRole{
+		{Role: v1alpha1.SpaceAuditor, Kind: "User", Name: "alice"},
+		{Role: v1alpha1.SpaceAuditor, Kind: "Group", Name: "auditors"},
+		{Role: v1alpha1.SpaceManager, Kind: "User", Name: "alice"},
+	}
+
+	cases := map[string]struct {
+		namespace  string
+		args       []string
+		getErr     error
+		wantErr    error
+		wantOutput []string
+	}{
+		"targeted space": {
+			namespace: "my-space",
+			wantOutput: []string{
+				"Getting users in space my-space",
+				"alice", "User", "SpaceManager, SpaceAuditor",
+				"auditors", "Group",
+			},
+		},
+		"named space": {
+			args:       []string{"my-space"},
+			wantOutput: []string{"Getting users in space my-space"},
+		},
+		"get error": {
+			args:    []string{"my-space"},
+			getErr:  errors.New("some-error"),
+			wantErr: errors.New("some-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fakeSpaces := fake.NewFakeClient(ctrl)
+			fakeSpaces.EXPECT().Get("my-space").Return(space, tc.getErr)
+
+			buffer := &bytes.Buffer{}
+
+			c := NewSpaceUsersCommand(&config.KfParams{Namespace: tc.namespace}, fakeSpaces)
+			c.SetOutput(buffer)
+			c.SetArgs(tc.args)
+
+			gotErr := c.Execute()
+			if tc.wantErr != nil || gotErr != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, gotErr)
+				return
+			}
+
+			testutil.AssertContainsAll(t, buffer.String(), tc.wantOutput)
+
+			ctrl.Finish()
+		})
+	}
+}
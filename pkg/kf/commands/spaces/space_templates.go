@@ -0,0 +1,70 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/describe"
+	"github.com/google/kf/pkg/kf/spacetemplates"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+
+	"github.com/spf13/cobra"
+)
+
+// NewListSpaceTemplatesCommand allows users to list the SpaceTemplates new
+// spaces can be created from.
+func NewListSpaceTemplatesCommand(p *config.KfParams, client spacetemplates.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "space-templates",
+		Short: "List templates spaces can be created from",
+		Long: `List the SpaceTemplates on the cluster.
+
+		Templates are created by operators and used with:
+
+		    kf create-space SPACE --template TEMPLATE
+
+		`,
+		Example: `kf space-templates`,
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			list, err := client.List()
+			if err != nil {
+				return err
+			}
+
+			describe.TabbedWriter(cmd.OutOrStdout(), func(w io.Writer) {
+				fmt.Fprintln(w, "Name\tAge\tRegistry\tQuota Plan")
+				for _, template := range list {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s",
+						template.Name,
+						table.ConvertToHumanReadableDateType(template.CreationTimestamp),
+						template.Spec.BuildpackBuild.ContainerRegistry,
+						template.Spec.ResourceLimits.QuotaPlan,
+					)
+					fmt.Fprintln(w)
+				}
+			})
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -19,38 +19,96 @@ import (
 
 	"github.com/google/kf/pkg/kf/commands/completion"
 	"github.com/google/kf/pkg/kf/commands/config"
+	"github.com/google/kf/pkg/kf/organizations"
+	"github.com/google/kf/pkg/kf/spaces"
 	"github.com/spf13/cobra"
 )
 
-// NewTargetCommand creates a command that can set the default space.
-func NewTargetCommand(p *config.KfParams) *cobra.Command {
-	var space string
+// NewTargetCommand creates a command that can set the default organization
+// and space.
+func NewTargetCommand(p *config.KfParams, orgsClient organizations.Client, spacesClient spaces.Client) *cobra.Command {
+	var (
+		organization string
+		space        string
+	)
 
 	command := &cobra.Command{
 		Use:   "target",
-		Short: "Set or view the targeted space",
+		Short: "Set or view the targeted organization and space",
+		Long: `Set or view the targeted organization and space.
+
+		Targeting an organization checks that it exists. Targeting a space
+		while an organization is targeted checks that the space belongs to
+		it. Switching organizations without targeting a space clears the
+		targeted space unless it belongs to the new organization.
+		`,
 		Example: `
-		# See the current space
+		# See the current organization and space
 		kf target
 		# Target a space
 		kf target -s my-space
+		# Target a space in an organization
+		kf target -o my-org -s my-space
 		`,
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			w := cmd.OutOrStdout()
+
+			if organization != "" {
+				if _, err := orgsClient.Get(organization); err != nil {
+					return err
+				}
+
+				if organization != p.Organization && space == "" && p.Namespace != "" {
+					// Keep the targeted space only if it's in the new
+					// organization.
+					current, err := spacesClient.Get(p.Namespace)
+					if err != nil || current.Spec.Organization != organization {
+						p.Namespace = ""
+					}
+				}
+
+				p.Organization = organization
+			}
+
 			if space != "" {
+				if p.Organization != "" {
+					target, err := spacesClient.Get(space)
+					if err != nil {
+						return err
+					}
+
+					if target.Spec.Organization != p.Organization {
+						return fmt.Errorf("space %q isn't in organization %q", space, p.Organization)
+					}
+				}
+
 				p.Namespace = space
+			}
+
+			if organization != "" || space != "" {
 				if err := config.Write(p.Config, p); err != nil {
 					return err
 				}
 			}
 
-			fmt.Println("Current space is:", p.Namespace)
+			if p.Organization != "" {
+				fmt.Fprintln(w, "Current organization is:", p.Organization)
+			}
+
+			if p.Namespace == "" {
+				fmt.Fprintln(w, "No space targeted, use 'kf target -s SPACE'")
+			} else {
+				fmt.Fprintln(w, "Current space is:", p.Namespace)
+			}
 
 			return nil
 		},
 	}
 
+	command.Flags().StringVarP(&organization, "organization", "o", "", "Target the given organization.")
 	command.Flags().StringVarP(&space, "space", "s", "", "Target the given space.")
+	completion.MarkFlagCompletionSupported(command.Flags(), "organization", completion.OrganizationCompletion)
 	completion.MarkFlagCompletionSupported(command.Flags(), "space", completion.SpaceCompletion)
 
 	return command
@@ -0,0 +1,165 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/commands/config"
+	orgfake "github.com/google/kf/pkg/kf/organizations/fake"
+	spacefake "github.com/google/kf/pkg/kf/spaces/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewTargetCommand(t *testing.T) {
+	t.Parallel()
+
+	newSpace := func(name, org string) *v1alpha1.Space {
+		return &v1alpha1.Space{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       v1alpha1.SpaceSpec{Organization: org},
+		}
+	}
+
+	cases := map[string]struct {
+		args      []string
+		namespace string
+		org       string
+		setup     func(orgs *orgfake.FakeClient, spaces *spacefake.FakeClient)
+		wantErr   error
+		wantNs    string
+		wantOrg   string
+		wantOut   string
+	}{
+		"shows target": {
+			namespace: "my-space",
+			org:       "my-org",
+			wantNs:    "my-space",
+			wantOrg:   "my-org",
+			wantOut:   "Current organization is: my-org\nCurrent space is: my-space\n",
+		},
+		"targets space without organization": {
+			args:      []string{"-s", "other-space"},
+			namespace: "my-space",
+			wantNs:    "other-space",
+			wantOut:   "Current space is: other-space\n",
+		},
+		"targets space in organization": {
+			args: []string{"-o", "my-org", "-s", "other-space"},
+			setup: func(orgs *orgfake.FakeClient, spaces *spacefake.FakeClient) {
+				orgs.EXPECT().Get("my-org").Return(&v1alpha1.Organization{}, nil)
+				spaces.EXPECT().Get("other-space").Return(newSpace("other-space", "my-org"), nil)
+			},
+			wantNs:  "other-space",
+			wantOrg: "my-org",
+			wantOut: "Current organization is: my-org\nCurrent space is: other-space\n",
+		},
+		"missing organization": {
+			args:      []string{"-o", "missing-org"},
+			namespace: "my-space",
+			setup: func(orgs *orgfake.FakeClient, spaces *spacefake.FakeClient) {
+				orgs.EXPECT().Get("missing-org").Return(nil, errors.New("not found"))
+			},
+			wantErr: errors.New("not found"),
+		},
+		"space in another organization": {
+			args: []string{"-o", "my-org", "-s", "other-space"},
+			setup: func(orgs *orgfake.FakeClient, spaces *spacefake.FakeClient) {
+				orgs.EXPECT().Get("my-org").Return(&v1alpha1.Organization{}, nil)
+				spaces.EXPECT().Get("other-space").Return(newSpace("other-space", "other-org"), nil)
+			},
+			wantErr: errors.New(`space "other-space" isn't in organization "my-org"`),
+		},
+		"space in targeted organization": {
+			args: []string{"-s", "other-space"},
+			org:  "my-org",
+			setup: func(orgs *orgfake.FakeClient, spaces *spacefake.FakeClient) {
+				spaces.EXPECT().Get("other-space").Return(newSpace("other-space", "other-org"), nil)
+			},
+			wantErr: errors.New(`space "other-space" isn't in organization "my-org"`),
+		},
+		"switching organization clears space": {
+			args:      []string{"-o", "other-org"},
+			namespace: "my-space",
+			org:       "my-org",
+			setup: func(orgs *orgfake.FakeClient, spaces *spacefake.FakeClient) {
+				orgs.EXPECT().Get("other-org").Return(&v1alpha1.Organization{}, nil)
+				spaces.EXPECT().Get("my-space").Return(newSpace("my-space", "my-org"), nil)
+			},
+			wantOrg: "other-org",
+			wantOut: "Current organization is: other-org\nNo space targeted, use 'kf target -s SPACE'\n",
+		},
+		"switching organization keeps space in it": {
+			args:      []string{"-o", "other-org"},
+			namespace: "my-space",
+			setup: func(orgs *orgfake.FakeClient, spaces *spacefake.FakeClient) {
+				orgs.EXPECT().Get("other-org").Return(&v1alpha1.Organization{}, nil)
+				spaces.EXPECT().Get("my-space").Return(newSpace("my-space", "other-org"), nil)
+			},
+			wantNs:  "my-space",
+			wantOrg: "other-org",
+			wantOut: "Current organization is: other-org\nCurrent space is: my-space\n",
+		},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			dir, err := ioutil.TempDir("", "kf-target")
+			testutil.AssertNil(t, "TempDir err", err)
+			defer os.RemoveAll(dir)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			orgs := orgfake.NewFakeClient(ctrl)
+			spaces := spacefake.NewFakeClient(ctrl)
+			if tc.setup != nil {
+				tc.setup(orgs, spaces)
+			}
+
+			p := &config.KfParams{
+				Config:       filepath.Join(dir, "config"),
+				Namespace:    tc.namespace,
+				Organization: tc.org,
+			}
+
+			var out bytes.Buffer
+			cmd := NewTargetCommand(p, orgs, spaces)
+			cmd.SetArgs(tc.args)
+			cmd.SetOutput(&out)
+
+			err = cmd.Execute()
+			if tc.wantErr != nil || err != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, err)
+				return
+			}
+
+			testutil.AssertEqual(t, "output", tc.wantOut, out.String())
+			testutil.AssertEqual(t, "namespace", tc.wantNs, p.Namespace)
+			testutil.AssertEqual(t, "organization", tc.wantOrg, p.Organization)
+		})
+	}
+}
@@ -12,30 +12,40 @@ import (
 	"github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/typed/servicecatalog/v1beta1"
 	"github.com/google/kf/pkg/kf/apps"
 	"github.com/google/kf/pkg/kf/buildpacks"
+	"github.com/google/kf/pkg/kf/cfutil"
 	apps2 "github.com/google/kf/pkg/kf/commands/apps"
 	buildpacks2 "github.com/google/kf/pkg/kf/commands/buildpacks"
 	"github.com/google/kf/pkg/kf/commands/builds"
 	"github.com/google/kf/pkg/kf/commands/completion"
 	"github.com/google/kf/pkg/kf/commands/config"
+	organizations2 "github.com/google/kf/pkg/kf/commands/organizations"
 	"github.com/google/kf/pkg/kf/commands/quotas"
 	routes2 "github.com/google/kf/pkg/kf/commands/routes"
+	securitygroups2 "github.com/google/kf/pkg/kf/commands/securitygroups"
 	servicebindings2 "github.com/google/kf/pkg/kf/commands/service-bindings"
 	"github.com/google/kf/pkg/kf/commands/service-brokers"
+	"github.com/google/kf/pkg/kf/commands/service-keys"
 	services2 "github.com/google/kf/pkg/kf/commands/services"
 	spaces2 "github.com/google/kf/pkg/kf/commands/spaces"
 	"github.com/google/kf/pkg/kf/istio"
 	"github.com/google/kf/pkg/kf/logs"
 	"github.com/google/kf/pkg/kf/marketplace"
+	"github.com/google/kf/pkg/kf/organizations"
+	"github.com/google/kf/pkg/kf/quotaplans"
 	"github.com/google/kf/pkg/kf/routeclaims"
 	"github.com/google/kf/pkg/kf/routes"
+	"github.com/google/kf/pkg/kf/securitygroups"
 	"github.com/google/kf/pkg/kf/service-bindings"
 	"github.com/google/kf/pkg/kf/services"
+	"github.com/google/kf/pkg/kf/sourceimage"
 	"github.com/google/kf/pkg/kf/sources"
 	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/google/kf/pkg/kf/spacetemplates"
 	logs2 "github.com/google/kf/third_party/knative-build/pkg/logs"
 	"github.com/google/wire"
-	"github.com/poy/kontext"
 	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	v1_2 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
 import (
@@ -53,7 +63,10 @@ func InjectPush(p *config.KfParams) *cobra.Command {
 	client := sources.NewClient(sourcesGetter, buildTailer)
 	appsClient := apps.NewClient(appsGetter, client)
 	pusher := apps.NewPusher(appsClient)
-	srcImageBuilder := provideSrcImageBuilder()
+	remoteImageFetcher := provideRemoteImageFetcher()
+	remoteImageWriter := provideRemoteImageWriter()
+	builder := sourceimage.NewBuilder(remoteImageFetcher, remoteImageWriter)
+	srcImageBuilder := provideSrcImageBuilder(builder)
 	versionedInterface := config.GetServiceCatalogClient(p)
 	clientInterface := servicebindings.NewClient(versionedInterface)
 	command := apps2.NewPushCommand(p, appsClient, pusher, srcImageBuilder, clientInterface)
@@ -89,7 +102,18 @@ func InjectGetApp(p *config.KfParams) *cobra.Command {
 	buildTailer := provideSourcesBuildTailer()
 	client := sources.NewClient(sourcesGetter, buildTailer)
 	appsClient := apps.NewClient(appsGetter, client)
-	command := apps2.NewGetAppCommand(p, appsClient)
+	remoteImageFetcher := provideRemoteImageFetcher()
+	sbomFetcher := buildpacks.NewSBOMFetcher(remoteImageFetcher)
+	command := apps2.NewGetAppCommand(p, appsClient, sbomFetcher)
+	return command
+}
+
+func InjectAppUsage(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	servingV1alpha1Interface := config.GetServingClient(p)
+	kubernetesInterface := config.GetKubernetes(p)
+	usageReporter := spaces.NewUsageReporter(kfV1alpha1Interface, servingV1alpha1Interface, kubernetesInterface)
+	command := apps2.NewAppUsageCommand(p, usageReporter)
 	return command
 }
 
@@ -148,6 +172,20 @@ func InjectRestage(p *config.KfParams) *cobra.Command {
 	return command
 }
 
+func InjectRebase(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	appsGetter := provideAppsGetter(kfV1alpha1Interface)
+	sourcesGetter := provideKfSources(kfV1alpha1Interface)
+	buildTailer := provideSourcesBuildTailer()
+	client := sources.NewClient(sourcesGetter, buildTailer)
+	appsClient := apps.NewClient(appsGetter, client)
+	remoteImageFetcher := provideRemoteImageFetcher()
+	remoteImageWriter := provideRemoteImageWriter()
+	rebaser := buildpacks.NewRebaser(remoteImageFetcher, remoteImageWriter)
+	command := apps2.NewRebaseCommand(p, appsClient, rebaser)
+	return command
+}
+
 func InjectProxy(p *config.KfParams) *cobra.Command {
 	kfV1alpha1Interface := config.GetKfClient(p)
 	appsGetter := provideAppsGetter(kfV1alpha1Interface)
@@ -206,7 +244,9 @@ func InjectCreateService(p *config.KfParams) *cobra.Command {
 	serviceInstancesGetter := provideServiceInstancesGetter(versionedInterface)
 	client := services.NewClient(serviceInstancesGetter)
 	sClientFactory := config.GetSvcatApp(p)
-	clientInterface := marketplace.NewClient(sClientFactory, versionedInterface)
+	kfV1alpha1Interface := config.GetKfClient(p)
+	servicePlanVisibilitiesGetter := provideServicePlanVisibilitiesGetter(kfV1alpha1Interface)
+	clientInterface := marketplace.NewClient(sClientFactory, versionedInterface, servicePlanVisibilitiesGetter)
 	command := services2.NewCreateServiceCommand(p, client, clientInterface)
 	return command
 }
@@ -215,7 +255,10 @@ func InjectDeleteService(p *config.KfParams) *cobra.Command {
 	versionedInterface := config.GetServiceCatalogClient(p)
 	serviceInstancesGetter := provideServiceInstancesGetter(versionedInterface)
 	client := services.NewClient(serviceInstancesGetter)
-	command := services2.NewDeleteServiceCommand(p, client)
+	kubernetesInterface := config.GetKubernetes(p)
+	secretsGetter := provideSecretsGetter(kubernetesInterface)
+	userProvidedClient := services.NewUserProvidedClient(secretsGetter)
+	command := services2.NewDeleteServiceCommand(p, client, userProvidedClient)
 	return command
 }
 
@@ -223,7 +266,39 @@ func InjectGetService(p *config.KfParams) *cobra.Command {
 	versionedInterface := config.GetServiceCatalogClient(p)
 	serviceInstancesGetter := provideServiceInstancesGetter(versionedInterface)
 	client := services.NewClient(serviceInstancesGetter)
-	command := services2.NewGetServiceCommand(p, client)
+	kubernetesInterface := config.GetKubernetes(p)
+	secretsGetter := provideSecretsGetter(kubernetesInterface)
+	userProvidedClient := services.NewUserProvidedClient(secretsGetter)
+	eventsGetter := provideEventsGetter(kubernetesInterface)
+	command := services2.NewGetServiceCommand(p, client, userProvidedClient, eventsGetter)
+	return command
+}
+
+func InjectUpdateService(p *config.KfParams) *cobra.Command {
+	versionedInterface := config.GetServiceCatalogClient(p)
+	serviceInstancesGetter := provideServiceInstancesGetter(versionedInterface)
+	client := services.NewClient(serviceInstancesGetter)
+	sClientFactory := config.GetSvcatApp(p)
+	kfV1alpha1Interface := config.GetKfClient(p)
+	servicePlanVisibilitiesGetter := provideServicePlanVisibilitiesGetter(kfV1alpha1Interface)
+	clientInterface := marketplace.NewClient(sClientFactory, versionedInterface, servicePlanVisibilitiesGetter)
+	command := services2.NewUpdateServiceCommand(p, client, clientInterface)
+	return command
+}
+
+func InjectCreateUserProvidedService(p *config.KfParams) *cobra.Command {
+	kubernetesInterface := config.GetKubernetes(p)
+	secretsGetter := provideSecretsGetter(kubernetesInterface)
+	userProvidedClient := services.NewUserProvidedClient(secretsGetter)
+	command := services2.NewCreateUserProvidedServiceCommand(p, userProvidedClient)
+	return command
+}
+
+func InjectUpdateUserProvidedService(p *config.KfParams) *cobra.Command {
+	kubernetesInterface := config.GetKubernetes(p)
+	secretsGetter := provideSecretsGetter(kubernetesInterface)
+	userProvidedClient := services.NewUserProvidedClient(secretsGetter)
+	command := services2.NewUpdateUserProvidedServiceCommand(p, userProvidedClient)
 	return command
 }
 
@@ -231,6 +306,9 @@ func InjectListServices(p *config.KfParams) *cobra.Command {
 	versionedInterface := config.GetServiceCatalogClient(p)
 	serviceInstancesGetter := provideServiceInstancesGetter(versionedInterface)
 	client := services.NewClient(serviceInstancesGetter)
+	kubernetesInterface := config.GetKubernetes(p)
+	secretsGetter := provideSecretsGetter(kubernetesInterface)
+	userProvidedClient := services.NewUserProvidedClient(secretsGetter)
 	kfV1alpha1Interface := config.GetKfClient(p)
 	appsGetter := provideAppsGetter(kfV1alpha1Interface)
 	sourcesGetter := provideKfSources(kfV1alpha1Interface)
@@ -238,15 +316,44 @@ func InjectListServices(p *config.KfParams) *cobra.Command {
 	sourcesClient := sources.NewClient(sourcesGetter, buildTailer)
 	appsClient := apps.NewClient(appsGetter, sourcesClient)
 	sClientFactory := config.GetSvcatApp(p)
-	clientInterface := marketplace.NewClient(sClientFactory, versionedInterface)
-	command := services2.NewListServicesCommand(p, client, appsClient, clientInterface)
+	servicePlanVisibilitiesGetter := provideServicePlanVisibilitiesGetter(kfV1alpha1Interface)
+	clientInterface := marketplace.NewClient(sClientFactory, versionedInterface, servicePlanVisibilitiesGetter)
+	serviceInstanceSharesGetter := provideServiceInstanceSharesGetter(kfV1alpha1Interface)
+	sharesClient := services.NewSharesClient(serviceInstanceSharesGetter)
+	command := services2.NewListServicesCommand(p, client, userProvidedClient, appsClient, clientInterface, sharesClient)
+	return command
+}
+
+func InjectShareService(p *config.KfParams) *cobra.Command {
+	versionedInterface := config.GetServiceCatalogClient(p)
+	serviceInstancesGetter := provideServiceInstancesGetter(versionedInterface)
+	client := services.NewClient(serviceInstancesGetter)
+	kubernetesInterface := config.GetKubernetes(p)
+	secretsGetter := provideSecretsGetter(kubernetesInterface)
+	userProvidedClient := services.NewUserProvidedClient(secretsGetter)
+	kfV1alpha1Interface := config.GetKfClient(p)
+	serviceInstanceSharesGetter := provideServiceInstanceSharesGetter(kfV1alpha1Interface)
+	sharesClient := services.NewSharesClient(serviceInstanceSharesGetter)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	spacesClient := spaces.NewClient(spacesGetter)
+	command := services2.NewShareServiceCommand(p, client, userProvidedClient, sharesClient, spacesClient)
+	return command
+}
+
+func InjectUnshareService(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	serviceInstanceSharesGetter := provideServiceInstanceSharesGetter(kfV1alpha1Interface)
+	sharesClient := services.NewSharesClient(serviceInstanceSharesGetter)
+	command := services2.NewUnshareServiceCommand(p, sharesClient)
 	return command
 }
 
 func InjectMarketplace(p *config.KfParams) *cobra.Command {
 	sClientFactory := config.GetSvcatApp(p)
 	versionedInterface := config.GetServiceCatalogClient(p)
-	clientInterface := marketplace.NewClient(sClientFactory, versionedInterface)
+	kfV1alpha1Interface := config.GetKfClient(p)
+	servicePlanVisibilitiesGetter := provideServicePlanVisibilitiesGetter(kfV1alpha1Interface)
+	clientInterface := marketplace.NewClient(sClientFactory, versionedInterface, servicePlanVisibilitiesGetter)
 	command := services2.NewMarketplaceCommand(p, clientInterface)
 	return command
 }
@@ -286,9 +393,69 @@ func InjectVcapServices(p *config.KfParams) *cobra.Command {
 	return command
 }
 
+func InjectCreateServiceKey(p *config.KfParams) *cobra.Command {
+	versionedInterface := config.GetServiceCatalogClient(p)
+	keysClient := servicebindings.NewKeysClient(versionedInterface)
+	command := servicekeys.NewCreateServiceKeyCommand(p, keysClient)
+	return command
+}
+
+func InjectListServiceKeys(p *config.KfParams) *cobra.Command {
+	versionedInterface := config.GetServiceCatalogClient(p)
+	keysClient := servicebindings.NewKeysClient(versionedInterface)
+	command := servicekeys.NewListServiceKeysCommand(p, keysClient)
+	return command
+}
+
+func InjectGetServiceKey(p *config.KfParams) *cobra.Command {
+	versionedInterface := config.GetServiceCatalogClient(p)
+	keysClient := servicebindings.NewKeysClient(versionedInterface)
+	kubernetesInterface := config.GetKubernetes(p)
+	systemEnvInjector := cfutil.NewSystemEnvInjector(versionedInterface, kubernetesInterface)
+	command := servicekeys.NewGetServiceKeyCommand(p, keysClient, systemEnvInjector)
+	return command
+}
+
+func InjectDeleteServiceKey(p *config.KfParams) *cobra.Command {
+	versionedInterface := config.GetServiceCatalogClient(p)
+	keysClient := servicebindings.NewKeysClient(versionedInterface)
+	command := servicekeys.NewDeleteServiceKeyCommand(p, keysClient)
+	return command
+}
+
 func InjectCreateServiceBroker(p *config.KfParams) *cobra.Command {
 	versionedInterface := config.GetServiceCatalogClient(p)
-	command := servicebrokers.NewCreateServiceBrokerCommand(p, versionedInterface)
+	kubernetesInterface := config.GetKubernetes(p)
+	secretsGetter := provideSecretsGetter(kubernetesInterface)
+	command := servicebrokers.NewCreateServiceBrokerCommand(p, versionedInterface, secretsGetter)
+	return command
+}
+
+func InjectListServiceBrokers(p *config.KfParams) *cobra.Command {
+	versionedInterface := config.GetServiceCatalogClient(p)
+	command := servicebrokers.NewListServiceBrokersCommand(p, versionedInterface)
+	return command
+}
+
+func InjectUpdateServiceBroker(p *config.KfParams) *cobra.Command {
+	versionedInterface := config.GetServiceCatalogClient(p)
+	kubernetesInterface := config.GetKubernetes(p)
+	secretsGetter := provideSecretsGetter(kubernetesInterface)
+	command := servicebrokers.NewUpdateServiceBrokerCommand(p, versionedInterface, secretsGetter)
+	return command
+}
+
+func InjectEnableServiceAccess(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	servicePlanVisibilitiesGetter := provideServicePlanVisibilitiesGetter(kfV1alpha1Interface)
+	command := servicebrokers.NewEnableServiceAccessCommand(p, servicePlanVisibilitiesGetter)
+	return command
+}
+
+func InjectDisableServiceAccess(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	servicePlanVisibilitiesGetter := provideServicePlanVisibilitiesGetter(kfV1alpha1Interface)
+	command := servicebrokers.NewDisableServiceAccessCommand(p, servicePlanVisibilitiesGetter)
 	return command
 }
 
@@ -316,6 +483,32 @@ func InjectStacks(p *config.KfParams) *cobra.Command {
 	return command
 }
 
+func InjectOrganizations(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	organizationsGetter := provideKfOrganizations(kfV1alpha1Interface)
+	client := organizations.NewClient(organizationsGetter)
+	command := organizations2.NewListOrganizationsCommand(p, client)
+	return command
+}
+
+func InjectCreateOrganization(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	organizationsGetter := provideKfOrganizations(kfV1alpha1Interface)
+	client := organizations.NewClient(organizationsGetter)
+	command := organizations2.NewCreateOrganizationCommand(p, client)
+	return command
+}
+
+func InjectTarget(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	organizationsGetter := provideKfOrganizations(kfV1alpha1Interface)
+	client := organizations.NewClient(organizationsGetter)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	spacesClient := spaces.NewClient(spacesGetter)
+	command := NewTargetCommand(p, client, spacesClient)
+	return command
+}
+
 func InjectSpaces(p *config.KfParams) *cobra.Command {
 	kfV1alpha1Interface := config.GetKfClient(p)
 	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
@@ -328,7 +521,10 @@ func InjectSpace(p *config.KfParams) *cobra.Command {
 	kfV1alpha1Interface := config.GetKfClient(p)
 	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
 	client := spaces.NewClient(spacesGetter)
-	command := spaces2.NewGetSpaceCommand(p, client)
+	servingV1alpha1Interface := config.GetServingClient(p)
+	kubernetesInterface := config.GetKubernetes(p)
+	usageReporter := spaces.NewUsageReporter(kfV1alpha1Interface, servingV1alpha1Interface, kubernetesInterface)
+	command := spaces2.NewGetSpaceCommand(p, client, usageReporter)
 	return command
 }
 
@@ -336,7 +532,9 @@ func InjectCreateSpace(p *config.KfParams) *cobra.Command {
 	kfV1alpha1Interface := config.GetKfClient(p)
 	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
 	client := spaces.NewClient(spacesGetter)
-	command := spaces2.NewCreateSpaceCommand(p, client)
+	spaceTemplatesGetter := provideKfSpaceTemplates(kfV1alpha1Interface)
+	spacetemplatesClient := spacetemplates.NewClient(spaceTemplatesGetter)
+	command := spaces2.NewCreateSpaceCommand(p, client, spacetemplatesClient)
 	return command
 }
 
@@ -344,7 +542,10 @@ func InjectDeleteSpace(p *config.KfParams) *cobra.Command {
 	kfV1alpha1Interface := config.GetKfClient(p)
 	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
 	client := spaces.NewClient(spacesGetter)
-	command := spaces2.NewDeleteSpaceCommand(p, client)
+	versionedInterface := config.GetServiceCatalogClient(p)
+	servicecatalogV1beta1Interface := provideServicecatalogV1beta1(versionedInterface)
+	contentsLister := spaces.NewContentsLister(kfV1alpha1Interface, servicecatalogV1beta1Interface)
+	command := spaces2.NewDeleteSpaceCommand(p, client, contentsLister)
 	return command
 }
 
@@ -356,6 +557,30 @@ func InjectConfigSpace(p *config.KfParams) *cobra.Command {
 	return command
 }
 
+func InjectSetSpaceRole(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	client := spaces.NewClient(spacesGetter)
+	command := spaces2.NewSetSpaceRoleCommand(p, client)
+	return command
+}
+
+func InjectUnsetSpaceRole(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	client := spaces.NewClient(spacesGetter)
+	command := spaces2.NewUnsetSpaceRoleCommand(p, client)
+	return command
+}
+
+func InjectSpaceUsers(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	client := spaces.NewClient(spacesGetter)
+	command := spaces2.NewSpaceUsersCommand(p, client)
+	return command
+}
+
 func InjectUpdateQuota(p *config.KfParams) *cobra.Command {
 	kfV1alpha1Interface := config.GetKfClient(p)
 	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
@@ -380,6 +605,94 @@ func InjectDeleteQuota(p *config.KfParams) *cobra.Command {
 	return command
 }
 
+func InjectQuotaPlans(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	quotaPlansGetter := provideKfQuotaPlans(kfV1alpha1Interface)
+	client := quotaplans.NewClient(quotaPlansGetter)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	spacesClient := spaces.NewClient(spacesGetter)
+	command := quotas.NewListQuotaPlansCommand(p, client, spacesClient)
+	return command
+}
+
+func InjectCreateQuotaPlan(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	quotaPlansGetter := provideKfQuotaPlans(kfV1alpha1Interface)
+	client := quotaplans.NewClient(quotaPlansGetter)
+	command := quotas.NewCreateQuotaPlanCommand(p, client)
+	return command
+}
+
+func InjectUpdateQuotaPlan(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	quotaPlansGetter := provideKfQuotaPlans(kfV1alpha1Interface)
+	client := quotaplans.NewClient(quotaPlansGetter)
+	command := quotas.NewUpdateQuotaPlanCommand(p, client)
+	return command
+}
+
+func InjectSetSpaceQuota(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	client := spaces.NewClient(spacesGetter)
+	quotaPlansGetter := provideKfQuotaPlans(kfV1alpha1Interface)
+	quotaplansClient := quotaplans.NewClient(quotaPlansGetter)
+	command := quotas.NewSetSpaceQuotaCommand(p, client, quotaplansClient)
+	return command
+}
+
+func InjectUnsetSpaceQuota(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	client := spaces.NewClient(spacesGetter)
+	command := quotas.NewUnsetSpaceQuotaCommand(p, client)
+	return command
+}
+
+func InjectSecurityGroups(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	securityGroupsGetter := provideKfSecurityGroups(kfV1alpha1Interface)
+	client := securitygroups.NewClient(securityGroupsGetter)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	spacesClient := spaces.NewClient(spacesGetter)
+	command := securitygroups2.NewListSecurityGroupsCommand(p, client, spacesClient)
+	return command
+}
+
+func InjectCreateSecurityGroup(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	securityGroupsGetter := provideKfSecurityGroups(kfV1alpha1Interface)
+	client := securitygroups.NewClient(securityGroupsGetter)
+	command := securitygroups2.NewCreateSecurityGroupCommand(p, client)
+	return command
+}
+
+func InjectBindSecurityGroup(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	client := spaces.NewClient(spacesGetter)
+	securityGroupsGetter := provideKfSecurityGroups(kfV1alpha1Interface)
+	securitygroupsClient := securitygroups.NewClient(securityGroupsGetter)
+	command := securitygroups2.NewBindSecurityGroupCommand(p, client, securitygroupsClient)
+	return command
+}
+
+func InjectUnbindSecurityGroup(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	spacesGetter := provideKfSpaces(kfV1alpha1Interface)
+	client := spaces.NewClient(spacesGetter)
+	command := securitygroups2.NewUnbindSecurityGroupCommand(p, client)
+	return command
+}
+
+func InjectSpaceTemplates(p *config.KfParams) *cobra.Command {
+	kfV1alpha1Interface := config.GetKfClient(p)
+	spaceTemplatesGetter := provideKfSpaceTemplates(kfV1alpha1Interface)
+	client := spacetemplates.NewClient(spaceTemplatesGetter)
+	command := spaces2.NewListSpaceTemplatesCommand(p, client)
+	return command
+}
+
 func InjectRoutes(p *config.KfParams) *cobra.Command {
 	kfV1alpha1Interface := config.GetKfClient(p)
 	client := routes.NewClient(kfV1alpha1Interface)
@@ -473,8 +786,8 @@ func InjectNamesCommand(p *config.KfParams) *cobra.Command {
 
 // wire_injector.go:
 
-func provideSrcImageBuilder() apps2.SrcImageBuilder {
-	return apps2.SrcImageBuilderFunc(kontext.BuildImageWithFilter)
+func provideSrcImageBuilder(b sourceimage.Builder) apps2.SrcImageBuilder {
+	return apps2.SrcImageBuilderFunc(b.BuildImageWithFilter)
 }
 
 var AppsSet = wire.NewSet(
@@ -486,12 +799,36 @@ func provideAppsGetter(ki v1alpha1.KfV1alpha1Interface) v1alpha1.AppsGetter {
 	return ki
 }
 
+func provideRemoteImageWriter() buildpacks.RemoteImageWriter {
+	return remote.Write
+}
+
 func provideServiceInstancesGetter(sc versioned.Interface) v1beta1.ServiceInstancesGetter {
 	return sc.ServicecatalogV1beta1()
 }
 
+func provideSecretsGetter(k kubernetes.Interface) v1_2.SecretsGetter {
+	return k.CoreV1()
+}
+
+func provideEventsGetter(k kubernetes.Interface) v1_2.EventsGetter {
+	return k.CoreV1()
+}
+
+func provideServiceInstanceSharesGetter(ki v1alpha1.KfV1alpha1Interface) v1alpha1.ServiceInstanceSharesGetter {
+	return ki
+}
+
+func provideServicePlanVisibilitiesGetter(ki v1alpha1.KfV1alpha1Interface) v1alpha1.ServicePlanVisibilitiesGetter {
+	return ki
+}
+
 var ServicesSet = wire.NewSet(
-	provideServiceInstancesGetter, config.GetServiceCatalogClient, config.GetSvcatApp, marketplace.NewClient, services.NewClient,
+	provideServiceInstancesGetter,
+	provideSecretsGetter,
+	provideEventsGetter,
+	provideServiceInstanceSharesGetter,
+	provideServicePlanVisibilitiesGetter, config.GetServiceCatalogClient, config.GetSvcatApp, config.GetKubernetes, marketplace.NewClient, services.NewClient, services.NewUserProvidedClient, services.NewSharesClient,
 )
 
 /////////////////
@@ -501,12 +838,40 @@ func provideRemoteImageFetcher() buildpacks.RemoteImageFetcher {
 	return remote.Image
 }
 
+var OrganizationsSet = wire.NewSet(config.GetKfClient, provideKfOrganizations, organizations.NewClient)
+
+func provideKfOrganizations(ki v1alpha1.KfV1alpha1Interface) v1alpha1.OrganizationsGetter {
+	return ki
+}
+
 var SpacesSet = wire.NewSet(config.GetKfClient, provideKfSpaces, spaces.NewClient)
 
 func provideKfSpaces(ki v1alpha1.KfV1alpha1Interface) v1alpha1.SpacesGetter {
 	return ki
 }
 
+func provideServicecatalogV1beta1(sc versioned.Interface) v1beta1.ServicecatalogV1beta1Interface {
+	return sc.ServicecatalogV1beta1()
+}
+
+var QuotaPlansSet = wire.NewSet(config.GetKfClient, provideKfQuotaPlans, quotaplans.NewClient)
+
+func provideKfQuotaPlans(ki v1alpha1.KfV1alpha1Interface) v1alpha1.QuotaPlansGetter {
+	return ki
+}
+
+var SecurityGroupsSet = wire.NewSet(config.GetKfClient, provideKfSecurityGroups, securitygroups.NewClient)
+
+func provideKfSecurityGroups(ki v1alpha1.KfV1alpha1Interface) v1alpha1.SecurityGroupsGetter {
+	return ki
+}
+
+var SpaceTemplatesSet = wire.NewSet(config.GetKfClient, provideKfSpaceTemplates, spacetemplates.NewClient)
+
+func provideKfSpaceTemplates(ki v1alpha1.KfV1alpha1Interface) v1alpha1.SpaceTemplatesGetter {
+	return ki
+}
+
 var SourcesSet = wire.NewSet(config.GetKfClient, provideSourcesBuildTailer, provideKfSources, sources.NewClient)
 
 func provideKfSources(ki v1alpha1.KfV1alpha1Interface) v1alpha1.SourcesGetter {
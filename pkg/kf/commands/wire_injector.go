@@ -23,34 +23,44 @@ import (
 	scv1beta1 "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/typed/servicecatalog/v1beta1"
 	"github.com/google/kf/pkg/kf/apps"
 	"github.com/google/kf/pkg/kf/buildpacks"
+	"github.com/google/kf/pkg/kf/cfutil"
 	capps "github.com/google/kf/pkg/kf/commands/apps"
 	cbuildpacks "github.com/google/kf/pkg/kf/commands/buildpacks"
 	cbuilds "github.com/google/kf/pkg/kf/commands/builds"
 	ccompletion "github.com/google/kf/pkg/kf/commands/completion"
 	"github.com/google/kf/pkg/kf/commands/config"
+	corganizations "github.com/google/kf/pkg/kf/commands/organizations"
 	cquotas "github.com/google/kf/pkg/kf/commands/quotas"
 	croutes "github.com/google/kf/pkg/kf/commands/routes"
+	csecuritygroups "github.com/google/kf/pkg/kf/commands/securitygroups"
 	servicebindingscmd "github.com/google/kf/pkg/kf/commands/service-bindings"
 	servicebrokerscmd "github.com/google/kf/pkg/kf/commands/service-brokers"
+	servicekeyscmd "github.com/google/kf/pkg/kf/commands/service-keys"
 	servicescmd "github.com/google/kf/pkg/kf/commands/services"
 	cspaces "github.com/google/kf/pkg/kf/commands/spaces"
 	"github.com/google/kf/pkg/kf/istio"
 	kflogs "github.com/google/kf/pkg/kf/logs"
 	"github.com/google/kf/pkg/kf/marketplace"
+	"github.com/google/kf/pkg/kf/organizations"
+	"github.com/google/kf/pkg/kf/quotaplans"
 	"github.com/google/kf/pkg/kf/routeclaims"
 	"github.com/google/kf/pkg/kf/routes"
+	"github.com/google/kf/pkg/kf/securitygroups"
 	servicebindings "github.com/google/kf/pkg/kf/service-bindings"
 	"github.com/google/kf/pkg/kf/services"
+	"github.com/google/kf/pkg/kf/sourceimage"
 	"github.com/google/kf/pkg/kf/sources"
 	"github.com/google/kf/pkg/kf/spaces"
+	"github.com/google/kf/pkg/kf/spacetemplates"
 	"github.com/google/kf/third_party/knative-build/pkg/logs"
 	"github.com/google/wire"
-	"github.com/poy/kontext"
 	"github.com/spf13/cobra"
+	k8sclient "k8s.io/client-go/kubernetes"
+	k8scorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
-func provideSrcImageBuilder() capps.SrcImageBuilder {
-	return capps.SrcImageBuilderFunc(kontext.BuildImageWithFilter)
+func provideSrcImageBuilder(b sourceimage.Builder) capps.SrcImageBuilder {
+	return capps.SrcImageBuilderFunc(b.BuildImageWithFilter)
 }
 
 ///////////////////
@@ -72,6 +82,9 @@ func InjectPush(p *config.KfParams) *cobra.Command {
 	wire.Build(
 		capps.NewPushCommand,
 		provideSrcImageBuilder,
+		sourceimage.NewBuilder,
+		provideRemoteImageFetcher,
+		provideRemoteImageWriter,
 		servicebindings.NewClient,
 		config.GetServiceCatalogClient,
 		AppsSet,
@@ -92,7 +105,24 @@ func InjectApps(p *config.KfParams) *cobra.Command {
 }
 
 func InjectGetApp(p *config.KfParams) *cobra.Command {
-	wire.Build(capps.NewGetAppCommand, AppsSet)
+	wire.Build(
+		capps.NewGetAppCommand,
+		buildpacks.NewSBOMFetcher,
+		provideRemoteImageFetcher,
+		AppsSet,
+	)
+
+	return nil
+}
+
+func InjectAppUsage(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		capps.NewAppUsageCommand,
+		config.GetKfClient,
+		config.GetServingClient,
+		config.GetKubernetes,
+		spaces.NewUsageReporter,
+	)
 
 	return nil
 }
@@ -122,6 +152,21 @@ func InjectRestage(p *config.KfParams) *cobra.Command {
 	return nil
 }
 
+func provideRemoteImageWriter() buildpacks.RemoteImageWriter {
+	return remote.Write
+}
+
+func InjectRebase(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		capps.NewRebaseCommand,
+		buildpacks.NewRebaser,
+		provideRemoteImageFetcher,
+		provideRemoteImageWriter,
+		AppsSet,
+	)
+	return nil
+}
+
 func InjectProxy(p *config.KfParams) *cobra.Command {
 	wire.Build(
 		capps.NewProxyCommand,
@@ -171,18 +216,42 @@ func provideServiceInstancesGetter(sc servicecatalogclient.Interface) scv1beta1.
 	return sc.ServicecatalogV1beta1()
 }
 
+func provideSecretsGetter(k k8sclient.Interface) k8scorev1.SecretsGetter {
+	return k.CoreV1()
+}
+
+func provideEventsGetter(k k8sclient.Interface) k8scorev1.EventsGetter {
+	return k.CoreV1()
+}
+
+func provideServiceInstanceSharesGetter(ki kfv1alpha1.KfV1alpha1Interface) kfv1alpha1.ServiceInstanceSharesGetter {
+	return ki
+}
+
+func provideServicePlanVisibilitiesGetter(ki kfv1alpha1.KfV1alpha1Interface) kfv1alpha1.ServicePlanVisibilitiesGetter {
+	return ki
+}
+
 var ServicesSet = wire.NewSet(
 	provideServiceInstancesGetter,
+	provideSecretsGetter,
+	provideEventsGetter,
+	provideServiceInstanceSharesGetter,
+	provideServicePlanVisibilitiesGetter,
 	config.GetServiceCatalogClient,
 	config.GetSvcatApp,
+	config.GetKubernetes,
 	marketplace.NewClient,
 	services.NewClient,
+	services.NewUserProvidedClient,
+	services.NewSharesClient,
 )
 
 func InjectCreateService(p *config.KfParams) *cobra.Command {
 	wire.Build(
 		servicescmd.NewCreateServiceCommand,
 		ServicesSet,
+		config.GetKfClient,
 	)
 	return nil
 }
@@ -203,6 +272,31 @@ func InjectGetService(p *config.KfParams) *cobra.Command {
 	return nil
 }
 
+func InjectUpdateService(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicescmd.NewUpdateServiceCommand,
+		ServicesSet,
+		config.GetKfClient,
+	)
+	return nil
+}
+
+func InjectCreateUserProvidedService(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicescmd.NewCreateUserProvidedServiceCommand,
+		ServicesSet,
+	)
+	return nil
+}
+
+func InjectUpdateUserProvidedService(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicescmd.NewUpdateUserProvidedServiceCommand,
+		ServicesSet,
+	)
+	return nil
+}
+
 func InjectListServices(p *config.KfParams) *cobra.Command {
 	wire.Build(
 		servicescmd.NewListServicesCommand,
@@ -212,10 +306,29 @@ func InjectListServices(p *config.KfParams) *cobra.Command {
 	return nil
 }
 
+func InjectShareService(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicescmd.NewShareServiceCommand,
+		ServicesSet,
+		SpacesSet,
+	)
+	return nil
+}
+
+func InjectUnshareService(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicescmd.NewUnshareServiceCommand,
+		ServicesSet,
+		config.GetKfClient,
+	)
+	return nil
+}
+
 func InjectMarketplace(p *config.KfParams) *cobra.Command {
 	wire.Build(
 		servicescmd.NewMarketplaceCommand,
 		ServicesSet,
+		config.GetKfClient,
 	)
 	return nil
 }
@@ -256,6 +369,47 @@ func InjectVcapServices(p *config.KfParams) *cobra.Command {
 	return nil
 }
 
+//////////////////
+// Service Keys //
+//////////////////
+func InjectCreateServiceKey(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicekeyscmd.NewCreateServiceKeyCommand,
+		servicebindings.NewKeysClient,
+		config.GetServiceCatalogClient,
+	)
+	return nil
+}
+
+func InjectListServiceKeys(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicekeyscmd.NewListServiceKeysCommand,
+		servicebindings.NewKeysClient,
+		config.GetServiceCatalogClient,
+	)
+	return nil
+}
+
+func InjectGetServiceKey(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicekeyscmd.NewGetServiceKeyCommand,
+		servicebindings.NewKeysClient,
+		cfutil.NewSystemEnvInjector,
+		config.GetServiceCatalogClient,
+		config.GetKubernetes,
+	)
+	return nil
+}
+
+func InjectDeleteServiceKey(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicekeyscmd.NewDeleteServiceKeyCommand,
+		servicebindings.NewKeysClient,
+		config.GetServiceCatalogClient,
+	)
+	return nil
+}
+
 ///////////////////////
 // Service Brokers  //
 /////////////////////
@@ -263,6 +417,44 @@ func InjectCreateServiceBroker(p *config.KfParams) *cobra.Command {
 	wire.Build(
 		servicebrokerscmd.NewCreateServiceBrokerCommand,
 		config.GetServiceCatalogClient,
+		config.GetKubernetes,
+		provideSecretsGetter,
+	)
+	return nil
+}
+
+func InjectListServiceBrokers(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicebrokerscmd.NewListServiceBrokersCommand,
+		config.GetServiceCatalogClient,
+	)
+	return nil
+}
+
+func InjectUpdateServiceBroker(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicebrokerscmd.NewUpdateServiceBrokerCommand,
+		config.GetServiceCatalogClient,
+		config.GetKubernetes,
+		provideSecretsGetter,
+	)
+	return nil
+}
+
+func InjectEnableServiceAccess(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicebrokerscmd.NewEnableServiceAccessCommand,
+		config.GetKfClient,
+		provideServicePlanVisibilitiesGetter,
+	)
+	return nil
+}
+
+func InjectDisableServiceAccess(p *config.KfParams) *cobra.Command {
+	wire.Build(
+		servicebrokerscmd.NewDisableServiceAccessCommand,
+		config.GetKfClient,
+		provideServicePlanVisibilitiesGetter,
 	)
 	return nil
 }
@@ -306,6 +498,38 @@ func InjectStacks(p *config.KfParams) *cobra.Command {
 	return nil
 }
 
+///////////////////////////
+// Organizations Command //
+///////////////////////////
+
+var OrganizationsSet = wire.NewSet(config.GetKfClient, provideKfOrganizations, organizations.NewClient)
+
+func provideKfOrganizations(ki kfv1alpha1.KfV1alpha1Interface) kfv1alpha1.OrganizationsGetter {
+	return ki
+}
+
+func InjectOrganizations(p *config.KfParams) *cobra.Command {
+	wire.Build(corganizations.NewListOrganizationsCommand, OrganizationsSet)
+
+	return nil
+}
+
+func InjectCreateOrganization(p *config.KfParams) *cobra.Command {
+	wire.Build(corganizations.NewCreateOrganizationCommand, OrganizationsSet)
+
+	return nil
+}
+
+////////////////////
+// Target Command //
+////////////////////
+
+func InjectTarget(p *config.KfParams) *cobra.Command {
+	wire.Build(NewTargetCommand, SpacesSet, provideKfOrganizations, organizations.NewClient)
+
+	return nil
+}
+
 ////////////////////
 // Spaces Command //
 ////////////////////
@@ -316,6 +540,10 @@ func provideKfSpaces(ki kfv1alpha1.KfV1alpha1Interface) kfv1alpha1.SpacesGetter
 	return ki
 }
 
+func provideServicecatalogV1beta1(sc servicecatalogclient.Interface) scv1beta1.ServicecatalogV1beta1Interface {
+	return sc.ServicecatalogV1beta1()
+}
+
 func InjectSpaces(p *config.KfParams) *cobra.Command {
 	wire.Build(cspaces.NewListSpacesCommand, SpacesSet)
 
@@ -323,19 +551,31 @@ func InjectSpaces(p *config.KfParams) *cobra.Command {
 }
 
 func InjectSpace(p *config.KfParams) *cobra.Command {
-	wire.Build(cspaces.NewGetSpaceCommand, SpacesSet)
+	wire.Build(
+		cspaces.NewGetSpaceCommand,
+		SpacesSet,
+		config.GetServingClient,
+		config.GetKubernetes,
+		spaces.NewUsageReporter,
+	)
 
 	return nil
 }
 
 func InjectCreateSpace(p *config.KfParams) *cobra.Command {
-	wire.Build(cspaces.NewCreateSpaceCommand, SpacesSet)
+	wire.Build(cspaces.NewCreateSpaceCommand, SpacesSet, provideKfSpaceTemplates, spacetemplates.NewClient)
 
 	return nil
 }
 
 func InjectDeleteSpace(p *config.KfParams) *cobra.Command {
-	wire.Build(cspaces.NewDeleteSpaceCommand, SpacesSet)
+	wire.Build(
+		cspaces.NewDeleteSpaceCommand,
+		SpacesSet,
+		config.GetServiceCatalogClient,
+		provideServicecatalogV1beta1,
+		spaces.NewContentsLister,
+	)
 
 	return nil
 }
@@ -346,6 +586,24 @@ func InjectConfigSpace(p *config.KfParams) *cobra.Command {
 	return nil
 }
 
+func InjectSetSpaceRole(p *config.KfParams) *cobra.Command {
+	wire.Build(cspaces.NewSetSpaceRoleCommand, SpacesSet)
+
+	return nil
+}
+
+func InjectUnsetSpaceRole(p *config.KfParams) *cobra.Command {
+	wire.Build(cspaces.NewUnsetSpaceRoleCommand, SpacesSet)
+
+	return nil
+}
+
+func InjectSpaceUsers(p *config.KfParams) *cobra.Command {
+	wire.Build(cspaces.NewSpaceUsersCommand, SpacesSet)
+
+	return nil
+}
+
 ////////////////////
 // Quotas Command //
 ////////////////////
@@ -368,6 +626,92 @@ func InjectDeleteQuota(p *config.KfParams) *cobra.Command {
 	return nil
 }
 
+var QuotaPlansSet = wire.NewSet(config.GetKfClient, provideKfQuotaPlans, quotaplans.NewClient)
+
+func provideKfQuotaPlans(ki kfv1alpha1.KfV1alpha1Interface) kfv1alpha1.QuotaPlansGetter {
+	return ki
+}
+
+func InjectQuotaPlans(p *config.KfParams) *cobra.Command {
+	wire.Build(cquotas.NewListQuotaPlansCommand, SpacesSet, provideKfQuotaPlans, quotaplans.NewClient)
+
+	return nil
+}
+
+func InjectCreateQuotaPlan(p *config.KfParams) *cobra.Command {
+	wire.Build(cquotas.NewCreateQuotaPlanCommand, QuotaPlansSet)
+
+	return nil
+}
+
+func InjectUpdateQuotaPlan(p *config.KfParams) *cobra.Command {
+	wire.Build(cquotas.NewUpdateQuotaPlanCommand, QuotaPlansSet)
+
+	return nil
+}
+
+func InjectSetSpaceQuota(p *config.KfParams) *cobra.Command {
+	wire.Build(cquotas.NewSetSpaceQuotaCommand, SpacesSet, provideKfQuotaPlans, quotaplans.NewClient)
+
+	return nil
+}
+
+func InjectUnsetSpaceQuota(p *config.KfParams) *cobra.Command {
+	wire.Build(cquotas.NewUnsetSpaceQuotaCommand, SpacesSet)
+
+	return nil
+}
+
+/////////////////////
+// Security Groups //
+/////////////////////
+
+var SecurityGroupsSet = wire.NewSet(config.GetKfClient, provideKfSecurityGroups, securitygroups.NewClient)
+
+func provideKfSecurityGroups(ki kfv1alpha1.KfV1alpha1Interface) kfv1alpha1.SecurityGroupsGetter {
+	return ki
+}
+
+func InjectSecurityGroups(p *config.KfParams) *cobra.Command {
+	wire.Build(csecuritygroups.NewListSecurityGroupsCommand, SpacesSet, provideKfSecurityGroups, securitygroups.NewClient)
+
+	return nil
+}
+
+func InjectCreateSecurityGroup(p *config.KfParams) *cobra.Command {
+	wire.Build(csecuritygroups.NewCreateSecurityGroupCommand, SecurityGroupsSet)
+
+	return nil
+}
+
+func InjectBindSecurityGroup(p *config.KfParams) *cobra.Command {
+	wire.Build(csecuritygroups.NewBindSecurityGroupCommand, SpacesSet, provideKfSecurityGroups, securitygroups.NewClient)
+
+	return nil
+}
+
+func InjectUnbindSecurityGroup(p *config.KfParams) *cobra.Command {
+	wire.Build(csecuritygroups.NewUnbindSecurityGroupCommand, SpacesSet)
+
+	return nil
+}
+
+/////////////////////
+// Space Templates //
+/////////////////////
+
+var SpaceTemplatesSet = wire.NewSet(config.GetKfClient, provideKfSpaceTemplates, spacetemplates.NewClient)
+
+func provideKfSpaceTemplates(ki kfv1alpha1.KfV1alpha1Interface) kfv1alpha1.SpaceTemplatesGetter {
+	return ki
+}
+
+func InjectSpaceTemplates(p *config.KfParams) *cobra.Command {
+	wire.Build(cspaces.NewListSpaceTemplatesCommand, SpaceTemplatesSet)
+
+	return nil
+}
+
 ////////////
 // Routes //
 ///////////
@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	kfv1alpha1 "github.com/google/kf/pkg/apis/kf/v1alpha1"
 	"github.com/google/kf/pkg/kf/services"
+	"github.com/google/kf/pkg/kf/spaces"
 	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -223,6 +225,27 @@ func SourceSpec(w io.Writer, spec kfv1alpha1.SourceSpec) {
 	})
 }
 
+// SourceBuildMetadata describes the provenance of a built image.
+func SourceBuildMetadata(w io.Writer, metadata *kfv1alpha1.SourceBuildMetadata) {
+	SectionWriter(w, "Build Metadata", func(w io.Writer) {
+		if metadata == nil {
+			return
+		}
+
+		fmt.Fprintf(w, "Image Digest:\t%s\n", metadata.ImageDigest)
+		fmt.Fprintf(w, "Stack:\t%s\n", metadata.Stack)
+		fmt.Fprintf(w, "Source Digest:\t%s\n", metadata.SourceDigest)
+		fmt.Fprintf(w, "Builder Image Digest:\t%s\n", metadata.BuilderImageDigest)
+		fmt.Fprintf(w, "SBOM:\t%s\n", metadata.SBOM)
+
+		SectionWriter(w, "Buildpacks", func(w io.Writer) {
+			for _, bp := range metadata.Buildpacks {
+				fmt.Fprintf(w, "%s:\t%s\n", bp.ID, bp.Version)
+			}
+		})
+	})
+}
+
 // AppSpecInstances describes the scaling features of the app.
 func AppSpecInstances(w io.Writer, instances kfv1alpha1.AppSpecInstances) {
 
@@ -339,6 +362,64 @@ func ServiceInstance(w io.Writer, service *v1beta1.ServiceInstance) {
 
 		cond := services.LastStatusCondition(*service)
 		fmt.Fprintf(w, "Status:\t%s\n", cond.Reason)
+
+		lastOperation := services.GetLastOperation(*service)
+		fmt.Fprintf(w, "Last Operation:\t%s\n", lastOperation)
+		if lastOperation.Description != "" {
+			fmt.Fprintf(w, "Message:\t%s\n", lastOperation.Description)
+		}
+	})
+}
+
+// ServiceInstanceOperations prints out the operation history of a service
+// instance, oldest first.
+func ServiceInstanceOperations(w io.Writer, events []corev1.Event) {
+	SectionWriter(w, "Operations", func(w io.Writer) {
+		if len(events) == 0 {
+			return
+		}
+
+		fmt.Fprintln(w, "Age\tType\tReason\tMessage")
+		for _, event := range events {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				translateTimestampSince(event.LastTimestamp),
+				event.Type,
+				event.Reason,
+				event.Message,
+			)
+		}
+	})
+}
+
+// UserProvidedService prints out a user-provided service instance. Only the
+// names of the credentials are printed.
+func UserProvidedService(w io.Writer, service *services.UserProvidedService) {
+	SectionWriter(w, "Service Instance", func(w io.Writer) {
+		if service == nil {
+			return
+		}
+
+		fmt.Fprintf(w, "Name:\t%s\n", service.Name)
+		fmt.Fprintf(w, "Service:\t%s\n", kfv1alpha1.UserProvidedServiceClass)
+
+		if len(service.Tags) > 0 {
+			fmt.Fprintf(w, "Tags:\t%s\n", strings.Join(service.Tags, ", "))
+		}
+
+		if service.SyslogDrainURL != "" {
+			fmt.Fprintf(w, "Syslog Drain URL:\t%s\n", service.SyslogDrainURL)
+		}
+
+		if service.RouteServiceURL != "" {
+			fmt.Fprintf(w, "Route Service URL:\t%s\n", service.RouteServiceURL)
+		}
+
+		var keys []string
+		for key := range service.Credentials {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fmt.Fprintf(w, "Credentials:\t%s\n", strings.Join(keys, ", "))
 	})
 }
 
@@ -362,3 +443,64 @@ func RouteSpecFieldsList(w io.Writer, routes []kfv1alpha1.RouteSpecFields) {
 		})
 	})
 }
+
+// AppUsage prints the resources requested by a single app.
+func AppUsage(w io.Writer, usage *spaces.AppUsage) {
+	SectionWriter(w, "Usage", func(w io.Writer) {
+		if usage == nil {
+			return
+		}
+
+		fmt.Fprintf(w, "Revision:\t%s\n", usage.Revision)
+		fmt.Fprintf(w, "Instances:\t%d\n", usage.Instances)
+		fmt.Fprintf(w, "CPU per instance:\t%s\n", usage.CPU.String())
+		fmt.Fprintf(w, "Memory per instance:\t%s\n", usage.Memory.String())
+		fmt.Fprintf(w, "Total CPU:\t%s\n", usage.TotalCPU.String())
+		fmt.Fprintf(w, "Total memory:\t%s\n", usage.TotalMemory.String())
+	})
+}
+
+// AppUsageList prints a table of the resources requested by apps.
+func AppUsageList(w io.Writer, apps []spaces.AppUsage) {
+	SectionWriter(w, "Apps", func(w io.Writer) {
+		if len(apps) == 0 {
+			return
+		}
+
+		TabbedWriter(w, func(w io.Writer) {
+			fmt.Fprintln(w, "Name\tInstances\tCPU\tMemory\tTotal CPU\tTotal Memory")
+			for _, app := range apps {
+				fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n",
+					app.Name,
+					app.Instances,
+					app.CPU.String(),
+					app.Memory.String(),
+					app.TotalCPU.String(),
+					app.TotalMemory.String())
+			}
+		})
+	})
+}
+
+// SpaceUsage prints the resources requested by the apps in a space and
+// consumed by its builds.
+func SpaceUsage(w io.Writer, usage *spaces.SpaceUsage) {
+	SectionWriter(w, "Usage", func(w io.Writer) {
+		if usage == nil {
+			return
+		}
+
+		fmt.Fprintf(w, "Total CPU:\t%s\n", usage.TotalCPU.String())
+		fmt.Fprintf(w, "Total memory:\t%s\n", usage.TotalMemory.String())
+
+		AppUsageList(w, usage.Apps)
+
+		SectionWriter(w, "Builds", func(w io.Writer) {
+			builds := usage.Builds
+			fmt.Fprintf(w, "Builds:\t%d\n", builds.Builds)
+			fmt.Fprintf(w, "Seconds:\t%.0f\n", builds.Seconds)
+			fmt.Fprintf(w, "CPU seconds:\t%.0f\n", builds.CPUSeconds)
+			fmt.Fprintf(w, "Memory GiB seconds:\t%.0f\n", builds.MemoryGiBSeconds)
+		})
+	})
+}
@@ -16,12 +16,16 @@ package describe_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"testing"
 	"time"
 
 	kfv1alpha1 "github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/cfutil"
 	"github.com/google/kf/pkg/kf/describe"
+	"github.com/google/kf/pkg/kf/services"
+	"github.com/google/kf/pkg/kf/spaces"
 	"github.com/google/kf/pkg/kf/testutil"
 	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -345,6 +349,34 @@ func ExampleSourceSpec_dockerfile() {
 	//     Destination:      gcr.io/my-registry/my-image:latest
 }
 
+func ExampleSourceBuildMetadata_nil() {
+	describe.SourceBuildMetadata(os.Stdout, nil)
+
+	// Output: Build Metadata: <empty>
+}
+
+func ExampleSourceBuildMetadata() {
+	describe.SourceBuildMetadata(os.Stdout, &kfv1alpha1.SourceBuildMetadata{
+		ImageDigest:        "sha256:111",
+		Stack:              "gcr.io/run@sha256:222",
+		SourceDigest:       "sha256:333",
+		BuilderImageDigest: "sha256:444",
+		SBOM:               "gcr.io/my-app@sha256:111",
+		Buildpacks: []kfv1alpha1.SourceBuildpackMetadata{
+			{ID: "java", Version: "1.0"},
+		},
+	})
+
+	// Output: Build Metadata:
+	//   Image Digest:          sha256:111
+	//   Stack:                 gcr.io/run@sha256:222
+	//   Source Digest:         sha256:333
+	//   Builder Image Digest:  sha256:444
+	//   SBOM:                  gcr.io/my-app@sha256:111
+	//   Buildpacks:
+	//     java:  1.0
+}
+
 func ExampleHealthCheck_nil() {
 	describe.HealthCheck(os.Stdout, nil)
 
@@ -412,6 +444,22 @@ func ExampleServiceInstance_nil() {
 	// Output: Service Instance: <empty>
 }
 
+func ExampleUserProvidedService() {
+	describe.UserProvidedService(os.Stdout, &services.UserProvidedService{
+		Name:           "my-db",
+		Credentials:    cfutil.Credentials{"uri": json.RawMessage(`"mysql://example.com"`), "password": json.RawMessage(`"secret"`)},
+		Tags:           []string{"mysql", "relational"},
+		SyslogDrainURL: "syslog://logs.example.com",
+	})
+
+	// Output: Service Instance:
+	//   Name:              my-db
+	//   Service:           user-provided
+	//   Tags:              mysql, relational
+	//   Syslog Drain URL:  syslog://logs.example.com
+	//   Credentials:       password, uri
+}
+
 func ExampleServiceInstance() {
 	describe.ServiceInstance(os.Stdout, &v1beta1.ServiceInstance{
 		ObjectMeta: metav1.ObjectMeta{
@@ -440,5 +488,86 @@ func ExampleServiceInstance() {
 	//   Plan:     myplan
 	//   Parameters:
 	//     some: params
-	//   Status:  Ready
+	//   Status:          Ready
+	//   Last Operation:  create in progress
+}
+
+func ExampleServiceInstanceOperations() {
+	describe.ServiceInstanceOperations(os.Stdout, []corev1.Event{
+		{Type: "Normal", Reason: "Provisioning", Message: "The instance is being provisioned asynchronously"},
+		{Type: "Warning", Reason: "ProvisionCallFailed", Message: "Error provisioning: quota exceeded"},
+	})
+
+	// Output: Operations:
+	//   Age        Type     Reason               Message
+	//   <unknown>  Normal   Provisioning         The instance is being provisioned asynchronously
+	//   <unknown>  Warning  ProvisionCallFailed  Error provisioning: quota exceeded
+}
+
+func ExampleAppUsage() {
+	describe.AppUsage(os.Stdout, &spaces.AppUsage{
+		Name:        "my-app",
+		Revision:    "my-app-abc",
+		Instances:   3,
+		CPU:         resource.MustParse("500m"),
+		Memory:      resource.MustParse("1Gi"),
+		TotalCPU:    resource.MustParse("1500m"),
+		TotalMemory: resource.MustParse("3Gi"),
+	})
+
+	// Output: Usage:
+	//   Revision:             my-app-abc
+	//   Instances:            3
+	//   CPU per instance:     500m
+	//   Memory per instance:  1Gi
+	//   Total CPU:            1500m
+	//   Total memory:         3Gi
+}
+
+func ExampleSpaceUsage() {
+	describe.SpaceUsage(os.Stdout, &spaces.SpaceUsage{
+		Space: "my-space",
+		Apps: []spaces.AppUsage{{
+			Name:        "my-app",
+			Instances:   3,
+			CPU:         resource.MustParse("500m"),
+			Memory:      resource.MustParse("1Gi"),
+			TotalCPU:    resource.MustParse("1500m"),
+			TotalMemory: resource.MustParse("3Gi"),
+		}},
+		TotalCPU:    resource.MustParse("1500m"),
+		TotalMemory: resource.MustParse("3Gi"),
+		Builds: spaces.BuildUsage{
+			Builds:           2,
+			Seconds:          150,
+			CPUSeconds:       250,
+			MemoryGiBSeconds: 200,
+		},
+	})
+
+	// Output: Usage:
+	//   Total CPU:     1500m
+	//   Total memory:  3Gi
+	//   Apps:
+	//     Name    Instances  CPU   Memory  Total CPU  Total Memory
+	//     my-app  3          500m  1Gi     1500m      3Gi
+	//   Builds:
+	//     Builds:              2
+	//     Seconds:             150
+	//     CPU seconds:         250
+	//     Memory GiB seconds:  200
+}
+
+func ExampleSpaceUsage_empty() {
+	describe.SpaceUsage(os.Stdout, &spaces.SpaceUsage{Space: "my-space"})
+
+	// Output: Usage:
+	//   Total CPU:     0
+	//   Total memory:  0
+	//   Apps: <empty>
+	//   Builds:
+	//     Builds:              0
+	//     Seconds:             0
+	//     CPU seconds:         0
+	//     Memory GiB seconds:  0
 }
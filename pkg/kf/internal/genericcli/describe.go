@@ -16,6 +16,7 @@ package genericcli
 
 import (
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 
@@ -24,12 +25,17 @@ import (
 	utils "github.com/google/kf/pkg/kf/internal/utils/cli"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/dynamic"
 )
 
+// DescribeExtension prints type specific information about a resource after
+// its generic description.
+type DescribeExtension func(w io.Writer, resource *unstructured.Unstructured) error
+
 // NewDescribeCommand creates a describe command.
-func NewDescribeCommand(t Type, p *config.KfParams, client dynamic.Interface) *cobra.Command {
+func NewDescribeCommand(t Type, p *config.KfParams, client dynamic.Interface, extensions ...DescribeExtension) *cobra.Command {
 	printFlags := genericclioptions.NewPrintFlags("")
 	friendlyType := t.FriendlyName()
 	commandName := strings.ToLower(friendlyType)
@@ -52,7 +58,7 @@ func NewDescribeCommand(t Type, p *config.KfParams, client dynamic.Interface) *c
 			resourceName := args[0]
 			w := cmd.OutOrStdout()
 
-			// Print status messages to stderr so stdout is syntatically valid output
+			// Print status messages to stderr so stdout is syntactically valid output
 			// if the user wanted JSON, YAML, etc.
 			if t.Namespaced() {
 				fmt.Fprintf(cmd.ErrOrStderr(), "Getting %s %s in namespace: %s\n", friendlyType, resourceName, p.Namespace)
@@ -80,6 +86,13 @@ func NewDescribeCommand(t Type, p *config.KfParams, client dynamic.Interface) *c
 			}
 
 			describe.Unstructured(w, resource)
+
+			for _, extension := range extensions {
+				if err := extension(w, resource); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
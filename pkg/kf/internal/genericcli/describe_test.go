@@ -17,6 +17,8 @@ package genericcli
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"io"
 	"testing"
 
 	"github.com/google/kf/pkg/kf/commands/config"
@@ -116,11 +118,12 @@ func TestNewDescribeCommand(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		t       Type
-		args    []string
-		setup   func(*testing.T, *mocks)
-		wantOut string
-		wantErr error
+		t          Type
+		args       []string
+		extensions []DescribeExtension
+		setup      func(*testing.T, *mocks)
+		wantOut    string
+		wantErr    error
 	}{
 		"no params": {
 			t:       nsType,
@@ -171,6 +174,41 @@ Metadata:
   Namespace:  my-ns
 `,
 		},
+		"extensions": {
+			t:    clusterType,
+			args: []string{"some-object-name"},
+			extensions: []DescribeExtension{
+				func(w io.Writer, resource *unstructured.Unstructured) error {
+					fmt.Fprintf(w, "Extended: %s\n", resource.GetName())
+					return nil
+				},
+			},
+			setup: func(t *testing.T, mocks *mocks) {
+				obj := clusterType.NewUnstructured("", "some-object-name")
+				mocks.client = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), obj)
+			},
+			wantOut: `Getting Space some-object-name
+API Version:  kf.dev/v1alpha1
+Kind:         Space
+Metadata:
+  Name:  some-object-name
+Extended: some-object-name
+`,
+		},
+		"extension error": {
+			t:    clusterType,
+			args: []string{"some-object-name"},
+			extensions: []DescribeExtension{
+				func(w io.Writer, resource *unstructured.Unstructured) error {
+					return errors.New("some-error")
+				},
+			},
+			setup: func(t *testing.T, mocks *mocks) {
+				obj := clusterType.NewUnstructured("", "some-object-name")
+				mocks.client = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), obj)
+			},
+			wantErr: errors.New("some-error"),
+		},
 		"custom output": {
 			t:    clusterType,
 			args: []string{"some-object-name", "-o", "name"},
@@ -199,7 +237,7 @@ space.kf.dev/some-object-name
 			}
 
 			buf := new(bytes.Buffer)
-			cmd := NewDescribeCommand(tc.t, mocks.p, mocks.client)
+			cmd := NewDescribeCommand(tc.t, mocks.p, mocks.client, tc.extensions...)
 			cmd.SetOutput(buf)
 			cmd.SetArgs(tc.args)
 
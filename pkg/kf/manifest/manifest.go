@@ -101,9 +101,13 @@ type Manifest struct {
 	Applications []Application `json:"applications"`
 }
 
-// Dockerfile contains the path to a Dockerfile to build.
+// Dockerfile contains the path to a Dockerfile to build and the options
+// used to build it.
 type Dockerfile struct {
-	Path string `json:"path,omitempty"`
+	Path      string            `json:"path,omitempty"`
+	BuildArgs map[string]string `json:"build-args,omitempty"`
+	Target    string            `json:"target,omitempty"`
+	Secrets   []string          `json:"secrets,omitempty"`
 }
 
 // NewFromFile creates a Manifest from a manifest file.
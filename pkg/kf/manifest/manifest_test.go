@@ -153,6 +153,34 @@ applications:
 				},
 			},
 		},
+		"dockerfile build options": {
+			fileContent: `---
+applications:
+- name: MY-APP
+  dockerfile:
+    path: "foo/Dockerfile"
+    target: release
+    build-args:
+      VERSION: 1.2.3
+    secrets:
+    - npmrc
+`,
+			expected: &manifest.Manifest{
+				Applications: []manifest.Application{
+					{
+						Name: "MY-APP",
+						KfApplicationExtension: manifest.KfApplicationExtension{
+							Dockerfile: manifest.Dockerfile{
+								Path:      "foo/Dockerfile",
+								BuildArgs: map[string]string{"VERSION": "1.2.3"},
+								Target:    "release",
+								Secrets:   []string{"npmrc"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for tn, tc := range cases {
@@ -15,6 +15,8 @@
 package marketplace
 
 import (
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
 	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned"
 	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
@@ -50,10 +52,15 @@ type SClientFactory func(namespace string) servicecatalog.SvcatClient
 
 // NewClient creates a new client capable of interacting siwht service catalog
 // services.
-func NewClient(sclient SClientFactory, kclient servicecatalogclient.Interface) ClientInterface {
+func NewClient(
+	sclient SClientFactory,
+	kclient servicecatalogclient.Interface,
+	visibilities kfv1alpha1.ServicePlanVisibilitiesGetter,
+) ClientInterface {
 	return &Client{
 		createSvcatClient: sclient,
 		kclient:           kclient,
+		visibilities:      visibilities,
 	}
 }
 
@@ -61,6 +68,7 @@ func NewClient(sclient SClientFactory, kclient servicecatalogclient.Interface) C
 type Client struct {
 	createSvcatClient SClientFactory
 	kclient           servicecatalogclient.Interface
+	visibilities      kfv1alpha1.ServicePlanVisibilitiesGetter
 }
 
 // Marketplace lists available services and plans in the marketplace.
@@ -83,10 +91,66 @@ func (c *Client) Marketplace(namespace string) (*KfMarketplace, error) {
 		return nil, err
 	}
 
-	return &KfMarketplace{
-		Services: classes,
-		Plans:    plans,
-	}, nil
+	visibilities, err := c.listVisibilities()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterVisible(classes, plans, visibilities, namespace), nil
+}
+
+// filterVisible removes the cluster plans that aren't visible in the
+// namespace and the cluster services that have no visible plans left.
+func filterVisible(
+	classes []servicecatalog.Class,
+	plans []servicecatalog.Plan,
+	visibilities []v1alpha1.ServicePlanVisibility,
+	namespace string,
+) *KfMarketplace {
+	classesByID := make(map[string]servicecatalog.Class)
+	for _, class := range classes {
+		classesByID[class.GetName()] = class
+	}
+
+	hasPlans := make(map[string]bool)
+	hasVisiblePlans := make(map[string]bool)
+	out := &KfMarketplace{}
+	for _, plan := range plans {
+		hasPlans[plan.GetClassID()] = true
+
+		class, ok := classesByID[plan.GetClassID()]
+		if ok && plan.GetNamespace() == "" && !v1alpha1.IsServicePlanVisible(
+			visibilities,
+			class.GetServiceBrokerName(),
+			class.GetExternalName(),
+			plan.GetExternalName(),
+			namespace,
+		) {
+			continue
+		}
+
+		hasVisiblePlans[plan.GetClassID()] = true
+		out.Plans = append(out.Plans, plan)
+	}
+
+	for _, class := range classes {
+		if class.GetNamespace() == "" && hasPlans[class.GetName()] && !hasVisiblePlans[class.GetName()] {
+			continue
+		}
+
+		out.Services = append(out.Services, class)
+	}
+
+	return out
+}
+
+func (c *Client) listVisibilities() ([]v1alpha1.ServicePlanVisibility, error) {
+	list, err := c.visibilities.ServicePlanVisibilities().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
 }
 
 // BrokerName fetches the service broker name for a service.
@@ -120,6 +184,9 @@ type ListPlanOptions struct {
 	PlanName    string
 	ServiceName string
 	BrokerName  string
+
+	// Space hides cluster plans that aren't visible in the space if set.
+	Space string
 }
 
 // ListClusterPlans gets cluster-wide plans matching the given filter.
@@ -133,6 +200,13 @@ func (c *Client) ListClusterPlans(filter ListPlanOptions) ([]servicecatalogv1bet
 		return nil, err
 	}
 
+	var visibilities []v1alpha1.ServicePlanVisibility
+	if filter.Space != "" {
+		if visibilities, err = c.listVisibilities(); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, plan := range plans.Items {
 		if filter.PlanName != "" && filter.PlanName != plan.GetExternalName() {
 			continue
@@ -142,7 +216,7 @@ func (c *Client) ListClusterPlans(filter ListPlanOptions) ([]servicecatalogv1bet
 			continue
 		}
 
-		if filter.ServiceName != "" {
+		if filter.ServiceName != "" || filter.Space != "" {
 			class, err := c.kclient.ServicecatalogV1beta1().
 				ClusterServiceClasses().
 				Get(plan.Spec.ClusterServiceClassRef.Name, metav1.GetOptions{})
@@ -150,7 +224,17 @@ func (c *Client) ListClusterPlans(filter ListPlanOptions) ([]servicecatalogv1bet
 				return nil, err
 			}
 
-			if filter.ServiceName != class.Spec.ExternalName {
+			if filter.ServiceName != "" && filter.ServiceName != class.Spec.ExternalName {
+				continue
+			}
+
+			if filter.Space != "" && !v1alpha1.IsServicePlanVisible(
+				visibilities,
+				plan.Spec.ClusterServiceBrokerName,
+				class.Spec.ExternalName,
+				plan.GetExternalName(),
+				filter.Space,
+			) {
 				continue
 			}
 		}
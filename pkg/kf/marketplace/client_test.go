@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
 	fakescclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/fake"
 	"github.com/google/kf/pkg/kf/testutil"
 	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
@@ -84,7 +86,7 @@ func TestClient_Marketplace(t *testing.T) {
 				testutil.AssertEqual(t, "namespace", tc.Namespace, ns)
 
 				return fakeClient
-			}, nil)
+			}, nil, kffake.NewSimpleClientset().KfV1alpha1())
 
 			_, actualErr := client.Marketplace(tc.Namespace)
 			if tc.ExpectErr != nil || actualErr != nil {
@@ -136,7 +138,7 @@ func TestClient_BrokerName(t *testing.T) {
 			client := NewClient(func(ns string) servicecatalog.SvcatClient {
 				testutil.AssertEqual(t, "namespace", tc.Namespace, ns)
 				return fakeClient
-			}, nil)
+			}, nil, nil)
 
 			name, actualErr := client.BrokerName(expectedSvc)
 			if tc.ExpectErr != nil || actualErr != nil {
@@ -198,11 +200,23 @@ func TestClient_ListClusterPlans(t *testing.T) {
 		filter ListPlanOptions
 	}
 
+	restricted := &v1alpha1.ServicePlanVisibility{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "restricted",
+		},
+		Spec: v1alpha1.ServicePlanVisibilitySpec{
+			ServiceName: "db-service",
+			PlanName:    "free",
+			Spaces:      []string{"enabled-space"},
+		},
+	}
+
 	cases := map[string]struct {
-		setup   func(t *testing.T) *fakescclient.Clientset
-		args    args
-		want    []servicecatalogv1beta1.ClusterServicePlan
-		wantErr error
+		setup        func(t *testing.T) *fakescclient.Clientset
+		visibilities []runtime.Object
+		args         args
+		want         []servicecatalogv1beta1.ClusterServicePlan
+		wantErr      error
 	}{
 		"bad server call listing plans": {
 			setup: func(t *testing.T) *fakescclient.Clientset {
@@ -275,6 +289,31 @@ func TestClient_ListClusterPlans(t *testing.T) {
 			},
 			want: []v1beta1.ClusterServicePlan{plan},
 		},
+		"hidden in space": {
+			setup: func(t *testing.T) *fakescclient.Clientset {
+				return fakescclient.NewSimpleClientset(planList, class)
+			},
+			visibilities: []runtime.Object{restricted},
+			args: args{
+				filter: ListPlanOptions{
+					PlanName: "free",
+					Space:    "other-space",
+				},
+			},
+		},
+		"visible in enabled space": {
+			setup: func(t *testing.T) *fakescclient.Clientset {
+				return fakescclient.NewSimpleClientset(planList, class)
+			},
+			visibilities: []runtime.Object{restricted},
+			args: args{
+				filter: ListPlanOptions{
+					PlanName: "free",
+					Space:    "enabled-space",
+				},
+			},
+			want: []v1beta1.ClusterServicePlan{plan},
+		},
 	}
 	for tn, tc := range cases {
 		t.Run(tn, func(t *testing.T) {
@@ -286,7 +325,8 @@ func TestClient_ListClusterPlans(t *testing.T) {
 				kclient = tc.setup(t)
 			}
 			c := &Client{
-				kclient: kclient,
+				kclient:      kclient,
+				visibilities: kffake.NewSimpleClientset(tc.visibilities...).KfV1alpha1(),
 			}
 			actualList, actualErr := c.ListClusterPlans(tc.args.filter)
 			testutil.AssertErrorsEqual(t, tc.wantErr, actualErr)
@@ -433,3 +473,53 @@ func TestClient_ListNamespacedPlans(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterVisible(t *testing.T) {
+	newClass := func(name, externalName string) *servicecatalogv1beta1.ClusterServiceClass {
+		class := &servicecatalogv1beta1.ClusterServiceClass{}
+		class.Name = name
+		class.Spec.ClusterServiceBrokerName = "broker-a"
+		class.Spec.ExternalName = externalName
+		return class
+	}
+
+	newPlan := func(classID, externalName string) *servicecatalogv1beta1.ClusterServicePlan {
+		plan := &servicecatalogv1beta1.ClusterServicePlan{}
+		plan.Name = classID + "-" + externalName
+		plan.Spec.ClusterServiceClassRef.Name = classID
+		plan.Spec.ExternalName = externalName
+		return plan
+	}
+
+	classes := []servicecatalog.Class{
+		newClass("db-id", "db"),
+		newClass("cache-id", "cache"),
+	}
+	plans := []servicecatalog.Plan{
+		newPlan("db-id", "small"),
+		newPlan("db-id", "large"),
+		newPlan("cache-id", "default"),
+	}
+	visibilities := []v1alpha1.ServicePlanVisibility{
+		{Spec: v1alpha1.ServicePlanVisibilitySpec{ServiceName: "db", PlanName: "large", Spaces: []string{"prod"}}},
+		{Spec: v1alpha1.ServicePlanVisibilitySpec{ServiceName: "cache"}},
+	}
+
+	names := func(m *KfMarketplace) (services, plans []string) {
+		for _, s := range m.Services {
+			services = append(services, s.GetExternalName())
+		}
+		for _, p := range m.Plans {
+			plans = append(plans, p.GetExternalName())
+		}
+		return
+	}
+
+	services, visiblePlans := names(filterVisible(classes, plans, visibilities, "dev"))
+	testutil.AssertEqual(t, "dev services", []string{"db"}, services)
+	testutil.AssertEqual(t, "dev plans", []string{"small"}, visiblePlans)
+
+	services, visiblePlans = names(filterVisible(classes, plans, visibilities, "prod"))
+	testutil.AssertEqual(t, "prod services", []string{"db"}, services)
+	testutil.AssertEqual(t, "prod plans", []string{"small", "large"}, visiblePlans)
+}
@@ -0,0 +1,140 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package marketplace
+
+import (
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewServicePlanChecker creates a v1alpha1.ServicePlanChecker backed by the
+// cluster's ServicePlanVisibilities.
+func NewServicePlanChecker(
+	kclient servicecatalogclient.Interface,
+	visibilities kfv1alpha1.ServicePlanVisibilitiesGetter,
+) v1alpha1.ServicePlanChecker {
+	return &servicePlanChecker{
+		client: &Client{
+			kclient:      kclient,
+			visibilities: visibilities,
+		},
+	}
+}
+
+type servicePlanChecker struct {
+	client *Client
+}
+
+// IsPlanVisible implements v1alpha1.ServicePlanChecker. Only cluster plans
+// are restricted, namespaced plans already belong to the space. Cluster plans
+// can be referenced by their external names, external IDs or Kubernetes
+// names.
+func (c *servicePlanChecker) IsPlanVisible(ref servicecatalogv1beta1.PlanReference, space string) (bool, error) {
+	var (
+		plans []servicecatalogv1beta1.ClusterServicePlan
+		err   error
+	)
+
+	switch {
+	case ref.ClusterServicePlanName != "":
+		plans, err = c.getPlan(ref.ClusterServicePlanName)
+	case ref.ClusterServicePlanExternalID != "":
+		plans, err = c.listPlansByExternalID(ref.ClusterServicePlanExternalID)
+	case ref.ClusterServiceClassExternalName != "" && ref.ClusterServicePlanExternalName != "":
+		plans, err = c.client.ListClusterPlans(ListPlanOptions{
+			PlanName:    ref.ClusterServicePlanExternalName,
+			ServiceName: ref.ClusterServiceClassExternalName,
+		})
+	default:
+		return true, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	// Unknown plans are rejected by the service catalog with a better
+	// message.
+	if len(plans) == 0 {
+		return true, nil
+	}
+
+	visibilities, err := c.client.listVisibilities()
+	if err != nil {
+		return false, err
+	}
+
+	for _, plan := range plans {
+		class, err := c.client.kclient.ServicecatalogV1beta1().
+			ClusterServiceClasses().
+			Get(plan.Spec.ClusterServiceClassRef.Name, metav1.GetOptions{})
+		switch {
+		case apierrs.IsNotFound(err):
+			continue
+		case err != nil:
+			return false, err
+		}
+
+		if v1alpha1.IsServicePlanVisible(
+			visibilities,
+			plan.Spec.ClusterServiceBrokerName,
+			class.Spec.ExternalName,
+			plan.GetExternalName(),
+			space,
+		) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getPlan gets the cluster plan with the given Kubernetes name.
+func (c *servicePlanChecker) getPlan(name string) ([]servicecatalogv1beta1.ClusterServicePlan, error) {
+	plan, err := c.client.kclient.ServicecatalogV1beta1().
+		ClusterServicePlans().
+		Get(name, metav1.GetOptions{})
+	switch {
+	case apierrs.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return []servicecatalogv1beta1.ClusterServicePlan{*plan}, nil
+}
+
+// listPlansByExternalID gets the cluster plans with the given external ID.
+func (c *servicePlanChecker) listPlansByExternalID(id string) ([]servicecatalogv1beta1.ClusterServicePlan, error) {
+	list, err := c.client.kclient.ServicecatalogV1beta1().
+		ClusterServicePlans().
+		List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var plans []servicecatalogv1beta1.ClusterServicePlan
+	for _, plan := range list.Items {
+		if plan.Spec.ExternalID == id {
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans, nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package marketplace
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	fakescclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestServicePlanChecker_IsPlanVisible(t *testing.T) {
+	plan := &servicecatalogv1beta1.ClusterServicePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-service-free"},
+		Spec: servicecatalogv1beta1.ClusterServicePlanSpec{
+			ClusterServiceBrokerName: "broker-a",
+			CommonServicePlanSpec: servicecatalogv1beta1.CommonServicePlanSpec{
+				ExternalName: "free",
+				ExternalID:   "free-external-id",
+			},
+			ClusterServiceClassRef: servicecatalogv1beta1.ClusterObjectReference{
+				Name: "db-service-id",
+			},
+		},
+	}
+
+	class := &servicecatalogv1beta1.ClusterServiceClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-service-id"},
+		Spec: servicecatalogv1beta1.ClusterServiceClassSpec{
+			ClusterServiceBrokerName: "broker-a",
+			CommonServiceClassSpec: servicecatalogv1beta1.CommonServiceClassSpec{
+				ExternalName: "db-service",
+			},
+		},
+	}
+
+	restricted := &v1alpha1.ServicePlanVisibility{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+		Spec: v1alpha1.ServicePlanVisibilitySpec{
+			ServiceName: "db-service",
+			Spaces:      []string{"enabled-space"},
+		},
+	}
+
+	clusterRef := servicecatalogv1beta1.PlanReference{
+		ClusterServiceClassExternalName: "db-service",
+		ClusterServicePlanExternalName:  "free",
+	}
+
+	cases := map[string]struct {
+		ref          servicecatalogv1beta1.PlanReference
+		space        string
+		visibilities []runtime.Object
+		listErr      error
+		want         bool
+		wantErr      error
+	}{
+		"unrestricted": {
+			ref:   clusterRef,
+			space: "some-space",
+			want:  true,
+		},
+		"enabled in space": {
+			ref:          clusterRef,
+			space:        "enabled-space",
+			visibilities: []runtime.Object{restricted},
+			want:         true,
+		},
+		"disabled in space": {
+			ref:          clusterRef,
+			space:        "some-space",
+			visibilities: []runtime.Object{restricted},
+			want:         false,
+		},
+		"unknown plan": {
+			ref: servicecatalogv1beta1.PlanReference{
+				ClusterServiceClassExternalName: "db-service",
+				ClusterServicePlanExternalName:  "unknown",
+			},
+			space:        "some-space",
+			visibilities: []runtime.Object{restricted},
+			want:         true,
+		},
+		"enabled in space by name": {
+			ref: servicecatalogv1beta1.PlanReference{
+				ClusterServiceClassName: "db-service-id",
+				ClusterServicePlanName:  "db-service-free",
+			},
+			space:        "enabled-space",
+			visibilities: []runtime.Object{restricted},
+			want:         true,
+		},
+		"disabled in space by name": {
+			ref: servicecatalogv1beta1.PlanReference{
+				ClusterServiceClassName: "db-service-id",
+				ClusterServicePlanName:  "db-service-free",
+			},
+			space:        "some-space",
+			visibilities: []runtime.Object{restricted},
+			want:         false,
+		},
+		"unknown plan by name": {
+			ref: servicecatalogv1beta1.PlanReference{
+				ClusterServiceClassName: "db-service-id",
+				ClusterServicePlanName:  "unknown",
+			},
+			space:        "some-space",
+			visibilities: []runtime.Object{restricted},
+			want:         true,
+		},
+		"disabled in space by external ID": {
+			ref: servicecatalogv1beta1.PlanReference{
+				ClusterServiceClassExternalID: "db-service-external-id",
+				ClusterServicePlanExternalID:  "free-external-id",
+			},
+			space:        "some-space",
+			visibilities: []runtime.Object{restricted},
+			want:         false,
+		},
+		"namespaced plan": {
+			ref: servicecatalogv1beta1.PlanReference{
+				ServiceClassExternalName: "db-service",
+				ServicePlanExternalName:  "free",
+			},
+			space:        "some-space",
+			visibilities: []runtime.Object{restricted},
+			want:         true,
+		},
+		"list error": {
+			ref:     clusterRef,
+			space:   "some-space",
+			listErr: errors.New("some-error"),
+			wantErr: errors.New("some-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			sclient := fakescclient.NewSimpleClientset(plan, class)
+			if tc.listErr != nil {
+				sclient.PrependReactor("list", "clusterserviceplans", func(action clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, tc.listErr
+				})
+			}
+
+			checker := NewServicePlanChecker(sclient, kffake.NewSimpleClientset(tc.visibilities...).KfV1alpha1())
+			got, err := checker.IsPlanVisible(tc.ref, tc.space)
+			testutil.AssertErrorsEqual(t, tc.wantErr, err)
+			testutil.AssertEqual(t, "visible", tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organizations
+
+import (
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	cv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewOrganizationLister creates a v1alpha1.OrganizationLister backed by the
+// cluster's Organizations and Spaces.
+func NewOrganizationLister(kclient cv1alpha1.KfV1alpha1Interface) v1alpha1.OrganizationLister {
+	return &organizationLister{kclient: kclient}
+}
+
+type organizationLister struct {
+	kclient cv1alpha1.KfV1alpha1Interface
+}
+
+// GetOrganization implements v1alpha1.OrganizationLister.
+func (l *organizationLister) GetOrganization(name string) (*v1alpha1.Organization, error) {
+	return l.kclient.Organizations().Get(name, metav1.GetOptions{})
+}
+
+// ListOrganizationSpaces implements v1alpha1.OrganizationLister.
+func (l *organizationLister) ListOrganizationSpaces(name string) ([]v1alpha1.Space, error) {
+	list, err := l.kclient.Spaces().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var spaces []v1alpha1.Space
+	for _, space := range list.Items {
+		if space.Spec.Organization == name {
+			spaces = append(spaces, space)
+		}
+	}
+
+	return spaces, nil
+}
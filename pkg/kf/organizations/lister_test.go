@@ -0,0 +1,61 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organizations
+
+import (
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestOrganizationLister(t *testing.T) {
+	org := &v1alpha1.Organization{}
+	org.Name = "my-org"
+
+	newSpace := func(name, org string) *v1alpha1.Space {
+		space := &v1alpha1.Space{}
+		space.Name = name
+		space.Spec.Organization = org
+		return space
+	}
+
+	objects := []runtime.Object{
+		org,
+		newSpace("dev", "my-org"),
+		newSpace("prod", "my-org"),
+		newSpace("other", "other-org"),
+		newSpace("loose", ""),
+	}
+	lister := NewOrganizationLister(kffake.NewSimpleClientset(objects...).KfV1alpha1())
+
+	got, err := lister.GetOrganization("my-org")
+	testutil.AssertNil(t, "get error", err)
+	testutil.AssertEqual(t, "organization", "my-org", got.Name)
+
+	spaces, err := lister.ListOrganizationSpaces("my-org")
+	testutil.AssertNil(t, "list error", err)
+
+	var names []string
+	for _, space := range spaces {
+		names = append(names, space.Name)
+	}
+	testutil.AssertEqual(t, "spaces", []string{"dev", "prod"}, names)
+
+	_, err = lister.GetOrganization("missing")
+	testutil.AssertNotNil(t, "missing error", err)
+}
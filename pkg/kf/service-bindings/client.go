@@ -60,6 +60,11 @@ func (c *Client) List(opts ...ListOption) ([]servicecatalogv1beta1.ServiceBindin
 
 	var filtered []servicecatalogv1beta1.ServiceBinding
 	for _, binding := range bindings.Items {
+		// Service keys aren't bound to apps, they're listed separately.
+		if IsServiceKey(&binding) {
+			continue
+		}
+
 		if filterByServiceInstance && binding.Spec.InstanceRef.Name != cfg.ServiceInstance {
 			continue
 		}
@@ -117,6 +117,26 @@ func TestClient_List(t *testing.T) {
 				testutil.AssertEqual(t, "filtered item", mybinding, list[0])
 			},
 		},
+		"service keys are skipped": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.ClientInterface) {
+				appbinding := servicecatalogv1beta1.ServiceBinding{}
+				appbinding.Name = "bound-to-my-app"
+				appbinding.Labels = map[string]string{kfv1alpha1.NameLabel: "my-app"}
+
+				key := servicecatalogv1beta1.ServiceBinding{}
+				key.Name = "kf-key-my-service-my-key"
+				key.Labels = kfv1alpha1.ServiceKeyLabels("my-key")
+
+				deps.apiserver.EXPECT().
+					List(gomock.Any(), "default", gomock.Any(), gomock.Any()).
+					Return(&servicecatalogv1beta1.ServiceBindingList{Items: []servicecatalogv1beta1.ServiceBinding{appbinding, key}}, nil)
+
+				list, err := client.List()
+				testutil.AssertNil(t, "list err", err)
+				testutil.AssertEqual(t, "item count", 1, len(list))
+				testutil.AssertEqual(t, "filtered item", appbinding, list[0])
+			},
+		},
 		"instances get filtered by service": {
 			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.ClientInterface) {
 				mybinding := servicecatalogv1beta1.ServiceBinding{}
@@ -22,3 +22,10 @@ import servicebindings "github.com/google/kf/pkg/kf/service-bindings"
 type ClientInterface interface {
 	servicebindings.ClientInterface
 }
+
+//go:generate mockgen --package=fake --copyright_file ../../internal/tools/option-builder/LICENSE_HEADER --destination=fake_keys_client.go --mock_names=KeysClient=FakeKeysClient github.com/google/kf/pkg/kf/service-bindings/fake KeysClient
+
+// KeysClient is implemented by servicebindings.KeysClient.
+type KeysClient interface {
+	servicebindings.KeysClient
+}
@@ -0,0 +1,217 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebindings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	servicecatalog "github.com/poy/service-catalog/pkg/svcat/service-catalog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// KeysClient manages service keys. Service keys are ServiceBindings that
+// aren't owned by an App, their credentials are read directly by users.
+type KeysClient interface {
+	// Create creates a new service key for the service instance.
+	Create(namespace, instanceName, keyName string, params json.RawMessage) (*servicecatalogv1beta1.ServiceBinding, error)
+
+	// Get gets a service key of the service instance. A NotFound error is
+	// returned if the instance has no key with the name.
+	Get(namespace, instanceName, keyName string) (*servicecatalogv1beta1.ServiceBinding, error)
+
+	// List lists the service keys in the namespace. If instanceName is set
+	// only keys for that service instance are returned.
+	List(namespace, instanceName string) ([]servicecatalogv1beta1.ServiceBinding, error)
+
+	// Delete removes a service key from the service instance.
+	Delete(namespace, instanceName, keyName string) error
+
+	// WaitForReady waits for the broker to finish creating the service key
+	// and returns it. An error is returned if the broker failed to create it.
+	WaitForReady(ctx context.Context, namespace, instanceName, keyName string, interval time.Duration) (*servicecatalogv1beta1.ServiceBinding, error)
+}
+
+// NewKeysClient creates a new KeysClient.
+func NewKeysClient(svcatClient servicecatalogclient.Interface) KeysClient {
+	return &keysClient{
+		svcatClient: svcatClient,
+	}
+}
+
+type keysClient struct {
+	svcatClient servicecatalogclient.Interface
+}
+
+var _ KeysClient = (*keysClient)(nil)
+
+// Create implements KeysClient.Create.
+func (c *keysClient) Create(namespace, instanceName, keyName string, params json.RawMessage) (*servicecatalogv1beta1.ServiceBinding, error) {
+	var parameters interface{}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &parameters); err != nil {
+			return nil, fmt.Errorf("couldn't parse parameters: %v", err)
+		}
+	}
+
+	name := v1alpha1.ServiceKeyBindingName(instanceName, keyName)
+	binding := &servicecatalogv1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    v1alpha1.ServiceKeyLabels(keyName),
+		},
+		Spec: servicecatalogv1beta1.ServiceBindingSpec{
+			InstanceRef: servicecatalogv1beta1.LocalObjectReference{
+				Name: instanceName,
+			},
+			SecretName: name,
+			Parameters: servicecatalog.BuildParameters(parameters),
+		},
+	}
+
+	return c.svcatClient.
+		ServicecatalogV1beta1().
+		ServiceBindings(namespace).
+		Create(binding)
+}
+
+// Get implements KeysClient.Get.
+func (c *keysClient) Get(namespace, instanceName, keyName string) (*servicecatalogv1beta1.ServiceBinding, error) {
+	binding, err := c.svcatClient.
+		ServicecatalogV1beta1().
+		ServiceBindings(namespace).
+		Get(v1alpha1.ServiceKeyBindingName(instanceName, keyName), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Guard against App bindings that happen to have the same name.
+	if !IsServiceKey(binding) || binding.Spec.InstanceRef.Name != instanceName {
+		return nil, keyNotFound(instanceName, keyName)
+	}
+
+	return binding, nil
+}
+
+// List implements KeysClient.List.
+func (c *keysClient) List(namespace, instanceName string) ([]servicecatalogv1beta1.ServiceBinding, error) {
+	isKey, err := labels.NewRequirement(v1alpha1.ServiceKeyLabel, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := c.svcatClient.
+		ServicecatalogV1beta1().
+		ServiceBindings(namespace).
+		List(metav1.ListOptions{LabelSelector: labels.NewSelector().Add(*isKey).String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []servicecatalogv1beta1.ServiceBinding
+	for _, binding := range bindings.Items {
+		if instanceName != "" && binding.Spec.InstanceRef.Name != instanceName {
+			continue
+		}
+
+		filtered = append(filtered, binding)
+	}
+
+	return filtered, nil
+}
+
+// Delete implements KeysClient.Delete.
+func (c *keysClient) Delete(namespace, instanceName, keyName string) error {
+	// Get first so bindings that aren't service keys can't be removed.
+	if _, err := c.Get(namespace, instanceName, keyName); err != nil {
+		return err
+	}
+
+	return c.svcatClient.
+		ServicecatalogV1beta1().
+		ServiceBindings(namespace).
+		Delete(v1alpha1.ServiceKeyBindingName(instanceName, keyName), &metav1.DeleteOptions{})
+}
+
+// WaitForReady implements KeysClient.WaitForReady.
+func (c *keysClient) WaitForReady(ctx context.Context, namespace, instanceName, keyName string, interval time.Duration) (*servicecatalogv1beta1.ServiceBinding, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		binding, err := c.Get(namespace, instanceName, keyName)
+		if err != nil {
+			return nil, err
+		}
+
+		if done, err := keyReady(binding); done {
+			return binding, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return binding, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// keyReady checks if the broker has finished creating the service key.
+func keyReady(binding *servicecatalogv1beta1.ServiceBinding) (bool, error) {
+	if binding.Generation != binding.Status.ReconciledGeneration {
+		return false, nil
+	}
+
+	for _, cond := range binding.Status.Conditions {
+		switch {
+		case cond.Type == servicecatalogv1beta1.ServiceBindingConditionReady && cond.Status == servicecatalogv1beta1.ConditionTrue:
+			return true, nil
+		case cond.Type == servicecatalogv1beta1.ServiceBindingConditionFailed && cond.Status == servicecatalogv1beta1.ConditionTrue:
+			return true, fmt.Errorf("create failed, message: %s reason: %s", cond.Message, cond.Reason)
+		}
+	}
+
+	return false, nil
+}
+
+// IsServiceKey returns true if the ServiceBinding holds a service key rather
+// than a binding to an App.
+func IsServiceKey(binding *servicecatalogv1beta1.ServiceBinding) bool {
+	_, ok := binding.Labels[v1alpha1.ServiceKeyLabel]
+	return ok
+}
+
+// ServiceKeyName gets the name of the service key stored in the
+// ServiceBinding.
+func ServiceKeyName(binding *servicecatalogv1beta1.ServiceBinding) string {
+	return binding.Labels[v1alpha1.ServiceKeyLabel]
+}
+
+func keyNotFound(instanceName, keyName string) error {
+	return apierrors.NewNotFound(
+		schema.GroupResource{Resource: "service keys"},
+		fmt.Sprintf("%s/%s", instanceName, keyName),
+	)
+}
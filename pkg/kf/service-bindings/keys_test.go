@@ -0,0 +1,242 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicebindings_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	kfv1alpha1 "github.com/google/kf/pkg/apis/kf/v1alpha1"
+	testclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/fake"
+	servicebindings "github.com/google/kf/pkg/kf/service-bindings"
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type KeysApiTestCase struct {
+	Run func(t *testing.T, fakes fakeDependencies, client servicebindings.KeysClient)
+}
+
+func (tc *KeysApiTestCase) ExecuteTest(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cs := &testclient.Clientset{}
+	fakeApiServer := testutil.AddFakeReactor(cs, controller)
+
+	client := servicebindings.NewKeysClient(cs)
+	tc.Run(t, fakeDependencies{apiserver: fakeApiServer}, client)
+}
+
+func dummyKey(instanceName, keyName string) *servicecatalogv1beta1.ServiceBinding {
+	key := &servicecatalogv1beta1.ServiceBinding{}
+	key.Name = kfv1alpha1.ServiceKeyBindingName(instanceName, keyName)
+	key.Labels = kfv1alpha1.ServiceKeyLabels(keyName)
+	key.Spec.InstanceRef.Name = instanceName
+	return key
+}
+
+func TestKeysClient_Create(t *testing.T) {
+	cases := map[string]KeysApiTestCase{
+		"creates binding without owner": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				deps.apiserver.EXPECT().
+					Create(gomock.Any(), "custom-ns", gomock.Any()).
+					DoAndReturn(func(_ schema.GroupVersionResource, _ string, obj runtime.Object) (runtime.Object, error) {
+						binding := obj.(*servicecatalogv1beta1.ServiceBinding)
+						testutil.AssertEqual(t, "name", "kf-key-mydb-mykey", binding.Name)
+						testutil.AssertEqual(t, "labels", kfv1alpha1.ServiceKeyLabels("mykey"), binding.Labels)
+						testutil.AssertEqual(t, "owners", 0, len(binding.OwnerReferences))
+						testutil.AssertEqual(t, "instance", "mydb", binding.Spec.InstanceRef.Name)
+						testutil.AssertEqual(t, "secret", "kf-key-mydb-mykey", binding.Spec.SecretName)
+						testutil.AssertJSONEqual(t, `{"role":"admin"}`, string(binding.Spec.Parameters.Raw))
+						return binding, nil
+					})
+
+				_, err := client.Create("custom-ns", "mydb", "mykey", json.RawMessage(`{"role":"admin"}`))
+				testutil.AssertNil(t, "create err", err)
+			},
+		},
+		"invalid parameters": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				_, err := client.Create("custom-ns", "mydb", "mykey", json.RawMessage(`{`))
+				testutil.AssertErrorsEqual(t, errors.New("couldn't parse parameters: unexpected end of JSON input"), err)
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, tc.ExecuteTest)
+	}
+}
+
+func TestKeysClient_Get(t *testing.T) {
+	cases := map[string]KeysApiTestCase{
+		"returns key": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				deps.apiserver.EXPECT().
+					Get(gomock.Any(), "custom-ns", "kf-key-mydb-mykey").
+					Return(dummyKey("mydb", "mykey"), nil)
+
+				key, err := client.Get("custom-ns", "mydb", "mykey")
+				testutil.AssertNil(t, "get err", err)
+				testutil.AssertEqual(t, "key name", "mykey", servicebindings.ServiceKeyName(key))
+			},
+		},
+		"app bindings aren't keys": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				binding := &servicecatalogv1beta1.ServiceBinding{}
+				binding.Name = "kf-key-mydb-mykey"
+				binding.Labels = map[string]string{kfv1alpha1.NameLabel: "kf-key"}
+
+				deps.apiserver.EXPECT().
+					Get(gomock.Any(), "custom-ns", "kf-key-mydb-mykey").
+					Return(binding, nil)
+
+				_, err := client.Get("custom-ns", "mydb", "mykey")
+				testutil.AssertEqual(t, "not found", true, apierrors.IsNotFound(err))
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, tc.ExecuteTest)
+	}
+}
+
+func TestKeysClient_List(t *testing.T) {
+	cases := map[string]KeysApiTestCase{
+		"selects keys": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				deps.apiserver.EXPECT().
+					List(gomock.Any(), "custom-ns", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ schema.GroupVersionResource, _ string, _ schema.GroupVersionKind, selector labels.Selector) (runtime.Object, error) {
+						testutil.AssertEqual(t, "selector", kfv1alpha1.ServiceKeyLabel, selector.String())
+						return &servicecatalogv1beta1.ServiceBindingList{
+							Items: []servicecatalogv1beta1.ServiceBinding{
+								*dummyKey("mydb", "a"),
+								*dummyKey("otherdb", "b"),
+							},
+						}, nil
+					})
+
+				list, err := client.List("custom-ns", "")
+				testutil.AssertNil(t, "list err", err)
+				testutil.AssertEqual(t, "item count", 2, len(list))
+			},
+		},
+		"filters by instance": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				deps.apiserver.EXPECT().
+					List(gomock.Any(), "custom-ns", gomock.Any(), gomock.Any()).
+					Return(&servicecatalogv1beta1.ServiceBindingList{
+						Items: []servicecatalogv1beta1.ServiceBinding{
+							*dummyKey("mydb", "a"),
+							*dummyKey("otherdb", "b"),
+						},
+					}, nil)
+
+				list, err := client.List("custom-ns", "mydb")
+				testutil.AssertNil(t, "list err", err)
+				testutil.AssertEqual(t, "keys", []servicecatalogv1beta1.ServiceBinding{*dummyKey("mydb", "a")}, list)
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, tc.ExecuteTest)
+	}
+}
+
+func TestKeysClient_Delete(t *testing.T) {
+	cases := map[string]KeysApiTestCase{
+		"deletes key": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				deps.apiserver.EXPECT().
+					Get(gomock.Any(), "custom-ns", "kf-key-mydb-mykey").
+					Return(dummyKey("mydb", "mykey"), nil)
+				deps.apiserver.EXPECT().
+					Delete(gomock.Any(), "custom-ns", "kf-key-mydb-mykey")
+
+				err := client.Delete("custom-ns", "mydb", "mykey")
+				testutil.AssertNil(t, "delete err", err)
+			},
+		},
+		"missing key": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				deps.apiserver.EXPECT().
+					Get(gomock.Any(), "custom-ns", "kf-key-mydb-mykey").
+					Return(nil, errors.New("not-found"))
+
+				err := client.Delete("custom-ns", "mydb", "mykey")
+				testutil.AssertErrorsEqual(t, errors.New("not-found"), err)
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, tc.ExecuteTest)
+	}
+}
+
+func TestKeysClient_WaitForReady(t *testing.T) {
+	withCondition := func(conditionType servicecatalogv1beta1.ServiceBindingConditionType, message string) *servicecatalogv1beta1.ServiceBinding {
+		key := dummyKey("mydb", "mykey")
+		key.Status.Conditions = []servicecatalogv1beta1.ServiceBindingCondition{
+			{Type: conditionType, Status: servicecatalogv1beta1.ConditionTrue, Message: message, Reason: "SomeReason"},
+		}
+		return key
+	}
+
+	cases := map[string]KeysApiTestCase{
+		"waits until ready": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				gomock.InOrder(
+					deps.apiserver.EXPECT().
+						Get(gomock.Any(), "custom-ns", "kf-key-mydb-mykey").
+						Return(dummyKey("mydb", "mykey"), nil),
+					deps.apiserver.EXPECT().
+						Get(gomock.Any(), "custom-ns", "kf-key-mydb-mykey").
+						Return(withCondition(servicecatalogv1beta1.ServiceBindingConditionReady, ""), nil),
+				)
+
+				_, err := client.WaitForReady(context.Background(), "custom-ns", "mydb", "mykey", time.Millisecond)
+				testutil.AssertNil(t, "wait err", err)
+			},
+		},
+		"broker failure": {
+			Run: func(t *testing.T, deps fakeDependencies, client servicebindings.KeysClient) {
+				deps.apiserver.EXPECT().
+					Get(gomock.Any(), "custom-ns", "kf-key-mydb-mykey").
+					Return(withCondition(servicecatalogv1beta1.ServiceBindingConditionFailed, "quota exceeded"), nil)
+
+				_, err := client.WaitForReady(context.Background(), "custom-ns", "mydb", "mykey", time.Millisecond)
+				testutil.AssertErrorsEqual(t, errors.New("create failed, message: quota exceeded reason: SomeReason"), err)
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, tc.ExecuteTest)
+	}
+}
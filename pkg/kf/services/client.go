@@ -27,6 +27,7 @@ import (
 // ClientExtension holds additional functions that should be exposed by client.
 type ClientExtension interface {
 	WaitForProvisionSuccess(ctx context.Context, namespace string, name string, interval time.Duration) (instance *v1beta1.ServiceInstance, err error)
+	WaitForUpdateSuccess(ctx context.Context, namespace string, name string, interval time.Duration) (instance *v1beta1.ServiceInstance, err error)
 }
 
 // NewClient creates a new service client.
@@ -41,9 +42,25 @@ func (core *coreClient) WaitForProvisionSuccess(ctx context.Context, namespace s
 	return core.WaitForE(ctx, namespace, name, interval, ProvisionSuccess)
 }
 
+// WaitForUpdateSuccess is a utility function that combines WaitForE with UpdateSuccess.
+func (core *coreClient) WaitForUpdateSuccess(ctx context.Context, namespace string, name string, interval time.Duration) (instance *v1beta1.ServiceInstance, err error) {
+	return core.WaitForE(ctx, namespace, name, interval, UpdateSuccess)
+}
+
 // ProvisionSuccess implements ConditionFuncE and can be used to wait until an
 // instance is successfully provisioned or fails.
 func ProvisionSuccess(obj *v1beta1.ServiceInstance, err error) (bool, error) {
+	return operationSuccess("provision", obj, err)
+}
+
+// UpdateSuccess implements ConditionFuncE and can be used to wait until a
+// change to an instance's plan or parameters is applied by the broker or
+// fails.
+func UpdateSuccess(obj *v1beta1.ServiceInstance, err error) (bool, error) {
+	return operationSuccess("update", obj, err)
+}
+
+func operationSuccess(operation string, obj *v1beta1.ServiceInstance, err error) (bool, error) {
 	if err != nil {
 		return true, err
 	}
@@ -67,7 +84,7 @@ func ProvisionSuccess(obj *v1beta1.ServiceInstance, err error) (bool, error) {
 
 	for _, cond := range ExtractConditions(obj) {
 		if cond.Type == apis.ConditionType(v1beta1.ServiceBindingConditionFailed) && cond.IsTrue() {
-			return true, fmt.Errorf("provision failed, message: %s reason: %s", cond.Message, cond.Reason)
+			return true, fmt.Errorf("%s failed, message: %s reason: %s", operation, cond.Message, cond.Reason)
 		}
 
 		if cond.Type == apis.ConditionType(v1beta1.ServiceBindingConditionReady) && cond.IsTrue() {
@@ -20,6 +20,7 @@ import (
 
 	"github.com/google/kf/pkg/kf/testutil"
 	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestProvisionSuccess(t *testing.T) {
@@ -108,3 +109,62 @@ func TestProvisionSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateSuccess(t *testing.T) {
+	cases := map[string]struct {
+		obj      *v1beta1.ServiceInstance
+		wantDone bool
+		wantErr  error
+	}{
+		"old generation": {
+			obj: &v1beta1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: v1beta1.ServiceInstanceStatus{
+					ObservedGeneration: 1,
+					Conditions: []v1beta1.ServiceInstanceCondition{
+						{Type: v1beta1.ServiceInstanceConditionReady, Status: v1beta1.ConditionTrue},
+					},
+				},
+			},
+			wantDone: false,
+		},
+		"updated": {
+			obj: &v1beta1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: v1beta1.ServiceInstanceStatus{
+					ObservedGeneration: 2,
+					Conditions: []v1beta1.ServiceInstanceCondition{
+						{Type: v1beta1.ServiceInstanceConditionReady, Status: v1beta1.ConditionTrue},
+					},
+				},
+			},
+			wantDone: true,
+		},
+		"broker rejected update": {
+			obj: &v1beta1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: v1beta1.ServiceInstanceStatus{
+					ObservedGeneration: 2,
+					Conditions: []v1beta1.ServiceInstanceCondition{
+						{
+							Type:    v1beta1.ServiceInstanceConditionFailed,
+							Status:  v1beta1.ConditionTrue,
+							Message: "plan change not supported",
+							Reason:  "UpdateInstanceCallFailed",
+						},
+					},
+				},
+			},
+			wantDone: true,
+			wantErr:  errors.New("update failed, message: plan change not supported reason: UpdateInstanceCallFailed"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			actualDone, actualErr := UpdateSuccess(tc.obj, nil)
+			testutil.AssertErrorsEqual(t, tc.wantErr, actualErr)
+			testutil.AssertEqual(t, "done", tc.wantDone, actualDone)
+		})
+	}
+}
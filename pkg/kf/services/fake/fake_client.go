@@ -239,3 +239,18 @@ func (mr *FakeClientMockRecorder) WaitForProvisionSuccess(arg0, arg1, arg2, arg3
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForProvisionSuccess", reflect.TypeOf((*FakeClient)(nil).WaitForProvisionSuccess), arg0, arg1, arg2, arg3)
 }
+
+// WaitForUpdateSuccess mocks base method
+func (m *FakeClient) WaitForUpdateSuccess(arg0 context.Context, arg1, arg2 string, arg3 time.Duration) (*v1beta1.ServiceInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForUpdateSuccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1beta1.ServiceInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForUpdateSuccess indicates an expected call of WaitForUpdateSuccess
+func (mr *FakeClientMockRecorder) WaitForUpdateSuccess(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForUpdateSuccess", reflect.TypeOf((*FakeClient)(nil).WaitForUpdateSuccess), arg0, arg1, arg2, arg3)
+}
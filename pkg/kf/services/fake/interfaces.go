@@ -22,3 +22,17 @@ import "github.com/google/kf/pkg/kf/services"
 type Client interface {
 	services.Client
 }
+
+//go:generate mockgen --package=fake --destination=fake_user_provided_client.go --copyright_file ../../internal/tools/option-builder/LICENSE_HEADER --mock_names=UserProvidedClient=FakeUserProvidedClient github.com/google/kf/pkg/kf/services/fake UserProvidedClient
+
+// UserProvidedClient is implemented by services.UserProvidedClient.
+type UserProvidedClient interface {
+	services.UserProvidedClient
+}
+
+//go:generate mockgen --package=fake --destination=fake_shares_client.go --copyright_file ../../internal/tools/option-builder/LICENSE_HEADER --mock_names=SharesClient=FakeSharesClient github.com/google/kf/pkg/kf/services/fake SharesClient
+
+// SharesClient is implemented by services.SharesClient.
+type SharesClient interface {
+	services.SharesClient
+}
@@ -0,0 +1,137 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// Operation types and states mirror the last_operation CF reports for
+// service instances.
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+
+	OperationInProgress = "in progress"
+	OperationSucceeded  = "succeeded"
+	OperationFailed     = "failed"
+)
+
+// LastOperation is the most recent operation the broker ran on a service
+// instance.
+type LastOperation struct {
+	// Type is one of create, update or delete.
+	Type string
+
+	// State is one of in progress, succeeded or failed.
+	State string
+
+	// Description is the message the broker or Service Catalog gave for the
+	// operation.
+	Description string
+}
+
+// String implements fmt.Stringer.
+func (op LastOperation) String() string {
+	return fmt.Sprintf("%s %s", op.Type, op.State)
+}
+
+// GetLastOperation derives the last operation of the service instance from
+// its Service Catalog status.
+func GetLastOperation(si v1beta1.ServiceInstance) LastOperation {
+	op := LastOperation{
+		Type:        lastOperationType(si),
+		State:       OperationInProgress,
+		Description: LastStatusCondition(si).Message,
+	}
+
+	// Conditions are stale until Service Catalog has seen the latest spec.
+	if si.Status.AsyncOpInProgress ||
+		si.Status.CurrentOperation != "" ||
+		si.Generation != si.Status.ReconciledGeneration {
+		return op
+	}
+
+	for _, cond := range si.Status.Conditions {
+		if cond.Status != v1beta1.ConditionTrue {
+			continue
+		}
+
+		switch cond.Type {
+		case v1beta1.ServiceInstanceConditionFailed:
+			op.State = OperationFailed
+			op.Description = cond.Message
+			return op
+		case v1beta1.ServiceInstanceConditionReady:
+			op.State = OperationSucceeded
+		}
+	}
+
+	return op
+}
+
+func lastOperationType(si v1beta1.ServiceInstance) string {
+	switch {
+	case si.DeletionTimestamp != nil,
+		si.Status.CurrentOperation == v1beta1.ServiceInstanceOperationDeprovision:
+		return OperationDelete
+	case si.Status.CurrentOperation == v1beta1.ServiceInstanceOperationUpdate,
+		si.Status.CurrentOperation == "" && si.Generation > 1:
+		return OperationUpdate
+	default:
+		return OperationCreate
+	}
+}
+
+// ListOperations gets the operation history of a service instance, oldest
+// first. The history comes from the events Service Catalog records while it
+// talks to the broker so it only goes back as far as the cluster keeps events.
+func ListOperations(events v1.EventsGetter, namespace, instanceName string) ([]corev1.Event, error) {
+	selector := fields.Set{
+		"involvedObject.kind": "ServiceInstance",
+		"involvedObject.name": instanceName,
+	}
+
+	list, err := events.Events(namespace).List(metav1.ListOptions{
+		FieldSelector: selector.AsSelector().String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []corev1.Event
+	for _, event := range list.Items {
+		// Not all API servers support field selectors on events.
+		if event.InvolvedObject.Kind != "ServiceInstance" || event.InvolvedObject.Name != instanceName {
+			continue
+		}
+
+		out = append(out, event)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].LastTimestamp.Before(&out[j].LastTimestamp)
+	})
+
+	return out, nil
+}
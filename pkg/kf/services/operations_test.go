@@ -0,0 +1,151 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/kf/pkg/kf/testutil"
+	"github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetLastOperation(t *testing.T) {
+	t.Parallel()
+
+	condition := func(conditionType v1beta1.ServiceInstanceConditionType, message string) v1beta1.ServiceInstanceCondition {
+		return v1beta1.ServiceInstanceCondition{
+			Type:    conditionType,
+			Status:  v1beta1.ConditionTrue,
+			Message: message,
+		}
+	}
+
+	cases := map[string]struct {
+		instance v1beta1.ServiceInstance
+		expected LastOperation
+	}{
+		"no status": {
+			expected: LastOperation{Type: OperationCreate, State: OperationInProgress},
+		},
+		"provisioning": {
+			instance: v1beta1.ServiceInstance{
+				Status: v1beta1.ServiceInstanceStatus{
+					AsyncOpInProgress: true,
+					CurrentOperation:  v1beta1.ServiceInstanceOperationProvision,
+					Conditions: []v1beta1.ServiceInstanceCondition{
+						{Type: v1beta1.ServiceInstanceConditionReady, Status: v1beta1.ConditionFalse, Message: "The instance is being provisioned asynchronously"},
+					},
+				},
+			},
+			expected: LastOperation{Type: OperationCreate, State: OperationInProgress, Description: "The instance is being provisioned asynchronously"},
+		},
+		"provisioned": {
+			instance: v1beta1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status: v1beta1.ServiceInstanceStatus{
+					ReconciledGeneration: 1,
+					Conditions: []v1beta1.ServiceInstanceCondition{
+						condition(v1beta1.ServiceInstanceConditionReady, "The instance was provisioned successfully"),
+					},
+				},
+			},
+			expected: LastOperation{Type: OperationCreate, State: OperationSucceeded, Description: "The instance was provisioned successfully"},
+		},
+		"update not yet seen": {
+			instance: v1beta1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: v1beta1.ServiceInstanceStatus{
+					ReconciledGeneration: 1,
+					Conditions: []v1beta1.ServiceInstanceCondition{
+						condition(v1beta1.ServiceInstanceConditionReady, "The instance was provisioned successfully"),
+					},
+				},
+			},
+			expected: LastOperation{Type: OperationUpdate, State: OperationInProgress, Description: "The instance was provisioned successfully"},
+		},
+		"update failed": {
+			instance: v1beta1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: v1beta1.ServiceInstanceStatus{
+					ReconciledGeneration: 2,
+					Conditions: []v1beta1.ServiceInstanceCondition{
+						condition(v1beta1.ServiceInstanceConditionReady, "The instance was provisioned successfully"),
+						condition(v1beta1.ServiceInstanceConditionFailed, "plan change not supported"),
+					},
+				},
+			},
+			expected: LastOperation{Type: OperationUpdate, State: OperationFailed, Description: "plan change not supported"},
+		},
+		"deprovisioning": {
+			instance: v1beta1.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &metav1.Time{Time: time.Now()}},
+				Status: v1beta1.ServiceInstanceStatus{
+					CurrentOperation: v1beta1.ServiceInstanceOperationDeprovision,
+				},
+			},
+			expected: LastOperation{Type: OperationDelete, State: OperationInProgress},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			testutil.AssertEqual(t, "last operation", tc.expected, GetLastOperation(tc.instance))
+		})
+	}
+}
+
+func TestListOperations(t *testing.T) {
+	t.Parallel()
+
+	event := func(name, kind, object string, at time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "custom-ns"},
+			InvolvedObject: corev1.ObjectReference{
+				Kind:      kind,
+				Name:      object,
+				Namespace: "custom-ns",
+			},
+			LastTimestamp: metav1.Time{Time: at},
+		}
+	}
+
+	now := time.Now()
+	events := k8sfake.NewSimpleClientset(
+		event("provisioned", "ServiceInstance", "mydb", now),
+		event("provisioning", "ServiceInstance", "mydb", now.Add(-time.Minute)),
+		event("other-instance", "ServiceInstance", "otherdb", now),
+		event("same-name-binding", "ServiceBinding", "mydb", now),
+	).CoreV1()
+
+	operations, err := ListOperations(events, "custom-ns", "mydb")
+	testutil.AssertNil(t, "err", err)
+
+	var names []string
+	for _, op := range operations {
+		names = append(names, op.Name)
+	}
+	testutil.AssertEqual(t, "operations", []string{"provisioning", "provisioned"}, names)
+}
+
+func ExampleLastOperation_String() {
+	fmt.Println(LastOperation{Type: OperationCreate, State: OperationSucceeded})
+
+	// Output: create succeeded
+}
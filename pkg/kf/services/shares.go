@@ -0,0 +1,142 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SharesClient manages the ServiceInstanceShares that let Apps in other
+// spaces bind to a service instance.
+type SharesClient interface {
+	// Share grants space access to the service instance in namespace.
+	Share(namespace, instanceName, space string) error
+
+	// Unshare revokes the access of space to the service instance in
+	// namespace. Bindings Apps in space have to the instance are removed.
+	Unshare(namespace, instanceName, space string) error
+
+	// List lists the shares of service instances in the namespace.
+	List(namespace string) ([]v1alpha1.ServiceInstanceShare, error)
+
+	// ListSharedWith lists the shares of service instances in other spaces
+	// that grant space access.
+	ListSharedWith(space string) ([]v1alpha1.ServiceInstanceShare, error)
+}
+
+type sharesClient struct {
+	shares kfv1alpha1.ServiceInstanceSharesGetter
+}
+
+// NewSharesClient creates a new SharesClient.
+func NewSharesClient(shares kfv1alpha1.ServiceInstanceSharesGetter) SharesClient {
+	return &sharesClient{
+		shares: shares,
+	}
+}
+
+// Share implements SharesClient.
+func (c *sharesClient) Share(namespace, instanceName, space string) error {
+	share := &v1alpha1.ServiceInstanceShare{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.ServiceInstanceShareName(instanceName, space),
+			Namespace: namespace,
+			Labels:    v1alpha1.ServiceInstanceShareLabels(instanceName, space),
+		},
+		Spec: v1alpha1.ServiceInstanceShareSpec{
+			InstanceName: instanceName,
+			Space:        space,
+		},
+	}
+
+	_, err := c.shares.ServiceInstanceShares(namespace).Create(share)
+	switch {
+	case apierrors.IsAlreadyExists(err):
+		return fmt.Errorf("service instance %q is already shared with space %q", instanceName, space)
+	case err != nil:
+		return fmt.Errorf("couldn't share service instance %q with space %q: %v", instanceName, space, err)
+	}
+
+	return nil
+}
+
+// Unshare implements SharesClient.
+func (c *sharesClient) Unshare(namespace, instanceName, space string) error {
+	err := c.shares.
+		ServiceInstanceShares(namespace).
+		Delete(v1alpha1.ServiceInstanceShareName(instanceName, space), &metav1.DeleteOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return fmt.Errorf("service instance %q isn't shared with space %q", instanceName, space)
+	case err != nil:
+		return fmt.Errorf("couldn't unshare service instance %q from space %q: %v", instanceName, space, err)
+	}
+
+	return nil
+}
+
+// List implements SharesClient.
+func (c *sharesClient) List(namespace string) ([]v1alpha1.ServiceInstanceShare, error) {
+	return c.list(namespace, labels.Everything())
+}
+
+// ListSharedWith implements SharesClient.
+func (c *sharesClient) ListSharedWith(space string) ([]v1alpha1.ServiceInstanceShare, error) {
+	selector := labels.SelectorFromSet(labels.Set{
+		v1alpha1.ServiceInstanceShareSpaceLabel: space,
+	})
+
+	// Shares live in the space of the instance, so they're listed across all
+	// namespaces.
+	return c.list(metav1.NamespaceAll, selector)
+}
+
+func (c *sharesClient) list(namespace string, selector labels.Selector) ([]v1alpha1.ServiceInstanceShare, error) {
+	shares, err := c.shares.ServiceInstanceShares(namespace).List(metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list service instance shares: %v", err)
+	}
+
+	out := shares.Items
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Spec.InstanceName != out[j].Spec.InstanceName {
+			return out[i].Spec.InstanceName < out[j].Spec.InstanceName
+		}
+
+		return out[i].Namespace+"/"+out[i].Spec.Space < out[j].Namespace+"/"+out[j].Spec.Space
+	})
+
+	return out, nil
+}
+
+// SharedWithSpaces gets the names of the spaces each service instance is
+// shared with from a list of shares.
+func SharedWithSpaces(shares []v1alpha1.ServiceInstanceShare) map[string][]string {
+	out := make(map[string][]string)
+	for _, share := range shares {
+		out[share.Spec.InstanceName] = append(out[share.Spec.InstanceName], share.Spec.Space)
+	}
+
+	return out
+}
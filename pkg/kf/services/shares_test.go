@@ -0,0 +1,67 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSharesClient(t *testing.T) {
+	t.Parallel()
+
+	kf := kffake.NewSimpleClientset()
+	client := NewSharesClient(kf.KfV1alpha1())
+
+	testutil.AssertNil(t, "share", client.Share("db-space", "my-db", "app-space"))
+	testutil.AssertNil(t, "share other space", client.Share("db-space", "my-db", "another-space"))
+	testutil.AssertNil(t, "share other instance", client.Share("other-space", "cache", "app-space"))
+
+	testutil.AssertErrorsEqual(t,
+		errors.New(`service instance "my-db" is already shared with space "app-space"`),
+		client.Share("db-space", "my-db", "app-space"),
+	)
+
+	share, err := kf.KfV1alpha1().ServiceInstanceShares("db-space").Get("kf-share-my-db-app-space", metav1.GetOptions{})
+	testutil.AssertNil(t, "get share", err)
+	testutil.AssertEqual(t, "spec", v1alpha1.ServiceInstanceShareSpec{
+		InstanceName: "my-db",
+		Space:        "app-space",
+	}, share.Spec)
+	testutil.AssertEqual(t, "space label", "app-space", share.Labels[v1alpha1.ServiceInstanceShareSpaceLabel])
+
+	shares, err := client.List("db-space")
+	testutil.AssertNil(t, "list", err)
+	testutil.AssertEqual(t, "shared with", map[string][]string{
+		"my-db": {"another-space", "app-space"},
+	}, SharedWithSpaces(shares))
+
+	sharedWith, err := client.ListSharedWith("app-space")
+	testutil.AssertNil(t, "list shared with", err)
+	testutil.AssertEqual(t, "shared with count", 2, len(sharedWith))
+	testutil.AssertEqual(t, "first", "cache", sharedWith[0].Spec.InstanceName)
+	testutil.AssertEqual(t, "second", "my-db", sharedWith[1].Spec.InstanceName)
+
+	testutil.AssertNil(t, "unshare", client.Unshare("db-space", "my-db", "app-space"))
+	testutil.AssertErrorsEqual(t,
+		errors.New(`service instance "my-db" isn't shared with space "app-space"`),
+		client.Unshare("db-space", "my-db", "app-space"),
+	)
+}
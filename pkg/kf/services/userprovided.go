@@ -0,0 +1,248 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/cfutil"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// UserProvidedService is a service instance that wasn't provisioned by a
+// broker, the user supplies its credentials.
+type UserProvidedService struct {
+	// Name is the name of the service instance.
+	Name string
+
+	// Credentials are injected into VCAP_SERVICES of bound apps.
+	Credentials cfutil.Credentials
+
+	// Tags are injected into VCAP_SERVICES of bound apps.
+	Tags []string
+
+	// SyslogDrainURL is the URL logs of bound apps are drained to.
+	SyslogDrainURL string
+
+	// RouteServiceURL is the URL of a route service.
+	RouteServiceURL string
+}
+
+// UserProvidedClient manages user-provided service instances. They're stored
+// as Secrets in the space.
+type UserProvidedClient interface {
+	// Create stores a new user-provided service.
+	Create(namespace string, service *UserProvidedService) error
+
+	// Update replaces an existing user-provided service.
+	Update(namespace string, service *UserProvidedService) error
+
+	// Get gets a user-provided service by name. A NotFound error is returned
+	// if there is no user-provided service with the name.
+	Get(namespace string, name string) (*UserProvidedService, error)
+
+	// List lists the user-provided services in the namespace.
+	List(namespace string) ([]UserProvidedService, error)
+
+	// Delete removes a user-provided service.
+	Delete(namespace string, name string) error
+}
+
+type userProvidedClient struct {
+	secrets v1.SecretsGetter
+}
+
+// NewUserProvidedClient creates a new UserProvidedClient.
+func NewUserProvidedClient(secrets v1.SecretsGetter) UserProvidedClient {
+	return &userProvidedClient{
+		secrets: secrets,
+	}
+}
+
+// Create implements UserProvidedClient.
+func (c *userProvidedClient) Create(namespace string, service *UserProvidedService) error {
+	secret := service.toSecret(namespace)
+	if _, err := c.secrets.Secrets(namespace).Create(secret); err != nil {
+		return fmt.Errorf("couldn't create the user-provided service %q: %v", service.Name, err)
+	}
+
+	return nil
+}
+
+// Update implements UserProvidedClient.
+func (c *userProvidedClient) Update(namespace string, service *UserProvidedService) error {
+	existing, err := c.getSecret(namespace, service.Name)
+	if err != nil {
+		return err
+	}
+
+	secret := service.toSecret(namespace)
+
+	// Preserve the rest of the object e.g. the resource version.
+	existing = existing.DeepCopy()
+	existing.Labels = v1alpha1.UnionMaps(existing.Labels, secret.Labels)
+	existing.Annotations = secret.Annotations
+	existing.Data = secret.Data
+
+	if _, err := c.secrets.Secrets(namespace).Update(existing); err != nil {
+		return fmt.Errorf("couldn't update the user-provided service %q: %v", service.Name, err)
+	}
+
+	return nil
+}
+
+// Get implements UserProvidedClient.
+func (c *userProvidedClient) Get(namespace string, name string) (*UserProvidedService, error) {
+	secret, err := c.getSecret(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return userProvidedServiceFromSecret(secret)
+}
+
+func (c *userProvidedClient) getSecret(namespace string, name string) (*corev1.Secret, error) {
+	secret, err := c.secrets.
+		Secrets(namespace).
+		Get(v1alpha1.UserProvidedServiceSecretName(name), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, userProvidedNotFound(name)
+	case err != nil:
+		return nil, fmt.Errorf("couldn't get the user-provided service %q: %v", name, err)
+	case !v1alpha1.IsUserProvidedService(secret):
+		return nil, userProvidedNotFound(name)
+	}
+
+	return secret, nil
+}
+
+// List implements UserProvidedClient.
+func (c *userProvidedClient) List(namespace string) ([]UserProvidedService, error) {
+	selector := labels.SelectorFromSet(labels.Set{
+		v1alpha1.UserProvidedServiceLabel: "true",
+	})
+
+	secrets, err := c.secrets.Secrets(namespace).List(metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list user-provided services: %v", err)
+	}
+
+	var out []UserProvidedService
+	for i := range secrets.Items {
+		service, err := userProvidedServiceFromSecret(&secrets.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *service)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+
+	return out, nil
+}
+
+// Delete implements UserProvidedClient.
+func (c *userProvidedClient) Delete(namespace string, name string) error {
+	if _, err := c.getSecret(namespace, name); err != nil {
+		return err
+	}
+
+	if err := c.secrets.
+		Secrets(namespace).
+		Delete(v1alpha1.UserProvidedServiceSecretName(name), &metav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("couldn't delete the user-provided service %q: %v", name, err)
+	}
+
+	return nil
+}
+
+func userProvidedNotFound(name string) error {
+	return apierrors.NewNotFound(schema.GroupResource{Resource: "user-provided services"}, name)
+}
+
+func (service *UserProvidedService) toSecret(namespace string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.UserProvidedServiceSecretName(service.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				v1alpha1.UserProvidedServiceLabel: "true",
+				v1alpha1.NameLabel:                service.Name,
+				v1alpha1.ManagedByLabel:           "kf",
+			},
+			Annotations: make(map[string]string),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: service.Credentials.SecretData(),
+	}
+
+	secret.Annotations = v1alpha1.SetServiceTags(secret.Annotations, service.Tags)
+
+	if service.SyslogDrainURL != "" {
+		secret.Annotations[v1alpha1.UserProvidedServiceSyslogDrainURLAnnotation] = service.SyslogDrainURL
+	}
+
+	if service.RouteServiceURL != "" {
+		secret.Annotations[v1alpha1.UserProvidedServiceRouteServiceURLAnnotation] = service.RouteServiceURL
+	}
+
+	return secret
+}
+
+func userProvidedServiceFromSecret(secret *corev1.Secret) (*UserProvidedService, error) {
+	tags, err := v1alpha1.UserProvidedServiceTags(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	service := &UserProvidedService{
+		Name:            v1alpha1.UserProvidedServiceInstanceName(secret),
+		Credentials:     cfutil.CredentialsFromSecretData(secret.Data),
+		Tags:            tags,
+		SyslogDrainURL:  secret.Annotations[v1alpha1.UserProvidedServiceSyslogDrainURLAnnotation],
+		RouteServiceURL: secret.Annotations[v1alpha1.UserProvidedServiceRouteServiceURLAnnotation],
+	}
+
+	return service, nil
+}
+
+// ParseCredentials parses user-provided service credentials from a JSON
+// object given in-line or in a file.
+func ParseCredentials(jsonOrFile string) (cfutil.Credentials, error) {
+	raw, err := ParseJSONOrFile(jsonOrFile)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(cfutil.Credentials)
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
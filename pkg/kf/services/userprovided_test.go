@@ -0,0 +1,133 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/cfutil"
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUserProvidedClient(t *testing.T) {
+	t.Parallel()
+
+	unrelated := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.UserProvidedServiceSecretName("unrelated"),
+			Namespace: "my-space",
+		},
+	}
+
+	k8s := k8sfake.NewSimpleClientset(unrelated)
+	client := NewUserProvidedClient(k8s.CoreV1())
+
+	db := &UserProvidedService{
+		Name: "my-db",
+		Credentials: cfutil.Credentials{
+			"uri":      json.RawMessage(`"mysql://example.com"`),
+			"port":     json.RawMessage(`3306`),
+			"password": json.RawMessage(`"12345"`),
+			"hosts":    json.RawMessage(`["a","b"]`),
+		},
+		Tags:            []string{"mysql", "relational"},
+		SyslogDrainURL:  "syslog://logs.example.com",
+		RouteServiceURL: "https://route.example.com",
+	}
+
+	testutil.AssertNil(t, "create", client.Create("my-space", db))
+	testutil.AssertNil(t, "create other", client.Create("my-space", &UserProvidedService{Name: "another"}))
+
+	secret, err := k8s.CoreV1().Secrets("my-space").Get("kf-user-provided-my-db", metav1.GetOptions{})
+	testutil.AssertNil(t, "get secret", err)
+	testutil.AssertEqual(t, "is user-provided", true, v1alpha1.IsUserProvidedService(secret))
+	testutil.AssertEqual(t, "tags annotation", `["mysql","relational"]`, secret.Annotations[v1alpha1.UserProvidedServiceTagsAnnotation])
+
+	got, err := client.Get("my-space", "my-db")
+	testutil.AssertNil(t, "get", err)
+	testutil.AssertEqual(t, "service", db, got)
+
+	list, err := client.List("my-space")
+	testutil.AssertNil(t, "list", err)
+	testutil.AssertEqual(t, "list count", 2, len(list))
+	testutil.AssertEqual(t, "list order", "another", list[0].Name)
+
+	got.Credentials = cfutil.Credentials{"uri": json.RawMessage(`"mysql://new.example.com"`)}
+	got.SyslogDrainURL = ""
+	testutil.AssertNil(t, "update", client.Update("my-space", got))
+
+	updated, err := client.Get("my-space", "my-db")
+	testutil.AssertNil(t, "get updated", err)
+	testutil.AssertEqual(t, "updated", got, updated)
+
+	_, err = client.Get("my-space", "unrelated")
+	testutil.AssertEqual(t, "unrelated secret not found", true, apierrors.IsNotFound(err))
+
+	testutil.AssertNil(t, "delete", client.Delete("my-space", "my-db"))
+	_, err = client.Get("my-space", "my-db")
+	testutil.AssertEqual(t, "deleted not found", true, apierrors.IsNotFound(err))
+
+	err = client.Delete("my-space", "unrelated")
+	testutil.AssertEqual(t, "delete unrelated not found", true, apierrors.IsNotFound(err))
+}
+
+func TestParseCredentials(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		input   string
+		want    cfutil.Credentials
+		wantErr error
+	}{
+		"strings": {
+			input: `{"uri":"mysql://example.com","username":"admin"}`,
+			want: cfutil.Credentials{
+				"uri":      json.RawMessage(`"mysql://example.com"`),
+				"username": json.RawMessage(`"admin"`),
+			},
+		},
+		"structured values are kept": {
+			input: `{"port":3306,"hosts":["a","b"],"tls":{"enabled":true}}`,
+			want: cfutil.Credentials{
+				"port":  json.RawMessage(`3306`),
+				"hosts": json.RawMessage(`["a","b"]`),
+				"tls":   json.RawMessage(`{"enabled":true}`),
+			},
+		},
+		"not a map": {
+			input:   `["a"]`,
+			wantErr: errors.New(`value must be a JSON map, got: "[\"a\"]"`),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got, err := ParseCredentials(tc.input)
+			if tc.wantErr != nil || err != nil {
+				testutil.AssertErrorsEqual(t, tc.wantErr, err)
+				return
+			}
+
+			testutil.AssertEqual(t, "credentials", tc.want, got)
+		})
+	}
+}
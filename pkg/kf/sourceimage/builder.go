@@ -0,0 +1,223 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceimage
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/kf/pkg/kf/buildpacks"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// SourcePath is the directory the source is stored in inside of the
+	// image.
+	SourcePath = "/var/run/kf/source"
+
+	// WorkspacePath is the directory the source is copied to when the image
+	// is run as a build source.
+	WorkspacePath = "/workspace"
+
+	// DefaultBaseImage is the image source layers are added to. It must have
+	// a shell and cp.
+	DefaultBaseImage = "busybox:1.31"
+
+	// maxLayers is the most layers source is split into, well below the
+	// limits of common container runtimes.
+	maxLayers = 64
+)
+
+// Builder creates and uploads source images.
+type Builder interface {
+	// BuildImageWithFilter uploads the files in dir that pass filter to the
+	// image srcImage. The filter is called with paths relative to dir.
+	//
+	// Each top level directory is stored in its own reproducible layer so
+	// only groups that changed are uploaded. If the registry already has the
+	// image, nothing is uploaded. Failed uploads are retried and skip the
+	// layers that were already written.
+	BuildImageWithFilter(dir, srcImage string, filter func(path string) (bool, error)) error
+}
+
+type builder struct {
+	imageFetcher buildpacks.RemoteImageFetcher
+	imageWriter  buildpacks.RemoteImageWriter
+	keychain     authn.Keychain
+	baseImage    string
+	backoff      wait.Backoff
+	logf         func(format string, args ...interface{})
+}
+
+// NewBuilder creates a new Builder that uses the local docker credentials to
+// read and write images.
+func NewBuilder(
+	imageFetcher buildpacks.RemoteImageFetcher,
+	imageWriter buildpacks.RemoteImageWriter,
+) Builder {
+	return &builder{
+		imageFetcher: imageFetcher,
+		imageWriter:  imageWriter,
+		keychain:     authn.DefaultKeychain,
+		baseImage:    DefaultBaseImage,
+		backoff: wait.Backoff{
+			Duration: time.Second,
+			Factor:   2,
+			Steps:    5,
+		},
+		logf: log.Printf,
+	}
+}
+
+// BuildImageWithFilter implements Builder.
+func (b *builder) BuildImageWithFilter(dir, srcImage string, filter func(path string) (bool, error)) error {
+	ref, err := name.ParseReference(srcImage, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+
+	auth, err := b.keychain.Resolve(ref.Context().Registry)
+	if err != nil {
+		return err
+	}
+
+	groups, err := groupFiles(dir, filter, maxLayers)
+	if err != nil {
+		return fmt.Errorf("failed to read source: %s", err)
+	}
+
+	img, layers, err := b.makeImage(dir, groups)
+	if err != nil {
+		return err
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return err
+	}
+
+	if b.exists(ref, auth, digest) {
+		b.logf("Source is unchanged, skipping upload of %s", digest)
+		return nil
+	}
+
+	var lastErr error
+	err = wait.ExponentialBackoff(b.backoff, func() (bool, error) {
+		if lastErr = b.imageWriter(ref, img, auth, http.DefaultTransport); lastErr != nil {
+			b.logf("Upload interrupted: %s, retrying", lastErr)
+			return false, nil
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload source: %s", lastErr)
+	}
+
+	uploaded := 0
+	for _, layer := range layers {
+		if layer.wasUploaded() {
+			uploaded++
+		}
+	}
+
+	b.logf("Uploaded %d of %d layers, %d already existed", uploaded, len(layers), len(layers)-uploaded)
+	b.logf("Source image %s", digest)
+
+	return nil
+}
+
+// makeImage adds a layer for each group to the base image and sets it up to
+// copy the source into the workspace when run.
+func (b *builder) makeImage(dir string, groups []fileGroup) (gcrv1.Image, []*progressLayer, error) {
+	baseRef, err := name.ParseReference(b.baseImage, name.WeakValidation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseAuth, err := b.keychain.Resolve(baseRef.Context().Registry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	base, err := b.imageFetcher(baseRef, remote.WithAuth(baseAuth))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch base image: %s", err)
+	}
+
+	var layers []*progressLayer
+	var addenda []gcrv1.Layer
+	for _, group := range groups {
+		layer, err := layerForGroup(dir, group)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to package %s: %s", group.name, err)
+		}
+
+		pl := &progressLayer{
+			Layer: layer,
+			name:  group.name,
+			logf:  b.logf,
+		}
+		layers = append(layers, pl)
+		addenda = append(addenda, pl)
+	}
+
+	img, err := mutate.AppendLayers(base, addenda...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg.Config.Entrypoint = []string{
+		"/bin/sh",
+		"-c",
+		fmt.Sprintf("cp -a %s/. %s/", SourcePath, WorkspacePath),
+	}
+	cfg.Config.Cmd = nil
+
+	img, err = mutate.Config(img, cfg.Config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return img, layers, nil
+}
+
+// exists returns true if the registry has an image at ref with the given
+// digest. Any errors fetching the image are treated as it not existing.
+func (b *builder) exists(ref name.Reference, auth authn.Authenticator, digest gcrv1.Hash) bool {
+	remoteImg, err := b.imageFetcher(ref, remote.WithAuth(auth))
+	if err != nil {
+		return false
+	}
+
+	remoteDigest, err := remoteImg.Digest()
+	if err != nil {
+		return false
+	}
+
+	return remoteDigest == digest
+}
@@ -0,0 +1,284 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceimage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/kf/pkg/kf/testutil"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type anonymousKeychain struct{}
+
+func (anonymousKeychain) Resolve(name.Registry) (authn.Authenticator, error) {
+	return authn.Anonymous, nil
+}
+
+// fakeRegistry stores blobs and images written to it. It can be set to fail
+// after a number of blobs are uploaded to simulate interrupted uploads.
+type fakeRegistry struct {
+	blobs      map[gcrv1.Hash]bool
+	images     map[string]gcrv1.Image
+	uploads    []gcrv1.Hash
+	failAfter  int
+	writeCalls int
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{
+		blobs:     make(map[gcrv1.Hash]bool),
+		images:    make(map[string]gcrv1.Image),
+		failAfter: -1,
+	}
+}
+
+func (r *fakeRegistry) fetch(ref name.Reference, options ...remote.ImageOption) (gcrv1.Image, error) {
+	if ref.Context().RepositoryStr() == "library/busybox" {
+		return empty.Image, nil
+	}
+
+	if img, ok := r.images[ref.Name()]; ok {
+		return img, nil
+	}
+
+	return nil, errors.New("not found")
+}
+
+func (r *fakeRegistry) write(ref name.Reference, img gcrv1.Image, auth authn.Authenticator, t http.RoundTripper) error {
+	r.writeCalls++
+
+	layers, err := img.Layers()
+	if err != nil {
+		return err
+	}
+
+	for _, layer := range layers {
+		digest, err := layer.Digest()
+		if err != nil {
+			return err
+		}
+
+		if r.blobs[digest] {
+			continue
+		}
+
+		if r.failAfter == 0 {
+			r.failAfter = -1
+			return errors.New("connection reset")
+		}
+		r.failAfter--
+
+		rc, err := layer.Compressed()
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(ioutil.Discard, rc); err != nil {
+			return err
+		}
+		rc.Close()
+
+		r.blobs[digest] = true
+		r.uploads = append(r.uploads, digest)
+	}
+
+	r.images[ref.Name()] = img
+	return nil
+}
+
+func newTestBuilder(registry *fakeRegistry) *builder {
+	return &builder{
+		imageFetcher: registry.fetch,
+		imageWriter:  registry.write,
+		keychain:     anonymousKeychain{},
+		baseImage:    DefaultBaseImage,
+		backoff:      wait.Backoff{Steps: 3},
+		logf:         func(string, ...interface{}) {},
+	}
+}
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "sourceimage")
+	testutil.AssertNil(t, "TempDir", err)
+
+	for path, contents := range files {
+		full := filepath.Join(dir, filepath.FromSlash(path))
+		testutil.AssertNil(t, "MkdirAll", os.MkdirAll(filepath.Dir(full), 0755))
+		testutil.AssertNil(t, "WriteFile", ioutil.WriteFile(full, []byte(contents), 0644))
+	}
+
+	return dir
+}
+
+func allowAll(string) (bool, error) {
+	return true, nil
+}
+
+func TestGroupFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := writeFiles(t, map[string]string{
+		"main.go":          "package main",
+		"README.md":        "readme",
+		"pkg/a/a.go":       "package a",
+		"pkg/b.go":         "package pkg",
+		"vendor/x/x.go":    "package x",
+		"ignored/secret":   "secret",
+		"pkg/ignored.tmp":  "tmp",
+		"docs/index.html":  "<html>",
+		"docs/img/kf.png":  "png",
+		"empty/.gitignore": "",
+	})
+	defer os.RemoveAll(dir)
+
+	filter := func(path string) (bool, error) {
+		return path != "ignored" && !strings.HasSuffix(path, ".tmp"), nil
+	}
+
+	groups, err := groupFiles(dir, filter, maxLayers)
+	testutil.AssertNil(t, "err", err)
+
+	got := make(map[string][]string)
+	var names []string
+	for _, g := range groups {
+		names = append(names, g.name)
+		got[g.name] = g.paths
+	}
+
+	testutil.AssertEqual(t, "groups", []string{".", "docs", "empty", "pkg", "vendor"}, names)
+	testutil.AssertEqual(t, "root", []string{"README.md", "main.go"}, got["."])
+	testutil.AssertEqual(t, "pkg", []string{"pkg", "pkg/a", "pkg/a/a.go", "pkg/b.go"}, got["pkg"])
+
+	t.Run("folds groups into buckets", func(t *testing.T) {
+		folded, err := groupFiles(dir, filter, 2)
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "bucket count", true, len(folded) <= 2)
+
+		total := 0
+		for _, g := range folded {
+			total += len(g.paths)
+		}
+		testutil.AssertEqual(t, "file count", 15, total)
+	})
+
+	t.Run("filter error", func(t *testing.T) {
+		_, err := groupFiles(dir, func(string) (bool, error) {
+			return false, errors.New("some-error")
+		}, maxLayers)
+		testutil.AssertErrorsEqual(t, errors.New("some-error"), err)
+	})
+}
+
+func TestBuilder_BuildImageWithFilter(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"main.go":       "package main",
+		"pkg/a.go":      "package pkg",
+		"vendor/x/x.go": "package x",
+	}
+
+	t.Run("uploads each group once", func(t *testing.T) {
+		dir := writeFiles(t, files)
+		defer os.RemoveAll(dir)
+
+		registry := newFakeRegistry()
+		b := newTestBuilder(registry)
+
+		testutil.AssertNil(t, "first push", b.BuildImageWithFilter(dir, "gcr.io/my-app/src", allowAll))
+		testutil.AssertEqual(t, "first uploads", 3, len(registry.uploads))
+
+		testutil.AssertNil(t, "WriteFile", ioutil.WriteFile(filepath.Join(dir, "pkg", "a.go"), []byte("package changed"), 0644))
+		testutil.AssertNil(t, "second push", b.BuildImageWithFilter(dir, "gcr.io/my-app/src", allowAll))
+		testutil.AssertEqual(t, "second uploads", 4, len(registry.uploads))
+	})
+
+	t.Run("skips unchanged source", func(t *testing.T) {
+		dir := writeFiles(t, files)
+		defer os.RemoveAll(dir)
+
+		registry := newFakeRegistry()
+		b := newTestBuilder(registry)
+
+		testutil.AssertNil(t, "first push", b.BuildImageWithFilter(dir, "gcr.io/my-app/src", allowAll))
+
+		// Touching files must not change the digest.
+		testutil.AssertNil(t, "Chtimes", os.Chtimes(filepath.Join(dir, "main.go"), time.Now(), time.Now()))
+		testutil.AssertNil(t, "second push", b.BuildImageWithFilter(dir, "gcr.io/my-app/src", allowAll))
+		testutil.AssertEqual(t, "write calls", 1, registry.writeCalls)
+	})
+
+	t.Run("resumes interrupted uploads", func(t *testing.T) {
+		dir := writeFiles(t, files)
+		defer os.RemoveAll(dir)
+
+		registry := newFakeRegistry()
+		registry.failAfter = 1
+		b := newTestBuilder(registry)
+
+		testutil.AssertNil(t, "push", b.BuildImageWithFilter(dir, "gcr.io/my-app/src", allowAll))
+		testutil.AssertEqual(t, "write calls", 2, registry.writeCalls)
+		testutil.AssertEqual(t, "uploads", 3, len(registry.uploads))
+	})
+
+	t.Run("gives up after retries", func(t *testing.T) {
+		dir := writeFiles(t, files)
+		defer os.RemoveAll(dir)
+
+		registry := newFakeRegistry()
+		b := newTestBuilder(registry)
+		b.imageWriter = func(name.Reference, gcrv1.Image, authn.Authenticator, http.RoundTripper) error {
+			return errors.New("connection reset")
+		}
+
+		err := b.BuildImageWithFilter(dir, "gcr.io/my-app/src", allowAll)
+		testutil.AssertErrorsEqual(t, errors.New("failed to upload source: connection reset"), err)
+	})
+
+	t.Run("bad image name", func(t *testing.T) {
+		b := newTestBuilder(newFakeRegistry())
+
+		err := b.BuildImageWithFilter("", "gcr.io/My App", allowAll)
+		testutil.AssertEqual(t, "err", true, err != nil)
+	})
+}
+
+func TestFormatBytes(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[int64]string{
+		10:              "10 B",
+		2048:            "2.0 KiB",
+		5 * 1024 * 1024: "5.0 MiB",
+	} {
+		testutil.AssertEqual(t, fmt.Sprintf("%d bytes", in), want, formatBytes(in))
+	}
+}
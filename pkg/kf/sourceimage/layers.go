@@ -0,0 +1,196 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceimage
+
+import (
+	"archive/tar"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+)
+
+// rootGroup holds the files directly inside the source directory.
+const rootGroup = "."
+
+// fileGroup is a set of files that are packaged into the same layer.
+type fileGroup struct {
+	name  string
+	paths []string
+}
+
+// groupFiles walks dir and splits the files that pass filter into groups.
+// Each top level directory gets its own group and top level files share one
+// so a change to a single file only changes the layer of its group. If there
+// are more than maxGroups groups, they're folded into maxGroups buckets by
+// the hash of their names so the assignment stays stable between pushes.
+//
+// Paths are relative to dir and use forward slashes. Groups and the paths in
+// them are sorted.
+func groupFiles(dir string, filter func(path string) (bool, error), maxGroups int) ([]fileGroup, error) {
+	byName := make(map[string][]string)
+
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		include, err := filter(rel)
+		if err != nil {
+			return err
+		}
+
+		if !include {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel = filepath.ToSlash(rel)
+		group := rootGroup
+		if idx := strings.Index(rel, "/"); idx >= 0 {
+			group = rel[:idx]
+		} else if info.IsDir() {
+			group = rel
+		}
+
+		byName[group] = append(byName[group], rel)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if maxGroups > 0 && len(byName) > maxGroups {
+		buckets := make(map[string][]string)
+		for name, paths := range byName {
+			bucket := fmt.Sprintf("bucket-%d", groupHash(name)%uint32(maxGroups))
+			buckets[bucket] = append(buckets[bucket], paths...)
+		}
+		byName = buckets
+	}
+
+	var groups []fileGroup
+	for name, paths := range byName {
+		sort.Strings(paths)
+		groups = append(groups, fileGroup{name: name, paths: paths})
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].name < groups[j].name
+	})
+
+	return groups, nil
+}
+
+func groupHash(name string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return h.Sum32()
+}
+
+// layerForGroup creates a layer containing the files of group under
+// SourcePath. The layer is reproducible: timestamps and owners are
+// normalized so unchanged files always produce the same digest.
+func layerForGroup(dir string, group fileGroup) (gcrv1.Layer, error) {
+	return tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		pr, pw := io.Pipe()
+		go func() {
+			pw.CloseWithError(writeGroupTar(pw, dir, group))
+		}()
+
+		return pr, nil
+	})
+}
+
+func writeGroupTar(w io.Writer, dir string, group fileGroup) error {
+	tw := tar.NewWriter(w)
+
+	for _, rel := range group.paths {
+		if err := addFile(tw, dir, rel); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+func addFile(tw *tar.Writer, dir, rel string) error {
+	p := filepath.Join(dir, filepath.FromSlash(rel))
+
+	info, err := os.Lstat(p)
+	if err != nil {
+		return err
+	}
+
+	link := ""
+	if info.Mode()&os.ModeSymlink != 0 {
+		if link, err = os.Readlink(p); err != nil {
+			return err
+		}
+	}
+
+	hdr, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+
+	hdr.Name = path.Join(SourcePath, rel)
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+	hdr.ModTime = time.Unix(0, 0)
+	hdr.AccessTime = time.Time{}
+	hdr.ChangeTime = time.Time{}
+	hdr.Uid = 0
+	hdr.Gid = 0
+	hdr.Uname = ""
+	hdr.Gname = ""
+
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(tw, f)
+	return err
+}
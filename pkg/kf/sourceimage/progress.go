@@ -0,0 +1,105 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceimage
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+)
+
+// progressLayer reports the progress of a layer as it's read for upload.
+// Layers that already exist in the registry are never read, so the layers
+// that weren't uploaded can be counted after the image is written.
+type progressLayer struct {
+	gcrv1.Layer
+
+	name     string
+	logf     func(format string, args ...interface{})
+	uploaded int32
+}
+
+var _ gcrv1.Layer = (*progressLayer)(nil)
+
+// Compressed implements gcrv1.Layer.
+func (l *progressLayer) Compressed() (io.ReadCloser, error) {
+	rc, err := l.Layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := l.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	atomic.StoreInt32(&l.uploaded, 1)
+	l.logf("Uploading %s (%s)", l.name, formatBytes(size))
+
+	return &progressReader{
+		ReadCloser: rc,
+		layer:      l,
+		size:       size,
+	}, nil
+}
+
+// wasUploaded returns true if the layer was read for upload.
+func (l *progressLayer) wasUploaded() bool {
+	return atomic.LoadInt32(&l.uploaded) == 1
+}
+
+// progressReader logs every time another quarter of the layer is read.
+type progressReader struct {
+	io.ReadCloser
+
+	layer    *progressLayer
+	size     int64
+	read     int64
+	reported int64
+}
+
+// Read implements io.Reader.
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.read += int64(n)
+
+	if r.size > 0 {
+		quarter := r.read * 4 / r.size
+		if quarter > r.reported {
+			r.reported = quarter
+			r.layer.logf("%s %d%% (%s/%s)", r.layer.name, quarter*25, formatBytes(r.read), formatBytes(r.size))
+		}
+	}
+
+	return n, err
+}
+
+// formatBytes formats a byte count in human readable units.
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -128,6 +128,38 @@ func (k *KfSource) GetDockerfileImage() string {
 	return k.Spec.Dockerfile.Image
 }
 
+// SetDockerfileBuildArgs sets the build arguments for dockerfile based builds.
+func (k *KfSource) SetDockerfileBuildArgs(args []corev1.EnvVar) {
+	k.Spec.Dockerfile.BuildArgs = args
+}
+
+// GetDockerfileBuildArgs gets the build arguments for dockerfile based builds.
+func (k *KfSource) GetDockerfileBuildArgs() []corev1.EnvVar {
+	return k.Spec.Dockerfile.BuildArgs
+}
+
+// SetDockerfileTarget sets the stage of a multi-stage Dockerfile to build.
+func (k *KfSource) SetDockerfileTarget(target string) {
+	k.Spec.Dockerfile.Target = target
+}
+
+// GetDockerfileTarget gets the stage of a multi-stage Dockerfile to build.
+func (k *KfSource) GetDockerfileTarget() string {
+	return k.Spec.Dockerfile.Target
+}
+
+// SetDockerfileSecrets sets the names of Secrets mounted while building the
+// Dockerfile.
+func (k *KfSource) SetDockerfileSecrets(secrets []string) {
+	k.Spec.Dockerfile.Secrets = secrets
+}
+
+// GetDockerfileSecrets gets the names of Secrets mounted while building the
+// Dockerfile.
+func (k *KfSource) GetDockerfileSecrets() []string {
+	return k.Spec.Dockerfile.Secrets
+}
+
 // SetBuildpackBuildStack sets the stack to use with a buildpack build.
 func (k *KfSource) SetBuildpackBuildStack(stack string) {
 	k.Spec.BuildpackBuild.Stack = stack
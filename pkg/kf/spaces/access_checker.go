@@ -0,0 +1,77 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	authorizationclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
+)
+
+// NewSpaceAccessChecker creates a v1alpha1.SpaceAccessChecker that asks the
+// Kubernetes API server what users are allowed to do.
+func NewSpaceAccessChecker(reviews authorizationclient.SubjectAccessReviewsGetter) v1alpha1.SpaceAccessChecker {
+	return &spaceAccessChecker{reviews: reviews}
+}
+
+type spaceAccessChecker struct {
+	reviews authorizationclient.SubjectAccessReviewsGetter
+}
+
+// CanConfigure implements v1alpha1.SpaceAccessChecker. Users that can update
+// the Space's Namespace are administrators of the space.
+func (c *spaceAccessChecker) CanConfigure(user authenticationv1.UserInfo, space *v1alpha1.Space) (bool, error) {
+	return c.allowed(user, authorizationv1.ResourceAttributes{
+		Verb:     "update",
+		Resource: "namespaces",
+		Name:     v1alpha1.NamespaceName(space),
+	})
+}
+
+// CanAssignRole implements v1alpha1.SpaceAccessChecker. Users can assign
+// roles that Kubernetes allows them to bind in the Space's Namespace.
+func (c *spaceAccessChecker) CanAssignRole(user authenticationv1.UserInfo, space *v1alpha1.Space, role v1alpha1.SpaceRoleName) (bool, error) {
+	return c.allowed(user, authorizationv1.ResourceAttributes{
+		Namespace: v1alpha1.NamespaceName(space),
+		Verb:      "bind",
+		Group:     rbacv1.GroupName,
+		Resource:  "roles",
+		Name:      v1alpha1.RoleNameForSpaceRole(space, role),
+	})
+}
+
+func (c *spaceAccessChecker) allowed(user authenticationv1.UserInfo, attrs authorizationv1.ResourceAttributes) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue)
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	review, err := c.reviews.SubjectAccessReviews().Create(&authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &attrs,
+			User:               user.Username,
+			Groups:             user.Groups,
+			UID:                user.UID,
+			Extra:              extra,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return review.Status.Allowed, nil
+}
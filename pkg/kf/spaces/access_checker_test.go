@@ -0,0 +1,110 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/testutil"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestSpaceAccessChecker(t *testing.T) {
+	space := &v1alpha1.Space{}
+	space.Name = "my-space"
+
+	user := authenticationv1.UserInfo{
+		Username: "alice",
+		Groups:   []string{"managers"},
+	}
+
+	cases := map[string]struct {
+		check       func(v1alpha1.SpaceAccessChecker) (bool, error)
+		allowed     bool
+		reviewErr   error
+		wantAllowed bool
+		wantAttrs   authorizationv1.ResourceAttributes
+		wantErr     error
+	}{
+		"configure": {
+			check: func(c v1alpha1.SpaceAccessChecker) (bool, error) {
+				return c.CanConfigure(user, space)
+			},
+			allowed:     true,
+			wantAllowed: true,
+			wantAttrs: authorizationv1.ResourceAttributes{
+				Verb:     "update",
+				Resource: "namespaces",
+				Name:     "my-space",
+			},
+		},
+		"assign role": {
+			check: func(c v1alpha1.SpaceAccessChecker) (bool, error) {
+				return c.CanAssignRole(user, space, v1alpha1.SpaceManager)
+			},
+			allowed:     false,
+			wantAllowed: false,
+			wantAttrs: authorizationv1.ResourceAttributes{
+				Namespace: "my-space",
+				Verb:      "bind",
+				Group:     "rbac.authorization.k8s.io",
+				Resource:  "roles",
+				Name:      "space-manager",
+			},
+		},
+		"review error": {
+			check: func(c v1alpha1.SpaceAccessChecker) (bool, error) {
+				return c.CanAssignRole(user, space, v1alpha1.SpaceDeveloper)
+			},
+			reviewErr: errors.New("some-error"),
+			wantErr:   errors.New("some-error"),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			client := k8sfake.NewSimpleClientset()
+
+			var gotReview *authorizationv1.SubjectAccessReview
+			client.PrependReactor("create", "subjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				gotReview = action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+				if tc.reviewErr != nil {
+					return true, nil, tc.reviewErr
+				}
+
+				out := gotReview.DeepCopy()
+				out.Status.Allowed = tc.allowed
+				return true, out, nil
+			})
+
+			allowed, err := tc.check(NewSpaceAccessChecker(client.AuthorizationV1()))
+			testutil.AssertErrorsEqual(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			testutil.AssertEqual(t, "allowed", tc.wantAllowed, allowed)
+			testutil.AssertEqual(t, "attributes", tc.wantAttrs, *gotReview.Spec.ResourceAttributes)
+			testutil.AssertEqual(t, "user", "alice", gotReview.Spec.User)
+			testutil.AssertEqual(t, "groups", []string{"managers"}, gotReview.Spec.Groups)
+		})
+	}
+}
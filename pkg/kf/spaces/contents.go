@@ -0,0 +1,115 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"fmt"
+
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	cv1beta1 "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/typed/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Contents lists the resources in a space that are deleted along with it.
+type Contents struct {
+	Apps             []string
+	ServiceInstances []string
+	ServiceBindings  []string
+	Routes           []string
+	RouteClaims      []string
+}
+
+// IsEmpty returns true if the space holds none of the listed resources.
+func (c *Contents) IsEmpty() bool {
+	return len(c.Apps)+
+		len(c.ServiceInstances)+
+		len(c.ServiceBindings)+
+		len(c.Routes)+
+		len(c.RouteClaims) == 0
+}
+
+// ContentsLister lists the resources in spaces.
+type ContentsLister interface {
+	// ListContents lists the resources in the space that are deleted along
+	// with it.
+	ListContents(space string) (*Contents, error)
+}
+
+// NewContentsLister creates a ContentsLister that reads the contents of
+// spaces from the Kubernetes API.
+func NewContentsLister(kclient kfv1alpha1.KfV1alpha1Interface, catalog cv1beta1.ServicecatalogV1beta1Interface) ContentsLister {
+	return &contentsLister{
+		kclient: kclient,
+		catalog: catalog,
+	}
+}
+
+type contentsLister struct {
+	kclient kfv1alpha1.KfV1alpha1Interface
+	catalog cv1beta1.ServicecatalogV1beta1Interface
+}
+
+// ListContents implements ContentsLister.
+func (c *contentsLister) ListContents(space string) (*Contents, error) {
+	contents := &Contents{}
+
+	apps, err := c.kclient.Apps(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, app := range apps.Items {
+		contents.Apps = append(contents.Apps, app.Name)
+	}
+
+	instances, err := c.catalog.ServiceInstances(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances.Items {
+		contents.ServiceInstances = append(contents.ServiceInstances, instance.Name)
+	}
+
+	bindings, err := c.catalog.ServiceBindings(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, binding := range bindings.Items {
+		contents.ServiceBindings = append(contents.ServiceBindings,
+			fmt.Sprintf("%s (%s)", binding.Name, binding.Spec.InstanceRef.Name))
+	}
+
+	routes, err := c.kclient.Routes(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	seenRoutes := make(map[string]bool)
+	for _, route := range routes.Items {
+		address := route.Spec.RouteSpecFields.String()
+		if !seenRoutes[address] {
+			seenRoutes[address] = true
+			contents.Routes = append(contents.Routes, address)
+		}
+	}
+
+	claims, err := c.kclient.RouteClaims(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range claims.Items {
+		contents.RouteClaims = append(contents.RouteClaims, claim.Spec.RouteSpecFields.String())
+	}
+
+	return contents, nil
+}
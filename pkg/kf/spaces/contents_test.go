@@ -0,0 +1,68 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	scfake "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestContentsLister_ListContents(t *testing.T) {
+	app := &v1alpha1.App{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-space"}}
+	otherApp := &v1alpha1.App{ObjectMeta: metav1.ObjectMeta{Name: "other-app", Namespace: "other-space"}}
+
+	routeFields := v1alpha1.RouteSpecFields{Hostname: "my-app", Domain: "example.com"}
+	route := &v1alpha1.Route{ObjectMeta: metav1.ObjectMeta{Name: "route-1", Namespace: "my-space"}}
+	route.Spec.RouteSpecFields = routeFields
+	duplicateRoute := &v1alpha1.Route{ObjectMeta: metav1.ObjectMeta{Name: "route-2", Namespace: "my-space"}}
+	duplicateRoute.Spec.RouteSpecFields = routeFields
+
+	claim := &v1alpha1.RouteClaim{ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "my-space"}}
+	claim.Spec.RouteSpecFields = routeFields
+
+	instance := &servicecatalogv1beta1.ServiceInstance{ObjectMeta: metav1.ObjectMeta{Name: "my-db", Namespace: "my-space"}}
+	binding := &servicecatalogv1beta1.ServiceBinding{ObjectMeta: metav1.ObjectMeta{Name: "kf-binding-my-app-my-db", Namespace: "my-space"}}
+	binding.Spec.InstanceRef.Name = "my-db"
+
+	lister := NewContentsLister(
+		kffake.NewSimpleClientset(app, otherApp, route, duplicateRoute, claim).KfV1alpha1(),
+		scfake.NewSimpleClientset(instance, binding).ServicecatalogV1beta1(),
+	)
+
+	t.Run("populated space", func(t *testing.T) {
+		contents, err := lister.ListContents("my-space")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "contents", &Contents{
+			Apps:             []string{"my-app"},
+			ServiceInstances: []string{"my-db"},
+			ServiceBindings:  []string{"kf-binding-my-app-my-db (my-db)"},
+			Routes:           []string{"my-app.example.com/"},
+			RouteClaims:      []string{"my-app.example.com/"},
+		}, contents)
+		testutil.AssertEqual(t, "empty", false, contents.IsEmpty())
+	})
+
+	t.Run("empty space", func(t *testing.T) {
+		contents, err := lister.ListContents("empty-space")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "empty", true, contents.IsEmpty())
+	})
+}
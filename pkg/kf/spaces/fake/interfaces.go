@@ -17,8 +17,20 @@ package fake
 import "github.com/google/kf/pkg/kf/spaces"
 
 //go:generate mockgen --package=fake --copyright_file ../../internal/tools/option-builder/LICENSE_HEADER --destination=fake_client.go --mock_names=Client=FakeClient github.com/google/kf/pkg/kf/spaces/fake Client
+//go:generate mockgen --package=fake --copyright_file ../../internal/tools/option-builder/LICENSE_HEADER --destination=fake_contents_lister.go --mock_names=ContentsLister=FakeContentsLister github.com/google/kf/pkg/kf/spaces/fake ContentsLister
+//go:generate mockgen --package=fake --copyright_file ../../internal/tools/option-builder/LICENSE_HEADER --destination=fake_usage_reporter.go --mock_names=UsageReporter=FakeUsageReporter github.com/google/kf/pkg/kf/spaces/fake UsageReporter
 
 // Client is the client for spaces.
 type Client interface {
 	spaces.Client
 }
+
+// ContentsLister is implemented by spaces.ContentsLister.
+type ContentsLister interface {
+	spaces.ContentsLister
+}
+
+// UsageReporter is implemented by spaces.UsageReporter.
+type UsageReporter interface {
+	spaces.UsageReporter
+}
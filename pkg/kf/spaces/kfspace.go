@@ -34,6 +34,16 @@ func (k *KfSpace) SetName(name string) {
 	k.Name = name
 }
 
+// GetOrganization gets the name of the organization the space belongs to.
+func (k *KfSpace) GetOrganization() string {
+	return k.Spec.Organization
+}
+
+// SetOrganization sets the name of the organization the space belongs to.
+func (k *KfSpace) SetOrganization(org string) {
+	k.Spec.Organization = org
+}
+
 // GetContainerRegistry gets the container registry for the space.
 func (k *KfSpace) GetContainerRegistry() string {
 	return k.Spec.BuildpackBuild.ContainerRegistry
@@ -54,6 +64,16 @@ func (k *KfSpace) SetBuildServiceAccount(serviceAccount string) {
 	k.Spec.Security.BuildServiceAccount = serviceAccount
 }
 
+// GetQuotaPlan gets the name of the QuotaPlan the space uses.
+func (k *KfSpace) GetQuotaPlan() string {
+	return k.Spec.ResourceLimits.QuotaPlan
+}
+
+// SetQuotaPlan sets the name of the QuotaPlan the space uses.
+func (k *KfSpace) SetQuotaPlan(plan string) {
+	k.Spec.ResourceLimits.QuotaPlan = plan
+}
+
 // GetQuota retrieves the space quota.
 func (k *KfSpace) GetQuota() v1.ResourceList {
 	return k.Spec.ResourceLimits.SpaceQuota
@@ -62,9 +82,15 @@ func (k *KfSpace) GetQuota() v1.ResourceList {
 // DeleteQuota deletes the space quota.
 func (k *KfSpace) DeleteQuota() error {
 	k.Spec.ResourceLimits.SpaceQuota = nil
+	k.Spec.ResourceLimits.KfQuota = v1alpha1.SpaceKfQuota{}
 	return nil
 }
 
+// GetKfQuota returns the limits on kf resources in the space.
+func (k *KfSpace) GetKfQuota() *v1alpha1.SpaceKfQuota {
+	return &k.Spec.ResourceLimits.KfQuota
+}
+
 // GetMemory returns the quota for total memory in a space.
 func (k *KfSpace) GetMemory() (resource.Quantity, bool) {
 	quantity, quotaExists := k.Spec.ResourceLimits.SpaceQuota[v1.ResourceMemory]
@@ -136,6 +162,94 @@ func (k *KfSpace) AppendDomains(domains ...v1alpha1.SpaceDomain) {
 	k.Spec.Execution.Domains = append(k.Spec.Execution.Domains, domains...)
 }
 
+// GetRoles gets the role assignments for the space.
+func (k *KfSpace) GetRoles() []v1alpha1.SpaceRole {
+	return k.Spec.Security.Roles
+}
+
+// AddRole assigns a role in the space if it isn't already assigned. It
+// returns true if the role was added.
+func (k *KfSpace) AddRole(role v1alpha1.SpaceRole) bool {
+	for _, r := range k.Spec.Security.Roles {
+		if r == role {
+			return false
+		}
+	}
+
+	k.Spec.Security.Roles = append(k.Spec.Security.Roles, role)
+	return true
+}
+
+// RemoveRole removes a role assignment from the space. It returns true if
+// the role was assigned.
+func (k *KfSpace) RemoveRole(role v1alpha1.SpaceRole) bool {
+	var out []v1alpha1.SpaceRole
+	for _, r := range k.Spec.Security.Roles {
+		if r != role {
+			out = append(out, r)
+		}
+	}
+
+	removed := len(out) != len(k.Spec.Security.Roles)
+	k.Spec.Security.Roles = out
+	return removed
+}
+
+// GetSecurityGroups gets the SecurityGroups bound to the space.
+func (k *KfSpace) GetSecurityGroups() []v1alpha1.SpaceSecurityGroup {
+	return k.Spec.Security.SecurityGroups
+}
+
+// BindSecurityGroup binds a SecurityGroup to the space if it isn't already
+// bound. It returns true if the binding was added.
+func (k *KfSpace) BindSecurityGroup(binding v1alpha1.SpaceSecurityGroup) bool {
+	for _, b := range k.Spec.Security.SecurityGroups {
+		if b == binding {
+			return false
+		}
+	}
+
+	k.Spec.Security.SecurityGroups = append(k.Spec.Security.SecurityGroups, binding)
+	return true
+}
+
+// UnbindSecurityGroup removes a SecurityGroup binding from the space. It
+// returns true if the group was bound.
+func (k *KfSpace) UnbindSecurityGroup(binding v1alpha1.SpaceSecurityGroup) bool {
+	var out []v1alpha1.SpaceSecurityGroup
+	for _, b := range k.Spec.Security.SecurityGroups {
+		if b != binding {
+			out = append(out, b)
+		}
+	}
+
+	removed := len(out) != len(k.Spec.Security.SecurityGroups)
+	k.Spec.Security.SecurityGroups = out
+	return removed
+}
+
+// CopySpecFrom copies the configuration of an existing space so a new space
+// can be created like it. Roles are never copied, access to the new space has
+// to be granted separately. Environment variables and quotas are only copied
+// if includeConfig is set.
+func (k *KfSpace) CopySpecFrom(source *v1alpha1.Space, includeConfig bool) {
+	spec := source.Spec.DeepCopy()
+	spec.Security.Roles = nil
+
+	if !includeConfig {
+		spec.Execution.Env = nil
+		spec.BuildpackBuild.Env = nil
+		spec.ResourceLimits = v1alpha1.SpaceSpecResourceLimits{}
+	}
+
+	k.Spec = *spec
+}
+
+// ApplyTemplate replaces the space's configuration with the template's.
+func (k *KfSpace) ApplyTemplate(template *v1alpha1.SpaceTemplate) {
+	k.Spec = *template.Spec.DeepCopy()
+}
+
 // ToSpace casts this alias back into a v1alpha1.Space.
 func (k *KfSpace) ToSpace() *v1alpha1.Space {
 	return (*v1alpha1.Space)(k)
@@ -27,17 +27,23 @@ func ExampleKfSpace() {
 	space := NewKfSpace()
 	// Setup
 	space.SetName("nsname")
+	space.SetOrganization("my-org")
 	space.SetContainerRegistry("gcr.io/my-registry")
 	space.SetBuildServiceAccount("some-service-account")
+	space.SetQuotaPlan("small")
 
 	// Values
 	fmt.Println("Name:", space.GetName())
+	fmt.Println("Organization:", space.GetOrganization())
 	fmt.Println("Registry:", space.GetContainerRegistry())
 	fmt.Println("Build Service Account:", space.GetBuildServiceAccount())
+	fmt.Println("Quota Plan:", space.GetQuotaPlan())
 
 	// Output: Name: nsname
+	// Organization: my-org
 	// Registry: gcr.io/my-registry
 	// Build Service Account: some-service-account
+	// Quota Plan: small
 }
 
 func TestKfSpace_ToSpace(t *testing.T) {
@@ -66,3 +72,100 @@ func ExampleKfSpace_AppendDomains() {
 
 	// Output: Domains: example.com, other-example.com
 }
+
+func ExampleKfSpace_AddRole() {
+	space := NewKfSpace()
+	alice := v1alpha1.SpaceRole{Role: v1alpha1.SpaceDeveloper, Kind: "User", Name: "alice"}
+
+	fmt.Println("Added:", space.AddRole(alice))
+	fmt.Println("Added again:", space.AddRole(alice))
+	fmt.Println("Roles:", len(space.GetRoles()))
+
+	// Output: Added: true
+	// Added again: false
+	// Roles: 1
+}
+
+func ExampleKfSpace_RemoveRole() {
+	space := NewKfSpace()
+	alice := v1alpha1.SpaceRole{Role: v1alpha1.SpaceDeveloper, Kind: "User", Name: "alice"}
+	bob := v1alpha1.SpaceRole{Role: v1alpha1.SpaceAuditor, Kind: "User", Name: "bob"}
+	space.AddRole(alice)
+	space.AddRole(bob)
+
+	fmt.Println("Removed:", space.RemoveRole(alice))
+	fmt.Println("Removed again:", space.RemoveRole(alice))
+	for _, role := range space.GetRoles() {
+		fmt.Println("Remaining:", role.Name, role.Role)
+	}
+
+	// Output: Removed: true
+	// Removed again: false
+	// Remaining: bob SpaceAuditor
+}
+
+func ExampleKfSpace_BindSecurityGroup() {
+	space := NewKfSpace()
+	dns := v1alpha1.SpaceSecurityGroup{Name: "dns", Lifecycle: v1alpha1.SecurityGroupLifecycleRunning}
+
+	fmt.Println("Bound:", space.BindSecurityGroup(dns))
+	fmt.Println("Bound again:", space.BindSecurityGroup(dns))
+	fmt.Println("Groups:", len(space.GetSecurityGroups()))
+
+	// Output: Bound: true
+	// Bound again: false
+	// Groups: 1
+}
+
+func ExampleKfSpace_UnbindSecurityGroup() {
+	space := NewKfSpace()
+	running := v1alpha1.SpaceSecurityGroup{Name: "dns", Lifecycle: v1alpha1.SecurityGroupLifecycleRunning}
+	staging := v1alpha1.SpaceSecurityGroup{Name: "dns", Lifecycle: v1alpha1.SecurityGroupLifecycleStaging}
+	space.BindSecurityGroup(running)
+	space.BindSecurityGroup(staging)
+
+	fmt.Println("Unbound:", space.UnbindSecurityGroup(running))
+	fmt.Println("Unbound again:", space.UnbindSecurityGroup(running))
+	for _, group := range space.GetSecurityGroups() {
+		fmt.Println("Remaining:", group.Name, group.Lifecycle)
+	}
+
+	// Output: Unbound: true
+	// Unbound again: false
+	// Remaining: dns staging
+}
+
+func ExampleKfSpace_CopySpecFrom() {
+	source := NewKfSpace()
+	source.SetContainerRegistry("gcr.io/my-registry")
+	source.SetQuotaPlan("small")
+	source.AddRole(v1alpha1.SpaceRole{Role: v1alpha1.SpaceDeveloper, Kind: "User", Name: "alice"})
+
+	withoutConfig := NewKfSpace()
+	withoutConfig.CopySpecFrom(source.ToSpace(), false)
+	fmt.Println("Registry:", withoutConfig.GetContainerRegistry())
+	fmt.Printf("Quota Plan: %q\n", withoutConfig.GetQuotaPlan())
+	fmt.Println("Roles:", len(withoutConfig.GetRoles()))
+
+	withConfig := NewKfSpace()
+	withConfig.CopySpecFrom(source.ToSpace(), true)
+	fmt.Println("Quota Plan with config:", withConfig.GetQuotaPlan())
+	fmt.Println("Roles with config:", len(withConfig.GetRoles()))
+
+	// Output: Registry: gcr.io/my-registry
+	// Quota Plan: ""
+	// Roles: 0
+	// Quota Plan with config: small
+	// Roles with config: 0
+}
+
+func ExampleKfSpace_ApplyTemplate() {
+	template := &v1alpha1.SpaceTemplate{}
+	template.Spec.BuildpackBuild.ContainerRegistry = "gcr.io/my-registry"
+
+	space := NewKfSpace()
+	space.ApplyTemplate(template)
+	fmt.Println("Registry:", space.GetContainerRegistry())
+
+	// Output: Registry: gcr.io/my-registry
+}
@@ -0,0 +1,116 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	cv1beta1 "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/typed/servicecatalog/v1beta1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewSpaceQuotaChecker creates a v1alpha1.SpaceQuotaChecker that counts the
+// resources in spaces using the Kubernetes API.
+func NewSpaceQuotaChecker(kclient kfv1alpha1.KfV1alpha1Interface, serviceInstances cv1beta1.ServiceInstancesGetter) v1alpha1.SpaceQuotaChecker {
+	return &spaceQuotaChecker{
+		kclient:          kclient,
+		serviceInstances: serviceInstances,
+	}
+}
+
+type spaceQuotaChecker struct {
+	kclient          kfv1alpha1.KfV1alpha1Interface
+	serviceInstances cv1beta1.ServiceInstancesGetter
+}
+
+// GetKfQuota implements v1alpha1.SpaceQuotaChecker. Namespaces that aren't
+// spaces and QuotaPlans that don't exist have no limits.
+func (c *spaceQuotaChecker) GetKfQuota(name string) (*v1alpha1.SpaceKfQuota, error) {
+	space, err := c.kclient.Spaces().Get(name, metav1.GetOptions{})
+	switch {
+	case apierrs.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	quota := space.Spec.ResourceLimits.KfQuota
+	if planName := space.Spec.ResourceLimits.QuotaPlan; planName != "" {
+		plan, err := c.kclient.QuotaPlans().Get(planName, metav1.GetOptions{})
+		switch {
+		case apierrs.IsNotFound(err):
+		case err != nil:
+			return nil, err
+		default:
+			quota = quota.WithDefaults(plan.Spec.SpaceKfQuota)
+		}
+	}
+
+	return &quota, nil
+}
+
+// GetUsage implements v1alpha1.SpaceQuotaChecker.
+func (c *spaceQuotaChecker) GetUsage(space string) (*v1alpha1.SpaceQuotaUsage, error) {
+	usage := &v1alpha1.SpaceQuotaUsage{
+		ServiceBindings: make(map[string]int64),
+		AppInstances:    make(map[string]int64),
+	}
+
+	seenRoutes := make(map[string]bool)
+	addRoute := func(route v1alpha1.RouteSpecFields) {
+		if !seenRoutes[route.String()] {
+			seenRoutes[route.String()] = true
+			usage.Routes = append(usage.Routes, route)
+		}
+	}
+
+	apps, err := c.kclient.Apps(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, app := range apps.Items {
+		usage.AppInstances[app.Name] = app.Spec.Instances.QuotaCount()
+		usage.ServiceBindings[app.Name] = int64(len(app.Spec.ServiceBindings))
+
+		for _, route := range app.Spec.Routes {
+			addRoute(route)
+		}
+	}
+
+	routes, err := c.kclient.Routes(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routes.Items {
+		addRoute(route.Spec.RouteSpecFields)
+	}
+
+	claims, err := c.kclient.RouteClaims(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range claims.Items {
+		addRoute(claim.Spec.RouteSpecFields)
+	}
+
+	instances, err := c.serviceInstances.ServiceInstances(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	usage.ServiceInstances = int64(len(instances.Items))
+
+	return usage, nil
+}
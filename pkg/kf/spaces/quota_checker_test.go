@@ -0,0 +1,98 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	scfake "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSpaceQuotaChecker_GetKfQuota(t *testing.T) {
+	five, ten, twenty := int64(5), int64(10), int64(20)
+
+	space := &v1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "my-space"}}
+	space.Spec.ResourceLimits.QuotaPlan = "small"
+	space.Spec.ResourceLimits.KfQuota.Routes = &five
+
+	plan := &v1alpha1.QuotaPlan{ObjectMeta: metav1.ObjectMeta{Name: "small"}}
+	plan.Spec.Routes = &twenty
+	plan.Spec.AppInstances = &ten
+
+	unplanned := &v1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "unplanned"}}
+	unplanned.Spec.ResourceLimits.QuotaPlan = "missing"
+	unplanned.Spec.ResourceLimits.KfQuota.AppInstances = &five
+
+	checker := NewSpaceQuotaChecker(
+		kffake.NewSimpleClientset(space, plan, unplanned).KfV1alpha1(),
+		scfake.NewSimpleClientset().ServicecatalogV1beta1(),
+	)
+
+	t.Run("plan fills in limits", func(t *testing.T) {
+		quota, err := checker.GetKfQuota("my-space")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "quota", v1alpha1.SpaceKfQuota{Routes: &five, AppInstances: &ten}, *quota)
+	})
+
+	t.Run("missing plan", func(t *testing.T) {
+		quota, err := checker.GetKfQuota("unplanned")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "quota", v1alpha1.SpaceKfQuota{AppInstances: &five}, *quota)
+	})
+
+	t.Run("not a space", func(t *testing.T) {
+		quota, err := checker.GetKfQuota("kube-system")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "quota", (*v1alpha1.SpaceKfQuota)(nil), quota)
+	})
+}
+
+func TestSpaceQuotaChecker_GetUsage(t *testing.T) {
+	three := 3
+	routeA := v1alpha1.RouteSpecFields{Hostname: "a", Domain: "example.com"}
+	routeB := v1alpha1.RouteSpecFields{Hostname: "b", Domain: "example.com"}
+
+	app := &v1alpha1.App{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-space"}}
+	app.Spec.Instances.Exactly = &three
+	app.Spec.Routes = []v1alpha1.RouteSpecFields{routeA}
+	app.Spec.ServiceBindings = []v1alpha1.AppSpecServiceBinding{{Instance: "db"}}
+
+	route := &v1alpha1.Route{ObjectMeta: metav1.ObjectMeta{Name: "route-a", Namespace: "my-space"}}
+	route.Spec.RouteSpecFields = routeA
+
+	claim := &v1alpha1.RouteClaim{ObjectMeta: metav1.ObjectMeta{Name: "claim-b", Namespace: "my-space"}}
+	claim.Spec.RouteSpecFields = routeB
+
+	otherSpace := &v1alpha1.App{ObjectMeta: metav1.ObjectMeta{Name: "other-app", Namespace: "other-space"}}
+
+	instance := &servicecatalogv1beta1.ServiceInstance{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "my-space"}}
+
+	checker := NewSpaceQuotaChecker(
+		kffake.NewSimpleClientset(app, route, claim, otherSpace).KfV1alpha1(),
+		scfake.NewSimpleClientset(instance).ServicecatalogV1beta1(),
+	)
+
+	usage, err := checker.GetUsage("my-space")
+	testutil.AssertNil(t, "err", err)
+	testutil.AssertEqual(t, "routes", []v1alpha1.RouteSpecFields{routeA, routeB}, usage.Routes)
+	testutil.AssertEqual(t, "service instances", int64(1), usage.ServiceInstances)
+	testutil.AssertEqual(t, "service bindings", map[string]int64{"my-app": 1}, usage.ServiceBindings)
+	testutil.AssertEqual(t, "app instances", map[string]int64{"my-app": 3}, usage.AppInstances)
+}
@@ -0,0 +1,46 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewSpaceGetter creates a v1alpha1.SpaceGetter that reads spaces from the
+// Kubernetes API so the webhook can enforce their policies.
+func NewSpaceGetter(spaces kfv1alpha1.SpacesGetter) v1alpha1.SpaceGetter {
+	return &spaceGetter{spaces: spaces}
+}
+
+type spaceGetter struct {
+	spaces kfv1alpha1.SpacesGetter
+}
+
+// GetSpace implements v1alpha1.SpaceGetter. Namespaces that aren't spaces
+// return nil so they have no policy.
+func (g *spaceGetter) GetSpace(name string) (*v1alpha1.Space, error) {
+	space, err := g.spaces.Spaces().Get(name, metav1.GetOptions{})
+	switch {
+	case apierrs.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	default:
+		return space, nil
+	}
+}
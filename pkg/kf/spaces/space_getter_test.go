@@ -0,0 +1,43 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSpaceGetter_GetSpace(t *testing.T) {
+	space := &v1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "my-space"}}
+	space.Spec.Policy.DisableContainerImages = true
+
+	getter := NewSpaceGetter(kffake.NewSimpleClientset(space).KfV1alpha1())
+
+	t.Run("space", func(t *testing.T) {
+		got, err := getter.GetSpace("my-space")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "policy", space.Spec.Policy, got.Spec.Policy)
+	})
+
+	t.Run("not a space", func(t *testing.T) {
+		got, err := getter.GetSpace("kube-system")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "found", false, got != nil)
+	})
+}
@@ -0,0 +1,322 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kfv1alpha1 "github.com/google/kf/pkg/client/clientset/versioned/typed/kf/v1alpha1"
+	serving "github.com/google/kf/third_party/knative-serving/pkg/client/clientset/versioned/typed/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sclient "k8s.io/client-go/kubernetes"
+)
+
+// AppUsage is the compute requested by an App's latest ready revision.
+type AppUsage struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Name is the name of the App.
+	Name string `json:"name"`
+
+	// Revision is the Knative revision the requests were read from, it's
+	// empty if the App has no ready revision.
+	Revision string `json:"revision,omitempty"`
+
+	// Instances is the number of instances the App is counted as. Autoscaled
+	// Apps count as their maximum the same way they do for quotas.
+	Instances int64 `json:"instances"`
+
+	// CPU and Memory are the requests of a single instance.
+	CPU    resource.Quantity `json:"cpu"`
+	Memory resource.Quantity `json:"memory"`
+
+	// TotalCPU and TotalMemory are the requests of all instances.
+	TotalCPU    resource.Quantity `json:"totalCPU"`
+	TotalMemory resource.Quantity `json:"totalMemory"`
+}
+
+// BuildUsage is the compute consumed by the Build pods still in a space.
+type BuildUsage struct {
+	// Builds is the number of Build pods that were counted.
+	Builds int `json:"builds"`
+
+	// Seconds is the combined running time of the Build pods.
+	Seconds float64 `json:"seconds"`
+
+	// CPUSeconds is the requested CPU cores multiplied by running time.
+	CPUSeconds float64 `json:"cpuSeconds"`
+
+	// MemoryGiBSeconds is the requested memory in GiB multiplied by running
+	// time.
+	MemoryGiBSeconds float64 `json:"memoryGiBSeconds"`
+}
+
+// SpaceUsage is the compute requested by the Apps in a space and consumed by
+// its Builds.
+type SpaceUsage struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Space is the name of the space.
+	Space string `json:"space"`
+
+	// Apps holds the usage of each App in the space.
+	Apps []AppUsage `json:"apps"`
+
+	// TotalCPU and TotalMemory are the requests of all App instances.
+	TotalCPU    resource.Quantity `json:"totalCPU"`
+	TotalMemory resource.Quantity `json:"totalMemory"`
+
+	// Builds is the compute consumed by Builds in the space.
+	Builds BuildUsage `json:"builds"`
+}
+
+// AppUsageList is the usage of every App in a space.
+type AppUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Items holds the usage of each App.
+	Items []AppUsage `json:"items"`
+}
+
+// GetGroupVersionKind returns the GroupVersionKind reports are printed with.
+func (u *AppUsage) GetGroupVersionKind() schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind("AppUsage")
+}
+
+// DeepCopyObject implements runtime.Object.
+func (u *AppUsage) DeepCopyObject() runtime.Object {
+	out := *u
+	out.CPU = u.CPU.DeepCopy()
+	out.Memory = u.Memory.DeepCopy()
+	out.TotalCPU = u.TotalCPU.DeepCopy()
+	out.TotalMemory = u.TotalMemory.DeepCopy()
+	return &out
+}
+
+// GetGroupVersionKind returns the GroupVersionKind reports are printed with.
+func (u *AppUsageList) GetGroupVersionKind() schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind("AppUsageList")
+}
+
+// DeepCopyObject implements runtime.Object.
+func (u *AppUsageList) DeepCopyObject() runtime.Object {
+	out := *u
+	out.Items = deepCopyAppUsages(u.Items)
+	return &out
+}
+
+// GetGroupVersionKind returns the GroupVersionKind reports are printed with.
+func (u *SpaceUsage) GetGroupVersionKind() schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind("SpaceUsage")
+}
+
+// DeepCopyObject implements runtime.Object.
+func (u *SpaceUsage) DeepCopyObject() runtime.Object {
+	out := *u
+	out.Apps = deepCopyAppUsages(u.Apps)
+	out.TotalCPU = u.TotalCPU.DeepCopy()
+	out.TotalMemory = u.TotalMemory.DeepCopy()
+	return &out
+}
+
+func deepCopyAppUsages(in []AppUsage) []AppUsage {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]AppUsage, len(in))
+	for i := range in {
+		out[i] = *in[i].DeepCopyObject().(*AppUsage)
+	}
+	return out
+}
+
+// UsageReporter reports the resources used by spaces and Apps.
+type UsageReporter interface {
+	// SpaceUsage reports the resources requested by all Apps in the space and
+	// consumed by its Builds.
+	SpaceUsage(space string) (*SpaceUsage, error)
+
+	// AppUsage reports the resources requested by a single App.
+	AppUsage(space, app string) (*AppUsage, error)
+}
+
+// NewUsageReporter creates a UsageReporter that reads Apps from Kf, requests
+// from Knative revisions and Build pods from Kubernetes.
+func NewUsageReporter(
+	kclient kfv1alpha1.KfV1alpha1Interface,
+	servingClient serving.ServingV1alpha1Interface,
+	k8s k8sclient.Interface,
+) UsageReporter {
+	return &usageReporter{
+		kclient:       kclient,
+		servingClient: servingClient,
+		k8s:           k8s,
+		now:           time.Now,
+	}
+}
+
+type usageReporter struct {
+	kclient       kfv1alpha1.KfV1alpha1Interface
+	servingClient serving.ServingV1alpha1Interface
+	k8s           k8sclient.Interface
+	now           func() time.Time
+}
+
+// SpaceUsage implements UsageReporter.
+func (u *usageReporter) SpaceUsage(space string) (*SpaceUsage, error) {
+	apps, err := u.kclient.Apps(space).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &SpaceUsage{
+		Space: space,
+		Apps:  []AppUsage{},
+	}
+	for i := range apps.Items {
+		appUsage, err := u.appUsage(&apps.Items[i])
+		if err != nil {
+			return nil, err
+		}
+
+		usage.Apps = append(usage.Apps, *appUsage)
+		usage.TotalCPU.Add(appUsage.TotalCPU)
+		usage.TotalMemory.Add(appUsage.TotalMemory)
+	}
+	sort.Slice(usage.Apps, func(i, j int) bool {
+		return usage.Apps[i].Name < usage.Apps[j].Name
+	})
+
+	pods, err := u.k8s.CoreV1().Pods(space).List(metav1.ListOptions{
+		LabelSelector: v1alpha1.BuildPodLabel,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		addBuildPod(&usage.Builds, &pods.Items[i], u.now())
+	}
+
+	return usage, nil
+}
+
+// AppUsage implements UsageReporter.
+func (u *usageReporter) AppUsage(space, app string) (*AppUsage, error) {
+	kfApp, err := u.kclient.Apps(space).Get(app, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.appUsage(kfApp)
+}
+
+func (u *usageReporter) appUsage(app *v1alpha1.App) (*AppUsage, error) {
+	usage := &AppUsage{
+		Name:      app.Name,
+		Revision:  app.Status.LatestReadyRevisionName,
+		Instances: app.Spec.Instances.QuotaCount(),
+	}
+
+	if usage.Revision != "" {
+		revision, err := u.servingClient.Revisions(app.Namespace).Get(usage.Revision, metav1.GetOptions{})
+		switch {
+		case apierrs.IsNotFound(err):
+			// The revision was garbage collected, report it as requesting
+			// nothing rather than failing the whole report.
+		case err != nil:
+			return nil, err
+		default:
+			requirements := revision.Spec.GetContainer().Resources
+			usage.CPU = requested(requirements, corev1.ResourceCPU)
+			usage.Memory = requested(requirements, corev1.ResourceMemory)
+		}
+	}
+
+	usage.TotalCPU = multiply(usage.CPU, usage.Instances)
+	usage.TotalMemory = multiply(usage.Memory, usage.Instances)
+
+	return usage, nil
+}
+
+// addBuildPod adds the consumption of a single Build pod to the usage. Knative
+// Build runs each step as an init container, so the pod requests the largest
+// of its init containers or the sum of its regular containers, whichever is
+// greater.
+func addBuildPod(usage *BuildUsage, pod *corev1.Pod, now time.Time) {
+	if pod.Status.StartTime == nil {
+		return
+	}
+
+	end := now
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		end = pod.Status.StartTime.Time
+		for _, statuses := range [][]corev1.ContainerStatus{
+			pod.Status.InitContainerStatuses,
+			pod.Status.ContainerStatuses,
+		} {
+			for _, status := range statuses {
+				if terminated := status.State.Terminated; terminated != nil && terminated.FinishedAt.After(end) {
+					end = terminated.FinishedAt.Time
+				}
+			}
+		}
+	}
+
+	seconds := end.Sub(pod.Status.StartTime.Time).Seconds()
+	if seconds < 0 {
+		seconds = 0
+	}
+
+	var cpu, memory resource.Quantity
+	for _, container := range pod.Spec.Containers {
+		cpu.Add(requested(container.Resources, corev1.ResourceCPU))
+		memory.Add(requested(container.Resources, corev1.ResourceMemory))
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if q := requested(container.Resources, corev1.ResourceCPU); q.Cmp(cpu) > 0 {
+			cpu = q
+		}
+		if q := requested(container.Resources, corev1.ResourceMemory); q.Cmp(memory) > 0 {
+			memory = q
+		}
+	}
+
+	usage.Builds++
+	usage.Seconds += seconds
+	usage.CPUSeconds += float64(cpu.MilliValue()) / 1000 * seconds
+	usage.MemoryGiBSeconds += float64(memory.Value()) / (1 << 30) * seconds
+}
+
+// requested returns the quantity of the resource a container requests.
+// Kubernetes defaults requests to limits if only limits are set.
+func requested(requirements corev1.ResourceRequirements, name corev1.ResourceName) resource.Quantity {
+	if q, ok := requirements.Requests[name]; ok {
+		return q
+	}
+
+	return requirements.Limits[name]
+}
+
+func multiply(q resource.Quantity, n int64) resource.Quantity {
+	return *resource.NewMilliQuantity(q.MilliValue()*n, q.Format)
+}
@@ -0,0 +1,135 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	"github.com/google/kf/pkg/kf/testutil"
+	serving "github.com/google/kf/third_party/knative-serving/pkg/apis/serving/v1alpha1"
+	servingfake "github.com/google/kf/third_party/knative-serving/pkg/client/clientset/versioned/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUsageReporter(t *testing.T) {
+	instances := 3
+	app := &v1alpha1.App{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-space"}}
+	app.Spec.Instances.Exactly = &instances
+	app.Status.LatestReadyRevisionName = "my-app-abc"
+
+	unready := &v1alpha1.App{ObjectMeta: metav1.ObjectMeta{Name: "unready", Namespace: "my-space"}}
+
+	revision := &serving.Revision{ObjectMeta: metav1.ObjectMeta{Name: "my-app-abc", Namespace: "my-space"}}
+	revision.Spec.Containers = []corev1.Container{{
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("500m"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		},
+	}}
+
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	completed := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "build-completed",
+		Namespace: "my-space",
+		Labels:    map[string]string{v1alpha1.BuildPodLabel: "completed"},
+	}}
+	completed.Spec.InitContainers = []corev1.Container{
+		{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		}}},
+		{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("2"),
+		}}},
+	}
+	completed.Status.Phase = corev1.PodSucceeded
+	completed.Status.StartTime = &metav1.Time{Time: start}
+	completed.Status.InitContainerStatuses = []corev1.ContainerStatus{{
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			FinishedAt: metav1.Time{Time: start.Add(100 * time.Second)},
+		}},
+	}}
+
+	running := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      "build-running",
+		Namespace: "my-space",
+		Labels:    map[string]string{v1alpha1.BuildPodLabel: "running"},
+	}}
+	running.Spec.Containers = []corev1.Container{
+		{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("1"),
+		}}},
+	}
+	running.Status.Phase = corev1.PodRunning
+	running.Status.StartTime = &metav1.Time{Time: start}
+
+	appPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "my-app-pod", Namespace: "my-space"}}
+
+	reporter := NewUsageReporter(
+		kffake.NewSimpleClientset(app, unready).KfV1alpha1(),
+		servingfake.NewSimpleClientset(revision).ServingV1alpha1(),
+		k8sfake.NewSimpleClientset(completed, running, appPod),
+	)
+	reporter.(*usageReporter).now = func() time.Time {
+		return start.Add(50 * time.Second)
+	}
+
+	t.Run("app", func(t *testing.T) {
+		usage, err := reporter.AppUsage("my-space", "my-app")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "revision", "my-app-abc", usage.Revision)
+		testutil.AssertEqual(t, "instances", int64(3), usage.Instances)
+		testutil.AssertEqual(t, "cpu", "500m", usage.CPU.String())
+		testutil.AssertEqual(t, "memory", "1Gi", usage.Memory.String())
+		testutil.AssertEqual(t, "total cpu", "1500m", usage.TotalCPU.String())
+		testutil.AssertEqual(t, "total memory", "3Gi", usage.TotalMemory.String())
+	})
+
+	t.Run("space", func(t *testing.T) {
+		usage, err := reporter.SpaceUsage("my-space")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "apps", 2, len(usage.Apps))
+		testutil.AssertEqual(t, "unready instances", int64(1), usage.Apps[1].Instances)
+		testutil.AssertEqual(t, "unready cpu", "0", usage.Apps[1].TotalCPU.String())
+		testutil.AssertEqual(t, "total cpu", "1500m", usage.TotalCPU.String())
+		testutil.AssertEqual(t, "total memory", "3Gi", usage.TotalMemory.String())
+
+		// The completed pod requests its largest init container (2 CPU, 2Gi)
+		// for 100 seconds and the running pod 1 CPU for 50 seconds so far.
+		testutil.AssertEqual(t, "builds", BuildUsage{
+			Builds:           2,
+			Seconds:          150,
+			CPUSeconds:       250,
+			MemoryGiBSeconds: 200,
+		}, usage.Builds)
+	})
+
+	t.Run("empty space", func(t *testing.T) {
+		usage, err := reporter.SpaceUsage("empty-space")
+		testutil.AssertNil(t, "err", err)
+		testutil.AssertEqual(t, "apps", 0, len(usage.Apps))
+		testutil.AssertEqual(t, "builds", 0, usage.Builds.Builds)
+	})
+}
@@ -1087,7 +1087,7 @@ func (k *Kf) CreateSpace(ctx context.Context, space string) []string {
 	return CombineOutputStr(ctx, k.t, output)
 }
 
-// DeleteSpace runs the create-space command.
+// DeleteSpace runs the delete-space command.
 func (k *Kf) DeleteSpace(ctx context.Context, space string) []string {
 	k.t.Helper()
 	Logf(k.t, "running delete-space...")
@@ -1096,6 +1096,7 @@ func (k *Kf) DeleteSpace(ctx context.Context, space string) []string {
 		Args: []string{
 			"delete-space",
 			space,
+			"--force",
 		},
 	})
 	PanicOnError(ctx, k.t, "delete-space", errs)
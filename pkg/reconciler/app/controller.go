@@ -21,6 +21,7 @@ import (
 	appinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/app"
 	routeinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/route"
 	routeclaiminformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/routeclaim"
+	shareinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/serviceinstanceshare"
 	sourceinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/source"
 	spaceinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/space"
 	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/injection/client"
@@ -29,6 +30,9 @@ import (
 	"github.com/google/kf/pkg/reconciler"
 	krevisioninformer "github.com/google/kf/third_party/knative-serving/pkg/client/injection/informers/serving/v1alpha1/revision"
 	kserviceinformer "github.com/google/kf/third_party/knative-serving/pkg/client/injection/informers/serving/v1alpha1/service"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
@@ -50,6 +54,7 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 	serviceBindingInformer := servicebindinginformer.Get(ctx)
 	serviceInstanceInformer := serviceinstanceinformer.Get(ctx)
 	secretInformer := secretinformer.Get(ctx)
+	shareInformer := shareinformer.Get(ctx)
 
 	serviceCatalogClient := servicecatalogclient.Get(ctx)
 
@@ -67,6 +72,7 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 		routeClaimLister:      routeClaimInformer.Lister(),
 		serviceBindingLister:  serviceBindingInformer.Lister(),
 		serviceInstanceLister: serviceInstanceInformer.Lister(),
+		shareLister:           shareInformer.Lister(),
 	}
 
 	impl := controller.NewImpl(c, logger, "Apps")
@@ -91,6 +97,85 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	})
 
+	// Bindings to shared service instances are in the space of the instance
+	// and owned by the ServiceInstanceShare rather than the App.
+	serviceBindingInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			binding, ok := obj.(*servicecatalogv1beta1.ServiceBinding)
+			return ok && binding.Labels[v1alpha1.AppSpaceLabel] != ""
+		},
+		Handler: controller.HandleAll(func(obj interface{}) {
+			binding, ok := obj.(*servicecatalogv1beta1.ServiceBinding)
+			if !ok {
+				return
+			}
+
+			app, err := c.appLister.
+				Apps(binding.Labels[v1alpha1.AppSpaceLabel]).
+				Get(binding.Labels[v1alpha1.NameLabel])
+			if err != nil {
+				return
+			}
+
+			impl.Enqueue(app)
+		}),
+	})
+
+	// Sharing or unsharing a service instance changes which bindings Apps in
+	// the other space can have.
+	shareInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		share, ok := obj.(*v1alpha1.ServiceInstanceShare)
+		if !ok {
+			return
+		}
+
+		apps, err := c.appLister.Apps(share.Spec.Space).List(labels.Everything())
+		if err != nil {
+			logger.Warnf("couldn't list apps bound to shared service %q: %v", share.Spec.InstanceName, err)
+			return
+		}
+
+		for _, app := range apps {
+			for _, binding := range app.Spec.ServiceBindings {
+				if binding.Instance == share.Spec.InstanceName && binding.InstanceSpace == share.Namespace {
+					impl.Enqueue(app)
+					break
+				}
+			}
+		}
+	}))
+
+	// Changes to user-provided services need to be reflected in the
+	// environment of the Apps bound to them.
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			secret, ok := obj.(*corev1.Secret)
+			return ok && v1alpha1.IsUserProvidedService(secret)
+		},
+		Handler: controller.HandleAll(func(obj interface{}) {
+			secret, ok := obj.(*corev1.Secret)
+			if !ok {
+				return
+			}
+
+			instanceName := v1alpha1.UserProvidedServiceInstanceName(secret)
+			apps, err := c.appLister.Apps(secret.Namespace).List(labels.Everything())
+			if err != nil {
+				logger.Warnf("couldn't list apps bound to user-provided service %q: %v", instanceName, err)
+				return
+			}
+
+			for _, app := range apps {
+				for _, binding := range app.Spec.ServiceBindings {
+					if binding.Instance == instanceName {
+						impl.Enqueue(app)
+						break
+					}
+				}
+			}
+		}),
+	})
+
 	routeInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: controller.Filter(v1alpha1.SchemeGroupVersion.WithKind("App")),
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
@@ -40,6 +40,7 @@ import (
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	v1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/controller"
@@ -65,6 +66,7 @@ type Reconciler struct {
 	routeClaimLister      kflisters.RouteClaimLister
 	serviceBindingLister  servicecataloglisters.ServiceBindingLister
 	serviceInstanceLister servicecataloglisters.ServiceInstanceLister
+	shareLister           kflisters.ServiceInstanceShareLister
 }
 
 // Check that our Reconciler implements controller.Reconciler
@@ -91,7 +93,10 @@ func (r *Reconciler) reconcileApp(ctx context.Context, namespace, name string) (
 	switch {
 	case apierrs.IsNotFound(err):
 		logger.Errorf("app %q no longer exists\n", name)
-		return nil
+
+		// Bindings in the spaces of shared service instances can't be owned
+		// by the App, so they need to be cleaned up explicitly.
+		return r.deleteSharedServiceBindings(namespace, name)
 
 	case err != nil:
 		return err
@@ -190,8 +195,18 @@ func (r *Reconciler) ApplyChanges(ctx context.Context, app *v1alpha1.App) error
 	// reconcile service bindings
 	var actualServiceBindings []servicecatalogv1beta1.ServiceBinding
 	{
-		desiredServiceBindings, err := resources.MakeServiceBindings(app)
 		condition := app.Status.ServiceBindingCondition()
+		userProvided, err := r.userProvidedServices(app)
+		if err != nil {
+			return condition.MarkReconciliationError("getting user-provided services", err)
+		}
+
+		shares, err := r.serviceInstanceShares(app)
+		if err != nil {
+			return condition.MarkReconciliationError("getting service instance shares for", err)
+		}
+
+		desiredServiceBindings, err := resources.MakeServiceBindings(app, userProvided, shares)
 		if err != nil {
 			return condition.MarkTemplateError(err)
 		}
@@ -205,14 +220,17 @@ func (r *Reconciler) ApplyChanges(ctx context.Context, app *v1alpha1.App) error
 			return condition.MarkReconciliationError("scanning for stale service bindings", err)
 		}
 
+		existingShared, err := r.serviceBindingLister.
+			List(resources.MakeSharedServiceBindingAppSelector(app.Namespace, app.Name))
+		if err != nil {
+			return condition.MarkReconciliationError("scanning for stale shared service bindings", err)
+		}
+		existing = append(existing, existingShared...)
+
 		// Search to see if any of the existing bindings are not in the desired
 		// list of and therefore stale. If they are, delete them.
 		for _, binding := range existing {
-			if algorithms.Search(
-				0,
-				v1alpha1.ServiceBindings{*binding},
-				v1alpha1.ServiceBindings(desiredServiceBindings),
-			) {
+			if hasServiceBinding(desiredServiceBindings, binding) {
 				continue
 			}
 
@@ -252,11 +270,12 @@ func (r *Reconciler) ApplyChanges(ctx context.Context, app *v1alpha1.App) error
 		}
 	}
 
+	systemEnvInjector := cfutil.NewSystemEnvInjector(r.serviceCatalogClient, r.KubeClientSet)
+
 	// Reconcile VCAP env vars secret
 	{
 		logger.Debug("reconciling env vars secret")
 		condition := app.Status.EnvVarSecretCondition()
-		systemEnvInjector := cfutil.NewSystemEnvInjector(r.serviceCatalogClient, r.KubeClientSet)
 		desired, err := resources.MakeKfInjectedEnvSecret(app, space, actualServiceBindings, systemEnvInjector)
 
 		if err != nil {
@@ -279,6 +298,63 @@ func (r *Reconciler) ApplyChanges(ctx context.Context, app *v1alpha1.App) error
 		app.Status.PropagateEnvVarSecretStatus(actual)
 	}
 
+	// Reconcile service binding files secrets
+	{
+		logger.Debug("reconciling service binding files")
+		condition := app.Status.EnvVarSecretCondition()
+
+		var files map[string]cfutil.ServiceBindingFiles
+		if resources.HasServiceBindingFiles(app, space) {
+			files, err = systemEnvInjector.GetServiceBindingFiles(app, actualServiceBindings)
+			if err != nil {
+				return condition.MarkTemplateError(err)
+			}
+		}
+
+		desiredSecrets, err := resources.MakeServiceBindingFilesSecrets(app, space, files)
+		if err != nil {
+			return condition.MarkTemplateError(err)
+		}
+
+		// Delete Stale Secrets
+		existing, err := r.secretLister.
+			Secrets(app.GetNamespace()).
+			List(resources.MakeServiceBindingFilesSelector(app))
+		if err != nil {
+			return condition.MarkReconciliationError("scanning for stale service binding files", err)
+		}
+
+		for _, secret := range existing {
+			if hasSecret(desiredSecrets, secret.Name) || !metav1.IsControlledBy(secret, app) {
+				continue
+			}
+
+			if err := r.KubeClientSet.
+				CoreV1().
+				Secrets(secret.Namespace).
+				Delete(secret.Name, &metav1.DeleteOptions{}); err != nil {
+				return condition.MarkReconciliationError("deleting existing service binding files", err)
+			}
+		}
+
+		for i := range desiredSecrets {
+			desired := &desiredSecrets[i]
+			actual, err := r.secretLister.Secrets(desired.GetNamespace()).Get(desired.Name)
+			if apierrs.IsNotFound(err) {
+				_, err = r.KubeClientSet.CoreV1().Secrets(desired.GetNamespace()).Create(desired)
+				if err != nil {
+					return condition.MarkReconciliationError("creating service binding files", err)
+				}
+			} else if err != nil {
+				return condition.MarkReconciliationError("getting latest service binding files", err)
+			} else if !metav1.IsControlledBy(actual, app) {
+				return condition.MarkChildNotOwned(desired.Name)
+			} else if _, err = r.reconcileSecret(ctx, desired, actual); err != nil {
+				return condition.MarkReconciliationError("updating existing service binding files", err)
+			}
+		}
+	}
+
 	// reconcile serving
 	{
 		logger.Debug("reconciling Knative Serving")
@@ -581,6 +657,104 @@ func (r *Reconciler) reconcileServiceBinding(
 		Update(existing)
 }
 
+// userProvidedServices returns the names of the user-provided service
+// instances the App is bound to.
+func (r *Reconciler) userProvidedServices(app *v1alpha1.App) (sets.String, error) {
+	userProvided := sets.NewString()
+	for _, binding := range app.Spec.ServiceBindings {
+		// User-provided services can't be shared.
+		if resources.IsSharedServiceBinding(app, &binding) {
+			continue
+		}
+
+		secret, err := r.secretLister.
+			Secrets(app.Namespace).
+			Get(v1alpha1.UserProvidedServiceSecretName(binding.Instance))
+		switch {
+		case apierrs.IsNotFound(err):
+			continue
+		case err != nil:
+			return nil, err
+		case v1alpha1.IsUserProvidedService(secret):
+			userProvided.Insert(binding.Instance)
+		}
+	}
+
+	return userProvided, nil
+}
+
+// serviceInstanceShares returns the ServiceInstanceShares that grant the App
+// access to service instances in other spaces by binding name. Bindings to
+// instances that haven't been shared with the App's space are left out.
+func (r *Reconciler) serviceInstanceShares(app *v1alpha1.App) (map[string]*v1alpha1.ServiceInstanceShare, error) {
+	shares := make(map[string]*v1alpha1.ServiceInstanceShare)
+	for _, binding := range app.Spec.ServiceBindings {
+		if !resources.IsSharedServiceBinding(app, &binding) {
+			continue
+		}
+
+		share, err := r.shareLister.
+			ServiceInstanceShares(binding.InstanceSpace).
+			Get(v1alpha1.ServiceInstanceShareName(binding.Instance, app.Namespace))
+		switch {
+		case apierrs.IsNotFound(err):
+			continue
+		case err != nil:
+			return nil, err
+		case share.Spec.InstanceName != binding.Instance || share.Spec.Space != app.Namespace:
+			continue
+		default:
+			shares[binding.BindingName] = share
+		}
+	}
+
+	return shares, nil
+}
+
+// deleteSharedServiceBindings deletes the bindings the App had to service
+// instances in other spaces.
+func (r *Reconciler) deleteSharedServiceBindings(namespace, name string) error {
+	bindings, err := r.serviceBindingLister.
+		List(resources.MakeSharedServiceBindingAppSelector(namespace, name))
+	if err != nil {
+		return err
+	}
+
+	for _, binding := range bindings {
+		if err := r.serviceCatalogClient.
+			ServicecatalogV1beta1().
+			ServiceBindings(binding.Namespace).
+			Delete(binding.Name, &metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasServiceBinding returns true if bindings contains a binding with the same
+// namespace and name as binding.
+func hasServiceBinding(bindings []servicecatalogv1beta1.ServiceBinding, binding *servicecatalogv1beta1.ServiceBinding) bool {
+	for _, b := range bindings {
+		if b.Namespace == binding.Namespace && b.Name == binding.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasSecret returns true if secrets contains a Secret with the name.
+func hasSecret(secrets []v1.Secret, name string) bool {
+	for _, s := range secrets {
+		if s.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *Reconciler) updateStatus(ctx context.Context, desired *v1alpha1.App) (*v1alpha1.App, error) {
 	logger := logging.FromContext(ctx)
 	logger.Info("updating status")
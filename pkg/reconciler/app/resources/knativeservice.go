@@ -69,6 +69,17 @@ func MakeKnativeService(
 	podSpec.Containers[0].Env = append(space.Spec.Execution.Env, podSpec.Containers[0].Env...)
 	podSpec.Containers[0].Env = envutil.DeduplicateEnvVars(podSpec.Containers[0].Env)
 
+	// Mount binding credentials as files for the bindings that opted in.
+	addServiceBindingFiles(app, space, podSpec)
+
+	// Run instances on the nodes the space is placed on. Knative Serving
+	// rejects placement fields in the pod spec so Kf's pod webhook sets them
+	// from the annotation.
+	placementAnnotations, err := space.Status.ExecutionPlacement.Annotations()
+	if err != nil {
+		return nil, err
+	}
+
 	// Inject VCAP env vars from secret
 	podSpec.Containers[0].EnvFrom = []corev1.EnvFromSource{
 		{
@@ -94,7 +105,7 @@ func MakeKnativeService(
 				Template: &serving.RevisionTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
 						Labels:      app.ComponentLabels("app-server"),
-						Annotations: app.Spec.Instances.ScalingAnnotations(),
+						Annotations: v1alpha1.UnionMaps(app.Spec.Instances.ScalingAnnotations(), placementAnnotations),
 					},
 					Spec: serving.RevisionSpec{
 						RevisionSpec: servingv1beta1.RevisionSpec{
@@ -0,0 +1,79 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestMakeKnativeService_placement(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		placement v1alpha1.PodPlacement
+		wantOk    bool
+	}{
+		"no placement": {},
+		"placement": {
+			placement: v1alpha1.PodPlacement{
+				NodeSelector: map[string]string{"pool": "pci"},
+				Tolerations: []corev1.Toleration{
+					{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "pci", Effect: corev1.TaintEffectNoSchedule},
+				},
+				Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}},
+			},
+			wantOk: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			app := &v1alpha1.App{}
+			app.Name = "my-app"
+			app.Namespace = "my-space"
+			app.UID = "1234"
+			app.Spec.Template.Spec.Containers = []corev1.Container{{}}
+			app.Status.Image = "gcr.io/my-app"
+
+			space := &v1alpha1.Space{}
+			space.Name = "my-space"
+			space.Status.ExecutionPlacement = tc.placement
+
+			service, err := MakeKnativeService(app, space)
+			testutil.AssertNil(t, "MakeKnativeService err", err)
+
+			// Knative Serving must accept the Service.
+			ctx := apis.WithinCreate(context.Background())
+			service.SetDefaults(ctx)
+			if err := service.Validate(ctx); err != nil {
+				t.Fatalf("Knative Serving rejected the Service: %v", err)
+			}
+
+			placement, ok, err := v1alpha1.PodPlacementFromAnnotations(service.Spec.Template.Annotations)
+			testutil.AssertNil(t, "PodPlacementFromAnnotations err", err)
+			testutil.AssertEqual(t, "has placement", tc.wantOk, ok)
+			testutil.AssertEqual(t, "placement", tc.placement, placement)
+		})
+	}
+}
@@ -49,8 +49,8 @@ func MakeRouteAppLabels(app *v1alpha1.App) map[string]string {
 	}
 }
 
-func mustRequirement(key string, op selection.Operator, val string) labels.Requirement {
-	r, err := labels.NewRequirement(key, op, []string{val})
+func mustRequirement(key string, op selection.Operator, vals ...string) labels.Requirement {
+	r, err := labels.NewRequirement(key, op, vals)
 	if err != nil {
 		panic(err)
 	}
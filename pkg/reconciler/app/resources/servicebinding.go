@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/kmeta"
 )
 
@@ -33,21 +34,76 @@ func MakeServiceBindingLabels(app *v1alpha1.App, binding *v1alpha1.AppSpecServic
 }
 
 func MakeServiceBindingName(app *v1alpha1.App, binding *v1alpha1.AppSpecServiceBinding) string {
+	if IsSharedServiceBinding(app, binding) {
+		// Bindings to shared instances live in the space of the instance, so
+		// the name includes the App's space to avoid collisions with Apps
+		// in that space.
+		return fmt.Sprintf("kf-binding-%s-%s-%s", app.Namespace, app.Name, binding.BindingName)
+	}
+
 	return fmt.Sprintf("kf-binding-%s-%s", app.Name, binding.BindingName)
 }
 
+// IsSharedServiceBinding returns true if the binding is to a service instance
+// that was shared with the App's space from another space.
+func IsSharedServiceBinding(app *v1alpha1.App, binding *v1alpha1.AppSpecServiceBinding) bool {
+	return binding.InstanceSpace != "" && binding.InstanceSpace != app.Namespace
+}
+
 // MakeServiceBindingAppSelector creates a labels.Selector for listing all the
-// Service Bindings for the given App.
+// Service Bindings for the given App in its own space.
 func MakeServiceBindingAppSelector(appName string) labels.Selector {
 	return labels.NewSelector().Add(
 		mustRequirement(v1alpha1.NameLabel, selection.Equals, appName),
+		mustRequirement(v1alpha1.AppSpaceLabel, selection.DoesNotExist),
 	)
 }
 
-func MakeServiceBindings(app *v1alpha1.App) ([]servicecatalogv1beta1.ServiceBinding, error) {
+// MakeSharedServiceBindingAppSelector creates a labels.Selector for listing
+// the Service Bindings the given App has in the spaces of shared service
+// instances.
+func MakeSharedServiceBindingAppSelector(appSpace, appName string) labels.Selector {
+	return labels.NewSelector().Add(
+		mustRequirement(v1alpha1.NameLabel, selection.Equals, appName),
+		mustRequirement(v1alpha1.AppSpaceLabel, selection.Equals, appSpace),
+	)
+}
+
+// MakeServiceBindings creates Service Catalog bindings for the App. Bindings to
+// user-provided service instances are skipped, their credentials are injected
+// directly. Bindings to service instances in other spaces require a
+// ServiceInstanceShare, shares holds them by binding name.
+func MakeServiceBindings(
+	app *v1alpha1.App,
+	userProvided sets.String,
+	shares map[string]*v1alpha1.ServiceInstanceShare,
+) ([]servicecatalogv1beta1.ServiceBinding, error) {
 	var bindings []servicecatalogv1beta1.ServiceBinding
 	for _, binding := range app.Spec.ServiceBindings {
-		serviceBinding, err := MakeServiceBinding(app, &binding)
+		if !IsSharedServiceBinding(app, &binding) {
+			if userProvided.Has(binding.Instance) {
+				continue
+			}
+
+			serviceBinding, err := MakeServiceBinding(app, &binding)
+			if err != nil {
+				return nil, err
+			}
+			bindings = append(bindings, *serviceBinding)
+			continue
+		}
+
+		share, ok := shares[binding.BindingName]
+		if !ok {
+			return nil, fmt.Errorf(
+				"service instance %q in space %q isn't shared with space %q",
+				binding.Instance,
+				binding.InstanceSpace,
+				app.Namespace,
+			)
+		}
+
+		serviceBinding, err := MakeSharedServiceBinding(app, &binding, share)
 		if err != nil {
 			return nil, err
 		}
@@ -80,3 +136,29 @@ func MakeServiceBinding(app *v1alpha1.App, binding *v1alpha1.AppSpecServiceBindi
 		},
 	}, nil
 }
+
+// MakeSharedServiceBinding creates a binding to a service instance shared
+// with the App's space. The binding is created in the space of the instance
+// because Service Catalog can't bind across namespaces. It is owned by the
+// ServiceInstanceShare so unsharing the instance removes it.
+func MakeSharedServiceBinding(
+	app *v1alpha1.App,
+	binding *v1alpha1.AppSpecServiceBinding,
+	share *v1alpha1.ServiceInstanceShare,
+) (*servicecatalogv1beta1.ServiceBinding, error) {
+	serviceBinding, err := MakeServiceBinding(app, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceBinding.Namespace = binding.InstanceSpace
+	serviceBinding.OwnerReferences = []metav1.OwnerReference{
+		*kmeta.NewControllerRef(share),
+	}
+	serviceBinding.Labels = v1alpha1.UnionMaps(
+		serviceBinding.Labels,
+		map[string]string{v1alpha1.AppSpaceLabel: app.Namespace},
+	)
+
+	return serviceBinding, nil
+}
@@ -15,6 +15,7 @@
 package resources
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -24,6 +25,7 @@ import (
 	"github.com/google/kf/pkg/kf/testutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 func ExampleMakeServiceBindingLabels() {
@@ -57,6 +59,26 @@ func ExampleMakeServiceBindingName() {
 	// Output: kf-binding-my-app-a-cool-binding
 }
 
+func ExampleMakeServiceBindings() {
+	app := &v1alpha1.App{}
+	app.Name = "my-app"
+	app.Spec.ServiceBindings = []v1alpha1.AppSpecServiceBinding{
+		{Instance: "my-db", BindingName: "my-db", Parameters: []byte("{}")},
+		{Instance: "my-ups", BindingName: "my-ups", Parameters: []byte("{}")},
+	}
+
+	bindings, err := MakeServiceBindings(app, sets.NewString("my-ups"), nil)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, binding := range bindings {
+		fmt.Println(binding.Name, binding.Spec.InstanceRef.Name)
+	}
+
+	// Output: kf-binding-my-app-my-db my-db
+}
+
 func TestMakeServiceBindingAppSelector(t *testing.T) {
 	t.Parallel()
 
@@ -69,8 +91,75 @@ func TestMakeServiceBindingAppSelector(t *testing.T) {
 		v1alpha1.NameLabel: "not-my-app",
 	}
 
+	shared := labels.Set{
+		v1alpha1.NameLabel:     "my-app",
+		v1alpha1.AppSpaceLabel: "other-space",
+	}
+
 	testutil.AssertEqual(t, "matches", true, s.Matches(good))
 	testutil.AssertEqual(t, "doesn't match", false, s.Matches(bad))
+	testutil.AssertEqual(t, "doesn't match shared", false, s.Matches(shared))
+}
+
+func TestMakeSharedServiceBindingAppSelector(t *testing.T) {
+	t.Parallel()
+
+	s := MakeSharedServiceBindingAppSelector("my-space", "my-app")
+
+	good := labels.Set{
+		v1alpha1.NameLabel:     "my-app",
+		v1alpha1.AppSpaceLabel: "my-space",
+	}
+	otherSpace := labels.Set{
+		v1alpha1.NameLabel:     "my-app",
+		v1alpha1.AppSpaceLabel: "other-space",
+	}
+	local := labels.Set{
+		v1alpha1.NameLabel: "my-app",
+	}
+
+	testutil.AssertEqual(t, "matches", true, s.Matches(good))
+	testutil.AssertEqual(t, "doesn't match other space", false, s.Matches(otherSpace))
+	testutil.AssertEqual(t, "doesn't match local", false, s.Matches(local))
+}
+
+func TestMakeServiceBindings_shared(t *testing.T) {
+	t.Parallel()
+
+	app := &v1alpha1.App{}
+	app.Name = "my-app"
+	app.Namespace = "my-space"
+	app.Spec.ServiceBindings = []v1alpha1.AppSpecServiceBinding{
+		{Instance: "my-db", InstanceSpace: "db-space", BindingName: "my-db", Parameters: []byte("{}")},
+	}
+
+	share := &v1alpha1.ServiceInstanceShare{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kf-share-my-db-my-space",
+			Namespace: "db-space",
+		},
+	}
+
+	t.Run("not shared", func(t *testing.T) {
+		_, err := MakeServiceBindings(app, sets.NewString(), nil)
+		testutil.AssertErrorsEqual(t, errors.New(`service instance "my-db" in space "db-space" isn't shared with space "my-space"`), err)
+	})
+
+	t.Run("shared", func(t *testing.T) {
+		bindings, err := MakeServiceBindings(app, sets.NewString(), map[string]*v1alpha1.ServiceInstanceShare{
+			"my-db": share,
+		})
+		testutil.AssertNil(t, "error", err)
+		testutil.AssertEqual(t, "count", 1, len(bindings))
+
+		binding := bindings[0]
+		testutil.AssertEqual(t, "name", "kf-binding-my-space-my-app-my-db", binding.Name)
+		testutil.AssertEqual(t, "namespace", "db-space", binding.Namespace)
+		testutil.AssertEqual(t, "instance name", "my-db", binding.Spec.InstanceRef.Name)
+		testutil.AssertEqual(t, "owner", "ServiceInstanceShare", binding.OwnerReferences[0].Kind)
+		testutil.AssertEqual(t, "owner name", share.Name, binding.OwnerReferences[0].Name)
+		testutil.AssertEqual(t, "app space label", "my-space", binding.Labels[v1alpha1.AppSpaceLabel])
+	})
 }
 
 func TestMakeServiceBinding(t *testing.T) {
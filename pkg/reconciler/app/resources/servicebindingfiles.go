@@ -0,0 +1,140 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/cfutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/kmeta"
+)
+
+const serviceBindingFilesComponent = "service-binding-files"
+
+// MountsServiceBindingFiles returns true if the credentials of the binding
+// are mounted as files, either because the binding or its space opted in.
+func MountsServiceBindingFiles(space *v1alpha1.Space, binding *v1alpha1.AppSpecServiceBinding) bool {
+	return binding.MountFiles || space.Spec.Execution.ServiceBindingFiles
+}
+
+// HasServiceBindingFiles returns true if any binding of the App mounts its
+// credentials as files.
+func HasServiceBindingFiles(app *v1alpha1.App, space *v1alpha1.Space) bool {
+	for _, binding := range app.Spec.ServiceBindings {
+		if MountsServiceBindingFiles(space, &binding) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ServiceBindingFilesSecretName gets the name of the Secret holding the files
+// of a binding.
+func ServiceBindingFilesSecretName(app *v1alpha1.App, bindingName string) string {
+	return fmt.Sprintf("kf-binding-files-%s-%s", app.Name, bindingName)
+}
+
+// MakeServiceBindingFilesSelector creates a labels.Selector for listing the
+// Secrets holding the binding files of the App.
+func MakeServiceBindingFilesSelector(app *v1alpha1.App) labels.Selector {
+	return labels.SelectorFromSet(app.ComponentLabels(serviceBindingFilesComponent))
+}
+
+// MakeServiceBindingFilesSecrets creates a Secret for each binding of the App
+// that mounts its credentials as files. files holds the files of the bindings
+// by binding name.
+func MakeServiceBindingFilesSecrets(
+	app *v1alpha1.App,
+	space *v1alpha1.Space,
+	files map[string]cfutil.ServiceBindingFiles,
+) ([]corev1.Secret, error) {
+	var secrets []corev1.Secret
+	for _, binding := range app.Spec.ServiceBindings {
+		if !MountsServiceBindingFiles(space, &binding) {
+			continue
+		}
+
+		bindingFiles, ok := files[binding.BindingName]
+		if !ok {
+			return nil, fmt.Errorf("couldn't find the credentials of binding %q", binding.BindingName)
+		}
+
+		secrets = append(secrets, corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceBindingFilesSecretName(app, binding.BindingName),
+				Namespace: app.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*kmeta.NewControllerRef(app),
+				},
+				Labels: v1alpha1.UnionMaps(app.GetLabels(), app.ComponentLabels(serviceBindingFilesComponent)),
+			},
+			Data: bindingFiles,
+		})
+	}
+
+	return secrets, nil
+}
+
+// addServiceBindingFiles mounts the binding files Secrets into the first
+// container of the pod in directories named after the bindings. The
+// directories are created in SERVICE_BINDING_ROOT, which defaults to
+// cfutil.DefaultServiceBindingRoot if the App doesn't set it.
+func addServiceBindingFiles(app *v1alpha1.App, space *v1alpha1.Space, podSpec *corev1.PodSpec) {
+	container := &podSpec.Containers[0]
+
+	root := ""
+	for _, env := range container.Env {
+		if env.Name == cfutil.ServiceBindingRootEnv {
+			root = env.Value
+		}
+	}
+
+	for _, binding := range app.Spec.ServiceBindings {
+		if !MountsServiceBindingFiles(space, &binding) {
+			continue
+		}
+
+		if root == "" {
+			root = cfutil.DefaultServiceBindingRoot
+			container.Env = append(container.Env, corev1.EnvVar{
+				Name:  cfutil.ServiceBindingRootEnv,
+				Value: root,
+			})
+		}
+
+		volumeName := v1alpha1.GenerateName("binding", binding.BindingName)
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: ServiceBindingFilesSecretName(app, binding.BindingName),
+				},
+			},
+		})
+
+		// Knative only allows read-only mounts.
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: path.Join(root, binding.BindingName),
+			ReadOnly:  true,
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/cfutil"
+	"github.com/google/kf/pkg/kf/testutil"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func ExampleServiceBindingFilesSecretName() {
+	app := &v1alpha1.App{}
+	app.Name = "my-app"
+
+	fmt.Println(ServiceBindingFilesSecretName(app, "my-db"))
+
+	// Output: kf-binding-files-my-app-my-db
+}
+
+func ExampleMakeServiceBindingFilesSelector() {
+	app := &v1alpha1.App{}
+	app.Name = "my-app"
+
+	fmt.Println(MakeServiceBindingFilesSelector(app).String())
+
+	// Output: app.kubernetes.io/component=service-binding-files,app.kubernetes.io/managed-by=kf,app.kubernetes.io/name=my-app
+}
+
+func TestMakeServiceBindingFilesSecrets(t *testing.T) {
+	files := map[string]cfutil.ServiceBindingFiles{
+		"db": {"type": []byte("mysql")},
+	}
+
+	cases := map[string]struct {
+		bindings   []v1alpha1.AppSpecServiceBinding
+		spaceFiles bool
+		files      map[string]cfutil.ServiceBindingFiles
+		wantNames  []string
+		wantErr    error
+	}{
+		"not opted in": {
+			bindings: []v1alpha1.AppSpecServiceBinding{
+				{Instance: "mydb", BindingName: "db"},
+			},
+		},
+		"binding opted in": {
+			bindings: []v1alpha1.AppSpecServiceBinding{
+				{Instance: "mydb", BindingName: "db", MountFiles: true},
+				{Instance: "cache", BindingName: "cache"},
+			},
+			files:     files,
+			wantNames: []string{"kf-binding-files-my-app-db"},
+		},
+		"space opted in": {
+			bindings: []v1alpha1.AppSpecServiceBinding{
+				{Instance: "mydb", BindingName: "db"},
+			},
+			spaceFiles: true,
+			files:      files,
+			wantNames:  []string{"kf-binding-files-my-app-db"},
+		},
+		"missing files": {
+			bindings: []v1alpha1.AppSpecServiceBinding{
+				{Instance: "cache", BindingName: "cache", MountFiles: true},
+			},
+			files:   files,
+			wantErr: errors.New(`couldn't find the credentials of binding "cache"`),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			app := &v1alpha1.App{}
+			app.Name = "my-app"
+			app.Namespace = "my-space"
+			app.Spec.ServiceBindings = tc.bindings
+
+			space := &v1alpha1.Space{}
+			space.Spec.Execution.ServiceBindingFiles = tc.spaceFiles
+
+			secrets, err := MakeServiceBindingFilesSecrets(app, space, tc.files)
+			testutil.AssertErrorsEqual(t, tc.wantErr, err)
+
+			var names []string
+			for _, secret := range secrets {
+				names = append(names, secret.Name)
+				testutil.AssertEqual(t, "namespace", "my-space", secret.Namespace)
+				testutil.AssertEqual(t, "data", tc.files["db"], cfutil.ServiceBindingFiles(secret.Data))
+			}
+			testutil.AssertEqual(t, "names", tc.wantNames, names)
+		})
+	}
+}
+
+func TestAddServiceBindingFiles(t *testing.T) {
+	cases := map[string]struct {
+		env      []corev1.EnvVar
+		wantRoot string
+		wantEnv  int
+	}{
+		"default root": {
+			wantRoot: "/bindings",
+			wantEnv:  1,
+		},
+		"custom root": {
+			env:      []corev1.EnvVar{{Name: "SERVICE_BINDING_ROOT", Value: "/etc/bindings"}},
+			wantRoot: "/etc/bindings",
+			wantEnv:  1,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			app := &v1alpha1.App{}
+			app.Name = "my-app"
+			app.Spec.ServiceBindings = []v1alpha1.AppSpecServiceBinding{
+				{Instance: "mydb", BindingName: "db", MountFiles: true},
+				{Instance: "cache", BindingName: "cache"},
+			}
+
+			podSpec := &corev1.PodSpec{
+				Containers: []corev1.Container{{Env: tc.env}},
+			}
+
+			addServiceBindingFiles(app, &v1alpha1.Space{}, podSpec)
+
+			container := podSpec.Containers[0]
+			testutil.AssertEqual(t, "env count", tc.wantEnv, len(container.Env))
+			testutil.AssertEqual(t, "root", tc.wantRoot, container.Env[0].Value)
+			testutil.AssertEqual(t, "volume count", 1, len(podSpec.Volumes))
+			testutil.AssertEqual(t, "secret", "kf-binding-files-my-app-db", podSpec.Volumes[0].Secret.SecretName)
+			testutil.AssertEqual(t, "mounts", []corev1.VolumeMount{{
+				Name:      podSpec.Volumes[0].Name,
+				MountPath: tc.wantRoot + "/db",
+				ReadOnly:  true,
+			}}, container.VolumeMounts)
+		})
+	}
+}
@@ -46,6 +46,7 @@ func MakeSource(app *v1alpha1.App, space *v1alpha1.Space) (*v1alpha1.Source, err
 	source := app.Spec.Source.DeepCopy()
 
 	source.ServiceAccount = space.Spec.Security.BuildServiceAccount
+	source.Placement = *space.Status.BuildPlacement.DeepCopy()
 
 	switch {
 	case source.IsBuildpackBuild():
@@ -54,6 +55,23 @@ func MakeSource(app *v1alpha1.App, space *v1alpha1.Space) (*v1alpha1.Source, err
 		source.BuildpackBuild.Image = BuildpackBuildImageDestination(app, space)
 		source.BuildpackBuild.BuildpackBuilder = space.Spec.BuildpackBuild.BuilderImage
 
+		// If the space has stacks, the builder and buildpacks come from the
+		// stack the app selected.
+		if stacks := v1alpha1.BuildpackStacks(space.Status.BuildpackStacks); len(stacks) > 0 {
+			stack, err := stacks.Find(source.BuildpackBuild.Stack)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := stack.ValidateBuildpack(source.BuildpackBuild.Buildpack); err != nil {
+				return nil, err
+			}
+
+			source.BuildpackBuild.BuildpackBuilder = stack.BuilderImage
+			source.BuildpackBuild.Stack = stack.RunImage
+			source.BuildpackBuild.BuildpackOrder = stack.BuildpackOrder()
+		}
+
 	case source.IsDockerfileBuild():
 		source.Dockerfile.Image = BuildpackBuildImageDestination(app, space)
 	}
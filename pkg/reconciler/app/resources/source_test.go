@@ -15,6 +15,7 @@
 package resources
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -204,6 +205,94 @@ func TestMakeSource(t *testing.T) {
 	}
 }
 
+func TestMakeSource_buildpackStacks(t *testing.T) {
+	space := &v1alpha1.Space{}
+	space.Spec.BuildpackBuild.BuilderImage = "gcr.io/default-builder"
+	space.Status.BuildpackStacks = []v1alpha1.BuildpackStack{
+		{
+			Name:         "cflinuxfs3",
+			BuilderImage: "gcr.io/cflinuxfs3-builder",
+			RunImage:     "cloudfoundry/cflinuxfs3",
+			Buildpacks: []v1alpha1.BuildpackCatalogEntry{
+				{ID: "java", Version: "1.0"},
+				{ID: "go"},
+				{ID: "php", Disabled: true},
+			},
+		},
+		{
+			Name:         "distroless",
+			BuilderImage: "gcr.io/distroless-builder",
+			Default:      true,
+		},
+	}
+
+	cases := map[string]struct {
+		stack     string
+		buildpack string
+
+		expectedBuilder string
+		expectedStack   string
+		expectedOrder   []string
+		expectedErr     error
+	}{
+		"default stack": {
+			expectedBuilder: "gcr.io/distroless-builder",
+		},
+		"selected stack": {
+			stack:           "cflinuxfs3",
+			buildpack:       "go",
+			expectedBuilder: "gcr.io/cflinuxfs3-builder",
+			expectedStack:   "cloudfoundry/cflinuxfs3",
+			expectedOrder:   []string{"java@1.0", "go"},
+		},
+		"unknown stack": {
+			stack:       "windows",
+			expectedErr: errors.New(`stack "windows" isn't available, choose one of: cflinuxfs3, distroless`),
+		},
+		"disabled buildpack": {
+			stack:       "cflinuxfs3",
+			buildpack:   "php",
+			expectedErr: errors.New(`buildpack "php" isn't enabled on stack "cflinuxfs3"`),
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			app := &v1alpha1.App{}
+			app.Name = "myapp"
+			app.Spec.Source.BuildpackBuild.Source = "gcr.io/my-source-image:latest"
+			app.Spec.Source.BuildpackBuild.Stack = tc.stack
+			app.Spec.Source.BuildpackBuild.Buildpack = tc.buildpack
+
+			actual, err := MakeSource(app, space)
+			if tc.expectedErr != nil || err != nil {
+				testutil.AssertErrorsEqual(t, tc.expectedErr, err)
+				return
+			}
+
+			testutil.AssertEqual(t, "builder", tc.expectedBuilder, actual.Spec.BuildpackBuild.BuildpackBuilder)
+			testutil.AssertEqual(t, "stack", tc.expectedStack, actual.Spec.BuildpackBuild.Stack)
+			testutil.AssertEqual(t, "order", tc.expectedOrder, actual.Spec.BuildpackBuild.BuildpackOrder)
+		})
+	}
+}
+
+func TestMakeSource_placement(t *testing.T) {
+	space := &v1alpha1.Space{}
+	space.Status.BuildPlacement = v1alpha1.PodPlacement{
+		NodeSelector: map[string]string{"pool": "builds"},
+	}
+
+	app := &v1alpha1.App{}
+	app.Name = "myapp"
+	app.Spec.Source.BuildpackBuild.Source = "gcr.io/my-source-image:latest"
+	app.Spec.Source.Placement.NodeSelector = map[string]string{"pool": "user-set"}
+
+	actual, err := MakeSource(app, space)
+	testutil.AssertNil(t, "err", err)
+	testutil.AssertEqual(t, "placement", space.Status.BuildPlacement, actual.Spec.Placement)
+}
+
 func boolPtr(b bool) *bool {
 	tmp := &b
 	return tmp
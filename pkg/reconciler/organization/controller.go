@@ -0,0 +1,83 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"context"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	organizationinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/organization"
+	spaceinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/space"
+	"github.com/google/kf/pkg/reconciler"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+)
+
+// NewController creates a new controller capable of reconciling Kf
+// Organizations.
+func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	logger := reconciler.NewControllerLogger(ctx, "organizations.kf.dev")
+
+	// Get informers off context
+	organizationInformer := organizationinformer.Get(ctx)
+	spaceInformer := spaceinformer.Get(ctx)
+
+	// Create reconciler
+	c := &Reconciler{
+		Base:               reconciler.NewBase(ctx, cmw),
+		organizationLister: organizationInformer.Lister(),
+		spaceLister:        spaceInformer.Lister(),
+	}
+
+	impl := controller.NewImpl(c, logger, "Organizations")
+
+	logger.Info("Setting up event handlers")
+	organizationInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+
+	// Spaces reference their organization by name so enqueue it whenever a
+	// space changes to update the totals. Spaces moving between
+	// organizations enqueue both.
+	enqueueOrganization := func(obj interface{}) {
+		space, ok := obj.(*v1alpha1.Space)
+		if !ok || space.Spec.Organization == "" {
+			return
+		}
+
+		org, err := c.organizationLister.Get(space.Spec.Organization)
+		if err != nil {
+			logger.Warnf("couldn't get Organization %q of Space %q: %v", space.Spec.Organization, space.Name, err)
+			return
+		}
+
+		impl.Enqueue(org)
+	}
+
+	spaceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueueOrganization,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			enqueueOrganization(oldObj)
+			enqueueOrganization(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			enqueueOrganization(obj)
+		},
+	})
+
+	return impl
+}
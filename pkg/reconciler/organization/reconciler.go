@@ -0,0 +1,143 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kflisters "github.com/google/kf/pkg/client/listers/kf/v1alpha1"
+	"github.com/google/kf/pkg/reconciler"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+// Reconciler reconciles an Organization object with the K8s cluster.
+type Reconciler struct {
+	*reconciler.Base
+
+	// listers index properties about resources
+	organizationLister kflisters.OrganizationLister
+	spaceLister        kflisters.SpaceLister
+}
+
+// Check that our Reconciler implements controller.Reconciler
+var _ controller.Reconciler = (*Reconciler)(nil)
+
+// Reconcile is called by Kubernetes.
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	logger := logging.FromContext(ctx)
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	original, err := r.organizationLister.Get(name)
+	switch {
+	case apierrs.IsNotFound(err):
+		logger.Errorf("organization %q no longer exists\n", name)
+		return nil
+
+	case err != nil:
+		return err
+
+	case original.GetDeletionTimestamp() != nil:
+		return nil
+	}
+
+	// Don't modify the informers copy
+	toReconcile := original.DeepCopy()
+
+	// Reconcile this copy of the organization and then write back any status
+	// updates regardless of whether the reconciliation errored out.
+	reconcileErr := r.ApplyChanges(ctx, toReconcile)
+	if equality.Semantic.DeepEqual(original.Status, toReconcile.Status) {
+		// If we didn't change anything then don't call updateStatus.
+		// This is important because the copy we loaded from the informer's
+		// cache may be stale and we don't want to overwrite a prior update
+		// to status with this stale state.
+
+	} else if _, uErr := r.updateStatus(toReconcile); uErr != nil {
+		logger.Warnw("Failed to update Organization status", zap.Error(uErr))
+		return uErr
+	}
+
+	return reconcileErr
+}
+
+// ApplyChanges copies the organization's defaults into the spaces that
+// belong to it and updates the status of the organization with them.
+func (r *Reconciler) ApplyChanges(ctx context.Context, org *v1alpha1.Organization) error {
+	logger := logging.FromContext(ctx)
+	org.Status.InitializeConditions()
+
+	spaces, err := r.spaceLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var members []*v1alpha1.Space
+	for _, space := range spaces {
+		if space.Spec.Organization == org.Name {
+			members = append(members, space)
+		}
+	}
+
+	org.Status.PropagateSpaces(org.Spec.Quota, members)
+
+	// Spaces only inherit defaults when they're admitted so replace the ones
+	// they inherited when the defaults change.
+	for _, space := range members {
+		desired := space.DeepCopy()
+		desired.Spec.RemoveOrganizationDefaults(org.Status.AppliedDefaults)
+		desired.Spec.InheritOrganizationDefaults(org.Spec.Defaults)
+
+		if equality.Semantic.DeepEqual(space.Spec, desired.Spec) {
+			continue
+		}
+
+		logger.Infof("updating the defaults of Space %q", space.Name)
+		if _, err := r.KfClientSet.KfV1alpha1().Spaces().Update(desired); err != nil {
+			return err
+		}
+	}
+
+	org.Status.AppliedDefaults = *org.Spec.Defaults.DeepCopy()
+
+	return nil
+}
+
+func (r *Reconciler) updateStatus(desired *v1alpha1.Organization) (*v1alpha1.Organization, error) {
+	actual, err := r.organizationLister.Get(desired.Name)
+	if err != nil {
+		return nil, err
+	}
+	// If there's nothing to update, just return.
+	if reflect.DeepEqual(actual.Status, desired.Status) {
+		return actual, nil
+	}
+
+	// Don't modify the informers copy.
+	existing := actual.DeepCopy()
+	existing.Status = desired.Status
+
+	return r.KfClientSet.KfV1alpha1().Organizations().UpdateStatus(existing)
+}
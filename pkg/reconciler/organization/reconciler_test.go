@@ -0,0 +1,164 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	kflisters "github.com/google/kf/pkg/client/listers/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/testutil"
+	"github.com/google/kf/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	newOrg := func(defaults v1alpha1.OrganizationSpecDefaults, applied v1alpha1.OrganizationSpecDefaults) *v1alpha1.Organization {
+		org := &v1alpha1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "my-org"}}
+		org.Spec.Defaults = defaults
+		org.Spec.Quota = corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("10Gi"),
+		}
+		org.Status.AppliedDefaults = applied
+		return org
+	}
+
+	newSpace := func(name, org, registry, memory string) *v1alpha1.Space {
+		space := &v1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		space.Spec.Organization = org
+		space.Spec.BuildpackBuild.ContainerRegistry = registry
+		space.Spec.ResourceLimits.SpaceQuota = corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse(memory),
+		}
+		return space
+	}
+
+	oldDefaults := v1alpha1.OrganizationSpecDefaults{ContainerRegistry: "gcr.io/old"}
+	newDefaults := v1alpha1.OrganizationSpecDefaults{ContainerRegistry: "gcr.io/new"}
+
+	cases := map[string]struct {
+		org            *v1alpha1.Organization
+		spaces         []*v1alpha1.Space
+		wantSpaces     []string
+		wantQuotaReady corev1.ConditionStatus
+		wantRegistries map[string]string
+	}{
+		"no spaces": {
+			org:            newOrg(newDefaults, oldDefaults),
+			wantQuotaReady: corev1.ConditionTrue,
+		},
+		"counts member spaces": {
+			org: newOrg(oldDefaults, oldDefaults),
+			spaces: []*v1alpha1.Space{
+				newSpace("b-space", "my-org", "gcr.io/old", "4Gi"),
+				newSpace("a-space", "my-org", "gcr.io/old", "4Gi"),
+				newSpace("other-space", "other-org", "gcr.io/other", "8Gi"),
+			},
+			wantSpaces:     []string{"a-space", "b-space"},
+			wantQuotaReady: corev1.ConditionTrue,
+			wantRegistries: map[string]string{
+				"a-space":     "gcr.io/old",
+				"b-space":     "gcr.io/old",
+				"other-space": "gcr.io/other",
+			},
+		},
+		"quota exceeded": {
+			org: newOrg(oldDefaults, oldDefaults),
+			spaces: []*v1alpha1.Space{
+				newSpace("a-space", "my-org", "gcr.io/old", "6Gi"),
+				newSpace("b-space", "my-org", "gcr.io/old", "6Gi"),
+			},
+			wantSpaces:     []string{"a-space", "b-space"},
+			wantQuotaReady: corev1.ConditionFalse,
+		},
+		"replaces changed defaults": {
+			org: newOrg(newDefaults, oldDefaults),
+			spaces: []*v1alpha1.Space{
+				newSpace("inherited", "my-org", "gcr.io/old", "1Gi"),
+				newSpace("overridden", "my-org", "gcr.io/space", "1Gi"),
+				newSpace("empty", "my-org", "", "1Gi"),
+				newSpace("other-space", "other-org", "gcr.io/old", "1Gi"),
+			},
+			wantSpaces:     []string{"empty", "inherited", "overridden"},
+			wantQuotaReady: corev1.ConditionTrue,
+			wantRegistries: map[string]string{
+				"inherited":   "gcr.io/new",
+				"overridden":  "gcr.io/space",
+				"empty":       "gcr.io/new",
+				"other-space": "gcr.io/old",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			objects := []runtime.Object{tc.org}
+			orgIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			testutil.AssertNil(t, "add org", orgIndexer.Add(tc.org))
+
+			spaceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, space := range tc.spaces {
+				objects = append(objects, space)
+				testutil.AssertNil(t, "add space", spaceIndexer.Add(space))
+			}
+
+			client := kffake.NewSimpleClientset(objects...)
+			r := &Reconciler{
+				Base:               &reconciler.Base{KfClientSet: client},
+				organizationLister: kflisters.NewOrganizationLister(orgIndexer),
+				spaceLister:        kflisters.NewSpaceLister(spaceIndexer),
+			}
+
+			testutil.AssertNil(t, "reconcile", r.Reconcile(context.Background(), "my-org"))
+
+			org, err := client.KfV1alpha1().Organizations().Get("my-org", metav1.GetOptions{})
+			testutil.AssertNil(t, "get org", err)
+			testutil.AssertEqual(t, "spaces", tc.wantSpaces, org.Status.Spaces)
+			testutil.AssertEqual(t, "applied defaults", tc.org.Spec.Defaults, org.Status.AppliedDefaults)
+
+			cond := org.Status.GetCondition(v1alpha1.OrganizationConditionQuotaReady)
+			testutil.AssertEqual(t, "quota ready", tc.wantQuotaReady, cond.Status)
+
+			for name, want := range tc.wantRegistries {
+				space, err := client.KfV1alpha1().Spaces().Get(name, metav1.GetOptions{})
+				testutil.AssertNil(t, "get space", err)
+				testutil.AssertEqual(t, name+" registry", want, space.Spec.BuildpackBuild.ContainerRegistry)
+			}
+		})
+	}
+}
+
+func TestReconciler_Reconcile_missingOrganization(t *testing.T) {
+	t.Parallel()
+
+	r := &Reconciler{
+		Base:               &reconciler.Base{KfClientSet: kffake.NewSimpleClientset()},
+		organizationLister: kflisters.NewOrganizationLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		spaceLister:        kflisters.NewSpaceLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+	}
+
+	testutil.AssertNil(t, "reconcile", r.Reconcile(context.Background(), "my-org"))
+}
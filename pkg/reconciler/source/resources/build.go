@@ -15,6 +15,8 @@
 package resources
 
 import (
+	"strings"
+
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	build "github.com/google/kf/third_party/knative-build/pkg/apis/build/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -27,6 +29,11 @@ const (
 	buildpackBuildTemplate = "buildpack"
 	containerImageTemplate = "container"
 	dockerImageTemplate    = "kaniko"
+
+	// dockerBuildSecretsVolume is the name of the volume the Dockerfile
+	// template mounts at v1alpha1.DockerfileBuildSecretsPath. The template
+	// doesn't declare it so every Dockerfile Build must.
+	dockerBuildSecretsVolume = "build-secrets"
 )
 
 // BuildName gets the name of a Build for a Source.
@@ -53,28 +60,103 @@ func makeContainerImageBuild(source *v1alpha1.Source) (*build.Build, error) {
 	}, nil
 }
 
+// makeRebasedImageBuild records an image that was rebased outside of the
+// cluster. The container template is used so detect and build are skipped.
+func makeRebasedImageBuild(source *v1alpha1.Source) (*build.Build, error) {
+	return &build.Build{
+		ObjectMeta: makeObjectMeta(source),
+		Spec: build.BuildSpec{
+			ServiceAccountName: source.Spec.ServiceAccount,
+			Template: &build.TemplateInstantiationSpec{
+				Name: containerImageTemplate,
+				Kind: "ClusterBuildTemplate",
+				Arguments: []build.ArgumentSpec{
+					{
+						Name:  v1alpha1.BuildArgImage,
+						Value: source.Spec.BuildpackBuild.RebasedImage,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
 func makeDockerImageBuild(source *v1alpha1.Source) (*build.Build, error) {
+	dockerfile := source.Spec.Dockerfile
+
 	return &build.Build{
 		ObjectMeta: makeObjectMeta(source),
 		Spec: build.BuildSpec{
 			ServiceAccountName: source.Spec.ServiceAccount,
 			Source: &build.SourceSpec{
 				Custom: &corev1.Container{
-					Image: source.Spec.Dockerfile.Source,
+					Image: dockerfile.Source,
 				},
 			},
 			Template: &build.TemplateInstantiationSpec{
 				Name: dockerImageTemplate,
 				Kind: "ClusterBuildTemplate",
 				Arguments: []build.ArgumentSpec{
-					{Name: v1alpha1.BuildArgImage, Value: source.Spec.Dockerfile.Image},
-					{Name: v1alpha1.BuildArgDockerfile, Value: source.Spec.Dockerfile.Path},
+					{Name: v1alpha1.BuildArgImage, Value: dockerfile.Image},
+					{Name: v1alpha1.BuildArgDockerfile, Value: dockerfile.Path},
+					{Name: v1alpha1.BuildArgDockerfileTarget, Value: dockerfile.Target},
+					{Name: v1alpha1.BuildArgDockerfileArgs, Value: dockerBuildArgs(dockerfile.BuildArgs)},
 				},
 			},
+			Volumes: dockerBuildSecretVolumes(dockerfile.Secrets),
 		},
 	}, nil
 }
 
+// dockerBuildArgs joins build args into NAME=VALUE lines to be passed to
+// the Dockerfile template.
+func dockerBuildArgs(args []corev1.EnvVar) string {
+	var lines []string
+	for _, arg := range args {
+		lines = append(lines, arg.Name+"="+arg.Value)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// dockerBuildSecretVolumes projects the given Secrets into the volume the
+// Dockerfile template mounts. An empty volume is used if there are no
+// Secrets.
+func dockerBuildSecretVolumes(secrets []string) []corev1.Volume {
+	if len(secrets) == 0 {
+		return []corev1.Volume{
+			{
+				Name: dockerBuildSecretsVolume,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			},
+		}
+	}
+
+	var sources []corev1.VolumeProjection
+	for _, secret := range secrets {
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: secret,
+				},
+			},
+		})
+	}
+
+	return []corev1.Volume{
+		{
+			Name: dockerBuildSecretsVolume,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: sources,
+				},
+			},
+		},
+	}
+}
+
 func makeBuildpackBuild(source *v1alpha1.Source) (*build.Build, error) {
 	return &build.Build{
 		ObjectMeta: makeObjectMeta(source),
@@ -105,6 +187,14 @@ func makeBuildpackBuild(source *v1alpha1.Source) (*build.Build, error) {
 						Name:  v1alpha1.BuildArgBuildpackRunImage,
 						Value: source.Spec.BuildpackBuild.Stack,
 					},
+					{
+						Name:  v1alpha1.BuildArgBuildpackSource,
+						Value: source.Spec.BuildpackBuild.Source,
+					},
+					{
+						Name:  v1alpha1.BuildArgBuildpackOrder,
+						Value: strings.Join(source.Spec.BuildpackBuild.BuildpackOrder, ","),
+					},
 				},
 				Env: source.Spec.BuildpackBuild.Env,
 			},
@@ -129,12 +219,36 @@ func makeObjectMeta(source *v1alpha1.Source) metav1.ObjectMeta {
 
 // MakeBuild creates a Build for a Source.
 func MakeBuild(source *v1alpha1.Source) (*build.Build, error) {
+	var (
+		b   *build.Build
+		err error
+	)
+
 	switch {
 	case source.Spec.IsContainerBuild():
-		return makeContainerImageBuild(source)
+		b, err = makeContainerImageBuild(source)
 	case source.Spec.IsDockerfileBuild():
-		return makeDockerImageBuild(source)
+		b, err = makeDockerImageBuild(source)
+	case source.Spec.IsRebasedBuild():
+		b, err = makeRebasedImageBuild(source)
 	default:
-		return makeBuildpackBuild(source)
+		b, err = makeBuildpackBuild(source)
 	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Knative Build doesn't support tolerations so the whole placement is
+	// also added as an annotation, which Knative Build copies to the build
+	// pod, for Kf's pod webhook to apply.
+	placement := source.Spec.Placement.DeepCopy()
+	b.Spec.NodeSelector = placement.NodeSelector
+	b.Spec.Affinity = placement.Affinity
+
+	if b.Annotations, err = placement.Annotations(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
 }
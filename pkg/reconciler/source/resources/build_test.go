@@ -16,9 +16,14 @@ package resources
 
 import (
 	"fmt"
+	"io/ioutil"
+	"testing"
 
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	"github.com/google/kf/pkg/kf/testutil"
+	build "github.com/google/kf/third_party/knative-build/pkg/apis/build/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
 )
 
 func ExampleBuildName() {
@@ -39,6 +44,7 @@ func ExampleMakeBuild() {
 	source.Spec.BuildpackBuild.Image = "gcr.io/image:123"
 	source.Spec.BuildpackBuild.Stack = "gcr.io/kf-releases/run:latest"
 	source.Spec.BuildpackBuild.BuildpackBuilder = "some-buildpack-builder"
+	source.Spec.BuildpackBuild.BuildpackOrder = []string{"java@1.0", "go"}
 	source.Spec.BuildpackBuild.Env = []corev1.EnvVar{
 		{
 			Name:  "some",
@@ -59,13 +65,159 @@ func ExampleMakeBuild() {
 	fmt.Println("Output Image:", v1alpha1.GetBuildArg(build, v1alpha1.BuildArgImage))
 	fmt.Println("Env:", build.Spec.Template.Env[0].Name, "=", build.Spec.Template.Env[0].Value)
 	fmt.Println("Stack:", v1alpha1.GetBuildArg(build, v1alpha1.BuildArgBuildpackRunImage))
+	fmt.Println("Source Image:", v1alpha1.GetBuildArg(build, v1alpha1.BuildArgBuildpackSource))
+	fmt.Println("Buildpack Order:", v1alpha1.GetBuildArg(build, v1alpha1.BuildArgBuildpackOrder))
 
 	// Output: Name: my-source
 	// Label Count: 1
 	// Managed By: kf
 	// Service Account: some-account
-	// Arg Count: 4
+	// Arg Count: 6
 	// Output Image: gcr.io/image:123
 	// Env: some = variable
 	// Stack: gcr.io/kf-releases/run:latest
+	// Source Image: some-source
+	// Buildpack Order: java@1.0,go
+}
+
+func ExampleMakeBuild_rebased() {
+	source := &v1alpha1.Source{}
+	source.Name = "my-source"
+	source.Namespace = "my-namespace"
+	source.Spec.BuildpackBuild.Source = "some-source"
+	source.Spec.BuildpackBuild.Image = "gcr.io/image:123"
+	source.Spec.BuildpackBuild.RebasedImage = "gcr.io/image@sha256:abc"
+
+	build, err := MakeBuild(source)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Template:", build.Spec.Template.Name)
+	fmt.Println("Has Source:", build.Spec.Source != nil)
+	fmt.Println("Output Image:", v1alpha1.GetBuildArg(build, v1alpha1.BuildArgImage))
+
+	// Output: Template: container
+	// Has Source: false
+	// Output Image: gcr.io/image@sha256:abc
+}
+
+func ExampleMakeBuild_dockerfile() {
+	source := &v1alpha1.Source{}
+	source.Name = "my-source"
+	source.Namespace = "my-namespace"
+	source.Spec.Dockerfile.Source = "some-source"
+	source.Spec.Dockerfile.Path = "Dockerfile"
+	source.Spec.Dockerfile.Image = "gcr.io/image:123"
+	source.Spec.Dockerfile.Target = "release"
+	source.Spec.Dockerfile.BuildArgs = []corev1.EnvVar{
+		{Name: "VERSION", Value: "1.2.3"},
+		{Name: "DEBUG", Value: "false"},
+	}
+	source.Spec.Dockerfile.Secrets = []string{"npmrc", "netrc"}
+
+	build, err := MakeBuild(source)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Template:", build.Spec.Template.Name)
+	fmt.Println("Target:", v1alpha1.GetBuildArg(build, v1alpha1.BuildArgDockerfileTarget))
+	fmt.Printf("Build Args: %q\n", v1alpha1.GetBuildArg(build, v1alpha1.BuildArgDockerfileArgs))
+	fmt.Println("Volume:", build.Spec.Volumes[0].Name)
+	for _, projection := range build.Spec.Volumes[0].Projected.Sources {
+		fmt.Println("Secret:", projection.Secret.Name)
+	}
+
+	// Output: Template: kaniko
+	// Target: release
+	// Build Args: "VERSION=1.2.3\nDEBUG=false"
+	// Volume: build-secrets
+	// Secret: npmrc
+	// Secret: netrc
+}
+
+func ExampleMakeBuild_placement() {
+	source := &v1alpha1.Source{}
+	source.Name = "my-source"
+	source.Namespace = "my-namespace"
+	source.Spec.BuildpackBuild.Source = "some-source"
+	source.Spec.Placement.NodeSelector = map[string]string{"pool": "builds"}
+	source.Spec.Placement.Tolerations = []corev1.Toleration{
+		{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "builds", Effect: corev1.TaintEffectNoSchedule},
+	}
+	source.Spec.Placement.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}}
+
+	build, err := MakeBuild(source)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Node Selector:", build.Spec.NodeSelector)
+	fmt.Println("Has Node Affinity:", build.Spec.Affinity.NodeAffinity != nil)
+
+	// Tolerations are applied to the build pod by the webhook.
+	placement, _, err := v1alpha1.PodPlacementFromAnnotations(build.Annotations)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("Pod Toleration:", placement.Tolerations[0].Key, placement.Tolerations[0].Value)
+
+	// Output: Node Selector: map[pool:builds]
+	// Has Node Affinity: true
+	// Pod Toleration: dedicated builds
+}
+
+func TestMakeBuild_dockerfileTemplate(t *testing.T) {
+	t.Parallel()
+
+	contents, err := ioutil.ReadFile("../../../../config/300-kaniko-clusterbuildtemplate.yaml")
+	testutil.AssertNil(t, "read error", err)
+
+	template := &build.ClusterBuildTemplate{}
+	testutil.AssertNil(t, "unmarshal error", yaml.Unmarshal(contents, template))
+
+	cases := map[string]struct {
+		secrets []string
+	}{
+		"no secrets":   {},
+		"with secrets": {secrets: []string{"npmrc", "netrc"}},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			source := &v1alpha1.Source{}
+			source.Name = "my-source"
+			source.Spec.Dockerfile.Source = "some-source"
+			source.Spec.Dockerfile.Image = "gcr.io/image:123"
+			source.Spec.Dockerfile.Secrets = tc.secrets
+
+			b, err := MakeBuild(source)
+			testutil.AssertNil(t, "MakeBuild error", err)
+			testutil.AssertEqual(t, "template", template.Name, b.Spec.Template.Name)
+
+			// Knative Build appends the template's volumes to the Build's
+			// when it creates the pod.
+			volumes := make(map[string]bool)
+			for _, v := range append(b.Spec.Volumes, template.Spec.Volumes...) {
+				testutil.AssertEqual(t, "duplicate volume "+v.Name, false, volumes[v.Name])
+				volumes[v.Name] = true
+			}
+
+			for _, step := range template.Spec.Steps {
+				for _, mount := range step.VolumeMounts {
+					testutil.AssertEqual(t, "volume for mount "+mount.Name, true, volumes[mount.Name])
+				}
+			}
+
+			params := make(map[string]bool)
+			for _, p := range template.Spec.Parameters {
+				params[p.Name] = true
+			}
+
+			for _, arg := range b.Spec.Template.Arguments {
+				testutil.AssertEqual(t, "parameter "+arg.Name, true, params[arg.Name])
+			}
+		})
+	}
 }
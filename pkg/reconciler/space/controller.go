@@ -18,15 +18,30 @@ import (
 	"context"
 
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	appinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/app"
+	cataloginformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/buildpackcatalog"
+	placementprofileinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/placementprofile"
+	quotaplaninformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/quotaplan"
+	securitygroupinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/securitygroup"
+	shareinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/serviceinstanceshare"
 	spaceinformer "github.com/google/kf/pkg/client/injection/informers/kf/v1alpha1/space"
+	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/injection/client"
+	servicebindinginformer "github.com/google/kf/pkg/client/servicecatalog/injection/informers/servicecatalog/v1beta1/servicebinding"
+	serviceinstanceinformer "github.com/google/kf/pkg/client/servicecatalog/injection/informers/servicecatalog/v1beta1/serviceinstance"
 	"github.com/google/kf/pkg/reconciler"
+	servicecatalogv1beta1 "github.com/poy/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	namespaceinformer "knative.dev/pkg/injection/informers/kubeinformers/corev1/namespace"
 	serviceaccountinformer "knative.dev/pkg/injection/informers/kubeinformers/corev1/serviceaccount"
+	networkpolicyinformer "knative.dev/pkg/injection/informers/kubeinformers/networkingv1/networkpolicy"
+	clusterroleinformer "knative.dev/pkg/injection/informers/kubeinformers/rbacv1/clusterrole"
+	clusterrolebindinginformer "knative.dev/pkg/injection/informers/kubeinformers/rbacv1/clusterrolebinding"
 	roleinformer "knative.dev/pkg/injection/informers/kubeinformers/rbacv1/role"
+	rolebindinginformer "knative.dev/pkg/injection/informers/kubeinformers/rbacv1/rolebinding"
 
 	limitrangeinformer "knative.dev/pkg/injection/informers/kubeinformers/corev1/limitrange"
 	quotainformer "knative.dev/pkg/injection/informers/kubeinformers/corev1/resourcequota"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 
 	"knative.dev/pkg/configmap"
@@ -41,19 +56,44 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 	nsInformer := namespaceinformer.Get(ctx)
 	spaceInformer := spaceinformer.Get(ctx)
 	roleInformer := roleinformer.Get(ctx)
+	roleBindingInformer := rolebindinginformer.Get(ctx)
+	clusterRoleInformer := clusterroleinformer.Get(ctx)
+	clusterRoleBindingInformer := clusterrolebindinginformer.Get(ctx)
 	quotaInformer := quotainformer.Get(ctx)
 	limitRangeInformer := limitrangeinformer.Get(ctx)
 	serviceAccountInformer := serviceaccountinformer.Get(ctx)
+	catalogInformer := cataloginformer.Get(ctx)
+	quotaPlanInformer := quotaplaninformer.Get(ctx)
+	networkPolicyInformer := networkpolicyinformer.Get(ctx)
+	securityGroupInformer := securitygroupinformer.Get(ctx)
+	placementProfileInformer := placementprofileinformer.Get(ctx)
+	appInformer := appinformer.Get(ctx)
+	serviceInstanceInformer := serviceinstanceinformer.Get(ctx)
+	serviceBindingInformer := servicebindinginformer.Get(ctx)
+	shareInformer := shareinformer.Get(ctx)
 
 	// Create reconciler
 	c := &Reconciler{
-		Base:                 reconciler.NewBase(ctx, cmw),
-		spaceLister:          spaceInformer.Lister(),
-		namespaceLister:      nsInformer.Lister(),
-		roleLister:           roleInformer.Lister(),
-		resourceQuotaLister:  quotaInformer.Lister(),
-		limitRangeLister:     limitRangeInformer.Lister(),
-		serviceAccountLister: serviceAccountInformer.Lister(),
+		Base:                     reconciler.NewBase(ctx, cmw),
+		spaceLister:              spaceInformer.Lister(),
+		namespaceLister:          nsInformer.Lister(),
+		roleLister:               roleInformer.Lister(),
+		roleBindingLister:        roleBindingInformer.Lister(),
+		clusterRoleLister:        clusterRoleInformer.Lister(),
+		clusterRoleBindingLister: clusterRoleBindingInformer.Lister(),
+		resourceQuotaLister:      quotaInformer.Lister(),
+		limitRangeLister:         limitRangeInformer.Lister(),
+		serviceAccountLister:     serviceAccountInformer.Lister(),
+		catalogLister:            catalogInformer.Lister(),
+		quotaPlanLister:          quotaPlanInformer.Lister(),
+		networkPolicyLister:      networkPolicyInformer.Lister(),
+		securityGroupLister:      securityGroupInformer.Lister(),
+		placementProfileLister:   placementProfileInformer.Lister(),
+		appLister:                appInformer.Lister(),
+		serviceInstanceLister:    serviceInstanceInformer.Lister(),
+		serviceBindingLister:     serviceBindingInformer.Lister(),
+		shareLister:              shareInformer.Lister(),
+		serviceCatalogClient:     servicecatalogclient.Get(ctx),
 	}
 
 	impl := controller.NewImpl(c, logger, "Spaces")
@@ -72,6 +112,21 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	})
 
+	roleBindingInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.Filter(v1alpha1.SchemeGroupVersion.WithKind("Space")),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
+	clusterRoleInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.Filter(v1alpha1.SchemeGroupVersion.WithKind("Space")),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
+	clusterRoleBindingInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.Filter(v1alpha1.SchemeGroupVersion.WithKind("Space")),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
 	quotaInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: controller.Filter(v1alpha1.SchemeGroupVersion.WithKind("Space")),
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
@@ -87,5 +142,128 @@ func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	})
 
+	networkPolicyInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.Filter(v1alpha1.SchemeGroupVersion.WithKind("Space")),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
+	// Catalogs aren't owned by spaces so enqueue every space that uses one
+	// when it changes.
+	catalogInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		catalog, ok := obj.(*v1alpha1.BuildpackCatalog)
+		if !ok {
+			return
+		}
+
+		spaces, err := c.spaceLister.List(labels.Everything())
+		if err != nil {
+			logger.Warnf("couldn't list spaces using BuildpackCatalog %q: %v", catalog.Name, err)
+			return
+		}
+
+		for _, space := range spaces {
+			if space.Spec.BuildpackBuild.Catalog == catalog.Name {
+				impl.Enqueue(space)
+			}
+		}
+	}))
+
+	// QuotaPlans are shared by many spaces so enqueue every space that
+	// references one when it changes.
+	quotaPlanInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		plan, ok := obj.(*v1alpha1.QuotaPlan)
+		if !ok {
+			return
+		}
+
+		spaces, err := c.spaceLister.List(labels.Everything())
+		if err != nil {
+			logger.Warnf("couldn't list spaces using QuotaPlan %q: %v", plan.Name, err)
+			return
+		}
+
+		for _, space := range spaces {
+			if space.Spec.ResourceLimits.QuotaPlan == plan.Name {
+				impl.Enqueue(space)
+			}
+		}
+	}))
+
+	// SecurityGroups can be bound to many spaces so enqueue every space
+	// that binds one when it changes.
+	securityGroupInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		group, ok := obj.(*v1alpha1.SecurityGroup)
+		if !ok {
+			return
+		}
+
+		spaces, err := c.spaceLister.List(labels.Everything())
+		if err != nil {
+			logger.Warnf("couldn't list spaces bound to SecurityGroup %q: %v", group.Name, err)
+			return
+		}
+
+		for _, space := range spaces {
+			for _, binding := range space.Spec.Security.SecurityGroups {
+				if binding.Name == group.Name {
+					impl.Enqueue(space)
+					break
+				}
+			}
+		}
+	}))
+
+	// PlacementProfiles are shared by many spaces so enqueue every space
+	// that references one when it changes.
+	placementProfileInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		profile, ok := obj.(*v1alpha1.PlacementProfile)
+		if !ok {
+			return
+		}
+
+		spaces, err := c.spaceLister.List(labels.Everything())
+		if err != nil {
+			logger.Warnf("couldn't list spaces using PlacementProfile %q: %v", profile.Name, err)
+			return
+		}
+
+		for _, space := range spaces {
+			if space.Spec.PlacementProfile == profile.Name {
+				impl.Enqueue(space)
+			}
+		}
+	}))
+
+	// Deleted spaces wait for their service instances to be deprovisioned so
+	// enqueue the space when an instance in it changes or goes away.
+	serviceInstanceInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		instance, ok := obj.(*servicecatalogv1beta1.ServiceInstance)
+		if !ok {
+			return
+		}
+
+		space, err := c.spaceLister.Get(instance.Namespace)
+		if err != nil || space.GetDeletionTimestamp() == nil {
+			return
+		}
+
+		impl.Enqueue(space)
+	}))
+
+	// Deleted spaces also wait for their service instances to be unshared.
+	shareInformer.Informer().AddEventHandler(controller.HandleAll(func(obj interface{}) {
+		share, ok := obj.(*v1alpha1.ServiceInstanceShare)
+		if !ok {
+			return
+		}
+
+		space, err := c.spaceLister.Get(share.Namespace)
+		if err != nil || space.GetDeletionTimestamp() == nil {
+			return
+		}
+
+		impl.Enqueue(space)
+	}))
+
 	return impl
 }
@@ -16,22 +16,30 @@ package space
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	kflisters "github.com/google/kf/pkg/client/listers/kf/v1alpha1"
+	servicecatalogclient "github.com/google/kf/pkg/client/servicecatalog/clientset/versioned"
+	servicecataloglisters "github.com/google/kf/pkg/client/servicecatalog/listers/servicecatalog/v1beta1"
 	"github.com/google/kf/pkg/reconciler"
 	"github.com/google/kf/pkg/reconciler/space/resources"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	v1listers "k8s.io/client-go/listers/core/v1"
+	networkingv1listers "k8s.io/client-go/listers/networking/v1"
 	rbacv1listers "k8s.io/client-go/listers/rbac/v1"
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/controller"
@@ -44,12 +52,26 @@ type Reconciler struct {
 	*reconciler.Base
 
 	// listers index properties about resources
-	spaceLister          kflisters.SpaceLister
-	namespaceLister      v1listers.NamespaceLister
-	roleLister           rbacv1listers.RoleLister
-	resourceQuotaLister  v1listers.ResourceQuotaLister
-	limitRangeLister     v1listers.LimitRangeLister
-	serviceAccountLister v1listers.ServiceAccountLister
+	spaceLister              kflisters.SpaceLister
+	namespaceLister          v1listers.NamespaceLister
+	roleLister               rbacv1listers.RoleLister
+	roleBindingLister        rbacv1listers.RoleBindingLister
+	clusterRoleLister        rbacv1listers.ClusterRoleLister
+	clusterRoleBindingLister rbacv1listers.ClusterRoleBindingLister
+	resourceQuotaLister      v1listers.ResourceQuotaLister
+	limitRangeLister         v1listers.LimitRangeLister
+	serviceAccountLister     v1listers.ServiceAccountLister
+	catalogLister            kflisters.BuildpackCatalogLister
+	quotaPlanLister          kflisters.QuotaPlanLister
+	networkPolicyLister      networkingv1listers.NetworkPolicyLister
+	securityGroupLister      kflisters.SecurityGroupLister
+	placementProfileLister   kflisters.PlacementProfileLister
+	appLister                kflisters.AppLister
+	serviceInstanceLister    servicecataloglisters.ServiceInstanceLister
+	serviceBindingLister     servicecataloglisters.ServiceBindingLister
+	shareLister              kflisters.ServiceInstanceShareLister
+
+	serviceCatalogClient servicecatalogclient.Interface
 }
 
 // Check that our Reconciler implements controller.Reconciler
@@ -71,9 +93,6 @@ func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
 
 	case err != nil:
 		return err
-
-	case original.GetDeletionTimestamp() != nil:
-		return nil
 	}
 
 	// Don't modify the informers copy
@@ -81,7 +100,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
 
 	// Reconcile this copy of the service and then write back any status
 	// updates regardless of whether the reconciliation errored out.
-	reconcileErr := r.ApplyChanges(ctx, toReconcile)
+	var reconcileErr error
+	if original.GetDeletionTimestamp() != nil {
+		reconcileErr = r.finalize(ctx, toReconcile)
+	} else {
+		reconcileErr = r.ApplyChanges(ctx, toReconcile)
+	}
 	if equality.Semantic.DeepEqual(original.Status, toReconcile.Status) {
 		// If we didn't change anything then don't call updateStatus.
 		// This is important because the copy we loaded from the informer's
@@ -101,7 +125,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
 func (r *Reconciler) ApplyChanges(ctx context.Context, space *v1alpha1.Space) error {
 	logger := logging.FromContext(ctx)
 	space.Status.InitializeConditions()
-	namespaceName := resources.NamespaceName(space)
+	namespaceName := v1alpha1.NamespaceName(space)
+
+	// Add the finalizer before anything else so service instances get
+	// deprovisioned if the space is deleted.
+	if !space.HasFinalizer() {
+		logger.Debug("adding Space finalizer")
+		if err := r.setFinalizers(space, append(space.Finalizers, v1alpha1.SpaceFinalizer)); err != nil {
+			return err
+		}
+	}
 
 	// Sync Namespace
 	{
@@ -190,10 +223,123 @@ func (r *Reconciler) ApplyChanges(ctx context.Context, space *v1alpha1.Space) er
 		space.Status.PropagateAuditorRoleStatus(actual)
 	}
 
+	// Sync manager role
+	{
+		logger.Debug("reconciling manager Role")
+		desired, err := resources.MakeManagerRole(space)
+		if err != nil {
+			return err
+		}
+
+		actual, err := r.roleLister.Roles(desired.Namespace).Get(desired.Name)
+		if errors.IsNotFound(err) {
+			actual, err = r.KubeClientSet.RbacV1().Roles(desired.Namespace).Create(desired)
+			if err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		} else if !metav1.IsControlledBy(actual, space) {
+			space.Status.MarkManagerRoleNotOwned(desired.Name)
+			return fmt.Errorf("space: %q does not own role: %q", space.Name, desired.Name)
+		} else if actual, err = r.reconcileGenericRole(ctx, desired, actual); err != nil {
+			return err
+		}
+
+		space.Status.PropagateManagerRoleStatus(actual)
+	}
+
+	// Sync role bindings
+	{
+		logger.Debug("reconciling RoleBindings")
+		desiredBindings, err := resources.MakeRoleBindings(space)
+		if err != nil {
+			return err
+		}
+
+		var actualBindings []*rv1.RoleBinding
+		for _, desired := range desiredBindings {
+			actual, err := r.roleBindingLister.RoleBindings(desired.Namespace).Get(desired.Name)
+			if errors.IsNotFound(err) {
+				actual, err = r.KubeClientSet.RbacV1().RoleBindings(desired.Namespace).Create(desired)
+				if err != nil {
+					return err
+				}
+			} else if err != nil {
+				return err
+			} else if !metav1.IsControlledBy(actual, space) {
+				space.Status.MarkRoleBindingNotOwned(desired.Name)
+				return fmt.Errorf("space: %q does not own rolebinding: %q", space.Name, desired.Name)
+			} else if actual, err = r.reconcileRoleBinding(ctx, desired, actual); err != nil {
+				return err
+			}
+
+			actualBindings = append(actualBindings, actual)
+		}
+
+		// Managers need to be able to update the Space to assign roles.
+		desiredRole, err := resources.MakeManagerClusterRole(space)
+		if err != nil {
+			return err
+		}
+
+		actualRole, err := r.clusterRoleLister.Get(desiredRole.Name)
+		if errors.IsNotFound(err) {
+			_, err = r.KubeClientSet.RbacV1().ClusterRoles().Create(desiredRole)
+			if err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		} else if !metav1.IsControlledBy(actualRole, space) {
+			space.Status.MarkManagerRoleNotOwned(desiredRole.Name)
+			return fmt.Errorf("space: %q does not own clusterrole: %q", space.Name, desiredRole.Name)
+		} else if _, err = r.reconcileClusterRole(ctx, desiredRole, actualRole); err != nil {
+			return err
+		}
+
+		desired, err := resources.MakeManagerClusterRoleBinding(space)
+		if err != nil {
+			return err
+		}
+
+		actual, err := r.clusterRoleBindingLister.Get(desired.Name)
+		if errors.IsNotFound(err) {
+			_, err = r.KubeClientSet.RbacV1().ClusterRoleBindings().Create(desired)
+			if err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		} else if !metav1.IsControlledBy(actual, space) {
+			space.Status.MarkRoleBindingNotOwned(desired.Name)
+			return fmt.Errorf("space: %q does not own clusterrolebinding: %q", space.Name, desired.Name)
+		} else if _, err = r.reconcileClusterRoleBinding(ctx, desired, actual); err != nil {
+			return err
+		}
+
+		space.Status.PropagateRoleBindingsStatus(actualBindings)
+	}
+
+	// The resource quota and limit range both include the QuotaPlan's limits.
+	// A missing QuotaPlan is reported on the quota but the space's own limits
+	// and the rest of the space are still reconciled.
+	var plan *v1alpha1.QuotaPlan
+	planFound := true
+	if name := space.Spec.ResourceLimits.QuotaPlan; name != "" {
+		var err error
+		plan, err = r.quotaPlanLister.Get(name)
+		if errors.IsNotFound(err) {
+			plan, planFound = nil, false
+		} else if err != nil {
+			return err
+		}
+	}
+
 	// Sync resource quota
 	{
 		logger.Debug("reconciling ResourceQuota")
-		desired, err := resources.MakeResourceQuota(space)
+		desired, err := resources.MakeResourceQuota(space, plan)
 		if err != nil {
 			return err
 		}
@@ -214,12 +360,15 @@ func (r *Reconciler) ApplyChanges(ctx context.Context, space *v1alpha1.Space) er
 		}
 
 		space.Status.PropagateResourceQuotaStatus(actual)
+		if !planFound {
+			space.Status.MarkQuotaPlanNotFound(space.Spec.ResourceLimits.QuotaPlan)
+		}
 	}
 
 	// Sync limit range
 	{
 		logger.Debug("reconciling LimitRange")
-		desired, err := resources.MakeLimitRange(space)
+		desired, err := resources.MakeLimitRange(space, plan)
 		if err != nil {
 			return err
 		}
@@ -272,6 +421,122 @@ func (r *Reconciler) ApplyChanges(ctx context.Context, space *v1alpha1.Space) er
 		space.Status.PropagateBuildServiceAccountStatus(actual)
 	}
 
+	// Sync network policies
+	{
+		logger.Debug("reconciling NetworkPolicies")
+		var actualPolicies []*networkingv1.NetworkPolicy
+		var missingGroup string
+		for _, lifecycle := range v1alpha1.SecurityGroupLifecycles {
+			names := space.Spec.Security.SecurityGroupsFor(lifecycle)
+
+			// Missing SecurityGroups are left out of the policy so they grant
+			// nothing until they're created.
+			var groups []*v1alpha1.SecurityGroup
+			for _, name := range names {
+				group, err := r.securityGroupLister.Get(name)
+				if errors.IsNotFound(err) {
+					missingGroup = name
+					continue
+				} else if err != nil {
+					return err
+				}
+				groups = append(groups, group)
+			}
+
+			desired, err := resources.MakeNetworkPolicy(space, lifecycle, groups)
+			if err != nil {
+				return err
+			}
+
+			actual, err := r.networkPolicyLister.NetworkPolicies(desired.Namespace).Get(desired.Name)
+			switch {
+			case len(names) == 0:
+				// Lifecycles without SecurityGroups aren't restricted so
+				// remove any policy left from groups that were unbound.
+				if err == nil && metav1.IsControlledBy(actual, space) {
+					err = r.KubeClientSet.NetworkingV1().NetworkPolicies(desired.Namespace).Delete(desired.Name, &metav1.DeleteOptions{})
+				}
+				if err != nil && !errors.IsNotFound(err) {
+					return err
+				}
+				continue
+			case errors.IsNotFound(err):
+				actual, err = r.KubeClientSet.NetworkingV1().NetworkPolicies(desired.Namespace).Create(desired)
+				if err != nil {
+					return err
+				}
+			case err != nil:
+				return err
+			case !metav1.IsControlledBy(actual, space):
+				space.Status.MarkNetworkPolicyNotOwned(desired.Name)
+				return fmt.Errorf("space: %q does not own NetworkPolicy: %q", space.Name, desired.Name)
+			default:
+				if actual, err = r.reconcileNetworkPolicy(ctx, desired, actual); err != nil {
+					return err
+				}
+			}
+
+			actualPolicies = append(actualPolicies, actual)
+		}
+
+		space.Status.PropagateNetworkPoliciesStatus(actualPolicies)
+		if missingGroup != "" {
+			space.Status.MarkSecurityGroupNotFound(missingGroup)
+		}
+	}
+
+	// Sync buildpack stacks
+	// A missing BuildpackCatalog is reported but the space's own stacks are
+	// still used.
+	{
+		logger.Debug("reconciling buildpack stacks")
+		var catalog *v1alpha1.BuildpackCatalog
+		catalogFound := true
+		if name := space.Spec.BuildpackBuild.Catalog; name != "" {
+			var err error
+			catalog, err = r.catalogLister.Get(name)
+			if errors.IsNotFound(err) {
+				catalog, catalogFound = nil, false
+			} else if err != nil {
+				return err
+			}
+		}
+
+		space.Status.PropagateBuildpackStacks(
+			v1alpha1.ResolveBuildpackStacks(catalog, space.Spec.BuildpackBuild.Stacks),
+		)
+		if !catalogFound {
+			space.Status.MarkBuildpackCatalogNotFound(space.Spec.BuildpackBuild.Catalog)
+		}
+	}
+
+	// Sync placement
+	// A missing PlacementProfile is reported but the space's own placement is
+	// still used.
+	{
+		logger.Debug("reconciling placement")
+		var profile v1alpha1.PlacementProfileSpec
+		profileFound := true
+		if name := space.Spec.PlacementProfile; name != "" {
+			actual, err := r.placementProfileLister.Get(name)
+			if errors.IsNotFound(err) {
+				profileFound = false
+			} else if err != nil {
+				return err
+			} else {
+				profile = actual.Spec
+			}
+		}
+
+		space.Status.PropagatePlacement(
+			v1alpha1.ResolvePodPlacement(profile.Execution, space.Spec.Execution.PodPlacement),
+			v1alpha1.ResolvePodPlacement(profile.Build, space.Spec.BuildpackBuild.PodPlacement),
+		)
+		if !profileFound {
+			space.Status.MarkPlacementProfileNotFound(space.Spec.PlacementProfile)
+		}
+	}
+
 	return nil
 }
 
@@ -335,6 +600,109 @@ func (r *Reconciler) reconcileGenericRole(
 	return r.KubeClientSet.RbacV1().Roles(existing.Namespace).Update(existing)
 }
 
+func (r *Reconciler) reconcileRoleBinding(
+	ctx context.Context,
+	desired *rv1.RoleBinding,
+	actual *rv1.RoleBinding,
+) (*rv1.RoleBinding, error) {
+	logger := logging.FromContext(ctx)
+
+	// Check for differences, if none we don't need to reconcile.
+	semanticEqual := equality.Semantic.DeepEqual(desired.ObjectMeta.Labels, actual.ObjectMeta.Labels)
+	semanticEqual = semanticEqual && equality.Semantic.DeepEqual(desired.Subjects, actual.Subjects)
+
+	if semanticEqual {
+		return actual, nil
+	}
+
+	diff, err := kmp.SafeDiff(desired.Subjects, actual.Subjects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff Subjects: %v", err)
+	}
+	logger.Debug("RoleBinding.Subjects diff:", diff)
+
+	// Don't modify the informers copy.
+	existing := actual.DeepCopy()
+
+	// Preserve the rest of the object (e.g. ObjectMeta except for labels).
+	// The RoleRef can't be changed and is the same for every binding with
+	// the same name.
+	existing.ObjectMeta.Labels = desired.ObjectMeta.Labels
+	existing.Subjects = desired.Subjects
+	return r.KubeClientSet.RbacV1().RoleBindings(existing.Namespace).Update(existing)
+}
+
+func (r *Reconciler) reconcileClusterRole(
+	ctx context.Context,
+	desired *rv1.ClusterRole,
+	actual *rv1.ClusterRole,
+) (*rv1.ClusterRole, error) {
+	logger := logging.FromContext(ctx)
+
+	// Check for differences, if none we don't need to reconcile.
+	semanticEqual := equality.Semantic.DeepEqual(desired.ObjectMeta.Labels, actual.ObjectMeta.Labels)
+	semanticEqual = semanticEqual && equality.Semantic.DeepEqual(desired.Rules, actual.Rules)
+
+	if semanticEqual {
+		return actual, nil
+	}
+
+	diff, err := kmp.SafeDiff(desired.Rules, actual.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff Rules: %v", err)
+	}
+	logger.Debug("ClusterRole.Rules diff:", diff)
+
+	// Don't modify the informers copy.
+	existing := actual.DeepCopy()
+
+	// Preserve the rest of the object (e.g. ObjectMeta except for labels).
+	existing.ObjectMeta.Labels = desired.ObjectMeta.Labels
+	existing.Rules = desired.Rules
+	return r.KubeClientSet.RbacV1().ClusterRoles().Update(existing)
+}
+
+func (r *Reconciler) reconcileClusterRoleBinding(
+	ctx context.Context,
+	desired *rv1.ClusterRoleBinding,
+	actual *rv1.ClusterRoleBinding,
+) (*rv1.ClusterRoleBinding, error) {
+	logger := logging.FromContext(ctx)
+
+	// The RoleRef can't be changed, so bindings that still reference the
+	// shared manager role from older releases get replaced.
+	if !equality.Semantic.DeepEqual(desired.RoleRef, actual.RoleRef) {
+		logger.Debugf("ClusterRoleBinding.RoleRef changed from %q to %q", actual.RoleRef.Name, desired.RoleRef.Name)
+
+		if err := r.KubeClientSet.RbacV1().ClusterRoleBindings().Delete(actual.Name, &metav1.DeleteOptions{}); err != nil {
+			return nil, err
+		}
+		return r.KubeClientSet.RbacV1().ClusterRoleBindings().Create(desired)
+	}
+
+	// Check for differences, if none we don't need to reconcile.
+	semanticEqual := equality.Semantic.DeepEqual(desired.ObjectMeta.Labels, actual.ObjectMeta.Labels)
+	semanticEqual = semanticEqual && equality.Semantic.DeepEqual(desired.Subjects, actual.Subjects)
+
+	if semanticEqual {
+		return actual, nil
+	}
+
+	diff, err := kmp.SafeDiff(desired.Subjects, actual.Subjects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff Subjects: %v", err)
+	}
+	logger.Debug("ClusterRoleBinding.Subjects diff:", diff)
+
+	// Don't modify the informers copy.
+	existing := actual.DeepCopy()
+
+	// Preserve the rest of the object (e.g. ObjectMeta except for labels).
+	existing.ObjectMeta.Labels = desired.ObjectMeta.Labels
+	existing.Subjects = desired.Subjects
+	return r.KubeClientSet.RbacV1().ClusterRoleBindings().Update(existing)
+}
+
 func (r *Reconciler) reconcileResourceQuota(
 	ctx context.Context,
 	desired *v1.ResourceQuota,
@@ -441,6 +809,156 @@ func (r *Reconciler) reconcileServiceAccount(
 		Update(existing)
 }
 
+func (r *Reconciler) reconcileNetworkPolicy(
+	ctx context.Context,
+	desired *networkingv1.NetworkPolicy,
+	actual *networkingv1.NetworkPolicy,
+) (*networkingv1.NetworkPolicy, error) {
+	logger := logging.FromContext(ctx)
+
+	// Check for differences, if none we don't need to reconcile.
+	semanticEqual := equality.Semantic.DeepEqual(desired.ObjectMeta.Labels, actual.ObjectMeta.Labels)
+	semanticEqual = semanticEqual && equality.Semantic.DeepEqual(desired.Spec, actual.Spec)
+
+	if semanticEqual {
+		return actual, nil
+	}
+
+	diff, err := kmp.SafeDiff(desired.Spec, actual.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff Spec (NetworkPolicy): %v", err)
+	}
+	logger.Debug("NetworkPolicy.Spec diff:", diff)
+
+	// Don't modify the informers copy.
+	existing := actual.DeepCopy()
+
+	// Preserve the rest of the object (e.g. ObjectMeta except for labels).
+	existing.ObjectMeta.Labels = desired.ObjectMeta.Labels
+	existing.Spec = desired.Spec
+	return r.KubeClientSet.NetworkingV1().NetworkPolicies(existing.Namespace).Update(existing)
+}
+
+// finalize cleans up a deleted space before its namespace is removed. Apps
+// and service bindings are deleted so the service instances in the space can
+// be deprovisioned by their brokers, then the finalizer is removed once no
+// service instances are left. Protected spaces are left untouched.
+func (r *Reconciler) finalize(ctx context.Context, space *v1alpha1.Space) error {
+	logger := logging.FromContext(ctx)
+	if !space.HasFinalizer() {
+		return nil
+	}
+
+	if space.Spec.DeletionProtection {
+		logger.Infof("space %q is protected from deletion", space.Name)
+		space.Status.MarkDeletionProtected()
+		return nil
+	}
+
+	namespace := v1alpha1.NamespaceName(space)
+
+	// Apps in other spaces may be bound to instances shared with them so
+	// nothing is removed until every instance is unshared.
+	shares, err := r.shareLister.ServiceInstanceShares(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var shared []string
+	for _, share := range shares {
+		if share.GetDeletionTimestamp() == nil {
+			shared = append(shared, fmt.Sprintf("%s (%s)", share.Spec.InstanceName, share.Spec.Space))
+		}
+	}
+	if len(shared) > 0 {
+		logger.Infof("space %q has shared service instances", space.Name)
+		sort.Strings(shared)
+		space.Status.MarkServiceInstancesShared(shared)
+		return nil
+	}
+
+	// Apps are deleted first so they don't recreate their service bindings.
+	apps, err := r.appLister.Apps(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		if app.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		logger.Infof("deleting App %q of deleted space", app.Name)
+		err := r.KfClientSet.KfV1alpha1().Apps(namespace).Delete(app.Name, &metav1.DeleteOptions{})
+		if err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+
+	// Brokers won't deprovision instances that still have bindings.
+	bindings, err := r.serviceBindingLister.ServiceBindings(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, binding := range bindings {
+		if binding.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		logger.Infof("deleting ServiceBinding %q of deleted space", binding.Name)
+		err := r.serviceCatalogClient.ServicecatalogV1beta1().ServiceBindings(namespace).Delete(binding.Name, &metav1.DeleteOptions{})
+		if err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+
+	instances, err := r.serviceInstanceLister.ServiceInstances(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if instance.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		logger.Infof("deprovisioning ServiceInstance %q of deleted space", instance.Name)
+		err := r.serviceCatalogClient.ServicecatalogV1beta1().ServiceInstances(namespace).Delete(instance.Name, &metav1.DeleteOptions{})
+		if err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+
+	// The space gets enqueued again as the instances go away.
+	if len(instances) > 0 {
+		space.Status.MarkDeprovisioning(len(instances))
+		return nil
+	}
+
+	var finalizers []string
+	for _, finalizer := range space.Finalizers {
+		if finalizer != v1alpha1.SpaceFinalizer {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+
+	return r.setFinalizers(space, finalizers)
+}
+
+// setFinalizers patches the finalizers of the space. The resource version is
+// included so the patch fails if the space changed since it was read.
+func (r *Reconciler) setFinalizers(space *v1alpha1.Space, finalizers []string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers":      finalizers,
+			"resourceVersion": space.ResourceVersion,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.KfClientSet.KfV1alpha1().Spaces().Patch(space.Name, types.MergePatchType, patch)
+	return err
+}
+
 func (r *Reconciler) updateStatus(desired *v1alpha1.Space) (*v1alpha1.Space, error) {
 	actual, err := r.spaceLister.Get(desired.Name)
 	if err != nil {
@@ -0,0 +1,336 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package space
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	kffake "github.com/google/kf/pkg/client/clientset/versioned/fake"
+	kflisters "github.com/google/kf/pkg/client/listers/kf/v1alpha1"
+	servicecataloglisters "github.com/google/kf/pkg/client/servicecatalog/listers/servicecatalog/v1beta1"
+	"github.com/google/kf/pkg/kf/testutil"
+	"github.com/google/kf/pkg/reconciler"
+	"github.com/google/kf/pkg/reconciler/space/resources"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	v1listers "k8s.io/client-go/listers/core/v1"
+	networkingv1listers "k8s.io/client-go/listers/networking/v1"
+	rbacv1listers "k8s.io/client-go/listers/rbac/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+)
+
+// testReconciler holds a Reconciler backed by fake clients whose listers
+// serve the objects it was created with.
+type testReconciler struct {
+	*Reconciler
+
+	kube *k8sfake.Clientset
+	kf   *kffake.Clientset
+}
+
+// newTestReconciler creates a Reconciler for the Space. The Space's
+// Namespace is created as active so reconciliation doesn't stop at it.
+func newTestReconciler(t *testing.T, space *v1alpha1.Space, objs ...runtime.Object) *testReconciler {
+	t.Helper()
+
+	ns, err := resources.MakeNamespace(space)
+	testutil.AssertNil(t, "MakeNamespace err", err)
+	ns.Status.Phase = corev1.NamespaceActive
+
+	indexers := map[string]cache.Indexer{}
+	indexer := func(kind string) cache.Indexer {
+		if _, ok := indexers[kind]; !ok {
+			indexers[kind] = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		}
+		return indexers[kind]
+	}
+
+	var kubeObjs, kfObjs []runtime.Object
+	for _, obj := range append([]runtime.Object{ns, space}, objs...) {
+		var kind string
+		switch obj.(type) {
+		case *corev1.Namespace:
+			kind = "Namespace"
+			kubeObjs = append(kubeObjs, obj)
+		case *v1alpha1.Space:
+			kind = "Space"
+			kfObjs = append(kfObjs, obj)
+		case *v1alpha1.QuotaPlan:
+			kind = "QuotaPlan"
+			kfObjs = append(kfObjs, obj)
+		case *v1alpha1.SecurityGroup:
+			kind = "SecurityGroup"
+			kfObjs = append(kfObjs, obj)
+		case *v1alpha1.ServiceInstanceShare:
+			kind = "ServiceInstanceShare"
+			kfObjs = append(kfObjs, obj)
+		case *v1alpha1.PlacementProfile:
+			kind = "PlacementProfile"
+			kfObjs = append(kfObjs, obj)
+		default:
+			t.Fatalf("unsupported object %T", obj)
+		}
+
+		testutil.AssertNil(t, "indexer add err", indexer(kind).Add(obj))
+	}
+
+	kube := k8sfake.NewSimpleClientset(kubeObjs...)
+	kf := kffake.NewSimpleClientset(kfObjs...)
+
+	return &testReconciler{
+		Reconciler: &Reconciler{
+			Base: &reconciler.Base{
+				KubeClientSet: kube,
+				KfClientSet:   kf,
+			},
+			spaceLister:              kflisters.NewSpaceLister(indexer("Space")),
+			namespaceLister:          v1listers.NewNamespaceLister(indexer("Namespace")),
+			roleLister:               rbacv1listers.NewRoleLister(indexer("Role")),
+			roleBindingLister:        rbacv1listers.NewRoleBindingLister(indexer("RoleBinding")),
+			clusterRoleLister:        rbacv1listers.NewClusterRoleLister(indexer("ClusterRole")),
+			clusterRoleBindingLister: rbacv1listers.NewClusterRoleBindingLister(indexer("ClusterRoleBinding")),
+			resourceQuotaLister:      v1listers.NewResourceQuotaLister(indexer("ResourceQuota")),
+			limitRangeLister:         v1listers.NewLimitRangeLister(indexer("LimitRange")),
+			serviceAccountLister:     v1listers.NewServiceAccountLister(indexer("ServiceAccount")),
+			catalogLister:            kflisters.NewBuildpackCatalogLister(indexer("BuildpackCatalog")),
+			quotaPlanLister:          kflisters.NewQuotaPlanLister(indexer("QuotaPlan")),
+			networkPolicyLister:      networkingv1listers.NewNetworkPolicyLister(indexer("NetworkPolicy")),
+			securityGroupLister:      kflisters.NewSecurityGroupLister(indexer("SecurityGroup")),
+			placementProfileLister:   kflisters.NewPlacementProfileLister(indexer("PlacementProfile")),
+			appLister:                kflisters.NewAppLister(indexer("App")),
+			serviceInstanceLister:    servicecataloglisters.NewServiceInstanceLister(indexer("ServiceInstance")),
+			serviceBindingLister:     servicecataloglisters.NewServiceBindingLister(indexer("ServiceBinding")),
+			shareLister:              kflisters.NewServiceInstanceShareLister(indexer("ServiceInstanceShare")),
+		},
+		kube: kube,
+		kf:   kf,
+	}
+}
+
+// newTestSpace creates a Space that already has its finalizer.
+func newTestSpace() *v1alpha1.Space {
+	space := &v1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "my-space",
+			UID:        "my-space-uid",
+			Finalizers: []string{v1alpha1.SpaceFinalizer},
+		},
+	}
+	space.SetDefaults(context.Background())
+
+	return space
+}
+
+func assertCondition(t *testing.T, space *v1alpha1.Space, condition apis.ConditionType, status corev1.ConditionStatus, reason string) {
+	t.Helper()
+
+	cond := space.Status.GetCondition(condition)
+	if cond == nil {
+		t.Fatalf("condition %s wasn't set", condition)
+	}
+
+	testutil.AssertEqual(t, string(condition)+" status", status, cond.Status)
+	testutil.AssertEqual(t, string(condition)+" reason", reason, cond.Reason)
+}
+
+func TestReconciler_ApplyChanges_quotaPlan(t *testing.T) {
+	t.Parallel()
+
+	memory := resource.MustParse("8Gi")
+	plan := &v1alpha1.QuotaPlan{ObjectMeta: metav1.ObjectMeta{Name: "small"}}
+	plan.Spec.Memory = &memory
+
+	cases := map[string]struct {
+		objs       []runtime.Object
+		wantStatus corev1.ConditionStatus
+		wantReason string
+		wantHard   map[corev1.ResourceName]string
+	}{
+		"plan found": {
+			objs:       []runtime.Object{plan},
+			wantStatus: corev1.ConditionTrue,
+			wantHard: map[corev1.ResourceName]string{
+				corev1.ResourceMemory: "8Gi",
+				corev1.ResourceCPU:    "1",
+			},
+		},
+		"plan missing": {
+			wantStatus: corev1.ConditionFalse,
+			wantReason: "QuotaPlanNotFound",
+			wantHard: map[corev1.ResourceName]string{
+				corev1.ResourceCPU: "1",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			space := newTestSpace()
+			space.Spec.ResourceLimits.QuotaPlan = "small"
+			space.Spec.ResourceLimits.SpaceQuota = corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("1"),
+			}
+
+			r := newTestReconciler(t, space, tc.objs...)
+			testutil.AssertNil(t, "ApplyChanges err", r.ApplyChanges(context.Background(), space))
+
+			assertCondition(t, space, v1alpha1.SpaceConditionResourceQuotaReady, tc.wantStatus, tc.wantReason)
+
+			// The rest of the space is reconciled either way.
+			assertCondition(t, space, v1alpha1.SpaceConditionLimitRangeReady, corev1.ConditionTrue, "")
+			assertCondition(t, space, v1alpha1.SpaceConditionBuildServiceAccountReady, corev1.ConditionTrue, "")
+			assertCondition(t, space, v1alpha1.SpaceConditionNetworkPoliciesReady, corev1.ConditionTrue, "")
+			assertCondition(t, space, v1alpha1.SpaceConditionPlacementReady, corev1.ConditionTrue, "")
+
+			quota, err := r.kube.CoreV1().ResourceQuotas("my-space").Get(resources.ResourceQuotaName(space), metav1.GetOptions{})
+			testutil.AssertNil(t, "get quota err", err)
+			hard := make(map[corev1.ResourceName]string)
+			for name, quantity := range quota.Spec.Hard {
+				hard[name] = quantity.String()
+			}
+			testutil.AssertEqual(t, "quota", tc.wantHard, hard)
+		})
+	}
+}
+
+func TestReconciler_ApplyChanges_missingSecurityGroup(t *testing.T) {
+	t.Parallel()
+
+	group := &v1alpha1.SecurityGroup{ObjectMeta: metav1.ObjectMeta{Name: "public"}}
+	group.Spec.Rules = []v1alpha1.SecurityGroupRule{
+		{Protocol: v1alpha1.SecurityGroupProtocolAll, Destination: "0.0.0.0/0"},
+	}
+
+	space := newTestSpace()
+	space.Spec.Security.SecurityGroups = []v1alpha1.SpaceSecurityGroup{
+		{Name: "public", Lifecycle: v1alpha1.SecurityGroupLifecycleRunning},
+		{Name: "missing", Lifecycle: v1alpha1.SecurityGroupLifecycleStaging},
+	}
+
+	r := newTestReconciler(t, space, group)
+	testutil.AssertNil(t, "ApplyChanges err", r.ApplyChanges(context.Background(), space))
+
+	assertCondition(t, space, v1alpha1.SpaceConditionNetworkPoliciesReady, corev1.ConditionFalse, "SecurityGroupNotFound")
+
+	// The rest of the space is reconciled either way.
+	assertCondition(t, space, v1alpha1.SpaceConditionPlacementReady, corev1.ConditionTrue, "")
+	assertCondition(t, space, v1alpha1.SpaceConditionBuildpackCatalogReady, corev1.ConditionTrue, "")
+
+	// Both lifecycles stay restricted, the missing group just grants nothing.
+	for _, lifecycle := range v1alpha1.SecurityGroupLifecycles {
+		name := resources.NetworkPolicyName(space, lifecycle)
+		_, err := r.kube.NetworkingV1().NetworkPolicies("my-space").Get(name, metav1.GetOptions{})
+		testutil.AssertNil(t, name+" err", err)
+	}
+}
+
+func TestReconciler_ApplyChanges_missingCatalog(t *testing.T) {
+	t.Parallel()
+
+	profile := &v1alpha1.PlacementProfile{ObjectMeta: metav1.ObjectMeta{Name: "pci"}}
+	profile.Spec.Execution.NodeSelector = map[string]string{"pool": "pci-nodes"}
+	profile.Spec.Build.NodeSelector = map[string]string{"pool": "pci-builds"}
+
+	space := newTestSpace()
+	space.Spec.PlacementProfile = "pci"
+	space.Spec.BuildpackBuild.Catalog = "missing"
+	space.Spec.BuildpackBuild.Stacks = []v1alpha1.BuildpackStack{
+		{Name: "cflinuxfs3", BuilderImage: "some/builder"},
+	}
+
+	r := newTestReconciler(t, space, profile)
+	testutil.AssertNil(t, "ApplyChanges err", r.ApplyChanges(context.Background(), space))
+
+	assertCondition(t, space, v1alpha1.SpaceConditionBuildpackCatalogReady, corev1.ConditionFalse, "NotFound")
+	testutil.AssertEqual(t, "stacks", space.Spec.BuildpackBuild.Stacks, space.Status.BuildpackStacks)
+
+	// Placement is still resolved from the profile.
+	assertCondition(t, space, v1alpha1.SpaceConditionPlacementReady, corev1.ConditionTrue, "")
+	testutil.AssertEqual(t, "execution placement", profile.Spec.Execution.NodeSelector, space.Status.ExecutionPlacement.NodeSelector)
+	testutil.AssertEqual(t, "build placement", profile.Spec.Build.NodeSelector, space.Status.BuildPlacement.NodeSelector)
+}
+
+func TestReconciler_ApplyChanges_missingPlacementProfile(t *testing.T) {
+	t.Parallel()
+
+	space := newTestSpace()
+	space.Spec.PlacementProfile = "missing"
+	space.Spec.Execution.PodPlacement.NodeSelector = map[string]string{"pool": "space-nodes"}
+
+	r := newTestReconciler(t, space)
+	testutil.AssertNil(t, "ApplyChanges err", r.ApplyChanges(context.Background(), space))
+
+	assertCondition(t, space, v1alpha1.SpaceConditionPlacementReady, corev1.ConditionFalse, "NotFound")
+	testutil.AssertEqual(t, "execution placement", space.Spec.Execution.PodPlacement.NodeSelector, space.Status.ExecutionPlacement.NodeSelector)
+}
+
+func TestReconciler_finalize_sharedServiceInstances(t *testing.T) {
+	t.Parallel()
+
+	share := &v1alpha1.ServiceInstanceShare{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-db-other-space",
+			Namespace: "my-space",
+		},
+		Spec: v1alpha1.ServiceInstanceShareSpec{
+			InstanceName: "my-db",
+			Space:        "other-space",
+		},
+	}
+
+	cases := map[string]struct {
+		objs           []runtime.Object
+		wantFinalizers []string
+		wantReason     string
+	}{
+		"shared": {
+			objs:           []runtime.Object{share},
+			wantFinalizers: []string{v1alpha1.SpaceFinalizer},
+			wantReason:     "ServiceInstancesShared",
+		},
+		"not shared": {},
+	}
+
+	for tn, tc := range cases {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			space := newTestSpace()
+			now := metav1.Now()
+			space.DeletionTimestamp = &now
+
+			r := newTestReconciler(t, space, tc.objs...)
+			testutil.AssertNil(t, "finalize err", r.finalize(context.Background(), space))
+
+			if tc.wantReason != "" {
+				assertCondition(t, space, v1alpha1.SpaceConditionReady, corev1.ConditionFalse, tc.wantReason)
+			}
+
+			actual, err := r.kf.KfV1alpha1().Spaces().Get(space.Name, metav1.GetOptions{})
+			testutil.AssertNil(t, "get space err", err)
+			testutil.AssertEqual(t, "finalizers", tc.wantFinalizers, actual.Finalizers)
+		})
+	}
+}
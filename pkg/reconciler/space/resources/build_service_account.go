@@ -33,7 +33,7 @@ func MakeBuildServiceAccount(space *v1alpha1.Space) (*corev1.ServiceAccount, err
 	return &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      BuildServiceAccountName(space),
-			Namespace: NamespaceName(space),
+			Namespace: v1alpha1.NamespaceName(space),
 			OwnerReferences: []metav1.OwnerReference{
 				*kmeta.NewControllerRef(space),
 			},
@@ -26,12 +26,13 @@ func LimitRangeName(space *v1alpha1.Space) string {
 	return "space-limit-range"
 }
 
-// MakeLimitRange creates a LimitRange from a Space object.
-func MakeLimitRange(space *v1alpha1.Space) (*v1.LimitRange, error) {
+// MakeLimitRange creates a LimitRange from a Space object and the QuotaPlan
+// it references, if any.
+func MakeLimitRange(space *v1alpha1.Space, plan *v1alpha1.QuotaPlan) (*v1.LimitRange, error) {
 	limitRange := &v1.LimitRange{}
 	limitRange.ObjectMeta = metav1.ObjectMeta{
 		Name:      LimitRangeName(space),
-		Namespace: NamespaceName(space),
+		Namespace: v1alpha1.NamespaceName(space),
 		OwnerReferences: []metav1.OwnerReference{
 			*kmeta.NewControllerRef(space),
 		},
@@ -40,5 +41,13 @@ func MakeLimitRange(space *v1alpha1.Space) (*v1.LimitRange, error) {
 		}),
 	}
 	limitRange.Spec.Limits = space.Spec.ResourceLimits.ResourceDefaults
+
+	if plan != nil {
+		limitRange.Spec.Limits = append(
+			plan.Spec.LimitRangeItems(),
+			space.Spec.ResourceLimits.ResourceDefaults...,
+		)
+	}
+
 	return limitRange, nil
 }
@@ -37,7 +37,7 @@ func ExampleMakeLimitRange() {
 	}
 	space.Spec.ResourceLimits.ResourceDefaults = []v1.LimitRangeItem{limit}
 
-	limitRange, err := MakeLimitRange(space)
+	limitRange, err := MakeLimitRange(space, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -54,3 +54,27 @@ func ExampleMakeLimitRange() {
 	// Default memory request: 1Gi
 	// Default cpu request: 100m
 }
+
+func ExampleMakeLimitRange_quotaPlan() {
+	space := &v1alpha1.Space{}
+	space.Name = "my-space"
+	space.Spec.ResourceLimits.ResourceDefaults = []v1.LimitRangeItem{
+		{Type: v1.LimitTypePod},
+	}
+
+	appMemory := resource.MustParse("2Gi")
+	plan := &v1alpha1.QuotaPlan{}
+	plan.Spec.AppMemory = &appMemory
+
+	limitRange, err := MakeLimitRange(space, plan)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, limit := range limitRange.Spec.Limits {
+		fmt.Println("Limit type:", limit.Type, "max memory:", limit.Max.Memory())
+	}
+
+	// Output: Limit type: Container max memory: 2Gi
+	// Limit type: Pod max memory: 0
+}
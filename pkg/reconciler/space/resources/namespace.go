@@ -26,16 +26,11 @@ const (
 	istioInjectionLabel = "istio-injection"
 )
 
-// NamespaceName gets the name of a namespace given the space.
-func NamespaceName(space *v1alpha1.Space) string {
-	return space.Name
-}
-
 // MakeNamespace creates a Namespace from a Space object.
 func MakeNamespace(space *v1alpha1.Space) (*v1.Namespace, error) {
 	return &v1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: NamespaceName(space),
+			Name: v1alpha1.NamespaceName(space),
 			OwnerReferences: []metav1.OwnerReference{
 				*kmeta.NewControllerRef(space),
 			},
@@ -20,15 +20,6 @@ import (
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 )
 
-func ExampleNamespaceName() {
-	space := &v1alpha1.Space{}
-	space.Name = "my-space"
-
-	fmt.Println(NamespaceName(space))
-
-	// Output: my-space
-}
-
 func ExampleMakeNamespace() {
 	space := &v1alpha1.Space{}
 	space.Name = "my-space"
@@ -38,7 +29,7 @@ func ExampleMakeNamespace() {
 		panic(err)
 	}
 
-	fmt.Println("Name:", NamespaceName(space))
+	fmt.Println("Name:", v1alpha1.NamespaceName(space))
 	fmt.Println("Label Count:", len(ns.Labels))
 	fmt.Println("Managed By:", ns.Labels[managedByLabel])
 	fmt.Println("Istio Injection:", ns.Labels[istioInjectionLabel])
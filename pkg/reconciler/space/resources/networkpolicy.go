@@ -0,0 +1,164 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/pkg/kmeta"
+)
+
+// DNSPodLabels select the cluster DNS pods that every pod in the space can
+// send DNS queries to.
+var DNSPodLabels = map[string]string{"k8s-app": "kube-dns"}
+
+// NetworkPolicyName gets the name of the NetworkPolicy for the SecurityGroups
+// bound to a lifecycle in the space.
+func NetworkPolicyName(space *v1alpha1.Space, lifecycle v1alpha1.SecurityGroupLifecycle) string {
+	return fmt.Sprintf("%s-security-groups", lifecycle)
+}
+
+// NetworkPolicyPodSelector selects the pods a lifecycle's SecurityGroups
+// apply to.
+func NetworkPolicyPodSelector(lifecycle v1alpha1.SecurityGroupLifecycle) metav1.LabelSelector {
+	if lifecycle == v1alpha1.SecurityGroupLifecycleStaging {
+		return metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: v1alpha1.BuildPodLabel, Operator: metav1.LabelSelectorOpExists},
+			},
+		}
+	}
+
+	return metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			v1alpha1.ManagedByLabel: "kf",
+			v1alpha1.ComponentLabel: "app-server",
+		},
+	}
+}
+
+// MakeNetworkPolicy creates an egress NetworkPolicy for the pods of a
+// lifecycle in the space. The policy always allows DNS queries to the
+// cluster DNS and traffic within the cluster, every other destination must be
+// allowed by one of the groups.
+func MakeNetworkPolicy(
+	space *v1alpha1.Space,
+	lifecycle v1alpha1.SecurityGroupLifecycle,
+	groups []*v1alpha1.SecurityGroup,
+) (*networkingv1.NetworkPolicy, error) {
+	egress := []networkingv1.NetworkPolicyEgressRule{
+		{
+			// Namespaces don't have a standard label with their name so the
+			// DNS pods are matched in any namespace.
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: &metav1.LabelSelector{},
+					PodSelector:       &metav1.LabelSelector{MatchLabels: DNSPodLabels},
+				},
+			},
+			Ports: makePolicyPorts(v1alpha1.SecurityGroupProtocolAll, []int32{53}),
+		},
+		{
+			To: []networkingv1.NetworkPolicyPeer{
+				{NamespaceSelector: &metav1.LabelSelector{}},
+			},
+		},
+	}
+
+	for _, group := range groups {
+		for i, rule := range group.Spec.Rules {
+			cidrs, err := rule.DestinationCIDRs()
+			if err != nil {
+				return nil, fmt.Errorf("SecurityGroup %q rule %d: %v", group.Name, i, err)
+			}
+
+			ports, err := rule.PortList()
+			if err != nil {
+				return nil, fmt.Errorf("SecurityGroup %q rule %d: %v", group.Name, i, err)
+			}
+
+			var peers []networkingv1.NetworkPolicyPeer
+			for _, cidr := range cidrs {
+				peers = append(peers, networkingv1.NetworkPolicyPeer{
+					IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+				})
+			}
+
+			egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+				To:    peers,
+				Ports: makePolicyPorts(rule.Protocol, ports),
+			})
+		}
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      NetworkPolicyName(space, lifecycle),
+			Namespace: v1alpha1.NamespaceName(space),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(space),
+			},
+			Labels: v1alpha1.UnionMaps(space.GetLabels(), map[string]string{
+				managedByLabel: "kf",
+			}),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: NetworkPolicyPodSelector(lifecycle),
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress:      egress,
+		},
+	}, nil
+}
+
+// makePolicyPorts converts a protocol and port list into NetworkPolicyPorts.
+// A nil result allows every port and protocol.
+func makePolicyPorts(protocol v1alpha1.SecurityGroupProtocol, ports []int32) []networkingv1.NetworkPolicyPort {
+	var protocols []corev1.Protocol
+	switch protocol {
+	case v1alpha1.SecurityGroupProtocolTCP:
+		protocols = []corev1.Protocol{corev1.ProtocolTCP}
+	case v1alpha1.SecurityGroupProtocolUDP:
+		protocols = []corev1.Protocol{corev1.ProtocolUDP}
+	default:
+		if len(ports) == 0 {
+			return nil
+		}
+		protocols = []corev1.Protocol{corev1.ProtocolTCP, corev1.ProtocolUDP}
+	}
+
+	var out []networkingv1.NetworkPolicyPort
+	for _, p := range protocols {
+		p := p
+		if len(ports) == 0 {
+			out = append(out, networkingv1.NetworkPolicyPort{Protocol: &p})
+			continue
+		}
+
+		for _, port := range ports {
+			portValue := intstr.FromInt(int(port))
+			out = append(out, networkingv1.NetworkPolicyPort{
+				Protocol: &p,
+				Port:     &portValue,
+			})
+		}
+	}
+
+	return out
+}
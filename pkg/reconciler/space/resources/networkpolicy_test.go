@@ -0,0 +1,100 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+)
+
+func ExampleMakeNetworkPolicy() {
+	space := &v1alpha1.Space{}
+	space.Name = "my-space"
+
+	group := &v1alpha1.SecurityGroup{}
+	group.Name = "public-https"
+	group.Spec.Rules = []v1alpha1.SecurityGroupRule{
+		{Protocol: v1alpha1.SecurityGroupProtocolTCP, Destination: "10.0.0.1-10.0.0.2", Ports: "443"},
+	}
+
+	policy, err := MakeNetworkPolicy(space, v1alpha1.SecurityGroupLifecycleRunning, []*v1alpha1.SecurityGroup{group})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Name:", policy.Name)
+	fmt.Println("Namespace:", policy.Namespace)
+	fmt.Println("Managed by:", policy.Labels[managedByLabel])
+	fmt.Println("Pod component:", policy.Spec.PodSelector.MatchLabels[v1alpha1.ComponentLabel])
+	fmt.Println("Policy types:", policy.Spec.PolicyTypes)
+	for _, rule := range policy.Spec.Egress {
+		for _, peer := range rule.To {
+			switch {
+			case peer.IPBlock != nil:
+				fmt.Println("CIDR:", peer.IPBlock.CIDR)
+			case peer.PodSelector != nil:
+				fmt.Println("Pods:", peer.PodSelector.MatchLabels)
+			default:
+				fmt.Println("Cluster")
+			}
+		}
+
+		for _, port := range rule.Ports {
+			fmt.Println("Port:", *port.Protocol, port.Port.String())
+		}
+	}
+
+	// Output: Name: running-security-groups
+	// Namespace: my-space
+	// Managed by: kf
+	// Pod component: app-server
+	// Policy types: [Egress]
+	// Pods: map[k8s-app:kube-dns]
+	// Port: TCP 53
+	// Port: UDP 53
+	// Cluster
+	// CIDR: 10.0.0.1/32
+	// CIDR: 10.0.0.2/32
+	// Port: TCP 443
+}
+
+func ExampleMakeNetworkPolicy_staging() {
+	space := &v1alpha1.Space{}
+	space.Name = "my-space"
+
+	group := &v1alpha1.SecurityGroup{}
+	group.Name = "package-mirrors"
+	group.Spec.Rules = []v1alpha1.SecurityGroupRule{
+		{Protocol: v1alpha1.SecurityGroupProtocolAll, Destination: "192.168.1.0/24"},
+	}
+
+	policy, err := MakeNetworkPolicy(space, v1alpha1.SecurityGroupLifecycleStaging, []*v1alpha1.SecurityGroup{group})
+	if err != nil {
+		panic(err)
+	}
+
+	last := policy.Spec.Egress[len(policy.Spec.Egress)-1]
+
+	fmt.Println("Name:", policy.Name)
+	fmt.Println("Pod selector:", policy.Spec.PodSelector.MatchExpressions[0].Key, policy.Spec.PodSelector.MatchExpressions[0].Operator)
+	fmt.Println("CIDR:", last.To[0].IPBlock.CIDR)
+	fmt.Println("Ports:", len(last.Ports))
+
+	// Output: Name: staging-security-groups
+	// Pod selector: build.knative.dev/buildName Exists
+	// CIDR: 192.168.1.0/24
+	// Ports: 0
+}
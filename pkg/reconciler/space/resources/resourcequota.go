@@ -26,12 +26,14 @@ func ResourceQuotaName(space *v1alpha1.Space) string {
 	return "space-quota"
 }
 
-// MakeResourceQuota creates a ResourceQuota from a Space object.
-func MakeResourceQuota(space *v1alpha1.Space) (*v1.ResourceQuota, error) {
+// MakeResourceQuota creates a ResourceQuota from a Space object and the
+// QuotaPlan it references, if any. Limits set on the Space override the
+// plan's.
+func MakeResourceQuota(space *v1alpha1.Space, plan *v1alpha1.QuotaPlan) (*v1.ResourceQuota, error) {
 	quota := &v1.ResourceQuota{}
 	quota.ObjectMeta = metav1.ObjectMeta{
 		Name:      ResourceQuotaName(space),
-		Namespace: NamespaceName(space),
+		Namespace: v1alpha1.NamespaceName(space),
 		OwnerReferences: []metav1.OwnerReference{
 			*kmeta.NewControllerRef(space),
 		},
@@ -40,5 +42,14 @@ func MakeResourceQuota(space *v1alpha1.Space) (*v1.ResourceQuota, error) {
 		}),
 	}
 	quota.Spec.Hard = space.Spec.ResourceLimits.SpaceQuota
+
+	if plan != nil {
+		hard := plan.Spec.ResourceList()
+		for name, quantity := range space.Spec.ResourceLimits.SpaceQuota {
+			hard[name] = quantity
+		}
+		quota.Spec.Hard = hard
+	}
+
 	return quota, nil
 }
@@ -32,7 +32,7 @@ func ExampleMakeResourceQuota() {
 		v1.ResourceCPU:    cpu,
 	}
 
-	quota, err := MakeResourceQuota(space)
+	quota, err := MakeResourceQuota(space, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -47,3 +47,28 @@ func ExampleMakeResourceQuota() {
 	// Memory quota: 20Gi
 	// CPU quota: 800m
 }
+
+func ExampleMakeResourceQuota_quotaPlan() {
+	space := &v1alpha1.Space{}
+	space.Name = "my-space"
+	space.Spec.ResourceLimits.SpaceQuota = v1.ResourceList{
+		v1.ResourceCPU: resource.MustParse("2"),
+	}
+
+	memory := resource.MustParse("10Gi")
+	cpu := resource.MustParse("1")
+	plan := &v1alpha1.QuotaPlan{}
+	plan.Spec.Memory = &memory
+	plan.Spec.CPU = &cpu
+
+	quota, err := MakeResourceQuota(space, plan)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Memory quota:", quota.Spec.Hard.Memory())
+	fmt.Println("CPU quota:", quota.Spec.Hard.Cpu())
+
+	// Output: Memory quota: 10Gi
+	// CPU quota: 2
+}
@@ -15,23 +15,20 @@
 package resources
 
 import (
+	"fmt"
+
 	"github.com/google/kf/pkg/apis/kf/v1alpha1"
 	v1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/kmeta"
 )
 
-// DeveloperRoleName gets the name of the developer role given the space.
-func DeveloperRoleName(space *v1alpha1.Space) string {
-	return "space-developer"
-}
-
 // MakeDeveloperRole creates a Role for developer access from a Space object.
 func MakeDeveloperRole(space *v1alpha1.Space) (*v1.Role, error) {
 	return &v1.Role{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      DeveloperRoleName(space),
-			Namespace: NamespaceName(space),
+			Name:      v1alpha1.DeveloperRoleName(space),
+			Namespace: v1alpha1.NamespaceName(space),
 			OwnerReferences: []metav1.OwnerReference{
 				*kmeta.NewControllerRef(space),
 			},
@@ -43,17 +40,12 @@ func MakeDeveloperRole(space *v1alpha1.Space) (*v1.Role, error) {
 	}, nil
 }
 
-// AuditorRoleName gets the name of the auditor role given the space.
-func AuditorRoleName(space *v1alpha1.Space) string {
-	return "space-auditor"
-}
-
 // MakeAuditorRole creates a Role for auditor access from a Space object.
 func MakeAuditorRole(space *v1alpha1.Space) (*v1.Role, error) {
 	return &v1.Role{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      AuditorRoleName(space),
-			Namespace: NamespaceName(space),
+			Name:      v1alpha1.AuditorRoleName(space),
+			Namespace: v1alpha1.NamespaceName(space),
 			OwnerReferences: []metav1.OwnerReference{
 				*kmeta.NewControllerRef(space),
 			},
@@ -65,6 +57,56 @@ func MakeAuditorRole(space *v1alpha1.Space) (*v1.Role, error) {
 	}, nil
 }
 
+// MakeManagerRole creates a Role for manager access from a Space object.
+// Managers can audit the space and bind the developer and auditor roles, but
+// not the manager role itself.
+func MakeManagerRole(space *v1alpha1.Space) (*v1.Role, error) {
+	return &v1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.ManagerRoleName(space),
+			Namespace: v1alpha1.NamespaceName(space),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(space),
+			},
+			Labels: v1alpha1.UnionMaps(space.GetLabels(), map[string]string{
+				managedByLabel: "kf",
+			}),
+		},
+		Rules: managerPolicyRules(space),
+	}, nil
+}
+
+// ManagerClusterRoleName gets the name of the ClusterRole that lets the
+// space's managers update the Space.
+func ManagerClusterRoleName(space *v1alpha1.Space) string {
+	return fmt.Sprintf("kf-space-manager-%s", space.Name)
+}
+
+// MakeManagerClusterRole creates a ClusterRole that allows updating only the
+// given Space. The webhook limits the changes managers can make to the role
+// assignments of the Space.
+func MakeManagerClusterRole(space *v1alpha1.Space) (*v1.ClusterRole, error) {
+	return &v1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ManagerClusterRoleName(space),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(space),
+			},
+			Labels: v1alpha1.UnionMaps(space.GetLabels(), map[string]string{
+				managedByLabel: "kf",
+			}),
+		},
+		Rules: []v1.PolicyRule{
+			{
+				APIGroups:     []string{"kf.dev"},
+				Verbs:         []string{"get", "update", "patch"},
+				Resources:     []string{"spaces"},
+				ResourceNames: []string{space.Name},
+			},
+		},
+	}, nil
+}
+
 func readOnlyVerbs() []string {
 	return []string{"get", "list", "watch"}
 }
@@ -79,13 +121,14 @@ func readEditVerbs() []string {
 
 func developerPolicyRules(space *v1alpha1.Space) []v1.PolicyRule {
 	modifyRules := []v1.PolicyRule{
-		// Create service instances and bindings
+		// Create service instances, bindings and space scoped brokers
 		{
 			APIGroups: []string{"servicecatalog.k8s.io"},
 			Verbs:     editVerbs(),
 			Resources: []string{
 				"serviceinstances",
 				"servicebindings",
+				"servicebrokers",
 			},
 		},
 		// Create and modify secrets
@@ -100,6 +143,12 @@ func developerPolicyRules(space *v1alpha1.Space) []v1.PolicyRule {
 			Verbs:     editVerbs(),
 			Resources: []string{"services"},
 		},
+		// Share service instances with other spaces
+		{
+			APIGroups: []string{"kf.dev"},
+			Verbs:     readEditVerbs(),
+			Resources: []string{"serviceinstanceshares"},
+		},
 	}
 
 	out := append(auditPolicyRules(space), modifyRules...)
@@ -144,3 +193,25 @@ func auditPolicyRules(space *v1alpha1.Space) []v1.PolicyRule {
 		},
 	}
 }
+
+func managerPolicyRules(space *v1alpha1.Space) []v1.PolicyRule {
+	return append(auditPolicyRules(space),
+		// Read access to who has access to the space.
+		v1.PolicyRule{
+			APIGroups: []string{"rbac.authorization.k8s.io"},
+			Verbs:     readOnlyVerbs(),
+			Resources: []string{"roles", "rolebindings"},
+		},
+		// Grant the developer and auditor roles. Kubernetes won't let
+		// managers bind roles that aren't listed here.
+		v1.PolicyRule{
+			APIGroups: []string{"rbac.authorization.k8s.io"},
+			Verbs:     []string{"bind"},
+			Resources: []string{"roles"},
+			ResourceNames: []string{
+				v1alpha1.DeveloperRoleName(space),
+				v1alpha1.AuditorRoleName(space),
+			},
+		},
+	)
+}
@@ -23,22 +23,45 @@ import (
 	v1 "k8s.io/api/rbac/v1"
 )
 
-func ExampleAuditorRoleName() {
+func TestMakeManagerRole(t *testing.T) {
 	space := &v1alpha1.Space{}
 	space.Name = "my-space"
 
-	fmt.Println(AuditorRoleName(space))
+	role, err := MakeManagerRole(space)
+	testutil.AssertNil(t, "MakeManagerRole error", err)
 
-	// Output: space-auditor
+	assertAllowed(t, role, "get", "serving.knative.dev", "services")
+	assertAllowed(t, role, "list", "rbac.authorization.k8s.io", "rolebindings")
+	assertNotAllowed(t, role, "create", "rbac.authorization.k8s.io", "rolebindings")
+	assertNotAllowed(t, role, "get", "", "secrets")
+
+	var bindable []string
+	for _, rule := range role.Rules {
+		if listMatches("bind", rule.Verbs) {
+			bindable = append(bindable, rule.ResourceNames...)
+		}
+	}
+	testutil.AssertEqual(t, "bindable roles", []string{"space-developer", "space-auditor"}, bindable)
 }
 
-func ExampleDeveloperRoleName() {
+func ExampleMakeManagerClusterRole() {
 	space := &v1alpha1.Space{}
 	space.Name = "my-space"
 
-	fmt.Println(DeveloperRoleName(space))
+	role, err := MakeManagerClusterRole(space)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Name:", role.Name)
+	fmt.Println("Managed by:", role.Labels[managedByLabel])
+	for _, rule := range role.Rules {
+		fmt.Println("Rule:", rule.APIGroups, rule.Resources, rule.ResourceNames, rule.Verbs)
+	}
 
-	// Output: space-developer
+	// Output: Name: kf-space-manager-my-space
+	// Managed by: kf
+	// Rule: [kf.dev] [spaces] [my-space] [get update patch]
 }
 
 func TestMakeAuditorRole(t *testing.T) {
@@ -69,6 +92,8 @@ func TestMakeDeveloperRole(t *testing.T) {
 			Space: v1alpha1.Space{},
 			Assert: func(t *testing.T, role *v1.Role) {
 				assertNotAllowed(t, role, "get", "", "pods/log")
+				assertAllowed(t, role, "create", "kf.dev", "serviceinstanceshares")
+				assertAllowed(t, role, "update", "servicecatalog.k8s.io", "servicebrokers")
 			},
 		},
 		"space allows logs": {
@@ -0,0 +1,102 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+// RoleBindingName gets the name of the RoleBinding for a role in the space.
+func RoleBindingName(space *v1alpha1.Space, role v1alpha1.SpaceRoleName) string {
+	return v1alpha1.RoleNameForSpaceRole(space, role)
+}
+
+// MakeRoleBindings creates a RoleBinding for every role in the space that
+// binds the role to the users and groups assigned to it.
+func MakeRoleBindings(space *v1alpha1.Space) ([]*v1.RoleBinding, error) {
+	var out []*v1.RoleBinding
+	for _, role := range v1alpha1.SpaceRoleNames {
+		out = append(out, &v1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      RoleBindingName(space, role),
+				Namespace: v1alpha1.NamespaceName(space),
+				OwnerReferences: []metav1.OwnerReference{
+					*kmeta.NewControllerRef(space),
+				},
+				Labels: v1alpha1.UnionMaps(space.GetLabels(), map[string]string{
+					managedByLabel: "kf",
+				}),
+			},
+			Subjects: makeSubjects(space, role),
+			RoleRef: v1.RoleRef{
+				APIGroup: v1.GroupName,
+				Kind:     "Role",
+				Name:     v1alpha1.RoleNameForSpaceRole(space, role),
+			},
+		})
+	}
+
+	return out, nil
+}
+
+// ManagerClusterRoleBindingName gets the name of the ClusterRoleBinding that
+// lets the space's managers update the Space.
+func ManagerClusterRoleBindingName(space *v1alpha1.Space) string {
+	return fmt.Sprintf("kf-space-manager-%s", space.Name)
+}
+
+// MakeManagerClusterRoleBinding creates a ClusterRoleBinding that lets the
+// space's managers update the Space so they can assign roles.
+func MakeManagerClusterRoleBinding(space *v1alpha1.Space) (*v1.ClusterRoleBinding, error) {
+	return &v1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ManagerClusterRoleBindingName(space),
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(space),
+			},
+			Labels: v1alpha1.UnionMaps(space.GetLabels(), map[string]string{
+				managedByLabel: "kf",
+			}),
+		},
+		Subjects: makeSubjects(space, v1alpha1.SpaceManager),
+		RoleRef: v1.RoleRef{
+			APIGroup: v1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     ManagerClusterRoleName(space),
+		},
+	}, nil
+}
+
+func makeSubjects(space *v1alpha1.Space, role v1alpha1.SpaceRoleName) []v1.Subject {
+	var out []v1.Subject
+	for _, r := range space.Spec.Security.Roles {
+		if r.Role != role {
+			continue
+		}
+
+		out = append(out, v1.Subject{
+			APIGroup: v1.GroupName,
+			Kind:     r.Kind,
+			Name:     r.Name,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,84 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"fmt"
+
+	"github.com/google/kf/pkg/apis/kf/v1alpha1"
+)
+
+func ExampleMakeRoleBindings() {
+	space := &v1alpha1.Space{}
+	space.Name = "my-space"
+	space.Spec.Security.Roles = []v1alpha1.SpaceRole{
+		{Role: v1alpha1.SpaceDeveloper, Kind: "User", Name: "alice@example.com"},
+		{Role: v1alpha1.SpaceDeveloper, Kind: "Group", Name: "devs@example.com"},
+		{Role: v1alpha1.SpaceAuditor, Kind: "User", Name: "bob@example.com"},
+	}
+
+	bindings, err := MakeRoleBindings(space)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, binding := range bindings {
+		fmt.Println("Name:", binding.Name)
+		fmt.Println("Namespace:", binding.Namespace)
+		fmt.Println("Role:", binding.RoleRef.Kind, binding.RoleRef.Name)
+		for _, subject := range binding.Subjects {
+			fmt.Println("Subject:", subject.Kind, subject.Name)
+		}
+	}
+
+	// Output: Name: space-manager
+	// Namespace: my-space
+	// Role: Role space-manager
+	// Name: space-developer
+	// Namespace: my-space
+	// Role: Role space-developer
+	// Subject: User alice@example.com
+	// Subject: Group devs@example.com
+	// Name: space-auditor
+	// Namespace: my-space
+	// Role: Role space-auditor
+	// Subject: User bob@example.com
+}
+
+func ExampleMakeManagerClusterRoleBinding() {
+	space := &v1alpha1.Space{}
+	space.Name = "my-space"
+	space.Spec.Security.Roles = []v1alpha1.SpaceRole{
+		{Role: v1alpha1.SpaceManager, Kind: "User", Name: "alice@example.com"},
+		{Role: v1alpha1.SpaceDeveloper, Kind: "User", Name: "bob@example.com"},
+	}
+
+	binding, err := MakeManagerClusterRoleBinding(space)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("Name:", binding.Name)
+	fmt.Println("Managed by:", binding.Labels[managedByLabel])
+	fmt.Println("Role:", binding.RoleRef.Kind, binding.RoleRef.Name)
+	for _, subject := range binding.Subjects {
+		fmt.Println("Subject:", subject.Kind, subject.Name)
+	}
+
+	// Output: Name: kf-space-manager-my-space
+	// Managed by: kf
+	// Role: ClusterRole kf-space-manager-my-space
+	// Subject: User alice@example.com
+}